@@ -546,6 +546,7 @@ func run(o *Options) error {
 	}
 
 	var egressController *egress.EgressController
+	var egressConntrackSyncer *egress.ConntrackSyncer
 
 	var externalIPPoolController *externalippool.ExternalIPPoolController
 	var externalIPController *serviceexternalip.ServiceExternalIPController
@@ -573,16 +574,26 @@ func run(o *Options) error {
 		linkMonitor = linkmonitor.NewLinkMonitor()
 	}
 	if o.enableEgress {
+		// The FQDNs in the destinations of Egresses are resolved with the DNS interception of FQDN policy rules.
+		var fqdnResolver egress.FQDNResolver
+		if antreaPolicyEnabled {
+			fqdnResolver = networkPolicyController
+		}
 		egressController, err = egress.NewEgressController(
 			ofClient, k8sClient, antreaClientProvider, crdClient, ifaceStore, routeClient, nodeConfig.Name, nodeConfig.NodeTransportInterfaceName,
 			memberlistCluster, egressInformer, externalIPPoolInformer, nodeInformer, podUpdateChannel, serviceCIDRProvider, o.config.Egress.MaxEgressIPsPerNode,
 			features.DefaultFeatureGate.Enabled(features.EgressTrafficShaping),
 			features.DefaultFeatureGate.Enabled(features.EgressSeparateSubnet),
 			linkMonitor,
+			groupIDAllocator,
+			fqdnResolver,
 		)
 		if err != nil {
 			return fmt.Errorf("error creating new Egress controller: %v", err)
 		}
+		if features.DefaultFeatureGate.Enabled(features.EgressConntrackSync) {
+			egressConntrackSyncer = egress.NewConntrackSyncer(nodeConfig.Name, memberlistCluster, egressController, nodeInformer.Lister())
+		}
 	}
 	if features.DefaultFeatureGate.Enabled(features.ServiceExternalIP) {
 		externalIPController, err = serviceexternalip.NewServiceExternalIPController(
@@ -669,7 +680,9 @@ func run(o *Options) error {
 			k8sClient,
 			crdClient,
 			packetCaptureInformer,
+			localPodInformer.Get(),
 			ifaceStore,
+			nodeConfig.Name,
 		)
 		if err != nil {
 			return fmt.Errorf("error when creating PacketCapture controller: %v", err)
@@ -778,12 +791,17 @@ func run(o *Options) error {
 		bgpController, err = bgp.NewBGPPolicyController(nodeInformer,
 			serviceInformer,
 			egressInformer,
+			externalIPPoolInformer,
 			bgpPolicyInformer,
 			endpointSliceInformer,
+			localPodInformer.Get(),
+			namespaceInformer,
 			o.enableEgress,
 			k8sClient,
+			routeClient,
 			nodeConfig,
-			networkConfig)
+			networkConfig,
+			serviceConfig)
 		if err != nil {
 			return err
 		}
@@ -814,7 +832,7 @@ func run(o *Options) error {
 			nodeInformer,
 			nodeLatencyMonitorInformer,
 			nodeConfig,
-			networkConfig.TrafficEncapMode,
+			networkConfig,
 		)
 	}
 
@@ -859,6 +877,9 @@ func run(o *Options) error {
 	go networkPolicyController.Run(stopCh)
 	if o.enableEgress {
 		go egressController.Run(stopCh)
+		if egressConntrackSyncer != nil {
+			go egressConntrackSyncer.Run(stopCh)
+		}
 	}
 
 	var mcastController *multicast.Controller
@@ -989,6 +1010,7 @@ func run(o *Options) error {
 		mcastController,
 		externalIPController,
 		bgpController,
+		packetCaptureController,
 		secureServing,
 		authentication,
 		authorization,
@@ -17,6 +17,7 @@ package apis
 import (
 	"strconv"
 	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 
@@ -213,23 +214,78 @@ func (r BGPPolicyResponse) SortRows() bool {
 
 // BGPPeerResponse describes the response struct of bgppeers command.
 type BGPPeerResponse struct {
-	Peer  string `json:"peer,omitempty"`
-	ASN   int32  `json:"asn,omitempty"`
-	State string `json:"state,omitempty"`
+	Peer               string               `json:"peer,omitempty"`
+	ASN                int32                `json:"asn,omitempty"`
+	State              string               `json:"state,omitempty"`
+	UptimeSeconds      int                  `json:"uptimeSeconds,omitempty"`
+	LastError          string               `json:"lastError,omitempty"`
+	MessagesReceived   BGPMessageStatistics `json:"messagesReceived"`
+	MessagesSent       BGPMessageStatistics `json:"messagesSent"`
+	ReceivedPrefixes   uint64               `json:"receivedPrefixes"`
+	AcceptedPrefixes   uint64               `json:"acceptedPrefixes"`
+	AdvertisedPrefixes uint64               `json:"advertisedPrefixes"`
+}
+
+// BGPMessageStatistics describes the counts of the BGP messages exchanged with a BGP peer.
+type BGPMessageStatistics struct {
+	Total        uint64 `json:"total"`
+	Open         uint64 `json:"open"`
+	Update       uint64 `json:"update"`
+	Notification uint64 `json:"notification"`
+	Keepalive    uint64 `json:"keepalive"`
+	Refresh      uint64 `json:"refresh"`
 }
 
 func (r BGPPeerResponse) GetTableHeader() []string {
-	return []string{"PEER", "ASN", "STATE"}
+	return []string{"PEER", "ASN", "STATE", "UPTIME", "RECEIVED", "ACCEPTED", "ADVERTISED", "LAST-ERROR"}
 }
 
 func (r BGPPeerResponse) GetTableRow(_ int) []string {
-	return []string{r.Peer, strconv.Itoa(int(r.ASN)), r.State}
+	var uptime string
+	if r.UptimeSeconds > 0 {
+		uptime = (time.Duration(r.UptimeSeconds) * time.Second).String()
+	}
+	return []string{r.Peer, strconv.Itoa(int(r.ASN)), r.State, uptime, strconv.FormatUint(r.ReceivedPrefixes, 10),
+		strconv.FormatUint(r.AcceptedPrefixes, 10), strconv.FormatUint(r.AdvertisedPrefixes, 10), r.LastError}
 }
 
 func (r BGPPeerResponse) SortRows() bool {
 	return true
 }
 
+// BGPPolicyDryRunResponse describes the response struct of the bgp dryrun command.
+type BGPPolicyDryRunResponse struct {
+	// SelectsNode is true if the candidate BGPPolicy selects the Node.
+	SelectsNode bool `json:"selectsNode"`
+	// EffectiveBGPPolicy is the name of the BGPPolicy which would be effective on the Node if the candidate BGPPolicy
+	// were applied.
+	EffectiveBGPPolicy string `json:"effectiveBGPPolicy,omitempty"`
+	// Routes are the routes which the candidate BGPPolicy would advertise or withdraw compared with the routes
+	// currently advertised on the Node.
+	Routes []BGPRouteChangeResponse `json:"routes,omitempty"`
+}
+
+type BGPRouteChange string
+
+const (
+	// BGPRouteAdded means that the route is not advertised yet and would be advertised.
+	BGPRouteAdded BGPRouteChange = "Added"
+	// BGPRouteWithdrawn means that the route is advertised and would be withdrawn.
+	BGPRouteWithdrawn BGPRouteChange = "Withdrawn"
+	// BGPRouteUpdated means that the route is advertised and would be advertised with different path attributes.
+	BGPRouteUpdated BGPRouteChange = "Updated"
+	// BGPRouteUnchanged means that the route is advertised and would be advertised the same way.
+	BGPRouteUnchanged BGPRouteChange = "Unchanged"
+)
+
+// BGPRouteChangeResponse describes a route in the response of the bgp dryrun command.
+type BGPRouteChangeResponse struct {
+	Route     string         `json:"route,omitempty"`
+	Type      string         `json:"type,omitempty"`
+	K8sObjRef string         `json:"k8sObjRef,omitempty"`
+	Change    BGPRouteChange `json:"change,omitempty"`
+}
+
 // BGPRouteResponse describes the response struct of bgproutes command.
 type BGPRouteResponse struct {
 	Route     string `json:"route,omitempty"`
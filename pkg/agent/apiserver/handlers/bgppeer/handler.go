@@ -82,9 +82,16 @@ func HandleFunc(bq querier.AgentBGPPolicyInfoQuerier) http.HandlerFunc {
 				continue
 			}
 			bgpPeersResp = append(bgpPeersResp, apis.BGPPeerResponse{
-				Peer:  net.JoinHostPort(peer.Address, strconv.Itoa(int(peer.Port))),
-				ASN:   peer.ASN,
-				State: string(peer.SessionState),
+				Peer:               net.JoinHostPort(peer.Address, strconv.Itoa(int(peer.Port))),
+				ASN:                peer.ASN,
+				State:              string(peer.SessionState),
+				UptimeSeconds:      peer.UptimeSeconds,
+				LastError:          peer.LastError,
+				MessagesReceived:   apis.BGPMessageStatistics(peer.MessagesReceived),
+				MessagesSent:       apis.BGPMessageStatistics(peer.MessagesSent),
+				ReceivedPrefixes:   peer.ReceivedPrefixes,
+				AcceptedPrefixes:   peer.AcceptedPrefixes,
+				AdvertisedPrefixes: peer.AdvertisedPrefixes,
 			})
 		}
 		// make sure that we provide a stable order for the API response
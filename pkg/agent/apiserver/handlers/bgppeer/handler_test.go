@@ -46,10 +46,17 @@ var (
 			SessionState: bgp.SessionActive,
 		},
 		{
-			Address:      "192.168.77.200",
-			Port:         179,
-			ASN:          65001,
-			SessionState: bgp.SessionEstablished,
+			Address:            "192.168.77.200",
+			Port:               179,
+			ASN:                65001,
+			SessionState:       bgp.SessionEstablished,
+			UptimeSeconds:      3600,
+			LastError:          "hold-timer-expired",
+			MessagesReceived:   bgp.MessageStatistics{Total: 15, Open: 1, Update: 4, Keepalive: 10},
+			MessagesSent:       bgp.MessageStatistics{Total: 14, Open: 1, Update: 3, Keepalive: 10},
+			ReceivedPrefixes:   8,
+			AcceptedPrefixes:   7,
+			AdvertisedPrefixes: 3,
 		},
 		{
 			Address:      "fec0::196:168:77:251",
@@ -78,9 +85,16 @@ func TestBGPPeerQuery(t *testing.T) {
 			expectedStatus: http.StatusOK,
 			expectedResponse: []apis.BGPPeerResponse{
 				{
-					Peer:  "192.168.77.200:179",
-					ASN:   65001,
-					State: "Established",
+					Peer:               "192.168.77.200:179",
+					ASN:                65001,
+					State:              "Established",
+					UptimeSeconds:      3600,
+					LastError:          "hold-timer-expired",
+					MessagesReceived:   apis.BGPMessageStatistics{Total: 15, Open: 1, Update: 4, Keepalive: 10},
+					MessagesSent:       apis.BGPMessageStatistics{Total: 14, Open: 1, Update: 3, Keepalive: 10},
+					ReceivedPrefixes:   8,
+					AcceptedPrefixes:   7,
+					AdvertisedPrefixes: 3,
 				},
 				{
 					Peer:  "192.168.77.201:179",
@@ -117,9 +131,16 @@ func TestBGPPeerQuery(t *testing.T) {
 			expectedStatus: http.StatusOK,
 			expectedResponse: []apis.BGPPeerResponse{
 				{
-					Peer:  "192.168.77.200:179",
-					ASN:   65001,
-					State: "Established",
+					Peer:               "192.168.77.200:179",
+					ASN:                65001,
+					State:              "Established",
+					UptimeSeconds:      3600,
+					LastError:          "hold-timer-expired",
+					MessagesReceived:   apis.BGPMessageStatistics{Total: 15, Open: 1, Update: 4, Keepalive: 10},
+					MessagesSent:       apis.BGPMessageStatistics{Total: 14, Open: 1, Update: 3, Keepalive: 10},
+					ReceivedPrefixes:   8,
+					AcceptedPrefixes:   7,
+					AdvertisedPrefixes: 3,
 				},
 				{
 					Peer:  "192.168.77.201:179",
@@ -17,11 +17,17 @@ package bgppolicy
 import (
 	"encoding/json"
 	"net/http"
+	"net/netip"
 	"reflect"
+	"slices"
+	"strings"
 
 	"k8s.io/klog/v2"
 
 	"antrea.io/antrea/pkg/agent/apis"
+	"antrea.io/antrea/pkg/agent/bgp"
+	bgpcontroller "antrea.io/antrea/pkg/agent/controller/bgp"
+	"antrea.io/antrea/pkg/apis/crd/v1alpha1"
 	"antrea.io/antrea/pkg/querier"
 )
 
@@ -52,3 +58,97 @@ func HandleFunc(bq querier.AgentBGPPolicyInfoQuerier) http.HandlerFunc {
 		}
 	}
 }
+
+// DryRunHandleFunc returns the function which can handle the BGPPolicy dry-run requests issued by the bgp dryrun
+// command. The request body is the candidate BGPPolicy in JSON.
+func DryRunHandleFunc(bq querier.AgentBGPPolicyInfoQuerier) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if bq == nil || reflect.ValueOf(bq).IsNil() {
+			// The error message must match the "FOO is not enabled" pattern to pass antctl e2e tests.
+			http.Error(w, "bgp is not enabled", http.StatusServiceUnavailable)
+			return
+		}
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		var policy v1alpha1.BGPPolicy
+		if err := json.NewDecoder(r.Body).Decode(&policy); err != nil {
+			http.Error(w, "invalid BGPPolicy: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		if policy.Name == "" {
+			http.Error(w, "invalid BGPPolicy: name is required", http.StatusBadRequest)
+			return
+		}
+
+		result := bq.DryRunBGPPolicy(&policy)
+		dryRunResp := apis.BGPPolicyDryRunResponse{
+			SelectsNode:        result.SelectsNode,
+			EffectiveBGPPolicy: result.EffectiveBGPPolicy,
+			Routes:             getRouteChanges(result.Routes, result.AdvertisedRoutes),
+		}
+		if err := json.NewEncoder(w).Encode(dryRunResp); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			klog.ErrorS(err, "Error when encoding BGPPolicyDryRunResp to json")
+		}
+	}
+}
+
+// getRouteChanges compares the routes which would be advertised with the routes currently advertised by prefix.
+func getRouteChanges(routes, advertisedRoutes map[bgp.Route]bgpcontroller.RouteMetadata) []apis.BGPRouteChangeResponse {
+	advertisedByPrefix := make(map[string]bgp.Route, len(advertisedRoutes))
+	for route := range advertisedRoutes {
+		advertisedByPrefix[route.Prefix] = route
+	}
+	prefixes := make(map[string]struct{}, len(routes))
+	var changes []apis.BGPRouteChangeResponse
+	for route, routeMetadata := range routes {
+		prefixes[route.Prefix] = struct{}{}
+		change := apis.BGPRouteAdded
+		if advertisedRoute, ok := advertisedByPrefix[route.Prefix]; ok {
+			if advertisedRoute == route {
+				change = apis.BGPRouteUnchanged
+			} else {
+				change = apis.BGPRouteUpdated
+			}
+		}
+		changes = append(changes, apis.BGPRouteChangeResponse{
+			Route:     route.Prefix,
+			Type:      string(routeMetadata.Type),
+			K8sObjRef: routeMetadata.K8sObjRef,
+			Change:    change,
+		})
+	}
+	for route, routeMetadata := range advertisedRoutes {
+		if _, ok := prefixes[route.Prefix]; ok {
+			continue
+		}
+		changes = append(changes, apis.BGPRouteChangeResponse{
+			Route:     route.Prefix,
+			Type:      string(routeMetadata.Type),
+			K8sObjRef: routeMetadata.K8sObjRef,
+			Change:    apis.BGPRouteWithdrawn,
+		})
+	}
+	// make sure that we provide a stable order for the API response, in the same way as the bgproutes command
+	slices.SortFunc(changes, func(a, b apis.BGPRouteChangeResponse) int {
+		pA, _ := netip.ParsePrefix(a.Route)
+		pB, _ := netip.ParsePrefix(b.Route)
+		if pA.Addr().Is4() && !pB.Addr().Is4() {
+			return -1
+		}
+		if !pA.Addr().Is4() && pB.Addr().Is4() {
+			return 1
+		}
+		if n := strings.Compare(a.Type, b.Type); n != 0 {
+			return n
+		}
+		if n := pA.Bits() - pB.Bits(); n != 0 {
+			return n
+		}
+		return pA.Addr().Compare(pB.Addr())
+	})
+	return changes
+}
@@ -15,6 +15,7 @@
 package bgppolicy
 
 import (
+	"bytes"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -23,8 +24,12 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"antrea.io/antrea/pkg/agent/apis"
+	"antrea.io/antrea/pkg/agent/bgp"
+	bgpcontroller "antrea.io/antrea/pkg/agent/controller/bgp"
+	"antrea.io/antrea/pkg/apis/crd/v1alpha1"
 	queriertest "antrea.io/antrea/pkg/querier/testing"
 )
 
@@ -74,3 +79,91 @@ func TestBGPPolicyQuery(t *testing.T) {
 		})
 	}
 }
+
+func TestBGPPolicyDryRun(t *testing.T) {
+	policy := &v1alpha1.BGPPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "policy-2"},
+		Spec: v1alpha1.BGPPolicySpec{
+			LocalASN:       65000,
+			Advertisements: v1alpha1.Advertisements{Pod: &v1alpha1.PodAdvertisement{}},
+		},
+	}
+	policyJSON, err := json.Marshal(policy)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name             string
+		method           string
+		body             []byte
+		dryRunResult     *bgpcontroller.BGPPolicyDryRunResult
+		expectedStatus   int
+		expectedResponse apis.BGPPolicyDryRunResponse
+	}{
+		{
+			name:   "route changes",
+			method: http.MethodPost,
+			body:   policyJSON,
+			dryRunResult: &bgpcontroller.BGPPolicyDryRunResult{
+				SelectsNode:        true,
+				EffectiveBGPPolicy: "policy-1",
+				Routes: map[bgp.Route]bgpcontroller.RouteMetadata{
+					{Prefix: "10.10.0.0/24"}: {Type: bgpcontroller.NodeIPAMPodCIDR},
+					{Prefix: "fec0:10:10::/64", Attributes: bgp.RouteAttributes{Communities: "65000:100"}}: {Type: bgpcontroller.NodeIPAMPodCIDR},
+					{Prefix: "10.96.10.10/32"}: {Type: bgpcontroller.ServiceClusterIP, K8sObjRef: "default/svc-1"},
+				},
+				AdvertisedRoutes: map[bgp.Route]bgpcontroller.RouteMetadata{
+					{Prefix: "10.10.0.0/24"}:     {Type: bgpcontroller.NodeIPAMPodCIDR},
+					{Prefix: "fec0:10:10::/64"}:  {Type: bgpcontroller.NodeIPAMPodCIDR},
+					{Prefix: "192.168.77.10/32"}: {Type: bgpcontroller.EgressIP, K8sObjRef: "egress-1"},
+				},
+			},
+			expectedStatus: http.StatusOK,
+			expectedResponse: apis.BGPPolicyDryRunResponse{
+				SelectsNode:        true,
+				EffectiveBGPPolicy: "policy-1",
+				Routes: []apis.BGPRouteChangeResponse{
+					{Route: "192.168.77.10/32", Type: "EgressIP", K8sObjRef: "egress-1", Change: apis.BGPRouteWithdrawn},
+					{Route: "10.10.0.0/24", Type: "NodeIPAMPodCIDR", Change: apis.BGPRouteUnchanged},
+					{Route: "10.96.10.10/32", Type: "ServiceClusterIP", K8sObjRef: "default/svc-1", Change: apis.BGPRouteAdded},
+					{Route: "fec0:10:10::/64", Type: "NodeIPAMPodCIDR", Change: apis.BGPRouteUpdated},
+				},
+			},
+		},
+		{
+			name:           "invalid method",
+			method:         http.MethodGet,
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			name:           "invalid body",
+			method:         http.MethodPost,
+			body:           []byte("invalid"),
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			q := queriertest.NewMockAgentBGPPolicyInfoQuerier(ctrl)
+			if tt.dryRunResult != nil {
+				q.EXPECT().DryRunBGPPolicy(policy).Return(tt.dryRunResult)
+			}
+			handler := DryRunHandleFunc(q)
+
+			req, err := http.NewRequest(tt.method, "", bytes.NewReader(tt.body))
+			require.NoError(t, err)
+
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+			assert.Equal(t, tt.expectedStatus, recorder.Code)
+
+			if tt.expectedStatus == http.StatusOK {
+				var received apis.BGPPolicyDryRunResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &received)
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, received)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packetcapture
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/agent/packetcapture"
+	"antrea.io/antrea/pkg/querier"
+)
+
+// flushWriter flushes the response after each write, so that the streamed packets are received as
+// soon as they are captured.
+type flushWriter struct {
+	w       http.ResponseWriter
+	written bool
+}
+
+func (fw *flushWriter) Write(p []byte) (int, error) {
+	if !fw.written {
+		fw.w.Header().Set("Content-Type", "application/octet-stream")
+		fw.written = true
+	}
+	n, err := fw.w.Write(p)
+	if flusher, ok := fw.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+	return n, err
+}
+
+func errorStatus(err error) int {
+	switch {
+	case errors.Is(err, packetcapture.ErrPacketCaptureNotFound), errors.Is(err, packetcapture.ErrPacketsFileNotAvailable):
+		return http.StatusNotFound
+	case errors.Is(err, packetcapture.ErrPacketCaptureNotRunning):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// HandleFunc returns the function which can handle the requests issued by the packetcapture
+// command, to download the packets captured on the Node for a PacketCapture, or to stream them
+// while the capture is running when the "follow" flag is set.
+func HandleFunc(pcq querier.AgentPacketCaptureQuerier) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if pcq == nil || reflect.ValueOf(pcq).IsNil() {
+			// The error message must match the "FOO is not enabled" pattern to pass antctl e2e tests.
+			http.Error(w, "packetcapture is not enabled", http.StatusServiceUnavailable)
+			return
+		}
+
+		values := r.URL.Query()
+		name := values.Get("name")
+		if name == "" {
+			http.Error(w, "name is required", http.StatusBadRequest)
+			return
+		}
+		var follow bool
+		if values.Has("follow") {
+			if values.Get("follow") != "" {
+				http.Error(w, "invalid query", http.StatusBadRequest)
+				return
+			}
+			follow = true
+		}
+
+		if follow {
+			fw := &flushWriter{w: w}
+			if err := pcq.StreamPacketCapture(r.Context(), name, fw); err != nil {
+				// The status can only be set if nothing has been streamed yet.
+				if !fw.written {
+					http.Error(w, err.Error(), errorStatus(err))
+					return
+				}
+				// The client may have stopped receiving the packets.
+				if r.Context().Err() == nil {
+					klog.ErrorS(err, "Error when streaming captured packets", "name", name)
+				}
+			}
+			return
+		}
+
+		file, err := pcq.GetPacketCaptureFile(name)
+		if err != nil {
+			http.Error(w, err.Error(), errorStatus(err))
+			return
+		}
+		defer file.Close()
+		w.Header().Set("Content-Type", "application/octet-stream")
+		if _, err := io.Copy(w, file); err != nil {
+			klog.ErrorS(err, "Error when sending captured packets", "name", name)
+		}
+	}
+}
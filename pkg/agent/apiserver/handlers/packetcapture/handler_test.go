@@ -0,0 +1,133 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packetcapture
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"antrea.io/antrea/pkg/agent/packetcapture"
+	queriertest "antrea.io/antrea/pkg/querier/testing"
+)
+
+func TestPacketCaptureQuery(t *testing.T) {
+	tests := []struct {
+		name           string
+		url            string
+		expectedCalls  func(mockQuerier *queriertest.MockAgentPacketCaptureQuerier)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "download packets file",
+			url:  "?name=pc1",
+			expectedCalls: func(mockQuerier *queriertest.MockAgentPacketCaptureQuerier) {
+				mockQuerier.EXPECT().GetPacketCaptureFile("pc1").Return(io.NopCloser(strings.NewReader("packets")), nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "packets",
+		},
+		{
+			name: "packets file not available",
+			url:  "?name=pc1",
+			expectedCalls: func(mockQuerier *queriertest.MockAgentPacketCaptureQuerier) {
+				mockQuerier.EXPECT().GetPacketCaptureFile("pc1").Return(nil, packetcapture.ErrPacketsFileNotAvailable)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name: "stream packets",
+			url:  "?name=pc1&follow",
+			expectedCalls: func(mockQuerier *queriertest.MockAgentPacketCaptureQuerier) {
+				mockQuerier.EXPECT().StreamPacketCapture(gomock.Any(), "pc1", gomock.Any()).DoAndReturn(func(ctx context.Context, name string, w io.Writer) error {
+					w.Write([]byte("packet1"))
+					w.Write([]byte("packet2"))
+					return nil
+				})
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "packet1packet2",
+		},
+		{
+			name: "stream error after packets are sent",
+			url:  "?name=pc1&follow",
+			expectedCalls: func(mockQuerier *queriertest.MockAgentPacketCaptureQuerier) {
+				mockQuerier.EXPECT().StreamPacketCapture(gomock.Any(), "pc1", gomock.Any()).DoAndReturn(func(ctx context.Context, name string, w io.Writer) error {
+					w.Write([]byte("packet1"))
+					return errors.New("write error")
+				})
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "packet1",
+		},
+		{
+			name: "capture not running",
+			url:  "?name=pc1&follow",
+			expectedCalls: func(mockQuerier *queriertest.MockAgentPacketCaptureQuerier) {
+				mockQuerier.EXPECT().StreamPacketCapture(gomock.Any(), "pc1", gomock.Any()).Return(packetcapture.ErrPacketCaptureNotRunning)
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name: "capture not found",
+			url:  "?name=pc1&follow",
+			expectedCalls: func(mockQuerier *queriertest.MockAgentPacketCaptureQuerier) {
+				mockQuerier.EXPECT().StreamPacketCapture(gomock.Any(), "pc1", gomock.Any()).Return(packetcapture.ErrPacketCaptureNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "missing name",
+			url:            "?follow",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "flag with value",
+			url:            "?name=pc1&follow=true",
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			q := queriertest.NewMockAgentPacketCaptureQuerier(ctrl)
+			if tt.expectedCalls != nil {
+				tt.expectedCalls(q)
+			}
+			handler := HandleFunc(q)
+
+			req, err := http.NewRequest(http.MethodGet, tt.url, nil)
+			require.NoError(t, err)
+
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+			assert.Equal(t, tt.expectedStatus, recorder.Code)
+			if tt.expectedStatus == http.StatusOK {
+				assert.Equal(t, tt.expectedBody, recorder.Body.String())
+				assert.Equal(t, "application/octet-stream", recorder.Header().Get("Content-Type"))
+			}
+		})
+	}
+}
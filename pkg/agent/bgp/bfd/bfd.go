@@ -0,0 +1,547 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bfd implements the asynchronous mode of single-hop Bidirectional Forwarding Detection (BFD), as defined in
+// RFC 5880 and RFC 5881, which is used to detect the failures of BGP peers in less than a second. The Echo function,
+// the Demand mode and authentication are not supported.
+package bfd
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"net/netip"
+	"sync"
+	"time"
+
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
+	"k8s.io/klog/v2"
+)
+
+// State is the state of a BFD session.
+type State uint8
+
+const (
+	StateAdminDown State = iota
+	StateDown
+	StateInit
+	StateUp
+)
+
+func (s State) String() string {
+	switch s {
+	case StateAdminDown:
+		return "AdminDown"
+	case StateDown:
+		return "Down"
+	case StateInit:
+		return "Init"
+	case StateUp:
+		return "Up"
+	default:
+		return "Unknown"
+	}
+}
+
+const (
+	// controlPort is the UDP destination port of single-hop BFD control packets.
+	controlPort = 3784
+	// The source port of control packets must be in the range 49152 through 65535.
+	minSourcePort = 49152
+	maxSourcePort = 65535
+	// Control packets of single-hop BFD are sent with the TTL or Hop Limit 255, so that they cannot be forwarded, and
+	// the received packets with another TTL or Hop Limit are dropped.
+	ttl = 255
+)
+
+// slowTxInterval is the minimum interval at which control packets are sent when a session is not Up. It is a variable
+// so that it can be shortened in tests.
+var slowTxInterval = time.Second
+
+// Config is the configuration of a BFD session.
+type Config struct {
+	// MinInterval is the desired minimum interval at which control packets are sent to and received from the peer.
+	MinInterval time.Duration
+	// Multiplier is the number of control packets which can be missed before the session is declared down.
+	Multiplier uint8
+}
+
+// StateChangeHandler is called when the state of a BFD session changes. It must not call the methods of the Manager.
+type StateChangeHandler func(peer netip.Addr, state State)
+
+type session struct {
+	peer               netip.Addr
+	config             Config
+	localDiscriminator uint32
+	conn               net.Conn
+
+	mutex sync.RWMutex
+	state State
+	diag  uint8
+	// polling is true when a Poll Sequence is in progress.
+	polling bool
+	// pendingFinal is true when a packet with the Final bit must be sent in response to a Poll.
+	pendingFinal               bool
+	remoteDiscriminator        uint32
+	remoteDesiredMinTxInterval time.Duration
+	remoteMinRxInterval        time.Duration
+	remoteDetectMult           uint8
+
+	rxCh   chan *controlPacket
+	stopCh chan struct{}
+	doneCh chan struct{}
+}
+
+func newSession(peer netip.Addr, config Config, localDiscriminator uint32) *session {
+	return &session{
+		peer:               peer,
+		config:             config,
+		localDiscriminator: localDiscriminator,
+		state:              StateDown,
+		rxCh:               make(chan *controlPacket, 16),
+		stopCh:             make(chan struct{}),
+		doneCh:             make(chan struct{}),
+	}
+}
+
+func (s *session) getState() State {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.state
+}
+
+func (s *session) setState(state State, diag uint8) {
+	klog.InfoS("BFD session state changed", "peer", s.peer, "from", s.state, "to", state, "diag", diag)
+	s.state = state
+	s.diag = diag
+	// The interval at which packets are sent is decreased when the session becomes Up, which must be signaled with a
+	// Poll Sequence.
+	if state == StateUp {
+		s.polling = true
+	}
+}
+
+// handlePacket updates the session according to a received control packet, following RFC 5880 section 6.8.6. It
+// returns whether the clients of the session should be notified of a state change.
+func (s *session) handlePacket(p *controlPacket) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.remoteDiscriminator = p.myDiscriminator
+	s.remoteDesiredMinTxInterval = time.Duration(p.desiredMinTxInterval) * time.Microsecond
+	s.remoteMinRxInterval = time.Duration(p.requiredMinRxInterval) * time.Microsecond
+	s.remoteDetectMult = p.detectMult
+	if p.final {
+		s.polling = false
+	}
+	if p.poll {
+		s.pendingFinal = true
+	}
+
+	if s.state == StateAdminDown {
+		return false
+	}
+	if p.state == StateAdminDown {
+		// The peer disabling the session is not a failure of the path, so it is not notified to the clients.
+		if s.state != StateDown {
+			s.setState(StateDown, diagNeighborSignaledSessionDown)
+		}
+		return false
+	}
+	oldState := s.state
+	switch s.state {
+	case StateDown:
+		if p.state == StateDown {
+			s.setState(StateInit, diagNone)
+		} else if p.state == StateInit {
+			s.setState(StateUp, diagNone)
+		}
+	case StateInit:
+		if p.state == StateInit || p.state == StateUp {
+			s.setState(StateUp, diagNone)
+		}
+	case StateUp:
+		if p.state == StateDown {
+			s.setState(StateDown, diagNeighborSignaledSessionDown)
+		}
+	}
+	return s.state != oldState
+}
+
+// handleDetectionTimeout brings the session down when no packet is received within the detection time. It returns
+// whether the state is changed.
+func (s *session) handleDetectionTimeout() bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.state != StateInit && s.state != StateUp {
+		return false
+	}
+	s.setState(StateDown, diagControlDetectionTimeExpired)
+	s.remoteDiscriminator = 0
+	s.polling = false
+	return true
+}
+
+func (s *session) desiredMinTxInterval() time.Duration {
+	if s.state != StateUp {
+		return max(s.config.MinInterval, slowTxInterval)
+	}
+	return s.config.MinInterval
+}
+
+// detectionTime returns how long the session waits for a packet from the peer before it is declared down.
+func (s *session) detectionTime() time.Duration {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return time.Duration(s.remoteDetectMult) * max(s.config.MinInterval, s.remoteDesiredMinTxInterval)
+}
+
+// txInterval returns the interval until the next control packet is sent, with the jitter required by RFC 5880
+// section 6.8.7.
+func (s *session) txInterval() time.Duration {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	interval := max(s.desiredMinTxInterval(), s.remoteMinRxInterval)
+	maxPercentage := 100
+	if s.config.Multiplier == 1 {
+		maxPercentage = 90
+	}
+	return interval * time.Duration(75+rand.Intn(maxPercentage-75+1)) / 100
+}
+
+func (s *session) buildPacket() *controlPacket {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	p := &controlPacket{
+		diag:                  s.diag,
+		state:                 s.state,
+		detectMult:            s.config.Multiplier,
+		myDiscriminator:       s.localDiscriminator,
+		yourDiscriminator:     s.remoteDiscriminator,
+		desiredMinTxInterval:  uint32(s.desiredMinTxInterval() / time.Microsecond),
+		requiredMinRxInterval: uint32(s.config.MinInterval / time.Microsecond),
+	}
+	// The Poll and Final bits must not be set at the same time.
+	if s.pendingFinal {
+		p.final = true
+		s.pendingFinal = false
+	} else {
+		p.poll = s.polling
+	}
+	return p
+}
+
+func (s *session) sendPacket() {
+	if _, err := s.conn.Write(s.buildPacket().marshal()); err != nil {
+		klog.V(2).InfoS("Failed to send BFD control packet", "peer", s.peer, "err", err)
+	}
+}
+
+func (s *session) run(onStateChange StateChangeHandler) {
+	defer close(s.doneCh)
+
+	txTimer := time.NewTimer(0)
+	defer txTimer.Stop()
+	// The detection timer is started once a packet is received from the peer.
+	detectionTimer := time.NewTimer(0)
+	detectionTimer.Stop()
+	defer detectionTimer.Stop()
+
+	for {
+		select {
+		case <-s.stopCh:
+			// Signal AdminDown to the peer, so that it doesn't consider the removal of the session as a failure.
+			s.mutex.Lock()
+			s.state = StateAdminDown
+			s.diag = diagAdministrativelyDown
+			s.mutex.Unlock()
+			s.sendPacket()
+			return
+		case p := <-s.rxCh:
+			changed := s.handlePacket(p)
+			detectionTimer.Reset(s.detectionTime())
+			if changed {
+				onStateChange(s.peer, s.getState())
+			}
+			// Reply immediately to a state change or a Poll, instead of waiting for the next periodic packet.
+			if changed || p.poll {
+				s.sendPacket()
+			}
+		case <-detectionTimer.C:
+			if s.handleDetectionTimeout() {
+				onStateChange(s.peer, s.getState())
+			}
+		case <-txTimer.C:
+			s.mutex.RLock()
+			// The peer doesn't want to receive periodic packets if its Required Min RX Interval is 0.
+			skip := s.remoteDetectMult != 0 && s.remoteMinRxInterval == 0
+			s.mutex.RUnlock()
+			if !skip {
+				s.sendPacket()
+			}
+			txTimer.Reset(s.txInterval())
+		}
+	}
+}
+
+func (s *session) stop() {
+	close(s.stopCh)
+	<-s.doneCh
+	s.conn.Close()
+}
+
+// Manager manages the BFD sessions with BGP peers. It receives the control packets of all sessions on a single UDP
+// socket per address family, and dispatches them to the sessions by discriminator or by peer address.
+type Manager struct {
+	onStateChange StateChangeHandler
+	// The ports are only changed in tests.
+	listenPort int
+	peerPort   int
+
+	mutex sync.RWMutex
+	// listeners are the UDP sockets on which control packets are received, keyed by whether they are for IPv6.
+	listeners      map[bool]*net.UDPConn
+	sessions       map[netip.Addr]*session
+	discriminators map[uint32]*session
+}
+
+func NewManager(onStateChange StateChangeHandler) *Manager {
+	return &Manager{
+		onStateChange:  onStateChange,
+		listenPort:     controlPort,
+		peerPort:       controlPort,
+		listeners:      make(map[bool]*net.UDPConn),
+		sessions:       make(map[netip.Addr]*session),
+		discriminators: make(map[uint32]*session),
+	}
+}
+
+// AddSession creates a BFD session with the peer. If a session with the peer already exists, it is recreated when the
+// configuration is changed.
+func (m *Manager) AddSession(peer netip.Addr, config Config) error {
+	if config.MinInterval <= 0 || config.Multiplier == 0 {
+		return fmt.Errorf("invalid BFD configuration %+v", config)
+	}
+	peer = peer.Unmap()
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if s, exists := m.sessions[peer]; exists {
+		if s.config == config {
+			return nil
+		}
+		m.deleteSession(s)
+	}
+	if err := m.listen(peer.Is6()); err != nil {
+		return err
+	}
+	conn, err := dialPeer(peer, m.peerPort)
+	if err != nil {
+		return err
+	}
+	s := newSession(peer, config, m.newDiscriminator())
+	s.conn = conn
+	m.sessions[peer] = s
+	m.discriminators[s.localDiscriminator] = s
+	go s.run(m.onStateChange)
+	klog.InfoS("Added BFD session", "peer", peer, "minInterval", config.MinInterval, "multiplier", config.Multiplier)
+	return nil
+}
+
+// RemoveSession deletes the BFD session with the peer. It returns whether the session existed.
+func (m *Manager) RemoveSession(peer netip.Addr) bool {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	s, exists := m.sessions[peer.Unmap()]
+	if exists {
+		m.deleteSession(s)
+		klog.InfoS("Removed BFD session", "peer", peer)
+	}
+	return exists
+}
+
+// GetSessionState returns the state of the BFD session with the peer, and whether the session exists.
+func (m *Manager) GetSessionState(peer netip.Addr) (State, bool) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	s, exists := m.sessions[peer.Unmap()]
+	if !exists {
+		return StateAdminDown, false
+	}
+	return s.getState(), true
+}
+
+// Stop deletes all the BFD sessions and stops receiving control packets.
+func (m *Manager) Stop() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for _, s := range m.sessions {
+		m.deleteSession(s)
+	}
+	for isIPv6, listener := range m.listeners {
+		listener.Close()
+		delete(m.listeners, isIPv6)
+	}
+}
+
+func (m *Manager) deleteSession(s *session) {
+	s.stop()
+	delete(m.sessions, s.peer)
+	delete(m.discriminators, s.localDiscriminator)
+}
+
+func (m *Manager) newDiscriminator() uint32 {
+	for {
+		d := rand.Uint32()
+		if _, exists := m.discriminators[d]; d != 0 && !exists {
+			return d
+		}
+	}
+}
+
+// packetReader reads a control packet into buf, and returns its length, its TTL or Hop Limit, and its source address.
+type packetReader func(buf []byte) (int, int, net.Addr, error)
+
+// listen creates the UDP socket on which control packets are received from the peers of the address family, if it
+// doesn't exist yet. The TTL or Hop Limit of the received packets is retrieved from the IP_TTL or IPV6_HOPLIMIT
+// control messages.
+func (m *Manager) listen(isIPv6 bool) error {
+	if _, exists := m.listeners[isIPv6]; exists {
+		return nil
+	}
+	network := "udp4"
+	if isIPv6 {
+		network = "udp6"
+	}
+	listener, err := net.ListenUDP(network, &net.UDPAddr{Port: m.listenPort})
+	if err != nil {
+		return fmt.Errorf("failed to listen for BFD control packets: %w", err)
+	}
+	var read packetReader
+	if isIPv6 {
+		conn := ipv6.NewPacketConn(listener)
+		err = conn.SetControlMessage(ipv6.FlagHopLimit, true)
+		read = func(buf []byte) (int, int, net.Addr, error) {
+			n, cm, addr, err := conn.ReadFrom(buf)
+			if cm == nil {
+				return n, 0, addr, err
+			}
+			return n, cm.HopLimit, addr, err
+		}
+	} else {
+		conn := ipv4.NewPacketConn(listener)
+		err = conn.SetControlMessage(ipv4.FlagTTL, true)
+		read = func(buf []byte) (int, int, net.Addr, error) {
+			n, cm, addr, err := conn.ReadFrom(buf)
+			if cm == nil {
+				return n, 0, addr, err
+			}
+			return n, cm.TTL, addr, err
+		}
+	}
+	if err != nil {
+		listener.Close()
+		return fmt.Errorf("failed to enable receiving the TTL of BFD control packets: %w", err)
+	}
+	m.listeners[isIPv6] = listener
+	go m.receive(read)
+	return nil
+}
+
+func (m *Manager) receive(read packetReader) {
+	buf := make([]byte, 1500)
+	for {
+		n, receivedTTL, addr, err := read(buf)
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
+			klog.ErrorS(err, "Failed to receive BFD control packet")
+			continue
+		}
+		// The Generalized TTL Security Mechanism (RFC 5082) ensures that the packet was sent by a directly connected
+		// peer, as required by RFC 5881.
+		if receivedTTL != ttl {
+			klog.V(4).InfoS("Dropped BFD control packet with unexpected TTL", "source", addr, "ttl", receivedTTL)
+			continue
+		}
+		p, err := parseControlPacket(buf[:n])
+		if err != nil {
+			klog.V(4).InfoS("Dropped invalid BFD control packet", "source", addr, "err", err)
+			continue
+		}
+		udpAddr, ok := addr.(*net.UDPAddr)
+		if !ok {
+			continue
+		}
+		m.dispatch(udpAddr.AddrPort().Addr().Unmap(), p)
+	}
+}
+
+// dispatch delivers a received packet to the session it belongs to. The session is identified by Your Discriminator
+// if it is set, or by the source address of the packet otherwise.
+func (m *Manager) dispatch(source netip.Addr, p *controlPacket) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	var s *session
+	if p.yourDiscriminator != 0 {
+		s = m.discriminators[p.yourDiscriminator]
+	} else {
+		s = m.sessions[source]
+	}
+	if s == nil || s.peer != source {
+		klog.V(4).InfoS("Dropped BFD control packet for unknown session", "source", source, "discriminator", p.yourDiscriminator)
+		return
+	}
+	select {
+	case s.rxCh <- p:
+	default:
+		klog.V(2).InfoS("Dropped BFD control packet as the session is busy", "peer", source)
+	}
+}
+
+// dialPeer creates the UDP connection on which control packets are sent to the peer.
+func dialPeer(peer netip.Addr, port int) (*net.UDPConn, error) {
+	var conn *net.UDPConn
+	var err error
+	// Retry with another source port if the chosen one is in use.
+	for i := 0; i < 10; i++ {
+		localAddr := &net.UDPAddr{Port: minSourcePort + rand.Intn(maxSourcePort-minSourcePort+1)}
+		conn, err = net.DialUDP("udp", localAddr, net.UDPAddrFromAddrPort(netip.AddrPortFrom(peer, uint16(port))))
+		if err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create BFD connection to %s: %w", peer, err)
+	}
+	if peer.Is4() {
+		err = ipv4.NewConn(conn).SetTTL(ttl)
+	} else {
+		err = ipv6.NewConn(conn).SetHopLimit(ttl)
+	}
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to set TTL of BFD connection to %s: %w", peer, err)
+	}
+	return conn, nil
+}
@@ -0,0 +1,232 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bfd
+
+import (
+	"net"
+	"net/netip"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/ipv4"
+)
+
+var (
+	peer1       = netip.MustParseAddr("192.168.77.100")
+	peer2       = netip.MustParseAddr("192.168.77.101")
+	localhostV4 = netip.MustParseAddr("127.0.0.1")
+)
+
+func TestControlPacket(t *testing.T) {
+	p := &controlPacket{
+		diag:                  diagControlDetectionTimeExpired,
+		state:                 StateUp,
+		poll:                  true,
+		detectMult:            3,
+		myDiscriminator:       1,
+		yourDiscriminator:     2,
+		desiredMinTxInterval:  300000,
+		requiredMinRxInterval: 100000,
+	}
+	b := p.marshal()
+	assert.Equal(t, []byte{0x21, 0xe0, 3, 24, 0, 0, 0, 1, 0, 0, 0, 2, 0, 0x04, 0x93, 0xe0, 0, 0x01, 0x86, 0xa0, 0, 0, 0, 0}, b)
+	parsed, err := parseControlPacket(b)
+	require.NoError(t, err)
+	assert.Equal(t, p, parsed)
+
+	tests := []struct {
+		name   string
+		modify func(b []byte) []byte
+	}{
+		{name: "too short", modify: func(b []byte) []byte { return b[:20] }},
+		{name: "invalid version", modify: func(b []byte) []byte { b[0] = 0x41; return b }},
+		{name: "invalid length", modify: func(b []byte) []byte { b[3] = 32; return b }},
+		{name: "authentication", modify: func(b []byte) []byte { b[1] |= flagAuth; return b }},
+		{name: "multipoint", modify: func(b []byte) []byte { b[1] |= flagMultipoint; return b }},
+		{name: "zero detect multiplier", modify: func(b []byte) []byte { b[2] = 0; return b }},
+		{name: "zero my discriminator", modify: func(b []byte) []byte { b[7] = 0; return b }},
+		{name: "zero your discriminator when up", modify: func(b []byte) []byte { b[11] = 0; return b }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseControlPacket(tt.modify(p.marshal()))
+			assert.Error(t, err)
+		})
+	}
+}
+
+// exchange delivers a control packet built by one session to another session, and returns whether the receiving
+// session notifies a state change.
+func exchange(t *testing.T, from, to *session) bool {
+	p, err := parseControlPacket(from.buildPacket().marshal())
+	require.NoError(t, err)
+	return to.handlePacket(p)
+}
+
+func TestSessionStateMachine(t *testing.T) {
+	config := Config{MinInterval: 100 * time.Millisecond, Multiplier: 3}
+	s1 := newSession(peer2, config, 1)
+	s2 := newSession(peer1, config, 2)
+
+	// Three-way handshake.
+	assert.True(t, exchange(t, s1, s2))
+	assert.Equal(t, StateInit, s2.getState())
+	assert.True(t, exchange(t, s2, s1))
+	assert.Equal(t, StateUp, s1.getState())
+	assert.True(t, exchange(t, s1, s2))
+	assert.Equal(t, StateUp, s2.getState())
+
+	// The sessions send packets at the slow rate until they are Up, and the detection time is based on the interval
+	// advertised by the peer.
+	assert.Equal(t, 3*slowTxInterval, s1.detectionTime())
+	assert.True(t, s1.polling)
+	p := s1.buildPacket()
+	assert.True(t, p.poll)
+	assert.Equal(t, uint32(100000), p.desiredMinTxInterval)
+	// The peer replies to the Poll with the Final bit, which terminates the Poll Sequence.
+	assert.False(t, exchange(t, s1, s2))
+	p = s2.buildPacket()
+	assert.True(t, p.final)
+	assert.False(t, p.poll)
+	assert.False(t, s1.handlePacket(p))
+	assert.False(t, s1.polling)
+	assert.Equal(t, 300*time.Millisecond, s1.detectionTime())
+	interval := s1.txInterval()
+	assert.GreaterOrEqual(t, interval, 75*time.Millisecond)
+	assert.LessOrEqual(t, interval, 100*time.Millisecond)
+
+	// The session goes down when no packet is received within the detection time.
+	assert.True(t, s1.handleDetectionTimeout())
+	assert.Equal(t, StateDown, s1.getState())
+	assert.Equal(t, diagControlDetectionTimeExpired, s1.buildPacket().diag)
+	assert.False(t, s1.handleDetectionTimeout())
+	// The peer follows the session down.
+	assert.True(t, exchange(t, s1, s2))
+	assert.Equal(t, StateDown, s2.getState())
+	assert.Equal(t, diagNeighborSignaledSessionDown, s2.buildPacket().diag)
+
+	// A session brought down by an AdminDown peer doesn't notify its clients.
+	assert.True(t, exchange(t, s1, s2))
+	assert.True(t, exchange(t, s2, s1))
+	assert.Equal(t, StateUp, s1.getState())
+	s2.state = StateAdminDown
+	assert.False(t, exchange(t, s2, s1))
+	assert.Equal(t, StateDown, s1.getState())
+}
+
+func getFreeUDPPort(t *testing.T) int {
+	conn, err := net.ListenUDP("udp", &net.UDPAddr{})
+	require.NoError(t, err)
+	defer conn.Close()
+	return conn.LocalAddr().(*net.UDPAddr).Port
+}
+
+type stateRecorder struct {
+	mutex  sync.Mutex
+	states []State
+}
+
+func (r *stateRecorder) onStateChange(_ netip.Addr, state State) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.states = append(r.states, state)
+}
+
+func (r *stateRecorder) getStates() []State {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return append([]State(nil), r.states...)
+}
+
+func TestManager(t *testing.T) {
+	defer func(interval time.Duration) {
+		slowTxInterval = interval
+	}(slowTxInterval)
+	slowTxInterval = 20 * time.Millisecond
+
+	port1, port2 := getFreeUDPPort(t), getFreeUDPPort(t)
+	recorder1, recorder2 := &stateRecorder{}, &stateRecorder{}
+	m1 := NewManager(recorder1.onStateChange)
+	m1.listenPort, m1.peerPort = port1, port2
+	defer m1.Stop()
+	m2 := NewManager(recorder2.onStateChange)
+	m2.listenPort, m2.peerPort = port2, port1
+	defer m2.Stop()
+
+	config := Config{MinInterval: 10 * time.Millisecond, Multiplier: 3}
+	assert.Error(t, m1.AddSession(localhostV4, Config{Multiplier: 3}))
+	require.NoError(t, m1.AddSession(localhostV4, config))
+	require.NoError(t, m2.AddSession(localhostV4, config))
+	// Adding a session with the same configuration again is a no-op.
+	require.NoError(t, m1.AddSession(localhostV4, config))
+
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		state1, _ := m1.GetSessionState(localhostV4)
+		state2, _ := m2.GetSessionState(localhostV4)
+		assert.Equal(c, StateUp, state1)
+		assert.Equal(c, StateUp, state2)
+	}, 2*time.Second, 10*time.Millisecond)
+	assert.Equal(t, StateUp, recorder1.getStates()[len(recorder1.getStates())-1])
+
+	// Removing the session on one side signals AdminDown to the other side, which is not notified as a failure.
+	assert.True(t, m2.RemoveSession(localhostV4))
+	assert.False(t, m2.RemoveSession(localhostV4))
+	_, exists := m2.GetSessionState(localhostV4)
+	assert.False(t, exists)
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		state1, _ := m1.GetSessionState(localhostV4)
+		assert.Equal(c, StateDown, state1)
+	}, 2*time.Second, 10*time.Millisecond)
+	assert.Equal(t, StateUp, recorder1.getStates()[len(recorder1.getStates())-1])
+}
+
+func TestManagerDropsPacketsWithUnexpectedTTL(t *testing.T) {
+	port1, port2 := getFreeUDPPort(t), getFreeUDPPort(t)
+	m := NewManager(func(netip.Addr, State) {})
+	m.listenPort, m.peerPort = port1, port2
+	defer m.Stop()
+	require.NoError(t, m.AddSession(localhostV4, Config{MinInterval: time.Second, Multiplier: 3}))
+
+	peerConn, err := dialPeer(localhostV4, port1)
+	require.NoError(t, err)
+	defer peerConn.Close()
+	p := &controlPacket{
+		state:                 StateDown,
+		detectMult:            3,
+		myDiscriminator:       1,
+		desiredMinTxInterval:  1000000,
+		requiredMinRxInterval: 1000000,
+	}
+
+	// A packet which may have been forwarded by a router is dropped.
+	require.NoError(t, ipv4.NewConn(peerConn).SetTTL(254))
+	_, err = peerConn.Write(p.marshal())
+	require.NoError(t, err)
+	assert.Never(t, func() bool {
+		state, _ := m.GetSessionState(localhostV4)
+		return state != StateDown
+	}, 200*time.Millisecond, 10*time.Millisecond)
+
+	require.NoError(t, ipv4.NewConn(peerConn).SetTTL(ttl))
+	_, err = peerConn.Write(p.marshal())
+	require.NoError(t, err)
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		state, _ := m.GetSessionState(localhostV4)
+		assert.Equal(c, StateInit, state)
+	}, 2*time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,112 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bfd
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+const (
+	protocolVersion = 1
+	// The length of a control packet without the optional authentication section.
+	packetLength = 24
+
+	flagPoll       = 0x20
+	flagFinal      = 0x10
+	flagAuth       = 0x04
+	flagMultipoint = 0x01
+)
+
+// Diagnostic codes defined in RFC 5880 section 4.1.
+const (
+	diagNone                        uint8 = 0
+	diagControlDetectionTimeExpired uint8 = 1
+	diagNeighborSignaledSessionDown uint8 = 3
+	diagAdministrativelyDown        uint8 = 7
+)
+
+// controlPacket is a BFD control packet. The intervals are in microseconds.
+type controlPacket struct {
+	diag                  uint8
+	state                 State
+	poll                  bool
+	final                 bool
+	detectMult            uint8
+	myDiscriminator       uint32
+	yourDiscriminator     uint32
+	desiredMinTxInterval  uint32
+	requiredMinRxInterval uint32
+}
+
+func (p *controlPacket) marshal() []byte {
+	b := make([]byte, packetLength)
+	b[0] = protocolVersion<<5 | p.diag&0x1f
+	b[1] = byte(p.state) << 6
+	if p.poll {
+		b[1] |= flagPoll
+	}
+	if p.final {
+		b[1] |= flagFinal
+	}
+	b[2] = p.detectMult
+	b[3] = packetLength
+	binary.BigEndian.PutUint32(b[4:8], p.myDiscriminator)
+	binary.BigEndian.PutUint32(b[8:12], p.yourDiscriminator)
+	binary.BigEndian.PutUint32(b[12:16], p.desiredMinTxInterval)
+	binary.BigEndian.PutUint32(b[16:20], p.requiredMinRxInterval)
+	// Echo is not supported, so Required Min Echo RX Interval is always 0.
+	return b
+}
+
+// parseControlPacket parses and validates a received control packet according to RFC 5880 section 6.8.6.
+func parseControlPacket(b []byte) (*controlPacket, error) {
+	if len(b) < packetLength {
+		return nil, fmt.Errorf("packet is too short: %d bytes", len(b))
+	}
+	if version := b[0] >> 5; version != protocolVersion {
+		return nil, fmt.Errorf("unsupported version %d", version)
+	}
+	if length := int(b[3]); length < packetLength || length > len(b) {
+		return nil, fmt.Errorf("invalid length %d", length)
+	}
+	if b[1]&flagAuth != 0 {
+		return nil, fmt.Errorf("authentication is not supported")
+	}
+	if b[1]&flagMultipoint != 0 {
+		return nil, fmt.Errorf("multipoint is not supported")
+	}
+	p := &controlPacket{
+		diag:                  b[0] & 0x1f,
+		state:                 State(b[1] >> 6),
+		poll:                  b[1]&flagPoll != 0,
+		final:                 b[1]&flagFinal != 0,
+		detectMult:            b[2],
+		myDiscriminator:       binary.BigEndian.Uint32(b[4:8]),
+		yourDiscriminator:     binary.BigEndian.Uint32(b[8:12]),
+		desiredMinTxInterval:  binary.BigEndian.Uint32(b[12:16]),
+		requiredMinRxInterval: binary.BigEndian.Uint32(b[16:20]),
+	}
+	if p.detectMult == 0 {
+		return nil, fmt.Errorf("invalid detect multiplier 0")
+	}
+	if p.myDiscriminator == 0 {
+		return nil, fmt.Errorf("invalid discriminator 0")
+	}
+	if p.yourDiscriminator == 0 && p.state != StateDown && p.state != StateAdminDown {
+		return nil, fmt.Errorf("your discriminator is 0 in state %s", p.state)
+	}
+	return p, nil
+}
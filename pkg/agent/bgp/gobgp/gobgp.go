@@ -17,36 +17,72 @@ package gobgp
 import (
 	"context"
 	"fmt"
+	"maps"
 	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	gobgpapi "github.com/osrg/gobgp/v3/api"
 	"github.com/osrg/gobgp/v3/pkg/server"
 	"google.golang.org/protobuf/types/known/anypb"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
 	"k8s.io/utils/net"
 
 	"antrea.io/antrea/pkg/agent/bgp"
+	"antrea.io/antrea/pkg/agent/bgp/bfd"
+	"antrea.io/antrea/pkg/apis/crd/v1alpha1"
 )
 
 const (
 	ipv4AllZero = "0.0.0.0"
 	ipv6AllZero = "::"
+
+	defaultHoldTimeSeconds            = 90
+	defaultBFDMinIntervalMilliseconds = 300
+	defaultBFDMultiplier              = 3
+
+	// The names of the gobgp policy which sets the next hop of the IPv6 routes advertised to IPv4 BGP peers, and of
+	// the global policy assignment.
+	ipv6NextHopPolicyName  = "antrea-ipv6-next-hop"
+	globalPolicyAssignment = "global"
 )
 
+// wellKnownCommunities are the well-known communities defined in RFC 1997.
+var wellKnownCommunities = map[string]uint32{
+	"no-export":           0xFFFFFF01,
+	"no-advertise":        0xFFFFFF02,
+	"no-export-subconfed": 0xFFFFFF03,
+}
+
 type Server struct {
 	server       *server.BgpServer
 	globalConfig *gobgpapi.Global
+	bfdManager   *bfd.Manager
+	// ipv6NextHops stores the next hops of the IPv6 routes advertised to IPv4 BGP peers, keyed by peer addresses.
+	ipv6NextHops map[string]string
+	// lastErrors stores the reasons why the BGP sessions last went down, keyed by peer addresses. It is updated by the
+	// gobgp goroutines, hence protected by lastErrorsMutex.
+	lastErrors      map[string]string
+	lastErrorsMutex sync.RWMutex
 }
 
 func NewGoBGPServer(globalConfig *bgp.GlobalConfig) *Server {
-	return &Server{
-		server: server.NewBgpServer(server.LoggerOption(newGoBGPLogger())),
+	s := &Server{
 		globalConfig: &gobgpapi.Global{
 			Asn:        globalConfig.ASN,
 			RouterId:   globalConfig.RouterID,
 			ListenPort: globalConfig.ListenPort,
 		},
+		ipv6NextHops: make(map[string]string),
+		lastErrors:   make(map[string]string),
 	}
+	s.server = server.NewBgpServer(server.LoggerOption(newGoBGPLogger(s.handlePeerDown)))
+	s.bfdManager = bfd.NewManager(s.handleBFDStateChange)
+	return s
 }
 
 func (s *Server) Start(ctx context.Context) error {
@@ -58,6 +94,7 @@ func (s *Server) Start(ctx context.Context) error {
 }
 
 func (s *Server) Stop(ctx context.Context) error {
+	s.bfdManager.Stop()
 	if err := s.server.StopBgp(ctx, &gobgpapi.StopBgpRequest{}); err != nil {
 		return err
 	}
@@ -73,7 +110,13 @@ func (s *Server) AddPeer(ctx context.Context, peerConf bgp.PeerConfig) error {
 	if err := s.server.AddPeer(ctx, request); err != nil {
 		return err
 	}
-	return nil
+	s.lastErrorsMutex.Lock()
+	s.lastErrors[peerConf.Address] = ""
+	s.lastErrorsMutex.Unlock()
+	if _, err := s.updateIPv6NextHop(ctx, peerConf.Address, peerConf.IPv6NextHop); err != nil {
+		return err
+	}
+	return s.updateBFDSession(ctx, peerConf)
 }
 
 func (s *Server) UpdatePeer(ctx context.Context, peerConf bgp.PeerConfig) error {
@@ -81,26 +124,193 @@ func (s *Server) UpdatePeer(ctx context.Context, peerConf bgp.PeerConfig) error
 	if err != nil {
 		return err
 	}
-	request := &gobgpapi.UpdatePeerRequest{Peer: peer}
-	if _, err := s.server.UpdatePeer(ctx, request); err != nil {
+	// The address families are negotiated when the BGP session is established, so the BGP peer is recreated when they
+	// are changed.
+	curFamilies, err := s.getPeerFamilies(ctx, peerConf.Address)
+	if err != nil {
 		return err
 	}
-	return nil
+	if !equalFamilies(curFamilies, peer.GetAfiSafis()) {
+		if err := s.server.DeletePeer(ctx, &gobgpapi.DeletePeerRequest{Address: peerConf.Address}); err != nil {
+			return err
+		}
+		if err := s.server.AddPeer(ctx, &gobgpapi.AddPeerRequest{Peer: peer}); err != nil {
+			return err
+		}
+	} else if _, err := s.server.UpdatePeer(ctx, &gobgpapi.UpdatePeerRequest{Peer: peer}); err != nil {
+		return err
+	}
+	changed, err := s.updateIPv6NextHop(ctx, peerConf.Address, peerConf.IPv6NextHop)
+	if err != nil {
+		return err
+	}
+	if changed {
+		// Advertise the routes to the BGP peer again with the updated next hop.
+		request := &gobgpapi.ResetPeerRequest{Address: peerConf.Address, Soft: true, Direction: gobgpapi.ResetPeerRequest_OUT}
+		if err := s.server.ResetPeer(ctx, request); err != nil {
+			klog.V(2).InfoS("Failed to soft reset BGP peer", "peer", peerConf.Address, "err", err)
+		}
+	}
+	return s.updateBFDSession(ctx, peerConf)
 }
 
 func (s *Server) RemovePeer(ctx context.Context, peerConf bgp.PeerConfig) error {
+	if peerAddr, err := netip.ParseAddr(peerConf.Address); err == nil {
+		s.bfdManager.RemoveSession(peerAddr)
+	}
 	request := &gobgpapi.DeletePeerRequest{Address: peerConf.Address}
 	if err := s.server.DeletePeer(ctx, request); err != nil {
 		return err
 	}
+	s.lastErrorsMutex.Lock()
+	delete(s.lastErrors, peerConf.Address)
+	s.lastErrorsMutex.Unlock()
+	if _, err := s.updateIPv6NextHop(ctx, peerConf.Address, ""); err != nil {
+		return err
+	}
 	return nil
 }
 
+// handlePeerDown records the reason why an established BGP session went down. The reasons of removed BGP peers are
+// ignored, as gobgp may report them after the BGP peers have been removed.
+func (s *Server) handlePeerDown(peerAddress, reason string) {
+	s.lastErrorsMutex.Lock()
+	defer s.lastErrorsMutex.Unlock()
+	if _, exists := s.lastErrors[peerAddress]; exists {
+		s.lastErrors[peerAddress] = reason
+	}
+}
+
+func (s *Server) getPeerFamilies(ctx context.Context, address string) ([]*gobgpapi.AfiSafi, error) {
+	var afiSafis []*gobgpapi.AfiSafi
+	fn := func(peer *gobgpapi.Peer) {
+		afiSafis = peer.GetAfiSafis()
+	}
+	if err := s.server.ListPeer(ctx, &gobgpapi.ListPeerRequest{Address: address}, fn); err != nil {
+		return nil, err
+	}
+	return afiSafis, nil
+}
+
+func equalFamilies(afiSafis1, afiSafis2 []*gobgpapi.AfiSafi) bool {
+	families := func(afiSafis []*gobgpapi.AfiSafi) sets.Set[string] {
+		s := sets.New[string]()
+		for _, afiSafi := range afiSafis {
+			s.Insert(afiSafi.GetConfig().GetFamily().String())
+		}
+		return s
+	}
+	return families(afiSafis1).Equal(families(afiSafis2))
+}
+
+// updateIPv6NextHop updates the next hop of the IPv6 routes advertised to an IPv4 BGP peer. It returns whether the next
+// hop is changed.
+func (s *Server) updateIPv6NextHop(ctx context.Context, address, nextHop string) (bool, error) {
+	if s.ipv6NextHops[address] == nextHop {
+		return false, nil
+	}
+	ipv6NextHops := maps.Clone(s.ipv6NextHops)
+	if nextHop == "" {
+		delete(ipv6NextHops, address)
+	} else {
+		ipv6NextHops[address] = nextHop
+	}
+	policies, assignment := buildIPv6NextHopPolicies(ipv6NextHops)
+	// The policy must be defined before it is assigned, and must be unassigned before it is deleted.
+	var err error
+	if len(ipv6NextHops) > 0 {
+		if err = s.server.SetPolicies(ctx, policies); err == nil {
+			err = s.server.SetPolicyAssignment(ctx, &gobgpapi.SetPolicyAssignmentRequest{Assignment: assignment})
+		}
+	} else {
+		if err = s.server.SetPolicyAssignment(ctx, &gobgpapi.SetPolicyAssignmentRequest{Assignment: assignment}); err == nil {
+			err = s.server.SetPolicies(ctx, policies)
+		}
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to set next hop of IPv6 routes for BGP peer %s: %w", address, err)
+	}
+	s.ipv6NextHops = ipv6NextHops
+	return true, nil
+}
+
+// buildIPv6NextHopPolicies builds the gobgp export policy which sets the next hops of the IPv6 routes advertised to
+// IPv4 BGP peers, and its global assignment. Without it, gobgp would use the IPv4 local address of the BGP session as
+// the next hop.
+func buildIPv6NextHopPolicies(ipv6NextHops map[string]string) (*gobgpapi.SetPoliciesRequest, *gobgpapi.PolicyAssignment) {
+	request := &gobgpapi.SetPoliciesRequest{}
+	assignment := &gobgpapi.PolicyAssignment{
+		Name:          globalPolicyAssignment,
+		Direction:     gobgpapi.PolicyDirection_EXPORT,
+		DefaultAction: gobgpapi.RouteAction_ACCEPT,
+	}
+	if len(ipv6NextHops) == 0 {
+		return request, assignment
+	}
+	policy := &gobgpapi.Policy{Name: ipv6NextHopPolicyName}
+	for _, address := range slices.Sorted(maps.Keys(ipv6NextHops)) {
+		neighborSetName := fmt.Sprintf("%s-%s", ipv6NextHopPolicyName, address)
+		request.DefinedSets = append(request.DefinedSets, &gobgpapi.DefinedSet{
+			DefinedType: gobgpapi.DefinedType_NEIGHBOR,
+			Name:        neighborSetName,
+			List:        []string{netip.PrefixFrom(netip.MustParseAddr(address), netip.MustParseAddr(address).BitLen()).String()},
+		})
+		policy.Statements = append(policy.Statements, &gobgpapi.Statement{
+			Name: neighborSetName,
+			Conditions: &gobgpapi.Conditions{
+				NeighborSet: &gobgpapi.MatchSet{Type: gobgpapi.MatchSet_ANY, Name: neighborSetName},
+				AfiSafiIn:   []*gobgpapi.Family{{Afi: gobgpapi.Family_AFI_IP6, Safi: gobgpapi.Family_SAFI_UNICAST}},
+			},
+			Actions: &gobgpapi.Actions{
+				Nexthop: &gobgpapi.NexthopAction{Address: ipv6NextHops[address]},
+			},
+		})
+	}
+	request.Policies = []*gobgpapi.Policy{policy}
+	assignment.Policies = []*gobgpapi.Policy{{Name: ipv6NextHopPolicyName}}
+	return request, assignment
+}
+
+// updateBFDSession creates, updates or deletes the BFD session with a BGP peer according to its configuration.
+func (s *Server) updateBFDSession(ctx context.Context, peerConf bgp.PeerConfig) error {
+	peerAddr, err := netip.ParseAddr(peerConf.Address)
+	if err != nil {
+		return fmt.Errorf("invalid peer address: %s", peerConf.Address)
+	}
+	if peerConf.BFD == nil {
+		// The BGP session might have been closed by the deleted BFD session, so it is allowed to be established again.
+		if s.bfdManager.RemoveSession(peerAddr) {
+			return s.server.EnablePeer(ctx, &gobgpapi.EnablePeerRequest{Address: peerConf.Address})
+		}
+		return nil
+	}
+	return s.bfdManager.AddSession(peerAddr, convertBFDConfig(peerConf.BFD))
+}
+
+// handleBFDStateChange closes the BGP session with a peer when the BFD session with it goes down, and allows the BGP
+// session to be established again when the BFD session is up.
+func (s *Server) handleBFDStateChange(peer netip.Addr, state bfd.State) {
+	ctx := context.TODO()
+	var err error
+	switch state {
+	case bfd.StateDown:
+		err = s.server.DisablePeer(ctx, &gobgpapi.DisablePeerRequest{Address: peer.String(), Communication: "BFD session down"})
+	case bfd.StateUp:
+		err = s.server.EnablePeer(ctx, &gobgpapi.EnablePeerRequest{Address: peer.String()})
+	}
+	if err != nil {
+		klog.ErrorS(err, "Failed to update BGP peer according to BFD session state", "peer", peer, "state", state)
+	}
+}
+
 func (s *Server) GetPeers(ctx context.Context) ([]bgp.PeerStatus, error) {
 	var peerStatuses []bgp.PeerStatus
 	fn := func(peer *gobgpapi.Peer) {
 		peerStatus := convertGoBGPPeerToPeerStatus(peer)
 		if peerStatus != nil {
+			s.lastErrorsMutex.RLock()
+			peerStatus.LastError = s.lastErrors[peerStatus.Address]
+			s.lastErrorsMutex.RUnlock()
 			peerStatuses = append(peerStatuses, *peerStatus)
 		}
 	}
@@ -113,7 +323,11 @@ func (s *Server) GetPeers(ctx context.Context) ([]bgp.PeerStatus, error) {
 
 func (s *Server) AdvertiseRoutes(ctx context.Context, routes []bgp.Route) error {
 	for i := range routes {
-		request := &gobgpapi.AddPathRequest{Path: convertRouteToGoBGPPath(&routes[i])}
+		path, err := convertRouteToGoBGPPath(&routes[i], s.globalConfig.Asn)
+		if err != nil {
+			return err
+		}
+		request := &gobgpapi.AddPathRequest{Path: path}
 		if _, err := s.server.AddPath(ctx, request); err != nil {
 			return err
 		}
@@ -123,7 +337,12 @@ func (s *Server) AdvertiseRoutes(ctx context.Context, routes []bgp.Route) error
 
 func (s *Server) WithdrawRoutes(ctx context.Context, routes []bgp.Route) error {
 	for i := range routes {
-		request := &gobgpapi.DeletePathRequest{Path: convertRouteToGoBGPPath(&routes[i])}
+		// Path attributes are not needed to withdraw a prefix.
+		path, err := convertRouteToGoBGPPath(&bgp.Route{Prefix: routes[i].Prefix}, s.globalConfig.Asn)
+		if err != nil {
+			return err
+		}
+		request := &gobgpapi.DeletePathRequest{Path: path}
 		if err := s.server.DeletePath(ctx, request); err != nil {
 			return err
 		}
@@ -142,10 +361,39 @@ func (s *Server) GetRoutes(ctx context.Context, routeType bgp.RouteType, peerAdd
 			routes = append(routes, *route)
 		}
 	}
+	afiSafis, err := s.getPeerFamilies(ctx, peerAddress)
+	if err != nil {
+		return nil, err
+	}
+	for _, afiSafi := range afiSafis {
+		request := &gobgpapi.ListPathRequest{
+			TableType: convertRouteTypeToGoBGPTableType(routeType),
+			Family:    afiSafi.GetConfig().GetFamily(),
+			Name:      peerAddress,
+		}
+		if err := s.server.ListPath(ctx, request, fn); err != nil {
+			return nil, err
+		}
+	}
+	return routes, nil
+}
+
+func (s *Server) GetBestReceivedRoutes(ctx context.Context, isIPv6 bool) ([]bgp.Route, error) {
+	var routes []bgp.Route
+	fn := func(destination *gobgpapi.Destination) {
+		for _, path := range destination.GetPaths() {
+			// Paths originated locally have no valid neighbor IP.
+			if !path.GetBest() || path.GetIsWithdraw() || path.GetIsNexthopInvalid() || !isValidIPString(path.GetNeighborIp()) {
+				continue
+			}
+			if nextHop := getGoBGPPathNextHop(path); nextHop != "" {
+				routes = append(routes, bgp.Route{Prefix: destination.GetPrefix(), NextHop: nextHop})
+			}
+		}
+	}
 	request := &gobgpapi.ListPathRequest{
-		TableType: convertRouteTypeToGoBGPTableType(routeType),
-		Family:    &gobgpapi.Family{Afi: convertToGoBGPFamilyAfi(net.IsIPv6String(peerAddress)), Safi: gobgpapi.Family_SAFI_UNICAST},
-		Name:      peerAddress,
+		TableType: gobgpapi.TableType_GLOBAL,
+		Family:    &gobgpapi.Family{Afi: convertToGoBGPFamilyAfi(isIPv6), Safi: gobgpapi.Family_SAFI_UNICAST},
 	}
 	if err := s.server.ListPath(ctx, request, fn); err != nil {
 		return nil, err
@@ -153,6 +401,23 @@ func (s *Server) GetRoutes(ctx context.Context, routeType bgp.RouteType, peerAdd
 	return routes, nil
 }
 
+// getGoBGPPathNextHop returns the next hop of a path, which is carried by the NEXT_HOP attribute for IPv4 routes, or
+// by the MP_REACH_NLRI attribute for IPv6 routes.
+func getGoBGPPathNextHop(path *gobgpapi.Path) string {
+	for _, attr := range path.GetPattrs() {
+		nextHopAttr := &gobgpapi.NextHopAttribute{}
+		if attr.UnmarshalTo(nextHopAttr) == nil {
+			return nextHopAttr.GetNextHop()
+		}
+		mpReachAttr := &gobgpapi.MpReachNLRIAttribute{}
+		if attr.UnmarshalTo(mpReachAttr) == nil && len(mpReachAttr.GetNextHops()) > 0 {
+			// The first next hop is the global address, which may be followed by a link-local address.
+			return mpReachAttr.GetNextHops()[0]
+		}
+	}
+	return ""
+}
+
 func convertGoBGPPeerToPeerStatus(peer *gobgpapi.Peer) *bgp.PeerStatus {
 	if peer == nil {
 		return nil
@@ -174,6 +439,10 @@ func convertGoBGPPeerToPeerStatus(peer *gobgpapi.Peer) *bgp.PeerStatus {
 		peerStatus.GracefulRestartTimeSeconds = int32(gracefulRestart.GetRestartTime())
 	}
 	if state := peer.GetState(); state != nil {
+		if messages := state.GetMessages(); messages != nil {
+			peerStatus.MessagesReceived = convertGoBGPMessageStatistics(messages.GetReceived())
+			peerStatus.MessagesSent = convertGoBGPMessageStatistics(messages.GetSent())
+		}
 		peerStatus.SessionState = convertGoBGPSessionStateToSessionState(state.GetSessionState())
 		if peerStatus.SessionState == bgp.SessionEstablished {
 			if timers := peer.GetTimers(); timers != nil {
@@ -183,9 +452,30 @@ func convertGoBGPPeerToPeerStatus(peer *gobgpapi.Peer) *bgp.PeerStatus {
 			}
 		}
 	}
+	for _, afiSafi := range peer.GetAfiSafis() {
+		if afiSafiState := afiSafi.GetState(); afiSafiState != nil {
+			peerStatus.ReceivedPrefixes += afiSafiState.GetReceived()
+			peerStatus.AcceptedPrefixes += afiSafiState.GetAccepted()
+			peerStatus.AdvertisedPrefixes += afiSafiState.GetAdvertised()
+		}
+	}
 	return peerStatus
 }
 
+func convertGoBGPMessageStatistics(message *gobgpapi.Message) bgp.MessageStatistics {
+	if message == nil {
+		return bgp.MessageStatistics{}
+	}
+	return bgp.MessageStatistics{
+		Total:        message.GetTotal(),
+		Open:         message.GetOpen(),
+		Update:       message.GetUpdate(),
+		Notification: message.GetNotification(),
+		Keepalive:    message.GetKeepalive(),
+		Refresh:      message.GetRefresh(),
+	}
+}
+
 func convertGoBGPDestinationToRoute(destination *gobgpapi.Destination) *bgp.Route {
 	if destination == nil {
 		return nil
@@ -201,7 +491,7 @@ func convertRouteTypeToGoBGPTableType(routeType bgp.RouteType) gobgpapi.TableTyp
 	return gobgpapi.TableType_ADJ_IN
 }
 
-func convertRouteToGoBGPPath(route *bgp.Route) *gobgpapi.Path {
+func convertRouteToGoBGPPath(route *bgp.Route, localASN uint32) (*gobgpapi.Path, error) {
 	isIPv6 := net.IsIPv6CIDRString(route.Prefix)
 	goBGPIPFamily := convertToGoBGPFamilyAfi(isIPv6)
 	prefix, _ := netip.ParsePrefix(route.Prefix)
@@ -228,11 +518,102 @@ func convertRouteToGoBGPPath(route *bgp.Route) *gobgpapi.Path {
 	}
 	attrs = append(attrs, a1, a2)
 
+	optionalAttrs, err := convertRouteAttributesToGoBGPAttrs(route.Attributes, localASN)
+	if err != nil {
+		return nil, fmt.Errorf("invalid attributes of route %s: %w", route.Prefix, err)
+	}
+	attrs = append(attrs, optionalAttrs...)
+
 	return &gobgpapi.Path{
 		Family: &gobgpapi.Family{Afi: goBGPIPFamily, Safi: gobgpapi.Family_SAFI_UNICAST},
 		Nlri:   nlri,
 		Pattrs: attrs,
+	}, nil
+}
+
+func convertRouteAttributesToGoBGPAttrs(attributes bgp.RouteAttributes, localASN uint32) ([]*anypb.Any, error) {
+	var attrs []*anypb.Any
+	if attributes.ASPathPrepend > 0 {
+		// The local AS number is prepended once more by gobgp when the route is sent to eBGP peers.
+		numbers := make([]uint32, attributes.ASPathPrepend)
+		for i := range numbers {
+			numbers[i] = localASN
+		}
+		attr, _ := anypb.New(&gobgpapi.AsPathAttribute{
+			Segments: []*gobgpapi.AsSegment{{Type: gobgpapi.AsSegment_AS_SEQUENCE, Numbers: numbers}},
+		})
+		attrs = append(attrs, attr)
+	}
+	if attributes.HasMED {
+		attr, _ := anypb.New(&gobgpapi.MultiExitDiscAttribute{Med: attributes.MED})
+		attrs = append(attrs, attr)
+	}
+	if attributes.HasLocalPreference {
+		attr, _ := anypb.New(&gobgpapi.LocalPrefAttribute{LocalPref: attributes.LocalPreference})
+		attrs = append(attrs, attr)
+	}
+	if attributes.Communities != "" {
+		var communities []uint32
+		for _, c := range strings.Split(attributes.Communities, ",") {
+			community, err := parseCommunity(c)
+			if err != nil {
+				return nil, err
+			}
+			communities = append(communities, community)
+		}
+		attr, _ := anypb.New(&gobgpapi.CommunitiesAttribute{Communities: communities})
+		attrs = append(attrs, attr)
+	}
+	if attributes.LargeCommunities != "" {
+		var communities []*gobgpapi.LargeCommunity
+		for _, c := range strings.Split(attributes.LargeCommunities, ",") {
+			community, err := parseLargeCommunity(c)
+			if err != nil {
+				return nil, err
+			}
+			communities = append(communities, community)
+		}
+		attr, _ := anypb.New(&gobgpapi.LargeCommunitiesAttribute{Communities: communities})
+		attrs = append(attrs, attr)
 	}
+	return attrs, nil
+}
+
+// parseCommunity parses a standard community in the "ASN:value" format or a well-known community name.
+func parseCommunity(s string) (uint32, error) {
+	if community, ok := wellKnownCommunities[s]; ok {
+		return community, nil
+	}
+	parts := strings.Split(s, ":")
+	if len(parts) != 2 {
+		return 0, fmt.Errorf("invalid community: %s", s)
+	}
+	asn, err := strconv.ParseUint(parts[0], 10, 16)
+	if err != nil {
+		return 0, fmt.Errorf("invalid community: %s", s)
+	}
+	value, err := strconv.ParseUint(parts[1], 10, 16)
+	if err != nil {
+		return 0, fmt.Errorf("invalid community: %s", s)
+	}
+	return uint32(asn)<<16 | uint32(value), nil
+}
+
+// parseLargeCommunity parses a large community in the "globalAdmin:localData1:localData2" format.
+func parseLargeCommunity(s string) (*gobgpapi.LargeCommunity, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("invalid large community: %s", s)
+	}
+	var values [3]uint32
+	for i, part := range parts {
+		value, err := strconv.ParseUint(part, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid large community: %s", s)
+		}
+		values[i] = uint32(value)
+	}
+	return &gobgpapi.LargeCommunity{GlobalAdmin: values[0], LocalData1: values[1], LocalData2: values[2]}, nil
 }
 
 func convertToGoBGPFamilyAfi(isIPv6 bool) gobgpapi.Family_Afi {
@@ -258,22 +639,22 @@ func convertPeerConfigToGoBGPPeer(peerConfig bgp.PeerConfig) (*gobgpapi.Peer, er
 			PeerAsn:         uint32(peerConfig.ASN),
 			AuthPassword:    peerConfig.Password,
 		},
-		AfiSafis: []*gobgpapi.AfiSafi{
-			{
-				Config: &gobgpapi.AfiSafiConfig{
-					Family: &gobgpapi.Family{
-						Afi:  convertToGoBGPFamilyAfi(net.IsIPv6String(peerConfig.Address)),
-						Safi: gobgpapi.Family_SAFI_UNICAST,
-					},
-					Enabled: true,
+	}
+	for _, isIPv6 := range getPeerAddressFamilies(peerConfig) {
+		peer.AfiSafis = append(peer.AfiSafis, &gobgpapi.AfiSafi{
+			Config: &gobgpapi.AfiSafiConfig{
+				Family: &gobgpapi.Family{
+					Afi:  convertToGoBGPFamilyAfi(isIPv6),
+					Safi: gobgpapi.Family_SAFI_UNICAST,
 				},
-				MpGracefulRestart: &gobgpapi.MpGracefulRestart{
-					Config: &gobgpapi.MpGracefulRestartConfig{
-						Enabled: true,
-					},
+				Enabled: true,
+			},
+			MpGracefulRestart: &gobgpapi.MpGracefulRestart{
+				Config: &gobgpapi.MpGracefulRestartConfig{
+					Enabled: true,
 				},
 			},
-		},
+		})
 	}
 	// The following pointer fields are set to default values when the corresponding BGPPolicy is created, so they
 	// should not be nil. However, it is safe and harmless to include nil checks.
@@ -294,9 +675,54 @@ func convertPeerConfigToGoBGPPeer(peerConfig bgp.PeerConfig) (*gobgpapi.Peer, er
 			RestartTime: uint32(*peerConfig.GracefulRestartTimeSeconds),
 		}
 	}
+	// gobgp uses the default hold time, and one third of the hold time as the keepalive time, if they are not set.
+	if peerConfig.HoldTimeSeconds != nil || peerConfig.KeepaliveTimeSeconds != nil {
+		timersConfig := &gobgpapi.TimersConfig{}
+		holdTime := int32(defaultHoldTimeSeconds)
+		if peerConfig.HoldTimeSeconds != nil {
+			holdTime = *peerConfig.HoldTimeSeconds
+			timersConfig.HoldTime = uint64(holdTime)
+		}
+		if peerConfig.KeepaliveTimeSeconds != nil {
+			if *peerConfig.KeepaliveTimeSeconds >= holdTime {
+				return nil, fmt.Errorf("keepalive time %d must be less than hold time %d", *peerConfig.KeepaliveTimeSeconds, holdTime)
+			}
+			timersConfig.KeepaliveInterval = uint64(*peerConfig.KeepaliveTimeSeconds)
+		}
+		peer.Timers = &gobgpapi.Timers{Config: timersConfig}
+	}
 	return peer, nil
 }
 
+// getPeerAddressFamilies returns whether each of the address families enabled for a BGP peer is IPv6. It defaults to
+// the address family of the peer address.
+func getPeerAddressFamilies(peerConfig bgp.PeerConfig) []bool {
+	if len(peerConfig.AddressFamilies) == 0 {
+		return []bool{net.IsIPv6String(peerConfig.Address)}
+	}
+	var families []bool
+	for _, family := range []v1alpha1.AddressFamily{v1alpha1.AddressFamilyIPv4Unicast, v1alpha1.AddressFamilyIPv6Unicast} {
+		if slices.Contains(peerConfig.AddressFamilies, family) {
+			families = append(families, family == v1alpha1.AddressFamilyIPv6Unicast)
+		}
+	}
+	return families
+}
+
+func convertBFDConfig(bfdConfig *v1alpha1.BFDConfig) bfd.Config {
+	config := bfd.Config{
+		MinInterval: defaultBFDMinIntervalMilliseconds * time.Millisecond,
+		Multiplier:  defaultBFDMultiplier,
+	}
+	if bfdConfig.MinIntervalMilliseconds != nil {
+		config.MinInterval = time.Duration(*bfdConfig.MinIntervalMilliseconds) * time.Millisecond
+	}
+	if bfdConfig.Multiplier != nil {
+		config.Multiplier = uint8(*bfdConfig.Multiplier)
+	}
+	return config
+}
+
 func convertGoBGPSessionStateToSessionState(s gobgpapi.PeerState_SessionState) bgp.SessionState {
 	switch s {
 	case gobgpapi.PeerState_UNKNOWN:
@@ -19,11 +19,15 @@ import (
 	"time"
 
 	gobgpapi "github.com/osrg/gobgp/v3/api"
+	gobgplog "github.com/osrg/gobgp/v3/pkg/log"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"k8s.io/utils/ptr"
 
 	"antrea.io/antrea/pkg/agent/bgp"
+	"antrea.io/antrea/pkg/agent/bgp/bfd"
 	"antrea.io/antrea/pkg/apis/crd/v1alpha1"
 )
 
@@ -56,12 +60,20 @@ func TestConvertGoBGPPeerToPeerStatus(t *testing.T) {
 				},
 				State: &gobgpapi.PeerState{
 					SessionState: gobgpapi.PeerState_ESTABLISHED,
+					Messages: &gobgpapi.Messages{
+						Received: &gobgpapi.Message{Total: 15, Open: 1, Update: 4, Keepalive: 10},
+						Sent:     &gobgpapi.Message{Total: 14, Open: 1, Update: 3, Keepalive: 10},
+					},
 				},
 				Timers: &gobgpapi.Timers{
 					State: &gobgpapi.TimersState{
 						Uptime: &timestamppb.Timestamp{Seconds: time.Now().Unix() - 3600},
 					},
 				},
+				AfiSafis: []*gobgpapi.AfiSafi{
+					{State: &gobgpapi.AfiSafiState{Received: 5, Accepted: 4, Advertised: 2}},
+					{State: &gobgpapi.AfiSafiState{Received: 3, Accepted: 3, Advertised: 1}},
+				},
 			},
 			expected: &bgp.PeerStatus{
 				Address:                    "192.168.1.1",
@@ -71,6 +83,11 @@ func TestConvertGoBGPPeerToPeerStatus(t *testing.T) {
 				GracefulRestartTimeSeconds: 120,
 				SessionState:               bgp.SessionEstablished,
 				UptimeSeconds:              3600,
+				MessagesReceived:           bgp.MessageStatistics{Total: 15, Open: 1, Update: 4, Keepalive: 10},
+				MessagesSent:               bgp.MessageStatistics{Total: 14, Open: 1, Update: 3, Keepalive: 10},
+				ReceivedPrefixes:           8,
+				AcceptedPrefixes:           7,
+				AdvertisedPrefixes:         3,
 			},
 		},
 		{
@@ -105,6 +122,19 @@ func TestConvertGoBGPPeerToPeerStatus(t *testing.T) {
 	}
 }
 
+func TestHandlePeerDown(t *testing.T) {
+	s := NewGoBGPServer(&bgp.GlobalConfig{ASN: 65000, RouterID: "192.168.1.2", ListenPort: 179})
+	s.lastErrors["192.168.1.1"] = ""
+	logger := newGoBGPLogger(s.handlePeerDown)
+
+	logger.Info("Peer Down", gobgplog.Fields{"Topic": "Peer", "Key": "192.168.1.1", "State": "BGP_FSM_ESTABLISHED", "Reason": "hold-timer-expired"})
+	assert.Equal(t, "hold-timer-expired", s.lastErrors["192.168.1.1"])
+	// Other messages and the BGP sessions of removed BGP peers are ignored.
+	logger.Info("Peer Up", gobgplog.Fields{"Topic": "Peer", "Key": "192.168.1.1", "State": "BGP_FSM_OPENCONFIRM"})
+	logger.Info("Peer Down", gobgplog.Fields{"Topic": "Peer", "Key": "192.168.1.3", "State": "BGP_FSM_ESTABLISHED", "Reason": "dying"})
+	assert.Equal(t, map[string]string{"192.168.1.1": "hold-timer-expired"}, s.lastErrors)
+}
+
 func TestConvertGoBGPDestinationToRoute(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -145,7 +175,8 @@ func TestConvertRouteTypeToGoBGPTableType(t *testing.T) {
 
 func TestConvertRouteToGoBGPPath(t *testing.T) {
 	route4 := &bgp.Route{Prefix: "192.168.0.0/24"}
-	path4 := convertRouteToGoBGPPath(route4)
+	path4, err := convertRouteToGoBGPPath(route4, 65000)
+	assert.NoError(t, err)
 
 	ipAddressPrefix4 := &gobgpapi.IPAddressPrefix{}
 	assert.NoError(t, path4.GetNlri().UnmarshalTo(ipAddressPrefix4))
@@ -154,7 +185,8 @@ func TestConvertRouteToGoBGPPath(t *testing.T) {
 	assert.Equal(t, gobgpapi.Family_AFI_IP, path4.GetFamily().Afi)
 
 	route6 := &bgp.Route{Prefix: "2001:db8::/64"}
-	path6 := convertRouteToGoBGPPath(route6)
+	path6, err := convertRouteToGoBGPPath(route6, 65000)
+	assert.NoError(t, err)
 
 	ipAddressPrefix6 := &gobgpapi.IPAddressPrefix{}
 	assert.NoError(t, path6.GetNlri().UnmarshalTo(ipAddressPrefix6))
@@ -163,6 +195,92 @@ func TestConvertRouteToGoBGPPath(t *testing.T) {
 	assert.Equal(t, gobgpapi.Family_AFI_IP6, path6.GetFamily().Afi)
 }
 
+func TestConvertRouteAttributesToGoBGPAttrs(t *testing.T) {
+	route := &bgp.Route{
+		Prefix: "192.168.0.0/24",
+		Attributes: bgp.RouteAttributes{
+			Communities:        "65000:100,no-export",
+			LargeCommunities:   "65000:1:2",
+			MED:                0,
+			HasMED:             true,
+			LocalPreference:    200,
+			HasLocalPreference: true,
+			ASPathPrepend:      2,
+		},
+	}
+	path, err := convertRouteToGoBGPPath(route, 64512)
+	assert.NoError(t, err)
+	// ORIGIN, NEXT_HOP, AS_PATH, MULTI_EXIT_DISC, LOCAL_PREF, COMMUNITIES and LARGE_COMMUNITY.
+	assert.Len(t, path.GetPattrs(), 7)
+
+	asPath := &gobgpapi.AsPathAttribute{}
+	assert.NoError(t, path.GetPattrs()[2].UnmarshalTo(asPath))
+	assert.Equal(t, []uint32{64512, 64512}, asPath.GetSegments()[0].GetNumbers())
+	med := &gobgpapi.MultiExitDiscAttribute{}
+	assert.NoError(t, path.GetPattrs()[3].UnmarshalTo(med))
+	assert.Equal(t, uint32(0), med.GetMed())
+	localPref := &gobgpapi.LocalPrefAttribute{}
+	assert.NoError(t, path.GetPattrs()[4].UnmarshalTo(localPref))
+	assert.Equal(t, uint32(200), localPref.GetLocalPref())
+	communities := &gobgpapi.CommunitiesAttribute{}
+	assert.NoError(t, path.GetPattrs()[5].UnmarshalTo(communities))
+	assert.Equal(t, []uint32{65000<<16 | 100, 0xFFFFFF01}, communities.GetCommunities())
+	largeCommunities := &gobgpapi.LargeCommunitiesAttribute{}
+	assert.NoError(t, path.GetPattrs()[6].UnmarshalTo(largeCommunities))
+	assert.Equal(t, uint32(65000), largeCommunities.GetCommunities()[0].GetGlobalAdmin())
+	assert.Equal(t, uint32(1), largeCommunities.GetCommunities()[0].GetLocalData1())
+	assert.Equal(t, uint32(2), largeCommunities.GetCommunities()[0].GetLocalData2())
+
+	// Routes without attributes only have ORIGIN and NEXT_HOP.
+	path, err = convertRouteToGoBGPPath(&bgp.Route{Prefix: "192.168.0.0/24"}, 64512)
+	assert.NoError(t, err)
+	assert.Len(t, path.GetPattrs(), 2)
+}
+
+func TestParseCommunities(t *testing.T) {
+	tests := []struct {
+		community   string
+		expected    uint32
+		expectedErr bool
+	}{
+		{community: "65000:100", expected: 65000<<16 | 100},
+		{community: "0:0", expected: 0},
+		{community: "no-advertise", expected: 0xFFFFFF02},
+		{community: "no-export-subconfed", expected: 0xFFFFFF03},
+		{community: "65536:100", expectedErr: true},
+		{community: "65000", expectedErr: true},
+		{community: "65000:100:1", expectedErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.community, func(t *testing.T) {
+			community, err := parseCommunity(tt.community)
+			if tt.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, community)
+			}
+		})
+	}
+
+	_, err := parseLargeCommunity("4294967295:0:1")
+	assert.NoError(t, err)
+	_, err = parseLargeCommunity("4294967296:0:1")
+	assert.Error(t, err)
+	_, err = parseLargeCommunity("65000:1")
+	assert.Error(t, err)
+}
+
+func TestGetGoBGPPathNextHop(t *testing.T) {
+	origin, _ := anypb.New(&gobgpapi.OriginAttribute{Origin: 0})
+	nextHop, _ := anypb.New(&gobgpapi.NextHopAttribute{NextHop: "192.168.77.100"})
+	mpReach, _ := anypb.New(&gobgpapi.MpReachNLRIAttribute{NextHops: []string{"fec0::100", "fe80::1"}})
+
+	assert.Equal(t, "192.168.77.100", getGoBGPPathNextHop(&gobgpapi.Path{Pattrs: []*anypb.Any{origin, nextHop}}))
+	assert.Equal(t, "fec0::100", getGoBGPPathNextHop(&gobgpapi.Path{Pattrs: []*anypb.Any{origin, mpReach}}))
+	assert.Equal(t, "", getGoBGPPathNextHop(&gobgpapi.Path{Pattrs: []*anypb.Any{origin}}))
+}
+
 func TestConvertPeerConfigToGoBGPPeer(t *testing.T) {
 	peerConfig := bgp.PeerConfig{
 		BGPPeer: &v1alpha1.BGPPeer{
@@ -183,7 +301,111 @@ func TestConvertPeerConfigToGoBGPPeer(t *testing.T) {
 	assert.Equal(t, uint32(179), peer.GetTransport().GetRemotePort())
 	assert.Equal(t, uint32(2), peer.GetEbgpMultihop().GetMultihopTtl())
 	assert.Equal(t, uint32(120), peer.GetGracefulRestart().GetRestartTime())
+	assert.Nil(t, peer.GetTimers())
+
+	peerConfig.HoldTimeSeconds = ptr.To(int32(9))
+	peerConfig.KeepaliveTimeSeconds = ptr.To(int32(3))
+	peer, err = convertPeerConfigToGoBGPPeer(peerConfig)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(9), peer.GetTimers().GetConfig().GetHoldTime())
+	assert.Equal(t, uint64(3), peer.GetTimers().GetConfig().GetKeepaliveInterval())
+
+	// The keepalive time must be less than the hold time, which is 90 seconds by default.
+	peerConfig.KeepaliveTimeSeconds = ptr.To(int32(9))
+	_, err = convertPeerConfigToGoBGPPeer(peerConfig)
+	assert.Error(t, err)
+	peerConfig.HoldTimeSeconds = nil
+	peerConfig.KeepaliveTimeSeconds = ptr.To(int32(90))
+	_, err = convertPeerConfigToGoBGPPeer(peerConfig)
+	assert.Error(t, err)
+}
+
+func TestGetPeerAddressFamilies(t *testing.T) {
+	tests := []struct {
+		name             string
+		address          string
+		addressFamilies  []v1alpha1.AddressFamily
+		expectedFamilies []gobgpapi.Family_Afi
+	}{
+		{
+			name:             "IPv4 peer by default",
+			address:          "192.168.0.1",
+			expectedFamilies: []gobgpapi.Family_Afi{gobgpapi.Family_AFI_IP},
+		},
+		{
+			name:             "IPv6 peer by default",
+			address:          "fec0::1",
+			expectedFamilies: []gobgpapi.Family_Afi{gobgpapi.Family_AFI_IP6},
+		},
+		{
+			name:             "IPv4 peer with both address families",
+			address:          "192.168.0.1",
+			addressFamilies:  []v1alpha1.AddressFamily{v1alpha1.AddressFamilyIPv6Unicast, v1alpha1.AddressFamilyIPv4Unicast},
+			expectedFamilies: []gobgpapi.Family_Afi{gobgpapi.Family_AFI_IP, gobgpapi.Family_AFI_IP6},
+		},
+		{
+			name:             "IPv6 peer with IPv4 address family",
+			address:          "fec0::1",
+			addressFamilies:  []v1alpha1.AddressFamily{v1alpha1.AddressFamilyIPv4Unicast},
+			expectedFamilies: []gobgpapi.Family_Afi{gobgpapi.Family_AFI_IP},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			peer, err := convertPeerConfigToGoBGPPeer(bgp.PeerConfig{
+				BGPPeer: &v1alpha1.BGPPeer{Address: tt.address, ASN: 65000, AddressFamilies: tt.addressFamilies},
+			})
+			require.NoError(t, err)
+			var families []gobgpapi.Family_Afi
+			for _, afiSafi := range peer.GetAfiSafis() {
+				families = append(families, afiSafi.GetConfig().GetFamily().GetAfi())
+				assert.Equal(t, gobgpapi.Family_SAFI_UNICAST, afiSafi.GetConfig().GetFamily().GetSafi())
+			}
+			assert.Equal(t, tt.expectedFamilies, families)
+		})
+	}
+}
+
+func TestEqualFamilies(t *testing.T) {
+	ipv4Peer, _ := convertPeerConfigToGoBGPPeer(bgp.PeerConfig{BGPPeer: &v1alpha1.BGPPeer{Address: "192.168.0.1", ASN: 65000}})
+	dualStackPeer1, _ := convertPeerConfigToGoBGPPeer(bgp.PeerConfig{BGPPeer: &v1alpha1.BGPPeer{Address: "192.168.0.1", ASN: 65000,
+		AddressFamilies: []v1alpha1.AddressFamily{v1alpha1.AddressFamilyIPv4Unicast, v1alpha1.AddressFamilyIPv6Unicast}}})
+	dualStackPeer2, _ := convertPeerConfigToGoBGPPeer(bgp.PeerConfig{BGPPeer: &v1alpha1.BGPPeer{Address: "192.168.0.1", ASN: 65001,
+		AddressFamilies: []v1alpha1.AddressFamily{v1alpha1.AddressFamilyIPv6Unicast, v1alpha1.AddressFamilyIPv4Unicast}}})
+	assert.True(t, equalFamilies(dualStackPeer1.GetAfiSafis(), dualStackPeer2.GetAfiSafis()))
+	assert.False(t, equalFamilies(ipv4Peer.GetAfiSafis(), dualStackPeer1.GetAfiSafis()))
+}
+
+func TestBuildIPv6NextHopPolicies(t *testing.T) {
+	policies, assignment := buildIPv6NextHopPolicies(nil)
+	assert.Empty(t, policies.GetPolicies())
+	assert.Empty(t, policies.GetDefinedSets())
+	assert.Empty(t, assignment.GetPolicies())
+	assert.Equal(t, gobgpapi.PolicyDirection_EXPORT, assignment.GetDirection())
+	assert.Equal(t, gobgpapi.RouteAction_ACCEPT, assignment.GetDefaultAction())
+
+	policies, assignment = buildIPv6NextHopPolicies(map[string]string{
+		"192.168.0.2": "fec0::100",
+		"192.168.0.1": "fec0::100",
+	})
+	require.Len(t, policies.GetDefinedSets(), 2)
+	assert.Equal(t, "antrea-ipv6-next-hop-192.168.0.1", policies.GetDefinedSets()[0].GetName())
+	assert.Equal(t, []string{"192.168.0.1/32"}, policies.GetDefinedSets()[0].GetList())
+	require.Len(t, policies.GetPolicies(), 1)
+	statements := policies.GetPolicies()[0].GetStatements()
+	require.Len(t, statements, 2)
+	assert.Equal(t, "antrea-ipv6-next-hop-192.168.0.2", statements[1].GetConditions().GetNeighborSet().GetName())
+	assert.Equal(t, gobgpapi.Family_AFI_IP6, statements[1].GetConditions().GetAfiSafiIn()[0].GetAfi())
+	assert.Equal(t, "fec0::100", statements[1].GetActions().GetNexthop().GetAddress())
+	assert.Equal(t, "antrea-ipv6-next-hop", assignment.GetPolicies()[0].GetName())
+}
 
+func TestConvertBFDConfig(t *testing.T) {
+	assert.Equal(t, bfd.Config{MinInterval: 300 * time.Millisecond, Multiplier: 3}, convertBFDConfig(&v1alpha1.BFDConfig{}))
+	assert.Equal(t, bfd.Config{MinInterval: 50 * time.Millisecond, Multiplier: 5}, convertBFDConfig(&v1alpha1.BFDConfig{
+		MinIntervalMilliseconds: ptr.To(int32(50)),
+		Multiplier:              ptr.To(int32(5)),
+	}))
 }
 
 func TestConvertGoBGPSessionStateToSessionState(t *testing.T) {
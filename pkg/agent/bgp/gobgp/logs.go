@@ -21,11 +21,22 @@ import (
 	"k8s.io/klog/v2"
 )
 
+// gobgp doesn't expose why a BGP session went down via its API, but logs it with the message and fields below when an
+// established BGP session goes down.
+const (
+	peerDownMessage     = "Peer Down"
+	peerDownKeyField    = "Key"
+	peerDownReasonField = "Reason"
+)
+
 // goBGPLogger implements https://github.com/osrg/gobgp/blob/master/pkg/log/logger.go interface.
-type goBGPLogger struct{}
+type goBGPLogger struct {
+	// peerDownHandler is called with the peer address and the reason when an established BGP session goes down.
+	peerDownHandler func(peerAddress, reason string)
+}
 
-func newGoBGPLogger() *goBGPLogger {
-	return &goBGPLogger{}
+func newGoBGPLogger(peerDownHandler func(peerAddress, reason string)) *goBGPLogger {
+	return &goBGPLogger{peerDownHandler: peerDownHandler}
 }
 
 func (g *goBGPLogger) Panic(msg string, fields gobgplog.Fields) {
@@ -46,6 +57,13 @@ func (g *goBGPLogger) Warn(msg string, fields gobgplog.Fields) {
 
 func (g *goBGPLogger) Info(msg string, fields gobgplog.Fields) {
 	klog.V(0).InfoS(msg, toSlice(fields)...)
+	if msg == peerDownMessage && g.peerDownHandler != nil {
+		peerAddress, _ := fields[peerDownKeyField].(string)
+		reason, _ := fields[peerDownReasonField].(string)
+		if peerAddress != "" {
+			g.peerDownHandler(peerAddress, reason)
+		}
+	}
 }
 
 func (g *goBGPLogger) Debug(msg string, fields gobgplog.Fields) {
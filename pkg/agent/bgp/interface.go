@@ -42,14 +42,19 @@ type Interface interface {
 	// GetPeers retrieves the current status of all BGP peers.
 	GetPeers(ctx context.Context) ([]PeerStatus, error)
 
-	// AdvertiseRoutes announces the specified routes to all BGP peers.
+	// AdvertiseRoutes announces the specified routes with their path attributes to all BGP peers. Advertising a route
+	// whose prefix has already been advertised replaces the path attributes of the prefix.
 	AdvertiseRoutes(ctx context.Context, routes []Route) error
 
-	// WithdrawRoutes withdraws the specified routes from all BGP peers.
+	// WithdrawRoutes withdraws the prefixes of the specified routes from all BGP peers.
 	WithdrawRoutes(ctx context.Context, routes []Route) error
 
 	// GetRoutes retrieves the advertised / received routes to / from the given peer.
 	GetRoutes(ctx context.Context, routeType RouteType, peerAddress string) ([]Route, error)
+
+	// GetBestReceivedRoutes retrieves the best routes received from all BGP peers for the given IP family, with their
+	// next hops.
+	GetBestReceivedRoutes(ctx context.Context, isIPv6 bool) ([]Route, error)
 }
 
 // GlobalConfig contains the global configuration to start a BGP server. More attributes might be added later.
@@ -88,6 +93,9 @@ type PeerConfig struct {
 	// required to establish a secure BGP connection. If the peer requires password-based authentication, this value
 	// must be set to the appropriate password. Leaving this field empty will disable password authentication.
 	Password string
+	// IPv6NextHop is the next hop of the IPv6 routes advertised to an IPv4 BGP peer with the IPv6 unicast address
+	// family enabled, as the local address of the BGP session cannot be used as their next hop.
+	IPv6NextHop string
 }
 
 // PeerStatus contains the status information for a BGP peer. More attributes related to status might be added later.
@@ -99,10 +107,54 @@ type PeerStatus struct {
 	GracefulRestartTimeSeconds int32
 	SessionState               SessionState
 	UptimeSeconds              int
+	// LastError is the reason why the last established BGP session with the BGP peer went down, e.g. a NOTIFICATION
+	// message received from the BGP peer or the expiration of the hold timer.
+	LastError string
+	// Statistics of the BGP messages exchanged with the BGP peer.
+	MessagesReceived MessageStatistics
+	MessagesSent     MessageStatistics
+	// Counts of the prefixes of all address families. ReceivedPrefixes is the number of prefixes received from the BGP
+	// peer, AcceptedPrefixes is the number of received prefixes accepted by the import policies, and
+	// AdvertisedPrefixes is the number of prefixes advertised to the BGP peer.
+	ReceivedPrefixes   uint64
+	AcceptedPrefixes   uint64
+	AdvertisedPrefixes uint64
+}
+
+// MessageStatistics contains the counts of the BGP messages exchanged with a BGP peer.
+type MessageStatistics struct {
+	Total        uint64
+	Open         uint64
+	Update       uint64
+	Notification uint64
+	Keepalive    uint64
+	Refresh      uint64
 }
 
-// Route represents a BGP route. Currently only prefix (e.g., "192.168.0.0/24") is needed. More attributes might be
-// added later.
+// Route represents a BGP route, identified by its prefix (e.g., "192.168.0.0/24"). It only has comparable fields so
+// that it can be used as a map key.
 type Route struct {
 	Prefix string
+	// Attributes are the optional path attributes attached to the route when it is advertised.
+	Attributes RouteAttributes
+	// NextHop is the next hop of a route received from a BGP peer. It is empty for advertised routes.
+	NextHop string
+}
+
+// RouteAttributes contains the optional path attributes of a BGP route. Lists are stored as comma-separated strings to
+// keep the struct comparable.
+type RouteAttributes struct {
+	// Communities is the comma-separated list of standard communities (RFC 1997), e.g. "65000:100,no-export".
+	Communities string
+	// LargeCommunities is the comma-separated list of large communities (RFC 8092), e.g. "65000:100:1".
+	LargeCommunities string
+	// MED is the MULTI_EXIT_DISC attribute, which is only attached when HasMED is true.
+	MED    uint32
+	HasMED bool
+	// LocalPreference is the LOCAL_PREF attribute, which is only attached when HasLocalPreference is true. It is only
+	// sent to iBGP peers.
+	LocalPreference    uint32
+	HasLocalPreference bool
+	// ASPathPrepend is the number of times the local AS number is prepended to the AS path.
+	ASPathPrepend uint32
 }
@@ -84,6 +84,21 @@ func (mr *MockInterfaceMockRecorder) AdvertiseRoutes(ctx, routes any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdvertiseRoutes", reflect.TypeOf((*MockInterface)(nil).AdvertiseRoutes), ctx, routes)
 }
 
+// GetBestReceivedRoutes mocks base method.
+func (m *MockInterface) GetBestReceivedRoutes(ctx context.Context, isIPv6 bool) ([]bgp.Route, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBestReceivedRoutes", ctx, isIPv6)
+	ret0, _ := ret[0].([]bgp.Route)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBestReceivedRoutes indicates an expected call of GetBestReceivedRoutes.
+func (mr *MockInterfaceMockRecorder) GetBestReceivedRoutes(ctx, isIPv6 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBestReceivedRoutes", reflect.TypeOf((*MockInterface)(nil).GetBestReceivedRoutes), ctx, isIPv6)
+}
+
 // GetPeers mocks base method.
 func (m *MockInterface) GetPeers(ctx context.Context) ([]bgp.PeerStatus, error) {
 	m.ctrl.T.Helper()
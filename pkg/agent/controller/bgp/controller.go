@@ -21,7 +21,10 @@ import (
 	"fmt"
 	"hash/fnv"
 	"net"
+	"net/netip"
 	"reflect"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
@@ -36,26 +39,30 @@ import (
 	coreinformers "k8s.io/client-go/informers/core/v1"
 	discoveryinformers "k8s.io/client-go/informers/discovery/v1"
 	"k8s.io/client-go/kubernetes"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	corelisters "k8s.io/client-go/listers/core/v1"
 	discoverylisters "k8s.io/client-go/listers/discovery/v1"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
 	utilnet "k8s.io/utils/net"
 	"k8s.io/utils/ptr"
-	"k8s.io/utils/strings/slices"
 
 	"antrea.io/antrea/pkg/agent/bgp"
 	"antrea.io/antrea/pkg/agent/bgp/gobgp"
 	"antrea.io/antrea/pkg/agent/config"
+	"antrea.io/antrea/pkg/agent/route"
 	"antrea.io/antrea/pkg/agent/types"
 	"antrea.io/antrea/pkg/apis/crd/v1alpha1"
 	"antrea.io/antrea/pkg/apis/crd/v1beta1"
+	"antrea.io/antrea/pkg/client/clientset/versioned/scheme"
 	crdinformersv1a1 "antrea.io/antrea/pkg/client/informers/externalversions/crd/v1alpha1"
 	crdinformersv1b1 "antrea.io/antrea/pkg/client/informers/externalversions/crd/v1beta1"
 	crdlistersv1a1 "antrea.io/antrea/pkg/client/listers/crd/v1alpha1"
 	crdlistersv1b1 "antrea.io/antrea/pkg/client/listers/crd/v1beta1"
 	"antrea.io/antrea/pkg/util/env"
+	"antrea.io/antrea/pkg/util/k8s"
 )
 
 const (
@@ -65,6 +72,13 @@ const (
 	maxRetryDelay = 300 * time.Second
 	// Disable resyncing.
 	resyncPeriod time.Duration = 0
+	// How often the routes received from BGP peers are synced to the routing table of the Node.
+	importedRoutesSyncInterval = 10 * time.Second
+	// The default metric of the routes imported from BGP peers.
+	defaultImportedRouteMetric = 20
+	// How long the routes imported before a BGP server is started are kept if they are not received again, which gives
+	// the BGP sessions time to be established.
+	importedRoutesRestartGracePeriod = 2 * time.Minute
 )
 
 const (
@@ -86,6 +100,7 @@ const (
 	ServiceExternalIP     AdvertisedRouteType = "ServiceExternalIP"
 	ServiceClusterIP      AdvertisedRouteType = "ServiceClusterIP"
 	NodeIPAMPodCIDR       AdvertisedRouteType = "NodeIPAMPodCIDR"
+	PodIP                 AdvertisedRouteType = "PodIP"
 )
 
 type RouteMetadata struct {
@@ -93,6 +108,19 @@ type RouteMetadata struct {
 	K8sObjRef string
 }
 
+// BGPPolicyDryRunResult is the result of evaluating a candidate BGPPolicy on the Node without applying it.
+type BGPPolicyDryRunResult struct {
+	// SelectsNode is true if the candidate BGPPolicy selects the Node.
+	SelectsNode bool
+	// EffectiveBGPPolicy is the name of the BGPPolicy which would be effective on the Node if the candidate BGPPolicy
+	// were applied. It is empty if no BGPPolicy would be effective.
+	EffectiveBGPPolicy string
+	// Routes are the routes which the candidate BGPPolicy would advertise if it were effective on the Node.
+	Routes map[bgp.Route]RouteMetadata
+	// AdvertisedRoutes are the routes currently advertised by the effective BGPPolicy.
+	AdvertisedRoutes map[bgp.Route]RouteMetadata
+}
+
 type bgpPolicyState struct {
 	// The local BGP server.
 	bgpServer bgp.Interface
@@ -109,6 +137,23 @@ type bgpPolicyState struct {
 	// peerConfigs is a map that stores configurations of BGP peers. The map keys are the concatenated strings of BGP
 	// peer IP address and ASN (e.g., "192.168.77.100-65000", "2001::1-65000").
 	peerConfigs map[string]bgp.PeerConfig
+	// routeImport is the import configuration of the effective BGPPolicy.
+	routeImport *v1alpha1.RouteImport
+	// importedRoutes stores the routes received from BGP peers and installed in the routing table of the Node. The map
+	// keys are the prefixes of the routes.
+	importedRoutes map[string]importedRoute
+	// importLimitExceeded indicates whether more routes than the limit of the import configuration are received, in
+	// which case no route is imported.
+	importLimitExceeded bool
+	// staleImportedRoutes stores the prefixes of the imported routes which were installed before the BGP server was
+	// started and have not been received from the BGP peers since. They are kept until importGracePeriodEnd.
+	staleImportedRoutes  sets.Set[string]
+	importGracePeriodEnd time.Time
+}
+
+type importedRoute struct {
+	nextHop string
+	metric  int
 }
 
 type Controller struct {
@@ -124,6 +169,9 @@ type Controller struct {
 	egressLister       crdlistersv1b1.EgressLister
 	egressListerSynced cache.InformerSynced
 
+	externalIPPoolLister       crdlistersv1b1.ExternalIPPoolLister
+	externalIPPoolListerSynced cache.InformerSynced
+
 	bgpPolicyInformer     cache.SharedIndexInformer
 	bgpPolicyLister       crdlistersv1a1.BGPPolicyLister
 	bgpPolicyListerSynced cache.InformerSynced
@@ -132,21 +180,41 @@ type Controller struct {
 	endpointSliceLister       discoverylisters.EndpointSliceLister
 	endpointSliceListerSynced cache.InformerSynced
 
+	podInformer     cache.SharedIndexInformer
+	podLister       corelisters.PodLister
+	podListerSynced cache.InformerSynced
+
+	namespaceInformer     cache.SharedIndexInformer
+	namespaceLister       corelisters.NamespaceLister
+	namespaceListerSynced cache.InformerSynced
+
 	secretInformer cache.SharedIndexInformer
 
 	bgpPolicyState      *bgpPolicyState
 	bgpPolicyStateMutex sync.RWMutex
+	// staleImportedRoutes stores the imported routes found in the routing table at startup, which are taken over by
+	// the first BGP server, or deleted if no BGPPolicy is applied to the Node.
+	staleImportedRoutes map[string]importedRoute
 
 	k8sClient             kubernetes.Interface
+	routeClient           route.Interface
+	eventBroadcaster      record.EventBroadcaster
+	record                record.EventRecorder
 	bgpPeerPasswords      map[string]string
 	bgpPeerPasswordsMutex sync.RWMutex
 
-	nodeName     string
-	enabledIPv4  bool
-	enabledIPv6  bool
-	podIPv4CIDR  string
-	podIPv6CIDR  string
-	nodeIPv4Addr string
+	nodeName    string
+	enabledIPv4 bool
+	enabledIPv6 bool
+	podIPv4CIDR string
+	podIPv6CIDR string
+	// serviceCIDRs are the Service ClusterIP CIDRs, for which routes received from BGP peers are never imported.
+	serviceCIDRs []netip.Prefix
+	// nodeTransportSubnets are the subnets of the Node transport interface, for which routes received from BGP peers
+	// are never imported.
+	nodeTransportSubnets []netip.Prefix
+	nodeIPv4Addr         string
+	nodeIPv6Addr         string
 
 	egressEnabled bool
 
@@ -158,12 +226,22 @@ type Controller struct {
 func NewBGPPolicyController(nodeInformer coreinformers.NodeInformer,
 	serviceInformer coreinformers.ServiceInformer,
 	egressInformer crdinformersv1b1.EgressInformer,
+	externalIPPoolInformer crdinformersv1b1.ExternalIPPoolInformer,
 	bgpPolicyInformer crdinformersv1a1.BGPPolicyInformer,
 	endpointSliceInformer discoveryinformers.EndpointSliceInformer,
+	podInformer cache.SharedIndexInformer,
+	namespaceInformer coreinformers.NamespaceInformer,
 	egressEnabled bool,
 	k8sClient kubernetes.Interface,
+	routeClient route.Interface,
 	nodeConfig *config.NodeConfig,
-	networkConfig *config.NetworkConfig) (*Controller, error) {
+	networkConfig *config.NetworkConfig,
+	serviceConfig *config.ServiceConfig) (*Controller, error) {
+	eventBroadcaster := record.NewBroadcaster()
+	recorder := eventBroadcaster.NewRecorder(
+		scheme.Scheme,
+		corev1.EventSource{Component: controllerName},
+	)
 	c := &Controller{
 		nodeInformer:              nodeInformer.Informer(),
 		nodeLister:                nodeInformer.Lister(),
@@ -177,7 +255,16 @@ func NewBGPPolicyController(nodeInformer coreinformers.NodeInformer,
 		endpointSliceInformer:     endpointSliceInformer.Informer(),
 		endpointSliceLister:       endpointSliceInformer.Lister(),
 		endpointSliceListerSynced: endpointSliceInformer.Informer().HasSynced,
+		podInformer:               podInformer,
+		podLister:                 corelisters.NewPodLister(podInformer.GetIndexer()),
+		podListerSynced:           podInformer.HasSynced,
+		namespaceInformer:         namespaceInformer.Informer(),
+		namespaceLister:           namespaceInformer.Lister(),
+		namespaceListerSynced:     namespaceInformer.Informer().HasSynced,
 		k8sClient:                 k8sClient,
+		routeClient:               routeClient,
+		eventBroadcaster:          eventBroadcaster,
+		record:                    recorder,
 		bgpPeerPasswords:          make(map[string]string),
 		nodeName:                  nodeConfig.Name,
 		enabledIPv4:               networkConfig.IPv4Enabled,
@@ -196,6 +283,28 @@ func NewBGPPolicyController(nodeInformer coreinformers.NodeInformer,
 			},
 		),
 	}
+	if nodeConfig.NodeIPv6Addr != nil {
+		c.nodeIPv6Addr = nodeConfig.NodeIPv6Addr.IP.String()
+	}
+	c.externalIPPoolLister = externalIPPoolInformer.Lister()
+	c.externalIPPoolListerSynced = externalIPPoolInformer.Informer().HasSynced
+	for _, serviceCIDR := range []*net.IPNet{serviceConfig.ServiceCIDR, serviceConfig.ServiceCIDRv6} {
+		if serviceCIDR == nil {
+			continue
+		}
+		if prefix, err := netip.ParsePrefix(serviceCIDR.String()); err == nil {
+			c.serviceCIDRs = append(c.serviceCIDRs, prefix)
+		}
+	}
+	for _, transportAddr := range []*net.IPNet{nodeConfig.NodeTransportIPv4Addr, nodeConfig.NodeTransportIPv6Addr} {
+		if transportAddr == nil {
+			continue
+		}
+		ones, _ := transportAddr.Mask.Size()
+		if addr, ok := netip.AddrFromSlice(transportAddr.IP); ok {
+			c.nodeTransportSubnets = append(c.nodeTransportSubnets, netip.PrefixFrom(addr.Unmap(), ones).Masked())
+		}
+	}
 	c.bgpPolicyInformer.AddEventHandlerWithResyncPeriod(
 		cache.ResourceEventHandlerFuncs{
 			AddFunc:    c.addBGPPolicy,
@@ -220,6 +329,22 @@ func NewBGPPolicyController(nodeInformer coreinformers.NodeInformer,
 		},
 		resyncPeriod,
 	)
+	c.podInformer.AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.addPod,
+			UpdateFunc: c.updatePod,
+			DeleteFunc: c.deletePod,
+		},
+		resyncPeriod,
+	)
+	c.namespaceInformer.AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    nil,
+			UpdateFunc: c.updateNamespace,
+			DeleteFunc: nil,
+		},
+		resyncPeriod,
+	)
 	if c.egressEnabled {
 		c.egressInformer = egressInformer.Informer()
 		c.egressLister = egressInformer.Lister()
@@ -264,6 +389,12 @@ func (c *Controller) Run(ctx context.Context) {
 	klog.InfoS("Starting", "controllerName", controllerName)
 	defer klog.InfoS("Shutting down", "controllerName", controllerName)
 
+	c.eventBroadcaster.StartStructuredLogging(0)
+	c.eventBroadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{
+		Interface: c.k8sClient.CoreV1().Events(""),
+	})
+	defer c.eventBroadcaster.Shutdown()
+
 	go c.secretInformer.Run(ctx.Done())
 
 	cacheSyncs := []cache.InformerSynced{
@@ -272,6 +403,9 @@ func (c *Controller) Run(ctx context.Context) {
 		c.bgpPolicyListerSynced,
 		c.endpointSliceListerSynced,
 		c.serviceListerSynced,
+		c.externalIPPoolListerSynced,
+		c.podListerSynced,
+		c.namespaceListerSynced,
 		c.secretInformer.HasSynced,
 	}
 	if c.egressEnabled {
@@ -281,7 +415,10 @@ func (c *Controller) Run(ctx context.Context) {
 		return
 	}
 
+	c.restoreImportedRoutes()
+
 	go wait.UntilWithContext(ctx, c.worker, time.Second)
+	go wait.UntilWithContext(ctx, c.syncImportedRoutes, importedRoutesSyncInterval)
 
 	<-ctx.Done()
 }
@@ -311,8 +448,13 @@ func (c *Controller) processNextWorkItem(ctx context.Context) bool {
 
 func (c *Controller) getEffectiveBGPPolicy() *v1alpha1.BGPPolicy {
 	allPolicies, _ := c.bgpPolicyLister.List(labels.Everything())
+	return c.getOldestBGPPolicy(allPolicies)
+}
+
+// getOldestBGPPolicy returns the oldest BGPPolicy applied to the current Node among the given BGPPolicies.
+func (c *Controller) getOldestBGPPolicy(policies []*v1alpha1.BGPPolicy) *v1alpha1.BGPPolicy {
 	var oldestPolicy *v1alpha1.BGPPolicy
-	for _, policy := range allPolicies {
+	for _, policy := range policies {
 		if c.matchesCurrentNode(policy) {
 			if oldestPolicy == nil || policy.CreationTimestamp.Before(&oldestPolicy.CreationTimestamp) {
 				oldestPolicy = policy
@@ -339,12 +481,16 @@ func (c *Controller) syncBGPPolicy(ctx context.Context) error {
 
 	// When the effective BGPPolicy is nil, it means that there is no available BGPPolicy.
 	if effectivePolicy == nil {
-		// If the BGPPolicy state is nil, just return.
+		// If the BGPPolicy state is nil, delete the routes imported by the previous agent if any, then return.
 		if c.bgpPolicyState == nil {
-			return nil
+			return c.deleteStaleImportedRoutes()
 		}
 
-		// If the BGPPolicy state is not nil, stop the BGP server and reset the state to nil, then return.
+		// If the BGPPolicy state is not nil, delete the imported routes and stop the BGP server, then reset the state to
+		// nil and return.
+		if err := c.reconcileImportedRoutes(ctx, nil); err != nil {
+			return err
+		}
 		if err := c.bgpPolicyState.bgpServer.Stop(ctx); err != nil {
 			return err
 		}
@@ -374,7 +520,15 @@ func (c *Controller) syncBGPPolicy(ctx context.Context) error {
 		c.bgpPolicyState.routerID != routerID
 
 	if needUpdateBGPServer {
+		// The imported routes, including the ones imported by the previous agent, are kept in the routing table until
+		// the routes are received by the new BGP server or the grace period ends.
+		importedRoutes := c.staleImportedRoutes
+		c.staleImportedRoutes = nil
+		if importedRoutes == nil {
+			importedRoutes = make(map[string]importedRoute)
+		}
 		if c.bgpPolicyState != nil {
+			importedRoutes = c.bgpPolicyState.importedRoutes
 			// Stop the current BGP server.
 			if err := c.bgpPolicyState.bgpServer.Stop(ctx); err != nil {
 				return fmt.Errorf("failed to stop current BGP server: %w", err)
@@ -397,13 +551,16 @@ func (c *Controller) syncBGPPolicy(ctx context.Context) error {
 
 		// Initialize the BGPPolicy state to store the new BGP server, BGP policy name, listen port, local ASN, and router ID.
 		c.bgpPolicyState = &bgpPolicyState{
-			bgpServer:     bgpServer,
-			bgpPolicyName: bgpPolicyName,
-			routerID:      routerID,
-			listenPort:    listenPort,
-			localASN:      localASN,
-			routes:        make(map[bgp.Route]RouteMetadata),
-			peerConfigs:   make(map[string]bgp.PeerConfig),
+			bgpServer:            bgpServer,
+			bgpPolicyName:        bgpPolicyName,
+			routerID:             routerID,
+			listenPort:           listenPort,
+			localASN:             localASN,
+			routes:               make(map[bgp.Route]RouteMetadata),
+			peerConfigs:          make(map[string]bgp.PeerConfig),
+			importedRoutes:       importedRoutes,
+			staleImportedRoutes:  sets.KeySet(importedRoutes),
+			importGracePeriodEnd: time.Now().Add(importedRoutesRestartGracePeriod),
 		}
 	} else if c.bgpPolicyState.bgpPolicyName != bgpPolicyName {
 		// It may happen that only BGP policy name has changed in effective BGP policy.
@@ -420,6 +577,12 @@ func (c *Controller) syncBGPPolicy(ctx context.Context) error {
 		return err
 	}
 
+	// Reconcile the routes imported from BGP peers.
+	c.bgpPolicyState.routeImport = effectivePolicy.Spec.Import
+	if err := c.reconcileImportedRoutes(ctx, effectivePolicy.Spec.Import); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -485,9 +648,17 @@ func (c *Controller) reconcileBGPAdvertisements(ctx context.Context, bgpAdvertis
 			K8sObjRef: curRoutes[route].K8sObjRef,
 		}
 	}
+	// A route whose path attributes have changed has been replaced by the advertisement of the same prefix with the new
+	// attributes, so only the stale state is deleted and the prefix is not withdrawn.
+	curPrefixes := sets.New[string]()
+	for route := range currRoutesKeys {
+		curPrefixes.Insert(route.Prefix)
+	}
 	for route := range routesToWithdraw {
-		if err := bgpServer.WithdrawRoutes(ctx, []bgp.Route{route}); err != nil {
-			return err
+		if !curPrefixes.Has(route.Prefix) {
+			if err := bgpServer.WithdrawRoutes(ctx, []bgp.Route{route}); err != nil {
+				return err
+			}
 		}
 		delete(c.bgpPolicyState.routes, route)
 	}
@@ -495,6 +666,269 @@ func (c *Controller) reconcileBGPAdvertisements(ctx context.Context, bgpAdvertis
 	return nil
 }
 
+// syncImportedRoutes periodically syncs the routes received from BGP peers to the routing table of the Node, as the
+// received routes can change at any time.
+func (c *Controller) syncImportedRoutes(ctx context.Context) {
+	c.bgpPolicyStateMutex.Lock()
+	defer c.bgpPolicyStateMutex.Unlock()
+
+	if c.bgpPolicyState == nil || c.bgpPolicyState.routeImport == nil && len(c.bgpPolicyState.importedRoutes) == 0 {
+		return
+	}
+	if err := c.reconcileImportedRoutes(ctx, c.bgpPolicyState.routeImport); err != nil {
+		klog.ErrorS(err, "Failed to sync routes imported from BGP peers")
+	}
+}
+
+// getRoutesToImport returns the best routes received from BGP peers which match the import configuration, keyed by
+// their prefixes. The second return value indicates whether more routes than the limit of the import configuration
+// are received, in which case no route is returned.
+func (c *Controller) getRoutesToImport(ctx context.Context, routeImport *v1alpha1.RouteImport) (map[string]importedRoute, bool, error) {
+	routesToImport := make(map[string]importedRoute)
+	if routeImport == nil {
+		return routesToImport, false, nil
+	}
+	metric := defaultImportedRouteMetric
+	if routeImport.Metric != nil {
+		metric = int(*routeImport.Metric)
+	}
+	var receivedRoutes []bgp.Route
+	for _, isIPv6 := range []bool{false, true} {
+		if isIPv6 && !c.enabledIPv6 || !isIPv6 && !c.enabledIPv4 {
+			continue
+		}
+		routes, err := c.bgpPolicyState.bgpServer.GetBestReceivedRoutes(ctx, isIPv6)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to get received routes: %w", err)
+		}
+		receivedRoutes = append(receivedRoutes, routes...)
+	}
+
+	reservedPrefixes := c.getReservedPrefixes()
+	for _, route := range receivedRoutes {
+		prefix, err := netip.ParsePrefix(route.Prefix)
+		if err != nil || !matchesImportPrefixes(prefix, routeImport.Prefixes) {
+			continue
+		}
+		// A default route or a route overlapping with the cluster networks, e.g. a host route for a Node IP, would
+		// divert the traffic of the cluster to the BGP peers.
+		if prefix.Bits() == 0 || overlapsPrefixes(prefix, reservedPrefixes) {
+			klog.V(2).InfoS("Ignoring received route overlapping with the cluster networks", "prefix", route.Prefix)
+			continue
+		}
+		routesToImport[prefix.Masked().String()] = importedRoute{nextHop: route.NextHop, metric: metric}
+	}
+	if routeImport.MaxPrefixes > 0 && len(routesToImport) > int(routeImport.MaxPrefixes) {
+		return map[string]importedRoute{}, true, nil
+	}
+	return routesToImport, false, nil
+}
+
+// getReservedPrefixes returns the Pod CIDRs and IPs of all Nodes, the subnets of the Node transport interface, the
+// Service CIDRs and the IP ranges of all ExternalIPPools, for which routes received from BGP peers are never imported.
+func (c *Controller) getReservedPrefixes() []netip.Prefix {
+	prefixes := slices.Clone(c.serviceCIDRs)
+	prefixes = append(prefixes, c.nodeTransportSubnets...)
+	for _, podCIDR := range []string{c.podIPv4CIDR, c.podIPv6CIDR} {
+		if prefix, err := netip.ParsePrefix(podCIDR); err == nil {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+	nodes, _ := c.nodeLister.List(labels.Everything())
+	for _, node := range nodes {
+		for _, podCIDR := range node.Spec.PodCIDRs {
+			if prefix, err := netip.ParsePrefix(podCIDR); err == nil {
+				prefixes = append(prefixes, prefix)
+			}
+		}
+		nodeIPs, _ := k8s.GetNodeAllAddrs(node)
+		for nodeIP := range nodeIPs {
+			if addr, err := netip.ParseAddr(nodeIP); err == nil {
+				prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+			}
+		}
+	}
+	pools, _ := c.externalIPPoolLister.List(labels.Everything())
+	for _, pool := range pools {
+		for _, ipRange := range pool.Spec.IPRanges {
+			if ipRange.CIDR != "" {
+				if prefix, err := netip.ParsePrefix(ipRange.CIDR); err == nil {
+					prefixes = append(prefixes, prefix)
+				}
+				continue
+			}
+			start, err1 := netip.ParseAddr(ipRange.Start)
+			end, err2 := netip.ParseAddr(ipRange.End)
+			if err1 == nil && err2 == nil && start.Is4() == end.Is4() {
+				prefixes = append(prefixes, ipRangeToPrefixes(start, end)...)
+			}
+		}
+	}
+	return prefixes
+}
+
+// ipRangeToPrefixes returns the smallest set of prefixes covering the IP range from start to end, inclusive.
+func ipRangeToPrefixes(start, end netip.Addr) []netip.Prefix {
+	var prefixes []netip.Prefix
+	for start.IsValid() && start.Compare(end) <= 0 {
+		bits := start.BitLen()
+		for bits > 0 {
+			prefix := netip.PrefixFrom(start, bits-1).Masked()
+			if prefix.Addr() != start || lastAddr(prefix).Compare(end) > 0 {
+				break
+			}
+			bits--
+		}
+		prefix := netip.PrefixFrom(start, bits)
+		prefixes = append(prefixes, prefix)
+		start = lastAddr(prefix).Next()
+	}
+	return prefixes
+}
+
+// lastAddr returns the last IP of the prefix.
+func lastAddr(prefix netip.Prefix) netip.Addr {
+	b := prefix.Masked().Addr().AsSlice()
+	for i := prefix.Bits(); i < len(b)*8; i++ {
+		b[i/8] |= 1 << (7 - i%8)
+	}
+	addr, _ := netip.AddrFromSlice(b)
+	return addr
+}
+
+func overlapsPrefixes(prefix netip.Prefix, prefixes []netip.Prefix) bool {
+	for _, p := range prefixes {
+		if prefix.Overlaps(p) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesImportPrefixes(prefix netip.Prefix, filters []v1alpha1.ImportPrefix) bool {
+	for _, filter := range filters {
+		cidr, err := netip.ParsePrefix(filter.CIDR)
+		if err != nil {
+			continue
+		}
+		cidr = cidr.Masked()
+		minLength := max(int(filter.MinLength), cidr.Bits())
+		maxLength := max(int(filter.MaxLength), minLength)
+		if prefix.Addr().Is4() == cidr.Addr().Is4() && cidr.Contains(prefix.Addr()) &&
+			prefix.Bits() >= minLength && prefix.Bits() <= maxLength {
+			return true
+		}
+	}
+	return false
+}
+
+// reconcileImportedRoutes installs the received routes matching the import configuration in the routing table of the
+// Node, and deletes the imported routes which are no longer received or no longer match. The routes imported before
+// the BGP server was started are kept until they are received again or the grace period ends, so that the traffic
+// to the imported prefixes is not disrupted while the BGP sessions are being established. A route which cannot be
+// installed, e.g. because its next hop is not reachable, is skipped and retried in the next sync.
+func (c *Controller) reconcileImportedRoutes(ctx context.Context, routeImport *v1alpha1.RouteImport) error {
+	routesToImport, limitExceeded, err := c.getRoutesToImport(ctx, routeImport)
+	if err != nil {
+		return err
+	}
+	if limitExceeded != c.bgpPolicyState.importLimitExceeded {
+		c.bgpPolicyState.importLimitExceeded = limitExceeded
+		c.reportImportLimit(routeImport, limitExceeded)
+	}
+	importedRoutes := c.bgpPolicyState.importedRoutes
+	staleImportedRoutes := c.bgpPolicyState.staleImportedRoutes
+	inGracePeriod := routeImport != nil && !limitExceeded && time.Now().Before(c.bgpPolicyState.importGracePeriodEnd)
+	for prefix, preRoute := range importedRoutes {
+		curRoute, exists := routesToImport[prefix]
+		if exists && curRoute.metric == preRoute.metric {
+			continue
+		}
+		if !exists && inGracePeriod && staleImportedRoutes.Has(prefix) {
+			continue
+		}
+		_, dst, _ := net.ParseCIDR(prefix)
+		if err := c.routeClient.DeleteBGPImportedRoute(dst, preRoute.metric); err != nil {
+			return fmt.Errorf("failed to delete imported route for %s: %w", prefix, err)
+		}
+		delete(importedRoutes, prefix)
+		staleImportedRoutes.Delete(prefix)
+	}
+	for prefix, curRoute := range routesToImport {
+		if preRoute, exists := importedRoutes[prefix]; exists && preRoute == curRoute {
+			staleImportedRoutes.Delete(prefix)
+			continue
+		}
+		_, dst, _ := net.ParseCIDR(prefix)
+		if err := c.routeClient.AddBGPImportedRoute(dst, net.ParseIP(curRoute.nextHop), curRoute.metric); err != nil {
+			klog.ErrorS(err, "Failed to install route imported from BGP peers", "prefix", prefix, "nextHop", curRoute.nextHop)
+			continue
+		}
+		importedRoutes[prefix] = curRoute
+		staleImportedRoutes.Delete(prefix)
+	}
+	return nil
+}
+
+// restoreImportedRoutes loads the routes imported by the previous agent from the routing table. Instead of being
+// flushed, they are reconciled against the routes received by the first BGP server.
+func (c *Controller) restoreImportedRoutes() {
+	routes, err := c.routeClient.ListBGPImportedRoutes()
+	if err != nil {
+		klog.ErrorS(err, "Failed to list routes imported from BGP peers")
+		return
+	}
+	if len(routes) == 0 {
+		return
+	}
+	c.bgpPolicyStateMutex.Lock()
+	defer c.bgpPolicyStateMutex.Unlock()
+	c.staleImportedRoutes = make(map[string]importedRoute, len(routes))
+	for _, r := range routes {
+		var nextHop string
+		if r.Gateway != nil {
+			nextHop = r.Gateway.String()
+		}
+		c.staleImportedRoutes[r.Dst.String()] = importedRoute{nextHop: nextHop, metric: r.Metric}
+	}
+	klog.InfoS("Restored routes imported from BGP peers", "count", len(routes))
+	// Make sure the routes are deleted if no BGPPolicy is applied to the Node.
+	c.queue.Add(dummyKey)
+}
+
+// deleteStaleImportedRoutes deletes the routes imported by the previous agent which are not taken over by a BGP
+// server.
+func (c *Controller) deleteStaleImportedRoutes() error {
+	for prefix, r := range c.staleImportedRoutes {
+		_, dst, _ := net.ParseCIDR(prefix)
+		if err := c.routeClient.DeleteBGPImportedRoute(dst, r.metric); err != nil {
+			return fmt.Errorf("failed to delete imported route for %s: %w", prefix, err)
+		}
+		delete(c.staleImportedRoutes, prefix)
+	}
+	return nil
+}
+
+// reportImportLimit reports that the number of received routes exceeds the limit of the import configuration, or
+// is back under it.
+func (c *Controller) reportImportLimit(routeImport *v1alpha1.RouteImport, limitExceeded bool) {
+	var policy *v1alpha1.BGPPolicy
+	if c.bgpPolicyState.bgpPolicyName != "" {
+		policy, _ = c.bgpPolicyLister.Get(c.bgpPolicyState.bgpPolicyName)
+	}
+	if limitExceeded {
+		klog.ErrorS(nil, "The number of received routes exceeds the limit, not importing any route", "bgpPolicy", c.bgpPolicyState.bgpPolicyName, "maxPrefixes", routeImport.MaxPrefixes)
+		if policy != nil {
+			c.record.Eventf(policy, corev1.EventTypeWarning, "ImportLimitExceeded", "The number of routes received by Node %s exceeds the limit %d, no route is imported", c.nodeName, routeImport.MaxPrefixes)
+		}
+		return
+	}
+	klog.InfoS("The number of received routes is under the limit again, importing routes", "bgpPolicy", c.bgpPolicyState.bgpPolicyName)
+	if policy != nil {
+		c.record.Eventf(policy, corev1.EventTypeNormal, "ImportLimitCleared", "The number of routes received by Node %s is under the limit again, routes are imported", c.nodeName)
+	}
+}
+
 func hashNodeNameToIP(s string) string {
 	h := fnv.New32a() // Create a new FNV hash
 	h.Write([]byte(s))
@@ -565,10 +999,10 @@ func (c *Controller) getRoutes(advertisements v1alpha1.Advertisements) map[bgp.R
 		c.addServiceRoutes(advertisements.Service, allRoutes)
 	}
 	if c.egressEnabled && advertisements.Egress != nil {
-		c.addEgressRoutes(allRoutes)
+		c.addEgressRoutes(advertisements.Egress, allRoutes)
 	}
 	if advertisements.Pod != nil {
-		c.addPodRoutes(allRoutes)
+		c.addPodRoutes(advertisements.Pod, allRoutes)
 	}
 
 	return allRoutes
@@ -576,9 +1010,13 @@ func (c *Controller) getRoutes(advertisements v1alpha1.Advertisements) map[bgp.R
 
 func (c *Controller) addServiceRoutes(advertisement *v1alpha1.ServiceAdvertisement, allRoutes map[bgp.Route]RouteMetadata) {
 	ipTypes := sets.New(advertisement.IPTypes...)
+	attributes := convertRouteAttributes(advertisement.Attributes)
 	services, _ := c.serviceLister.List(labels.Everything())
 
 	for _, svc := range services {
+		if !c.selectsService(svc, advertisement) {
+			continue
+		}
 		svcRef := svc.Namespace + "/" + svc.Name
 		internalLocal := svc.Spec.InternalTrafficPolicy != nil && *svc.Spec.InternalTrafficPolicy == corev1.ServiceInternalTrafficPolicyLocal
 		externalLocal := svc.Spec.ExternalTrafficPolicy == corev1.ServiceExternalTrafficPolicyLocal
@@ -590,9 +1028,9 @@ func (c *Controller) addServiceRoutes(advertisement *v1alpha1.ServiceAdvertiseme
 			if internalLocal && hasLocalEndpoints || !internalLocal {
 				for _, clusterIP := range svc.Spec.ClusterIPs {
 					if c.enabledIPv4 && utilnet.IsIPv4String(clusterIP) {
-						addRoutes(allRoutes, clusterIP+ipv4Suffix, svcRef, ServiceClusterIP)
+						addRoutes(allRoutes, clusterIP+ipv4Suffix, svcRef, ServiceClusterIP, attributes)
 					} else if c.enabledIPv6 && utilnet.IsIPv6String(clusterIP) {
-						addRoutes(allRoutes, clusterIP+ipv6Suffix, svcRef, ServiceClusterIP)
+						addRoutes(allRoutes, clusterIP+ipv6Suffix, svcRef, ServiceClusterIP, attributes)
 					}
 				}
 			}
@@ -601,9 +1039,9 @@ func (c *Controller) addServiceRoutes(advertisement *v1alpha1.ServiceAdvertiseme
 			if externalLocal && hasLocalEndpoints || !externalLocal {
 				for _, externalIP := range svc.Spec.ExternalIPs {
 					if c.enabledIPv4 && utilnet.IsIPv4String(externalIP) {
-						addRoutes(allRoutes, externalIP+ipv4Suffix, svcRef, ServiceExternalIP)
+						addRoutes(allRoutes, externalIP+ipv4Suffix, svcRef, ServiceExternalIP, attributes)
 					} else if c.enabledIPv6 && utilnet.IsIPv6String(externalIP) {
-						addRoutes(allRoutes, externalIP+ipv6Suffix, svcRef, ServiceExternalIP)
+						addRoutes(allRoutes, externalIP+ipv6Suffix, svcRef, ServiceExternalIP, attributes)
 					}
 				}
 			}
@@ -613,9 +1051,9 @@ func (c *Controller) addServiceRoutes(advertisement *v1alpha1.ServiceAdvertiseme
 				loadBalancerIPs := getIngressIPs(svc)
 				for _, loadBalancerIP := range loadBalancerIPs {
 					if c.enabledIPv4 && utilnet.IsIPv4String(loadBalancerIP) {
-						addRoutes(allRoutes, loadBalancerIP+ipv4Suffix, svcRef, ServiceLoadBalancerIP)
+						addRoutes(allRoutes, loadBalancerIP+ipv4Suffix, svcRef, ServiceLoadBalancerIP, attributes)
 					} else if c.enabledIPv6 && utilnet.IsIPv6String(loadBalancerIP) {
-						addRoutes(allRoutes, loadBalancerIP+ipv6Suffix, svcRef, ServiceLoadBalancerIP)
+						addRoutes(allRoutes, loadBalancerIP+ipv6Suffix, svcRef, ServiceLoadBalancerIP, attributes)
 					}
 				}
 			}
@@ -623,37 +1061,80 @@ func (c *Controller) addServiceRoutes(advertisement *v1alpha1.ServiceAdvertiseme
 	}
 }
 
-func (c *Controller) addEgressRoutes(allRoutes map[bgp.Route]RouteMetadata) {
+func (c *Controller) addEgressRoutes(advertisement *v1alpha1.EgressAdvertisement, allRoutes map[bgp.Route]RouteMetadata) {
+	attributes := convertRouteAttributes(advertisement.Attributes)
 	egresses, _ := c.egressLister.List(labels.Everything())
 	for _, eg := range egresses {
-		if eg.Status.EgressNode != c.nodeName {
+		if eg.Status.EgressNode != c.nodeName || !selectsEgress(eg, advertisement) {
 			continue
 		}
 		ip := eg.Status.EgressIP
 		if c.enabledIPv4 && utilnet.IsIPv4String(ip) {
-			addRoutes(allRoutes, ip+ipv4Suffix, eg.Name, EgressIP)
+			addRoutes(allRoutes, ip+ipv4Suffix, eg.Name, EgressIP, attributes)
 		} else if c.enabledIPv6 && utilnet.IsIPv6String(ip) {
-			addRoutes(allRoutes, ip+ipv6Suffix, eg.Name, EgressIP)
+			addRoutes(allRoutes, ip+ipv6Suffix, eg.Name, EgressIP, attributes)
 		}
 	}
 }
 
-func (c *Controller) addPodRoutes(allRoutes map[bgp.Route]RouteMetadata) {
+func (c *Controller) addPodRoutes(advertisement *v1alpha1.PodAdvertisement, allRoutes map[bgp.Route]RouteMetadata) {
+	attributes := convertRouteAttributes(advertisement.Attributes)
+	// When Pods are selected, advertise the IPs of the selected local Pods as host routes instead of the NodeIPAM Pod
+	// CIDRs.
+	if hasPodSelectors(advertisement) {
+		pods, _ := c.podLister.List(labels.Everything())
+		for _, pod := range pods {
+			if !isPodIPAdvertisable(pod) || !c.selectsPod(pod, advertisement) {
+				continue
+			}
+			podRef := pod.Namespace + "/" + pod.Name
+			for _, podIP := range pod.Status.PodIPs {
+				if c.enabledIPv4 && utilnet.IsIPv4String(podIP.IP) {
+					addRoutes(allRoutes, podIP.IP+ipv4Suffix, podRef, PodIP, attributes)
+				} else if c.enabledIPv6 && utilnet.IsIPv6String(podIP.IP) {
+					addRoutes(allRoutes, podIP.IP+ipv6Suffix, podRef, PodIP, attributes)
+				}
+			}
+		}
+		return
+	}
 	if c.enabledIPv4 {
-		addRoutes(allRoutes, c.podIPv4CIDR, "", NodeIPAMPodCIDR)
+		addRoutes(allRoutes, c.podIPv4CIDR, "", NodeIPAMPodCIDR, attributes)
 	}
 	if c.enabledIPv6 {
-		addRoutes(allRoutes, c.podIPv6CIDR, "", NodeIPAMPodCIDR)
+		addRoutes(allRoutes, c.podIPv6CIDR, "", NodeIPAMPodCIDR, attributes)
 	}
 }
 
-func addRoutes(allRoutes map[bgp.Route]RouteMetadata, prefix, k8sObjRef string, routeType AdvertisedRouteType) {
-	allRoutes[bgp.Route{Prefix: prefix}] = RouteMetadata{
+func addRoutes(allRoutes map[bgp.Route]RouteMetadata, prefix, k8sObjRef string, routeType AdvertisedRouteType, attributes bgp.RouteAttributes) {
+	allRoutes[bgp.Route{Prefix: prefix, Attributes: attributes}] = RouteMetadata{
 		Type:      routeType,
 		K8sObjRef: k8sObjRef,
 	}
 }
 
+// convertRouteAttributes converts the BGP path attributes of an advertisement to the attributes of the advertised
+// routes. The CRD schema only accepts communities whose parts are in range, and MED and local preference values in
+// the range of uint32. The communities are parsed again when the routes are advertised.
+func convertRouteAttributes(attributes *v1alpha1.RouteAttributes) bgp.RouteAttributes {
+	var routeAttributes bgp.RouteAttributes
+	if attributes == nil {
+		return routeAttributes
+	}
+	routeAttributes.Communities = strings.Join(attributes.Communities, ",")
+	routeAttributes.LargeCommunities = strings.Join(attributes.LargeCommunities, ",")
+	if attributes.MED != nil {
+		routeAttributes.MED = uint32(*attributes.MED)
+		routeAttributes.HasMED = true
+	}
+	if attributes.LocalPreference != nil {
+		routeAttributes.LocalPreference = uint32(*attributes.LocalPreference)
+		routeAttributes.HasLocalPreference = true
+	}
+	routeAttributes.ASPathPrepend = uint32(attributes.ASPathPrepend)
+	return routeAttributes
+}
+
 func (c *Controller) hasLocalEndpoints(svc *corev1.Service) bool {
 	labelSelector := labels.Set{discovery.LabelServiceName: svc.GetName()}.AsSelector()
 	items, _ := c.endpointSliceLister.EndpointSlices(svc.GetNamespace()).List(labelSelector)
@@ -682,10 +1163,16 @@ func (c *Controller) getPeerConfigs(peers []v1alpha1.BGPPeer) map[string]bgp.Pee
 				password = p
 			}
 
-			peerConfigs[peerKey] = bgp.PeerConfig{
+			peerConfig := bgp.PeerConfig{
 				BGPPeer:  &peers[i],
 				Password: password,
 			}
+			// The IPv6 routes advertised to an IPv4 BGP peer use the IPv6 address of the Node as the next hop.
+			if c.enabledIPv6 && c.nodeIPv6Addr != "" && utilnet.IsIPv4String(peers[i].Address) &&
+				slices.Contains(peers[i].AddressFamilies, v1alpha1.AddressFamilyIPv6Unicast) {
+				peerConfig.IPv6NextHop = c.nodeIPv6Addr
+			}
+			peerConfigs[peerKey] = peerConfig
 		}
 	}
 	return peerConfigs
@@ -758,13 +1245,67 @@ func matchesService(svc *corev1.Service, bgpPolicy *v1alpha1.BGPPolicy) bool {
 	return false
 }
 
+// matchesSelectors returns whether an object with the given labels in the given Namespace is selected by both the
+// object selector and the Namespace selector. A nil selector selects all objects.
+func (c *Controller) matchesSelectors(namespace string, objLabels map[string]string, objSelector, namespaceSelector *metav1.LabelSelector) bool {
+	if objSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(objSelector)
+		if err != nil || !selector.Matches(labels.Set(objLabels)) {
+			return false
+		}
+	}
+	if namespaceSelector != nil {
+		ns, _ := c.namespaceLister.Get(namespace)
+		if ns == nil {
+			return false
+		}
+		selector, err := metav1.LabelSelectorAsSelector(namespaceSelector)
+		if err != nil || !selector.Matches(labels.Set(ns.Labels)) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *Controller) selectsService(svc *corev1.Service, advertisement *v1alpha1.ServiceAdvertisement) bool {
+	return c.matchesSelectors(svc.Namespace, svc.Labels, advertisement.ServiceSelector, advertisement.NamespaceSelector)
+}
+
+func (c *Controller) selectsPod(pod *corev1.Pod, advertisement *v1alpha1.PodAdvertisement) bool {
+	return c.matchesSelectors(pod.Namespace, pod.Labels, advertisement.PodSelector, advertisement.NamespaceSelector)
+}
+
+func selectsEgress(eg *v1beta1.Egress, advertisement *v1alpha1.EgressAdvertisement) bool {
+	if advertisement.EgressSelector == nil {
+		return true
+	}
+	selector, err := metav1.LabelSelectorAsSelector(advertisement.EgressSelector)
+	if err != nil {
+		return false
+	}
+	return selector.Matches(labels.Set(eg.Labels))
+}
+
+// hasPodSelectors returns whether individual Pods are selected by the Pod advertisement, in which case the Pod IPs
+// rather than the NodeIPAM Pod CIDRs are advertised.
+func hasPodSelectors(advertisement *v1alpha1.PodAdvertisement) bool {
+	return advertisement.PodSelector != nil || advertisement.NamespaceSelector != nil
+}
+
+// isPodIPAdvertisable returns whether the IPs of a Pod can be advertised. The IPs of hostNetwork Pods are Node IPs,
+// and the IPs of terminated Pods may have been released.
+func isPodIPAdvertisable(pod *corev1.Pod) bool {
+	return !pod.Spec.HostNetwork && len(pod.Status.PodIPs) != 0 &&
+		pod.Status.Phase != corev1.PodSucceeded && pod.Status.Phase != corev1.PodFailed
+}
+
 func (c *Controller) hasAffectedPolicyByService(svc *corev1.Service) bool {
 	allPolicies, _ := c.bgpPolicyLister.List(labels.Everything())
 	for _, policy := range allPolicies {
 		if policy.Spec.Advertisements.Service == nil || !c.matchesCurrentNode(policy) {
 			continue
 		}
-		if matchesService(svc, policy) {
+		if matchesService(svc, policy) && c.selectsService(svc, policy.Spec.Advertisements.Service) {
 			return true
 		}
 	}
@@ -787,7 +1328,8 @@ func (c *Controller) updateService(oldObj, obj interface{}) {
 		slices.Equal(oldSvc.Spec.ExternalIPs, svc.Spec.ExternalIPs) &&
 		slices.Equal(getIngressIPs(oldSvc), getIngressIPs(svc)) &&
 		oldSvc.Spec.ExternalTrafficPolicy == svc.Spec.ExternalTrafficPolicy &&
-		ptr.Equal(oldSvc.Spec.InternalTrafficPolicy, svc.Spec.InternalTrafficPolicy) {
+		ptr.Equal(oldSvc.Spec.InternalTrafficPolicy, svc.Spec.InternalTrafficPolicy) &&
+		reflect.DeepEqual(oldSvc.GetLabels(), svc.GetLabels()) {
 		return
 	}
 	if c.hasAffectedPolicyByService(oldSvc) || c.hasAffectedPolicyByService(svc) {
@@ -864,13 +1406,13 @@ func (c *Controller) deleteEndpointSlice(obj interface{}) {
 	}
 }
 
-func (c *Controller) hasAffectedPolicyByEgress() bool {
+func (c *Controller) hasAffectedPolicyByEgress(eg *v1beta1.Egress) bool {
 	allPolicies, _ := c.bgpPolicyLister.List(labels.Everything())
 	for _, policy := range allPolicies {
-		if !c.matchesCurrentNode(policy) {
+		if policy.Spec.Advertisements.Egress == nil || !c.matchesCurrentNode(policy) {
 			continue
 		}
-		if policy.Spec.Advertisements.Egress != nil {
+		if selectsEgress(eg, policy.Spec.Advertisements.Egress) {
 			return true
 		}
 	}
@@ -882,7 +1424,7 @@ func (c *Controller) addEgress(obj interface{}) {
 	if eg.Status.EgressNode != c.nodeName {
 		return
 	}
-	if c.hasAffectedPolicyByEgress() {
+	if c.hasAffectedPolicyByEgress(eg) {
 		klog.V(2).InfoS("Processing Egress ADD event", "Egress", klog.KObj(eg))
 		c.queue.Add(dummyKey)
 	}
@@ -894,10 +1436,11 @@ func (c *Controller) updateEgress(oldObj, obj interface{}) {
 	if oldEg.Status.EgressNode != c.nodeName && eg.Status.EgressNode != c.nodeName {
 		return
 	}
-	if oldEg.Status.EgressIP == eg.Status.EgressIP && oldEg.Status.EgressNode == eg.Status.EgressNode {
+	if oldEg.Status.EgressIP == eg.Status.EgressIP && oldEg.Status.EgressNode == eg.Status.EgressNode &&
+		reflect.DeepEqual(oldEg.GetLabels(), eg.GetLabels()) {
 		return
 	}
-	if c.hasAffectedPolicyByEgress() {
+	if c.hasAffectedPolicyByEgress(oldEg) || c.hasAffectedPolicyByEgress(eg) {
 		klog.V(2).InfoS("Processing Egress UPDATE event", "Egress", klog.KObj(eg))
 		c.queue.Add(dummyKey)
 	}
@@ -908,12 +1451,115 @@ func (c *Controller) deleteEgress(obj interface{}) {
 	if eg.Status.EgressNode != c.nodeName {
 		return
 	}
-	if c.hasAffectedPolicyByEgress() {
+	if c.hasAffectedPolicyByEgress(eg) {
 		klog.V(2).InfoS("Processing Egress DELETE event", "Egress", klog.KObj(eg))
 		c.queue.Add(dummyKey)
 	}
 }
 
+func (c *Controller) hasAffectedPolicyByPod(pod *corev1.Pod) bool {
+	allPolicies, _ := c.bgpPolicyLister.List(labels.Everything())
+	for _, policy := range allPolicies {
+		advertisement := policy.Spec.Advertisements.Pod
+		if advertisement == nil || !hasPodSelectors(advertisement) || !c.matchesCurrentNode(policy) {
+			continue
+		}
+		if c.selectsPod(pod, advertisement) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Controller) addPod(obj interface{}) {
+	pod := obj.(*corev1.Pod)
+	if !isPodIPAdvertisable(pod) {
+		return
+	}
+	if c.hasAffectedPolicyByPod(pod) {
+		klog.V(2).InfoS("Processing Pod ADD event", "Pod", klog.KObj(pod))
+		c.queue.Add(dummyKey)
+	}
+}
+
+func (c *Controller) updatePod(oldObj, obj interface{}) {
+	oldPod := oldObj.(*corev1.Pod)
+	pod := obj.(*corev1.Pod)
+	if isPodIPAdvertisable(oldPod) == isPodIPAdvertisable(pod) &&
+		reflect.DeepEqual(oldPod.Status.PodIPs, pod.Status.PodIPs) &&
+		reflect.DeepEqual(oldPod.GetLabels(), pod.GetLabels()) {
+		return
+	}
+	if c.hasAffectedPolicyByPod(oldPod) || c.hasAffectedPolicyByPod(pod) {
+		klog.V(2).InfoS("Processing Pod UPDATE event", "Pod", klog.KObj(pod))
+		c.queue.Add(dummyKey)
+	}
+}
+
+func (c *Controller) deletePod(obj interface{}) {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		deletedState, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			klog.ErrorS(nil, "Received unexpected object", "object", obj)
+			return
+		}
+		pod, ok = deletedState.Obj.(*corev1.Pod)
+		if !ok {
+			klog.ErrorS(nil, "DeletedFinalStateUnknown contains non-Pod object", "object", deletedState.Obj)
+			return
+		}
+	}
+	if !isPodIPAdvertisable(pod) {
+		return
+	}
+	if c.hasAffectedPolicyByPod(pod) {
+		klog.V(2).InfoS("Processing Pod DELETE event", "Pod", klog.KObj(pod))
+		c.queue.Add(dummyKey)
+	}
+}
+
+func matchesNamespace(ns *corev1.Namespace, namespaceSelector *metav1.LabelSelector) bool {
+	if namespaceSelector == nil {
+		return false
+	}
+	selector, err := metav1.LabelSelectorAsSelector(namespaceSelector)
+	if err != nil {
+		return false
+	}
+	return selector.Matches(labels.Set(ns.Labels))
+}
+
+func (c *Controller) hasAffectedPolicyByNamespace(ns *corev1.Namespace) bool {
+	allPolicies, _ := c.bgpPolicyLister.List(labels.Everything())
+	for _, policy := range allPolicies {
+		if !c.matchesCurrentNode(policy) {
+			continue
+		}
+		if service := policy.Spec.Advertisements.Service; service != nil && matchesNamespace(ns, service.NamespaceSelector) {
+			return true
+		}
+		if pod := policy.Spec.Advertisements.Pod; pod != nil && matchesNamespace(ns, pod.NamespaceSelector) {
+			return true
+		}
+	}
+	return false
+}
+
+// updateNamespace handles label changes of Namespaces, which may change the Services and Pods selected by the
+// Namespace selectors of the effective BGPPolicy.
+func (c *Controller) updateNamespace(oldObj, obj interface{}) {
+	oldNS := oldObj.(*corev1.Namespace)
+	ns := obj.(*corev1.Namespace)
+	if reflect.DeepEqual(oldNS.GetLabels(), ns.GetLabels()) {
+		return
+	}
+	if c.hasAffectedPolicyByNamespace(oldNS) || c.hasAffectedPolicyByNamespace(ns) {
+		klog.V(2).InfoS("Processing Namespace UPDATE event", "Namespace", klog.KObj(ns))
+		c.queue.Add(dummyKey)
+	}
+}
+
 func (c *Controller) hasAffectedPolicyByNode(node *corev1.Node) bool {
 	allPolicies, _ := c.bgpPolicyLister.List(labels.Everything())
 	for _, policy := range allPolicies {
@@ -976,7 +1622,8 @@ func (c *Controller) updateBGPPeerPasswords(secret *corev1.Secret) {
 	defer c.bgpPeerPasswordsMutex.Unlock()
 
 	c.bgpPeerPasswords = make(map[string]string)
-	if secret.Data != nil {
+	// The Secret is nil when it is deleted, in which case authentication is disabled for all BGP peers.
+	if secret != nil {
 		for k, v := range secret.Data {
 			c.bgpPeerPasswords[k] = string(v)
 		}
@@ -1022,6 +1669,40 @@ func (c *Controller) GetBGPPeerStatus(ctx context.Context) ([]bgp.PeerStatus, er
 	return allPeers, nil
 }
 
+// DryRunBGPPolicy evaluates the given BGPPolicy on the Node without applying it. The BGPPolicy replaces the existing
+// BGPPolicy with the same name if any, otherwise it is considered as created now.
+func (c *Controller) DryRunBGPPolicy(policy *v1alpha1.BGPPolicy) *BGPPolicyDryRunResult {
+	candidate := policy.DeepCopy()
+	candidate.CreationTimestamp = metav1.Now()
+	allPolicies, _ := c.bgpPolicyLister.List(labels.Everything())
+	policies := []*v1alpha1.BGPPolicy{candidate}
+	for _, p := range allPolicies {
+		if p.Name == candidate.Name {
+			candidate.CreationTimestamp = p.CreationTimestamp
+			continue
+		}
+		policies = append(policies, p)
+	}
+
+	result := &BGPPolicyDryRunResult{
+		SelectsNode:      c.matchesCurrentNode(candidate),
+		Routes:           c.getRoutes(candidate.Spec.Advertisements),
+		AdvertisedRoutes: make(map[bgp.Route]RouteMetadata),
+	}
+	if effectivePolicy := c.getOldestBGPPolicy(policies); effectivePolicy != nil {
+		result.EffectiveBGPPolicy = effectivePolicy.Name
+	}
+
+	c.bgpPolicyStateMutex.RLock()
+	defer c.bgpPolicyStateMutex.RUnlock()
+	if c.bgpPolicyState != nil {
+		for route, routeMetadata := range c.bgpPolicyState.routes {
+			result.AdvertisedRoutes[route] = routeMetadata
+		}
+	}
+	return result
+}
+
 // GetBGPRoutes returns the advertised BGP routes.
 func (c *Controller) GetBGPRoutes(ctx context.Context) (map[bgp.Route]RouteMetadata, error) {
 	c.bgpPolicyStateMutex.RLock()
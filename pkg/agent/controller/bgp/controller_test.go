@@ -17,6 +17,8 @@ package bgp
 import (
 	"context"
 	"fmt"
+	"net"
+	"net/netip"
 	"reflect"
 	"testing"
 	"time"
@@ -40,6 +42,8 @@ import (
 	"antrea.io/antrea/pkg/agent/bgp"
 	bgptest "antrea.io/antrea/pkg/agent/bgp/testing"
 	"antrea.io/antrea/pkg/agent/config"
+	"antrea.io/antrea/pkg/agent/route"
+	routetest "antrea.io/antrea/pkg/agent/route/testing"
 	"antrea.io/antrea/pkg/agent/types"
 	"antrea.io/antrea/pkg/apis/crd/v1alpha1"
 	crdv1b1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
@@ -59,12 +63,20 @@ var (
 	podIPv6CIDR      = ip.MustParseCIDR("fec0:10:10::/64")
 	podIPv6CIDRRoute = bgp.Route{Prefix: podIPv6CIDR.String()}
 	nodeIPv4Addr     = ip.MustParseCIDR("192.168.77.100/24")
+	nodeIPv6Addr     = ip.MustParseCIDR("fec0::192:168:77:100/80")
 
 	testNodeConfig = &config.NodeConfig{
-		PodIPv4CIDR:  podIPv4CIDR,
-		PodIPv6CIDR:  podIPv6CIDR,
-		NodeIPv4Addr: nodeIPv4Addr,
-		Name:         localNodeName,
+		PodIPv4CIDR:           podIPv4CIDR,
+		PodIPv6CIDR:           podIPv6CIDR,
+		NodeIPv4Addr:          nodeIPv4Addr,
+		NodeIPv6Addr:          nodeIPv6Addr,
+		NodeTransportIPv4Addr: nodeIPv4Addr,
+		NodeTransportIPv6Addr: nodeIPv6Addr,
+		Name:                  localNodeName,
+	}
+	testServiceConfig = &config.ServiceConfig{
+		ServiceCIDR:   ip.MustParseCIDR("10.96.0.0/16"),
+		ServiceCIDRv6: ip.MustParseCIDR("fec0:10:96::/112"),
 	}
 
 	peer1ASN          = int32(65531)
@@ -224,6 +236,7 @@ type fakeController struct {
 	*Controller
 	mockController     *gomock.Controller
 	mockBGPServer      *bgptest.MockInterface
+	mockRouteClient    *routetest.MockInterface
 	crdClient          *fakeversioned.Clientset
 	crdInformerFactory crdinformers.SharedInformerFactory
 	client             *fake.Clientset
@@ -240,6 +253,7 @@ func (c *fakeController) startInformers(stopCh chan struct{}) {
 func newFakeController(t *testing.T, objects []runtime.Object, crdObjects []runtime.Object, ipv4Enabled, ipv6Enabled bool) *fakeController {
 	ctrl := gomock.NewController(t)
 	mockBGPServer := bgptest.NewMockInterface(ctrl)
+	mockRouteClient := routetest.NewMockInterface(ctrl)
 
 	client := fake.NewSimpleClientset(objects...)
 	crdClient := fakeversioned.NewSimpleClientset(crdObjects...)
@@ -250,21 +264,29 @@ func newFakeController(t *testing.T, objects []runtime.Object, crdObjects []runt
 	nodeInformer := informerFactory.Core().V1().Nodes()
 	serviceInformer := informerFactory.Core().V1().Services()
 	egressInformer := crdInformerFactory.Crd().V1beta1().Egresses()
+	externalIPPoolInformer := crdInformerFactory.Crd().V1beta1().ExternalIPPools()
 	endpointSliceInformer := informerFactory.Discovery().V1().EndpointSlices()
 	bgpPolicyInformer := crdInformerFactory.Crd().V1alpha1().BGPPolicies()
+	podInformer := informerFactory.Core().V1().Pods().Informer()
+	namespaceInformer := informerFactory.Core().V1().Namespaces()
 
 	bgpController, _ := NewBGPPolicyController(nodeInformer,
 		serviceInformer,
 		egressInformer,
+		externalIPPoolInformer,
 		bgpPolicyInformer,
 		endpointSliceInformer,
+		podInformer,
+		namespaceInformer,
 		true,
 		client,
+		mockRouteClient,
 		testNodeConfig,
 		&config.NetworkConfig{
 			IPv4Enabled: ipv4Enabled,
 			IPv6Enabled: ipv6Enabled,
-		})
+		},
+		testServiceConfig)
 	bgpController.egressEnabled = true
 	bgpController.newBGPServerFn = func(_ *bgp.GlobalConfig) bgp.Interface {
 		return mockBGPServer
@@ -274,6 +296,7 @@ func newFakeController(t *testing.T, objects []runtime.Object, crdObjects []runt
 		Controller:         bgpController,
 		mockController:     ctrl,
 		mockBGPServer:      mockBGPServer,
+		mockRouteClient:    mockRouteClient,
 		crdClient:          crdClient,
 		crdInformerFactory: crdInformerFactory,
 		client:             client,
@@ -1625,6 +1648,588 @@ func TestEgressLifecycle(t *testing.T) {
 	doneDummyEvent(t, c)
 }
 
+func TestPodLifecycle(t *testing.T) {
+	policy := generateBGPPolicy(bgpPolicyName1,
+		creationTimestamp,
+		nodeLabels1,
+		179,
+		65000,
+		false,
+		false,
+		false,
+		false,
+		true,
+		[]v1alpha1.BGPPeer{ipv4Peer1})
+	policy.Spec.Advertisements.Pod.PodSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}
+	policy.Spec.Advertisements.Pod.NamespaceSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}}
+	namespace := generateNamespace(namespaceDefault, map[string]string{"env": "prod"})
+	c := newFakeController(t, []runtime.Object{node, namespace}, []runtime.Object{policy}, true, false)
+	mockBGPServer := c.mockBGPServer
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	ctx := context.Background()
+	c.startInformers(stopCh)
+
+	// Fake the passwords of BGP peers.
+	c.bgpPeerPasswords = bgpPeerPasswords
+
+	// Wait for the dummy event triggered by BGPPolicy add events. Since Pods are selected, the NodeIPAM Pod CIDR is not
+	// advertised.
+	waitAndGetDummyEvent(t, c)
+	mockBGPServer.EXPECT().Start(gomock.Any())
+	mockBGPServer.EXPECT().AddPeer(gomock.Any(), ipv4Peer1Config)
+	require.NoError(t, c.syncBGPPolicy(ctx))
+	// Done with the dummy event.
+	doneDummyEvent(t, c)
+
+	// Create a selected Pod.
+	pod := generatePod("pod1", namespaceDefault, map[string]string{"app": "web"}, "10.10.0.5")
+	_, err := c.client.CoreV1().Pods(namespaceDefault).Create(context.TODO(), pod, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	waitAndGetDummyEvent(t, c)
+	mockBGPServer.EXPECT().AdvertiseRoutes(gomock.Any(), []bgp.Route{{Prefix: "10.10.0.5/32"}})
+	require.NoError(t, c.syncBGPPolicy(ctx))
+	doneDummyEvent(t, c)
+
+	// Update the labels of the Namespace so that it is no longer selected.
+	updatedNamespace := generateNamespace(namespaceDefault, map[string]string{"env": "dev"})
+	_, err = c.client.CoreV1().Namespaces().Update(context.TODO(), updatedNamespace, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	waitAndGetDummyEvent(t, c)
+	mockBGPServer.EXPECT().WithdrawRoutes(gomock.Any(), []bgp.Route{{Prefix: "10.10.0.5/32"}})
+	require.NoError(t, c.syncBGPPolicy(ctx))
+	doneDummyEvent(t, c)
+
+	// Restore the labels of the Namespace.
+	_, err = c.client.CoreV1().Namespaces().Update(context.TODO(), namespace, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	waitAndGetDummyEvent(t, c)
+	mockBGPServer.EXPECT().AdvertiseRoutes(gomock.Any(), []bgp.Route{{Prefix: "10.10.0.5/32"}})
+	require.NoError(t, c.syncBGPPolicy(ctx))
+	doneDummyEvent(t, c)
+
+	// Update the IP of the Pod.
+	updatedPod := generatePod("pod1", namespaceDefault, map[string]string{"app": "web"}, "10.10.0.6")
+	_, err = c.client.CoreV1().Pods(namespaceDefault).Update(context.TODO(), updatedPod, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	waitAndGetDummyEvent(t, c)
+	mockBGPServer.EXPECT().AdvertiseRoutes(gomock.Any(), []bgp.Route{{Prefix: "10.10.0.6/32"}})
+	mockBGPServer.EXPECT().WithdrawRoutes(gomock.Any(), []bgp.Route{{Prefix: "10.10.0.5/32"}})
+	require.NoError(t, c.syncBGPPolicy(ctx))
+	doneDummyEvent(t, c)
+
+	// Update the labels of the Pod so that it is no longer selected.
+	updatedPod = generatePod("pod1", namespaceDefault, map[string]string{"app": "db"}, "10.10.0.6")
+	_, err = c.client.CoreV1().Pods(namespaceDefault).Update(context.TODO(), updatedPod, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	waitAndGetDummyEvent(t, c)
+	mockBGPServer.EXPECT().WithdrawRoutes(gomock.Any(), []bgp.Route{{Prefix: "10.10.0.6/32"}})
+	require.NoError(t, c.syncBGPPolicy(ctx))
+	doneDummyEvent(t, c)
+
+	// Restore the labels of the Pod.
+	updatedPod = generatePod("pod1", namespaceDefault, map[string]string{"app": "web"}, "10.10.0.6")
+	_, err = c.client.CoreV1().Pods(namespaceDefault).Update(context.TODO(), updatedPod, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	waitAndGetDummyEvent(t, c)
+	mockBGPServer.EXPECT().AdvertiseRoutes(gomock.Any(), []bgp.Route{{Prefix: "10.10.0.6/32"}})
+	require.NoError(t, c.syncBGPPolicy(ctx))
+	doneDummyEvent(t, c)
+
+	// Delete the Pod.
+	err = c.client.CoreV1().Pods(namespaceDefault).Delete(context.TODO(), updatedPod.Name, metav1.DeleteOptions{})
+	require.NoError(t, err)
+
+	waitAndGetDummyEvent(t, c)
+	mockBGPServer.EXPECT().WithdrawRoutes(gomock.Any(), []bgp.Route{{Prefix: "10.10.0.6/32"}})
+	require.NoError(t, c.syncBGPPolicy(ctx))
+	doneDummyEvent(t, c)
+}
+
+func TestGetRoutesWithSelectors(t *testing.T) {
+	webService := generateService("web", corev1.ServiceTypeClusterIP, "10.96.10.10", "", "", false, false)
+	webService.Labels = map[string]string{"app": "web"}
+	dbService := generateService("db", corev1.ServiceTypeClusterIP, "10.96.10.11", "", "", false, false)
+	dbService.Labels = map[string]string{"app": "db"}
+	dnsService := generateService("dns", corev1.ServiceTypeClusterIP, "10.96.10.12", "", "", false, false)
+	dnsService.Namespace = namespaceKubeSystem
+	dnsService.Labels = map[string]string{"app": "web"}
+
+	webPod := generatePod("web", namespaceDefault, map[string]string{"app": "web"}, "10.10.0.5")
+	dbPod := generatePod("db", namespaceDefault, map[string]string{"app": "db"}, "10.10.0.6")
+	dnsPod := generatePod("dns", namespaceKubeSystem, map[string]string{"app": "web"}, "10.10.0.7")
+	hostNetworkPod := generatePod("host", namespaceDefault, map[string]string{"app": "web"}, "192.168.77.100")
+	hostNetworkPod.Spec.HostNetwork = true
+
+	webEgress := generateEgress("eg-web", "192.168.77.200", localNodeName)
+	webEgress.Labels = map[string]string{"app": "web"}
+	dbEgress := generateEgress("eg-db", "192.168.77.201", localNodeName)
+	dbEgress.Labels = map[string]string{"app": "db"}
+
+	objects := []runtime.Object{
+		node,
+		generateNamespace(namespaceDefault, map[string]string{"env": "prod"}),
+		generateNamespace(namespaceKubeSystem, nil),
+		webService,
+		dbService,
+		dnsService,
+		webPod,
+		dbPod,
+		dnsPod,
+		hostNetworkPod,
+	}
+	crdObjects := []runtime.Object{webEgress, dbEgress}
+	appWebSelector := &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}
+	envProdSelector := &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}}
+
+	testCases := []struct {
+		name           string
+		advertisements v1alpha1.Advertisements
+		expectedRoutes map[bgp.Route]RouteMetadata
+	}{
+		{
+			name: "Service selector",
+			advertisements: v1alpha1.Advertisements{
+				Service: &v1alpha1.ServiceAdvertisement{
+					IPTypes:         []v1alpha1.ServiceIPType{v1alpha1.ServiceIPTypeClusterIP},
+					ServiceSelector: appWebSelector,
+				},
+			},
+			expectedRoutes: map[bgp.Route]RouteMetadata{
+				{Prefix: "10.96.10.10/32"}: {Type: ServiceClusterIP, K8sObjRef: "default/web"},
+				{Prefix: "10.96.10.12/32"}: {Type: ServiceClusterIP, K8sObjRef: "kube-system/dns"},
+			},
+		},
+		{
+			name: "Service and Namespace selectors",
+			advertisements: v1alpha1.Advertisements{
+				Service: &v1alpha1.ServiceAdvertisement{
+					IPTypes:           []v1alpha1.ServiceIPType{v1alpha1.ServiceIPTypeClusterIP},
+					ServiceSelector:   appWebSelector,
+					NamespaceSelector: envProdSelector,
+				},
+			},
+			expectedRoutes: map[bgp.Route]RouteMetadata{
+				{Prefix: "10.96.10.10/32"}: {Type: ServiceClusterIP, K8sObjRef: "default/web"},
+			},
+		},
+		{
+			name: "Pod selector",
+			advertisements: v1alpha1.Advertisements{
+				Pod: &v1alpha1.PodAdvertisement{
+					PodSelector: appWebSelector,
+				},
+			},
+			expectedRoutes: map[bgp.Route]RouteMetadata{
+				{Prefix: "10.10.0.5/32"}: {Type: PodIP, K8sObjRef: "default/web"},
+				{Prefix: "10.10.0.7/32"}: {Type: PodIP, K8sObjRef: "kube-system/dns"},
+			},
+		},
+		{
+			name: "Namespace selector for Pods",
+			advertisements: v1alpha1.Advertisements{
+				Pod: &v1alpha1.PodAdvertisement{
+					NamespaceSelector: envProdSelector,
+				},
+			},
+			expectedRoutes: map[bgp.Route]RouteMetadata{
+				{Prefix: "10.10.0.5/32"}: {Type: PodIP, K8sObjRef: "default/web"},
+				{Prefix: "10.10.0.6/32"}: {Type: PodIP, K8sObjRef: "default/db"},
+			},
+		},
+		{
+			name: "No Pod selector",
+			advertisements: v1alpha1.Advertisements{
+				Pod: &v1alpha1.PodAdvertisement{},
+			},
+			expectedRoutes: map[bgp.Route]RouteMetadata{
+				podIPv4CIDRRoute: {Type: NodeIPAMPodCIDR},
+			},
+		},
+		{
+			name: "Egress selector",
+			advertisements: v1alpha1.Advertisements{
+				Egress: &v1alpha1.EgressAdvertisement{
+					EgressSelector: appWebSelector,
+				},
+			},
+			expectedRoutes: map[bgp.Route]RouteMetadata{
+				{Prefix: "192.168.77.200/32"}: {Type: EgressIP, K8sObjRef: "eg-web"},
+			},
+		},
+		{
+			name: "No Egress selector",
+			advertisements: v1alpha1.Advertisements{
+				Egress: &v1alpha1.EgressAdvertisement{},
+			},
+			expectedRoutes: map[bgp.Route]RouteMetadata{
+				{Prefix: "192.168.77.200/32"}: {Type: EgressIP, K8sObjRef: "eg-web"},
+				{Prefix: "192.168.77.201/32"}: {Type: EgressIP, K8sObjRef: "eg-db"},
+			},
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newFakeController(t, objects, crdObjects, true, false)
+
+			stopCh := make(chan struct{})
+			defer close(stopCh)
+			c.startInformers(stopCh)
+
+			assert.Equal(t, tt.expectedRoutes, c.getRoutes(tt.advertisements))
+		})
+	}
+}
+
+func TestRouteAttributes(t *testing.T) {
+	policy := generateBGPPolicy(bgpPolicyName1,
+		creationTimestamp,
+		nodeLabels1,
+		179,
+		65000,
+		false,
+		false,
+		false,
+		true,
+		false,
+		[]v1alpha1.BGPPeer{ipv4Peer1})
+	policy.Generation = 1
+	policy.Spec.Advertisements.Egress.Attributes = &v1alpha1.RouteAttributes{
+		Communities:      []string{"65000:100", "no-export"},
+		LargeCommunities: []string{"65000:1:2"},
+		MED:              ptr.To[int64](10),
+		ASPathPrepend:    2,
+	}
+	c := newFakeController(t, []runtime.Object{node}, []runtime.Object{policy, ipv4Egress1}, true, false)
+	mockBGPServer := c.mockBGPServer
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	ctx := context.Background()
+	c.startInformers(stopCh)
+
+	// Fake the passwords of BGP peers.
+	c.bgpPeerPasswords = bgpPeerPasswords
+
+	// Wait for the dummy event triggered by BGPPolicy add events.
+	waitAndGetDummyEvent(t, c)
+	mockBGPServer.EXPECT().Start(gomock.Any())
+	mockBGPServer.EXPECT().AddPeer(gomock.Any(), ipv4Peer1Config)
+	mockBGPServer.EXPECT().AdvertiseRoutes(gomock.Any(), []bgp.Route{{
+		Prefix: ipv4EgressIP1Route.Prefix,
+		Attributes: bgp.RouteAttributes{
+			Communities:      "65000:100,no-export",
+			LargeCommunities: "65000:1:2",
+			MED:              10,
+			HasMED:           true,
+			ASPathPrepend:    2,
+		},
+	}})
+	require.NoError(t, c.syncBGPPolicy(ctx))
+	// Done with the dummy event.
+	doneDummyEvent(t, c)
+
+	// Update the attributes of the Egress advertisement.
+	updatedPolicy := policy.DeepCopy()
+	updatedPolicy.Generation = 2
+	updatedPolicy.Spec.Advertisements.Egress.Attributes = &v1alpha1.RouteAttributes{
+		LocalPreference: ptr.To[int64](200),
+	}
+	_, err := c.crdClient.CrdV1alpha1().BGPPolicies().Update(context.TODO(), updatedPolicy, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	// The prefix is advertised again with the new attributes, which replaces the old attributes without withdrawing the
+	// prefix.
+	waitAndGetDummyEvent(t, c)
+	mockBGPServer.EXPECT().AdvertiseRoutes(gomock.Any(), []bgp.Route{{
+		Prefix: ipv4EgressIP1Route.Prefix,
+		Attributes: bgp.RouteAttributes{
+			LocalPreference:    200,
+			HasLocalPreference: true,
+		},
+	}})
+	require.NoError(t, c.syncBGPPolicy(ctx))
+	doneDummyEvent(t, c)
+
+	// Remove the attributes of the Egress advertisement.
+	updatedPolicy = updatedPolicy.DeepCopy()
+	updatedPolicy.Generation = 3
+	updatedPolicy.Spec.Advertisements.Egress.Attributes = nil
+	_, err = c.crdClient.CrdV1alpha1().BGPPolicies().Update(context.TODO(), updatedPolicy, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	waitAndGetDummyEvent(t, c)
+	mockBGPServer.EXPECT().AdvertiseRoutes(gomock.Any(), []bgp.Route{ipv4EgressIP1Route})
+	require.NoError(t, c.syncBGPPolicy(ctx))
+	doneDummyEvent(t, c)
+
+	routes, err := c.GetBGPRoutes(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[bgp.Route]RouteMetadata{ipv4EgressIP1Route: {Type: EgressIP, K8sObjRef: "eg1-4"}}, routes)
+}
+
+func TestImportRoutes(t *testing.T) {
+	policy := generateBGPPolicy(bgpPolicyName1,
+		creationTimestamp,
+		nodeLabels1,
+		179,
+		65000,
+		false,
+		false,
+		false,
+		false,
+		false,
+		[]v1alpha1.BGPPeer{ipv4Peer1})
+	policy.Generation = 1
+	policy.Spec.Import = &v1alpha1.RouteImport{
+		Prefixes:    []v1alpha1.ImportPrefix{{CIDR: "0.0.0.0/0", MaxLength: 24}},
+		MaxPrefixes: 2,
+	}
+	peerNode := generateNode("peer-node", nil, nil)
+	peerNode.Spec.PodCIDRs = []string{"10.10.1.0/24"}
+	peerNode.Status.Addresses = []corev1.NodeAddress{{Type: corev1.NodeInternalIP, Address: "192.168.78.10"}}
+	pool := &crdv1b1.ExternalIPPool{
+		ObjectMeta: metav1.ObjectMeta{Name: "pool1"},
+		Spec: crdv1b1.ExternalIPPoolSpec{
+			IPRanges: []crdv1b1.IPRange{{CIDR: "10.20.1.0/24"}, {Start: "10.20.0.10", End: "10.20.0.20"}},
+		},
+	}
+	c := newFakeController(t, []runtime.Object{node, peerNode}, []runtime.Object{policy, pool}, true, false)
+	mockBGPServer := c.mockBGPServer
+	mockRouteClient := c.mockRouteClient
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	ctx := context.Background()
+	c.startInformers(stopCh)
+
+	// Fake the passwords of BGP peers.
+	c.bgpPeerPasswords = bgpPeerPasswords
+
+	// 10.3.0.0/25 is longer than maxLength. The default route and the routes overlapping with the Pod CIDRs and IPs
+	// of the Nodes, the Node transport subnet, the Service CIDR and the ExternalIPPool ranges are never imported, so
+	// they don't count towards maxPrefixes.
+	waitAndGetDummyEvent(t, c)
+	mockBGPServer.EXPECT().Start(gomock.Any())
+	mockBGPServer.EXPECT().AddPeer(gomock.Any(), ipv4Peer1Config)
+	mockBGPServer.EXPECT().GetBestReceivedRoutes(gomock.Any(), false).Return([]bgp.Route{
+		{Prefix: "10.2.0.0/24", NextHop: "192.168.77.200"},
+		{Prefix: "10.1.0.0/16", NextHop: "192.168.77.200"},
+		{Prefix: "10.3.0.0/25", NextHop: "192.168.77.200"},
+		{Prefix: "0.0.0.0/0", NextHop: "192.168.77.200"},
+		{Prefix: "10.10.0.0/16", NextHop: "192.168.77.200"},
+		{Prefix: "10.10.1.0/24", NextHop: "192.168.77.200"},
+		{Prefix: "10.96.0.0/24", NextHop: "192.168.77.200"},
+		{Prefix: "192.168.78.10/32", NextHop: "192.168.77.200"},
+		{Prefix: "192.168.77.128/25", NextHop: "192.168.77.200"},
+		{Prefix: "10.20.1.0/24", NextHop: "192.168.77.200"},
+		{Prefix: "10.20.0.16/30", NextHop: "192.168.77.200"},
+	}, nil)
+	mockRouteClient.EXPECT().AddBGPImportedRoute(ip.MustParseCIDR("10.1.0.0/16"), net.ParseIP("192.168.77.200"), 20)
+	mockRouteClient.EXPECT().AddBGPImportedRoute(ip.MustParseCIDR("10.2.0.0/24"), net.ParseIP("192.168.77.200"), 20)
+	require.NoError(t, c.syncBGPPolicy(ctx))
+	doneDummyEvent(t, c)
+
+	// More routes than maxPrefixes are received: all imported routes are deleted.
+	mockBGPServer.EXPECT().GetBestReceivedRoutes(gomock.Any(), false).Return([]bgp.Route{
+		{Prefix: "10.4.0.0/16", NextHop: "192.168.77.200"},
+		{Prefix: "10.2.0.0/24", NextHop: "192.168.77.200"},
+		{Prefix: "10.1.0.0/16", NextHop: "192.168.77.200"},
+	}, nil)
+	mockRouteClient.EXPECT().DeleteBGPImportedRoute(ip.MustParseCIDR("10.1.0.0/16"), 20)
+	mockRouteClient.EXPECT().DeleteBGPImportedRoute(ip.MustParseCIDR("10.2.0.0/24"), 20)
+	c.syncImportedRoutes(ctx)
+	assert.Empty(t, c.bgpPolicyState.importedRoutes)
+	assert.True(t, c.bgpPolicyState.importLimitExceeded)
+
+	// The received routes are under the limit again: the next hop of 10.1.0.0/16 is updated and 10.2.0.0/24 is
+	// withdrawn.
+	mockBGPServer.EXPECT().GetBestReceivedRoutes(gomock.Any(), false).Return([]bgp.Route{
+		{Prefix: "10.4.0.0/16", NextHop: "192.168.77.200"},
+		{Prefix: "10.1.0.0/16", NextHop: "192.168.77.201"},
+	}, nil)
+	mockRouteClient.EXPECT().AddBGPImportedRoute(ip.MustParseCIDR("10.1.0.0/16"), net.ParseIP("192.168.77.201"), 20)
+	mockRouteClient.EXPECT().AddBGPImportedRoute(ip.MustParseCIDR("10.4.0.0/16"), net.ParseIP("192.168.77.200"), 20)
+	c.syncImportedRoutes(ctx)
+	assert.Equal(t, map[string]importedRoute{
+		"10.1.0.0/16": {nextHop: "192.168.77.201", metric: 20},
+		"10.4.0.0/16": {nextHop: "192.168.77.200", metric: 20},
+	}, c.bgpPolicyState.importedRoutes)
+	assert.False(t, c.bgpPolicyState.importLimitExceeded)
+
+	// Update the metric of the imported routes. The routes with the old metric are deleted first.
+	updatedPolicy := policy.DeepCopy()
+	updatedPolicy.Generation = 2
+	updatedPolicy.Spec.Import.Metric = ptr.To[int32](100)
+	_, err := c.crdClient.CrdV1alpha1().BGPPolicies().Update(context.TODO(), updatedPolicy, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	waitAndGetDummyEvent(t, c)
+	mockBGPServer.EXPECT().GetBestReceivedRoutes(gomock.Any(), false).Return([]bgp.Route{
+		{Prefix: "10.1.0.0/16", NextHop: "192.168.77.201"},
+	}, nil)
+	mockRouteClient.EXPECT().DeleteBGPImportedRoute(ip.MustParseCIDR("10.1.0.0/16"), 20)
+	mockRouteClient.EXPECT().DeleteBGPImportedRoute(ip.MustParseCIDR("10.4.0.0/16"), 20)
+	mockRouteClient.EXPECT().AddBGPImportedRoute(ip.MustParseCIDR("10.1.0.0/16"), net.ParseIP("192.168.77.201"), 100)
+	require.NoError(t, c.syncBGPPolicy(ctx))
+	doneDummyEvent(t, c)
+
+	// Disable importing routes. All imported routes are deleted.
+	updatedPolicy = updatedPolicy.DeepCopy()
+	updatedPolicy.Generation = 3
+	updatedPolicy.Spec.Import = nil
+	_, err = c.crdClient.CrdV1alpha1().BGPPolicies().Update(context.TODO(), updatedPolicy, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	waitAndGetDummyEvent(t, c)
+	mockRouteClient.EXPECT().DeleteBGPImportedRoute(ip.MustParseCIDR("10.1.0.0/16"), 100)
+	require.NoError(t, c.syncBGPPolicy(ctx))
+	doneDummyEvent(t, c)
+	assert.Empty(t, c.bgpPolicyState.importedRoutes)
+}
+
+func TestRestoreImportedRoutes(t *testing.T) {
+	policy := generateBGPPolicy(bgpPolicyName1,
+		creationTimestamp,
+		nodeLabels1,
+		179,
+		65000,
+		false,
+		false,
+		false,
+		false,
+		false,
+		[]v1alpha1.BGPPeer{ipv4Peer1})
+	policy.Spec.Import = &v1alpha1.RouteImport{
+		Prefixes: []v1alpha1.ImportPrefix{{CIDR: "0.0.0.0/0", MaxLength: 24}},
+	}
+	restoredRoutes := []route.BGPImportedRoute{
+		{Dst: ip.MustParseCIDR("10.1.0.0/16"), Gateway: net.ParseIP("192.168.77.200"), Metric: 20},
+		{Dst: ip.MustParseCIDR("10.2.0.0/16"), Gateway: net.ParseIP("192.168.77.200"), Metric: 20},
+	}
+
+	t.Run("with BGPPolicy", func(t *testing.T) {
+		c := newFakeController(t, []runtime.Object{node}, []runtime.Object{policy}, true, false)
+		mockBGPServer := c.mockBGPServer
+		mockRouteClient := c.mockRouteClient
+
+		stopCh := make(chan struct{})
+		defer close(stopCh)
+		ctx := context.Background()
+		c.startInformers(stopCh)
+		c.bgpPeerPasswords = bgpPeerPasswords
+
+		mockRouteClient.EXPECT().ListBGPImportedRoutes().Return(restoredRoutes, nil)
+		c.restoreImportedRoutes()
+
+		// The restored routes are not flushed when the BGP server is started: 10.1.0.0/16 is received again and left
+		// untouched, and 10.2.0.0/16 is kept until the grace period ends.
+		waitAndGetDummyEvent(t, c)
+		mockBGPServer.EXPECT().Start(gomock.Any())
+		mockBGPServer.EXPECT().AddPeer(gomock.Any(), ipv4Peer1Config)
+		mockBGPServer.EXPECT().GetBestReceivedRoutes(gomock.Any(), false).Return([]bgp.Route{
+			{Prefix: "10.1.0.0/16", NextHop: "192.168.77.200"},
+		}, nil)
+		require.NoError(t, c.syncBGPPolicy(ctx))
+		doneDummyEvent(t, c)
+		assert.Equal(t, map[string]importedRoute{
+			"10.1.0.0/16": {nextHop: "192.168.77.200", metric: 20},
+			"10.2.0.0/16": {nextHop: "192.168.77.200", metric: 20},
+		}, c.bgpPolicyState.importedRoutes)
+		assert.Nil(t, c.staleImportedRoutes)
+
+		// 10.2.0.0/16 is deleted once the grace period ends.
+		c.bgpPolicyState.importGracePeriodEnd = time.Now()
+		mockBGPServer.EXPECT().GetBestReceivedRoutes(gomock.Any(), false).Return([]bgp.Route{
+			{Prefix: "10.1.0.0/16", NextHop: "192.168.77.200"},
+		}, nil)
+		mockRouteClient.EXPECT().DeleteBGPImportedRoute(ip.MustParseCIDR("10.2.0.0/16"), 20)
+		c.syncImportedRoutes(ctx)
+		assert.Equal(t, map[string]importedRoute{
+			"10.1.0.0/16": {nextHop: "192.168.77.200", metric: 20},
+		}, c.bgpPolicyState.importedRoutes)
+	})
+
+	t.Run("without BGPPolicy", func(t *testing.T) {
+		c := newFakeController(t, []runtime.Object{node}, nil, true, false)
+		mockRouteClient := c.mockRouteClient
+
+		stopCh := make(chan struct{})
+		defer close(stopCh)
+		ctx := context.Background()
+		c.startInformers(stopCh)
+
+		mockRouteClient.EXPECT().ListBGPImportedRoutes().Return(restoredRoutes, nil)
+		c.restoreImportedRoutes()
+
+		waitAndGetDummyEvent(t, c)
+		mockRouteClient.EXPECT().DeleteBGPImportedRoute(ip.MustParseCIDR("10.1.0.0/16"), 20)
+		mockRouteClient.EXPECT().DeleteBGPImportedRoute(ip.MustParseCIDR("10.2.0.0/16"), 20)
+		require.NoError(t, c.syncBGPPolicy(ctx))
+		doneDummyEvent(t, c)
+		assert.Empty(t, c.staleImportedRoutes)
+	})
+}
+
+func TestIPRangeToPrefixes(t *testing.T) {
+	tests := []struct {
+		start    string
+		end      string
+		expected []string
+	}{
+		{start: "10.20.0.10", end: "10.20.0.10", expected: []string{"10.20.0.10/32"}},
+		{start: "10.20.0.10", end: "10.20.0.20", expected: []string{"10.20.0.10/31", "10.20.0.12/30", "10.20.0.16/30", "10.20.0.20/32"}},
+		{start: "10.20.0.0", end: "10.20.1.255", expected: []string{"10.20.0.0/23"}},
+		{start: "255.255.255.254", end: "255.255.255.255", expected: []string{"255.255.255.254/31"}},
+		{start: "fec0::1", end: "fec0::3", expected: []string{"fec0::1/128", "fec0::2/127"}},
+		{start: "10.20.0.20", end: "10.20.0.10", expected: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.start+"-"+tt.end, func(t *testing.T) {
+			var expected []netip.Prefix
+			for _, prefix := range tt.expected {
+				expected = append(expected, netip.MustParsePrefix(prefix))
+			}
+			assert.Equal(t, expected, ipRangeToPrefixes(netip.MustParseAddr(tt.start), netip.MustParseAddr(tt.end)))
+		})
+	}
+}
+
+func TestMatchesImportPrefixes(t *testing.T) {
+	filters := []v1alpha1.ImportPrefix{
+		{CIDR: "10.0.0.0/8", MinLength: 16, MaxLength: 24},
+		{CIDR: "192.168.0.0/16"},
+		{CIDR: "fec0::/16", MaxLength: 64},
+	}
+	tests := []struct {
+		prefix   string
+		expected bool
+	}{
+		{prefix: "10.0.0.0/8", expected: false},
+		{prefix: "10.1.0.0/16", expected: true},
+		{prefix: "10.1.2.0/24", expected: true},
+		{prefix: "10.1.2.0/25", expected: false},
+		{prefix: "192.168.0.0/16", expected: true},
+		{prefix: "192.168.1.0/24", expected: false},
+		{prefix: "172.16.0.0/16", expected: false},
+		{prefix: "fec0:10::/64", expected: true},
+		{prefix: "fec0:10::/96", expected: false},
+		{prefix: "::ffff:10.1.0.0/112", expected: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.prefix, func(t *testing.T) {
+			assert.Equal(t, tt.expected, matchesImportPrefixes(netip.MustParsePrefix(tt.prefix), filters))
+		})
+	}
+}
+
 func TestBGPSecretUpdate(t *testing.T) {
 	policy := generateBGPPolicy(bgpPolicyName1,
 		creationTimestamp,
@@ -1707,6 +2312,141 @@ func TestBGPSecretUpdate(t *testing.T) {
 	require.NoError(t, c.syncBGPPolicy(ctx))
 	// Done with the dummy event.
 	doneDummyEvent(t, c)
+
+	// Delete the Secret, which disables authentication for all BGP peers.
+	require.NoError(t, c.client.CoreV1().Secrets(namespaceKubeSystem).Delete(context.TODO(), updatedSecret.Name, metav1.DeleteOptions{}))
+	require.Eventually(t, func() bool {
+		c.bgpPeerPasswordsMutex.RLock()
+		defer c.bgpPeerPasswordsMutex.RUnlock()
+		return len(c.bgpPeerPasswords) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	waitAndGetDummyEvent(t, c)
+	for _, peerConfig := range []bgp.PeerConfig{ipv4Peer1Config, ipv4Peer2Config, ipv4Peer3Config} {
+		peerConfig.Password = ""
+		mockBGPServer.EXPECT().UpdatePeer(gomock.Any(), peerConfig)
+	}
+	require.NoError(t, c.syncBGPPolicy(ctx))
+	doneDummyEvent(t, c)
+}
+
+func TestBGPPeerAddressFamilies(t *testing.T) {
+	peer := ipv4Peer1
+	peer.AddressFamilies = []v1alpha1.AddressFamily{v1alpha1.AddressFamilyIPv4Unicast, v1alpha1.AddressFamilyIPv6Unicast}
+	policy := generateBGPPolicy(bgpPolicyName1,
+		creationTimestamp,
+		nodeLabels1,
+		179,
+		65000,
+		false,
+		false,
+		false,
+		false,
+		true,
+		[]v1alpha1.BGPPeer{peer})
+	policy.Generation = 1
+
+	tests := []struct {
+		name               string
+		ipv6Enabled        bool
+		expectedPeerConfig bgp.PeerConfig
+		expectedRoutes     []bgp.Route
+	}{
+		{
+			name:        "dual-stack",
+			ipv6Enabled: true,
+			expectedPeerConfig: bgp.PeerConfig{
+				BGPPeer:     &peer,
+				Password:    peer1AuthPassword,
+				IPv6NextHop: nodeIPv6Addr.IP.String(),
+			},
+			expectedRoutes: []bgp.Route{podIPv4CIDRRoute, podIPv6CIDRRoute},
+		},
+		{
+			name:        "IPv4-only",
+			ipv6Enabled: false,
+			expectedPeerConfig: bgp.PeerConfig{
+				BGPPeer:  &peer,
+				Password: peer1AuthPassword,
+			},
+			expectedRoutes: []bgp.Route{podIPv4CIDRRoute},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newFakeController(t, []runtime.Object{node}, []runtime.Object{policy}, true, tt.ipv6Enabled)
+			mockBGPServer := c.mockBGPServer
+
+			stopCh := make(chan struct{})
+			defer close(stopCh)
+			ctx := context.Background()
+			c.startInformers(stopCh)
+
+			// Fake the passwords of BGP peers.
+			c.bgpPeerPasswords = bgpPeerPasswords
+
+			// The IPv6 routes are advertised to the IPv4 BGP peer with the IPv6 address of the Node as the next hop.
+			waitAndGetDummyEvent(t, c)
+			mockBGPServer.EXPECT().Start(gomock.Any())
+			mockBGPServer.EXPECT().AddPeer(gomock.Any(), tt.expectedPeerConfig)
+			for _, route := range tt.expectedRoutes {
+				mockBGPServer.EXPECT().AdvertiseRoutes(gomock.Any(), []bgp.Route{route})
+			}
+			require.NoError(t, c.syncBGPPolicy(ctx))
+			doneDummyEvent(t, c)
+		})
+	}
+}
+
+func TestBGPPeerTimersAndBFD(t *testing.T) {
+	policy := generateBGPPolicy(bgpPolicyName1,
+		creationTimestamp,
+		nodeLabels1,
+		179,
+		65000,
+		false,
+		false,
+		false,
+		false,
+		true,
+		[]v1alpha1.BGPPeer{ipv4Peer1})
+	policy.Generation = 1
+	c := newFakeController(t, []runtime.Object{node}, []runtime.Object{policy}, true, false)
+	mockBGPServer := c.mockBGPServer
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	ctx := context.Background()
+	c.startInformers(stopCh)
+
+	// Fake the passwords of BGP peers.
+	c.bgpPeerPasswords = bgpPeerPasswords
+
+	waitAndGetDummyEvent(t, c)
+	mockBGPServer.EXPECT().Start(gomock.Any())
+	mockBGPServer.EXPECT().AddPeer(gomock.Any(), ipv4Peer1Config)
+	mockBGPServer.EXPECT().AdvertiseRoutes(gomock.Any(), []bgp.Route{podIPv4CIDRRoute})
+	require.NoError(t, c.syncBGPPolicy(ctx))
+	doneDummyEvent(t, c)
+
+	// Set the timers and enable BFD for the BGP peer, which updates the BGP peer without restarting the BGP server.
+	updatedPolicy := policy.DeepCopy()
+	updatedPolicy.Generation = 2
+	updatedPolicy.Spec.BGPPeers[0].HoldTimeSeconds = ptr.To[int32](9)
+	updatedPolicy.Spec.BGPPeers[0].KeepaliveTimeSeconds = ptr.To[int32](3)
+	updatedPolicy.Spec.BGPPeers[0].BFD = &v1alpha1.BFDConfig{
+		MinIntervalMilliseconds: ptr.To[int32](100),
+		Multiplier:              ptr.To[int32](3),
+	}
+	_, err := c.crdClient.CrdV1alpha1().BGPPolicies().Update(context.TODO(), updatedPolicy, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	waitAndGetDummyEvent(t, c)
+	updatedPeer1Config := ipv4Peer1Config
+	updatedPeer1Config.BGPPeer = &updatedPolicy.Spec.BGPPeers[0]
+	mockBGPServer.EXPECT().UpdatePeer(gomock.Any(), updatedPeer1Config)
+	require.NoError(t, c.syncBGPPolicy(ctx))
+	doneDummyEvent(t, c)
 }
 
 func TestSyncBGPPolicyFailures(t *testing.T) {
@@ -2051,6 +2791,34 @@ func generateEgress(name string, ip string, nodeName string) *crdv1b1.Egress {
 	}
 }
 
+func generatePod(name, namespace string, labels map[string]string, ip string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    labels,
+			UID:       "test-uid",
+		},
+		Spec: corev1.PodSpec{
+			NodeName: localNodeName,
+		},
+		Status: corev1.PodStatus{
+			Phase:  corev1.PodRunning,
+			PodIP:  ip,
+			PodIPs: []corev1.PodIP{{IP: ip}},
+		},
+	}
+}
+
+func generateNamespace(name string, labels map[string]string) *corev1.Namespace {
+	return &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: labels,
+		},
+	}
+}
+
 func generateNode(name string, labels, annotations map[string]string) *corev1.Node {
 	return &corev1.Node{
 		ObjectMeta: metav1.ObjectMeta{
@@ -2304,3 +3072,119 @@ func TestGetBGPRoutes(t *testing.T) {
 		})
 	}
 }
+
+func TestDryRunBGPPolicy(t *testing.T) {
+	effectivePolicy := generateBGPPolicy(bgpPolicyName1,
+		creationTimestamp,
+		nodeLabels1,
+		179,
+		65000,
+		false,
+		false,
+		false,
+		false,
+		true,
+		[]v1alpha1.BGPPeer{ipv4Peer1})
+	effectivePolicyState := generateBGPPolicyState(bgpPolicyName1,
+		179,
+		65000,
+		nodeAnnotations1[types.NodeBGPRouterIDAnnotationKey],
+		[]bgp.Route{podIPv4CIDRRoute},
+		[]bgp.PeerConfig{ipv4Peer1Config},
+	)
+	testCases := []struct {
+		name           string
+		policy         *v1alpha1.BGPPolicy
+		existingState  *bgpPolicyState
+		expectedResult *BGPPolicyDryRunResult
+	}{
+		{
+			name: "new BGPPolicy",
+			policy: generateBGPPolicy(bgpPolicyName2,
+				metav1.Time{},
+				nodeLabels1,
+				179,
+				65000,
+				true,
+				false,
+				false,
+				false,
+				true,
+				[]v1alpha1.BGPPeer{ipv4Peer1}),
+			existingState: effectivePolicyState,
+			expectedResult: &BGPPolicyDryRunResult{
+				SelectsNode:        true,
+				EffectiveBGPPolicy: bgpPolicyName1,
+				Routes: map[bgp.Route]RouteMetadata{
+					clusterIPv4Route1: allRoutes[clusterIPv4Route1],
+					podIPv4CIDRRoute:  allRoutes[podIPv4CIDRRoute],
+				},
+				AdvertisedRoutes: map[bgp.Route]RouteMetadata{
+					podIPv4CIDRRoute: allRoutes[podIPv4CIDRRoute],
+				},
+			},
+		},
+		{
+			name: "update of the effective BGPPolicy",
+			policy: generateBGPPolicy(bgpPolicyName1,
+				metav1.Time{},
+				nodeLabels1,
+				179,
+				65000,
+				true,
+				false,
+				false,
+				false,
+				false,
+				[]v1alpha1.BGPPeer{ipv4Peer1}),
+			existingState: effectivePolicyState,
+			expectedResult: &BGPPolicyDryRunResult{
+				SelectsNode:        true,
+				EffectiveBGPPolicy: bgpPolicyName1,
+				Routes: map[bgp.Route]RouteMetadata{
+					clusterIPv4Route1: allRoutes[clusterIPv4Route1],
+				},
+				AdvertisedRoutes: map[bgp.Route]RouteMetadata{
+					podIPv4CIDRRoute: allRoutes[podIPv4CIDRRoute],
+				},
+			},
+		},
+		{
+			name: "update of the effective BGPPolicy not selecting the Node",
+			policy: generateBGPPolicy(bgpPolicyName1,
+				metav1.Time{},
+				nodeLabels2,
+				179,
+				65000,
+				false,
+				false,
+				false,
+				false,
+				true,
+				[]v1alpha1.BGPPeer{ipv4Peer1}),
+			existingState: effectivePolicyState,
+			expectedResult: &BGPPolicyDryRunResult{
+				SelectsNode: false,
+				Routes: map[bgp.Route]RouteMetadata{
+					podIPv4CIDRRoute: allRoutes[podIPv4CIDRRoute],
+				},
+				AdvertisedRoutes: map[bgp.Route]RouteMetadata{
+					podIPv4CIDRRoute: allRoutes[podIPv4CIDRRoute],
+				},
+			},
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newFakeController(t, []runtime.Object{node, ipv4ClusterIP1}, []runtime.Object{effectivePolicy}, true, false)
+			stopCh := make(chan struct{})
+			defer close(stopCh)
+			c.startInformers(stopCh)
+
+			// Fake the BGPPolicy state.
+			c.bgpPolicyState = tt.existingState
+
+			assert.Equal(t, tt.expectedResult, c.DryRunBGPPolicy(tt.policy))
+		})
+	}
+}
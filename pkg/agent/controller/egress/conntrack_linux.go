@@ -0,0 +1,166 @@
+//go:build linux
+// +build linux
+
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package egress
+
+import (
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netlink/nl"
+	"golang.org/x/sys/unix"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// Netlink attributes used to set up NAT for a conntrack entry, which are not defined in the netlink library.
+// See include/uapi/linux/netfilter/nfnetlink_conntrack.h.
+const (
+	ctaNATSrc = 6
+
+	ctaNATV4MinIP = 1
+	ctaNATV4MaxIP = 2
+	ctaNATProto   = 3
+	ctaNATV6MinIP = 4
+	ctaNATV6MaxIP = 5
+
+	ctaProtoNATPortMin = 1
+	ctaProtoNATPortMax = 2
+
+	// IPS_SEEN_REPLY | IPS_ASSURED | IPS_CONFIRMED. The synced connections are established, and the entries must not
+	// be evicted before the connections are taken over. The kernel confirms the entries created via netlink and
+	// rejects the request if IPS_CONFIRMED is not set.
+	syncedConntrackEntryStatus = 1<<1 | 1<<2 | 1<<3
+)
+
+type netlinkConntrackClient struct{}
+
+func newConntrackClient() conntrackClient {
+	return &netlinkConntrackClient{}
+}
+
+func (c *netlinkConntrackClient) dumpSNATEntries(snatIPs sets.Set[string]) ([]conntrackEntry, error) {
+	var entries []conntrackEntry
+	for _, family := range []netlink.InetFamily{unix.AF_INET, unix.AF_INET6} {
+		flows, err := netlink.ConntrackTableList(netlink.ConntrackTable, family)
+		if err != nil {
+			return nil, fmt.Errorf("error listing conntrack entries: %w", err)
+		}
+		for _, flow := range flows {
+			if flow.Forward.Protocol != unix.IPPROTO_TCP && flow.Forward.Protocol != unix.IPPROTO_UDP {
+				continue
+			}
+			// A connection SNAT'd to an Egress IP has the Egress IP as the destination of the reply direction.
+			if !snatIPs.Has(flow.Reverse.DstIP.String()) || flow.Reverse.DstIP.Equal(flow.Forward.SrcIP) {
+				continue
+			}
+			entry := conntrackEntry{
+				Protocol: flow.Forward.Protocol,
+				SrcIP:    flow.Forward.SrcIP,
+				DstIP:    flow.Forward.DstIP,
+				SrcPort:  flow.Forward.SrcPort,
+				DstPort:  flow.Forward.DstPort,
+				SNATIP:   flow.Reverse.DstIP,
+				SNATPort: flow.Reverse.DstPort,
+				Timeout:  flow.TimeOut,
+				Mark:     flow.Mark,
+			}
+			if protoInfo, ok := flow.ProtoInfo.(*netlink.ProtoInfoTCP); ok {
+				entry.TCPState = protoInfo.State
+			}
+			entries = append(entries, entry)
+		}
+	}
+	return entries, nil
+}
+
+func (c *netlinkConntrackClient) installEntry(entry *conntrackEntry) error {
+	family := uint8(unix.AF_INET)
+	if entry.SrcIP.To4() == nil {
+		family = unix.AF_INET6
+	}
+	err := executeConntrackRequest(family, unix.NLM_F_ACK|unix.NLM_F_CREATE|unix.NLM_F_EXCL, entry, true)
+	if errors.Is(err, unix.EEXIST) {
+		// The entry already exists, only refresh its timeout.
+		err = executeConntrackRequest(family, unix.NLM_F_ACK, entry, false)
+	}
+	if err != nil {
+		return fmt.Errorf("error installing conntrack entry %s:%d -> %s:%d: %w", entry.SrcIP, entry.SrcPort, entry.DstIP, entry.DstPort, err)
+	}
+	return nil
+}
+
+func executeConntrackRequest(family uint8, flags int, entry *conntrackEntry, create bool) error {
+	req := nl.NewNetlinkRequest((int(netlink.ConntrackTable)<<8)|nl.IPCTNL_MSG_CT_NEW, flags)
+	req.AddData(&nl.Nfgenmsg{NfgenFamily: family, Version: nl.NFNETLINK_V0})
+
+	ipv4 := family == unix.AF_INET
+	req.AddData(tupleAttr(nl.CTA_TUPLE_ORIG, ipv4, entry.Protocol, entry.SrcIP, entry.DstIP, entry.SrcPort, entry.DstPort))
+	req.AddData(tupleAttr(nl.CTA_TUPLE_REPLY, ipv4, entry.Protocol, entry.DstIP, entry.SNATIP, entry.DstPort, entry.SNATPort))
+	if create {
+		req.AddData(snatAttr(ipv4, entry))
+		req.AddData(nl.NewRtAttr(nl.CTA_STATUS, nl.BEUint32Attr(syncedConntrackEntryStatus)))
+		req.AddData(nl.NewRtAttr(nl.CTA_MARK, nl.BEUint32Attr(entry.Mark)))
+		if entry.Protocol == unix.IPPROTO_TCP && entry.TCPState != 0 {
+			protoInfo := nl.NewRtAttr(unix.NLA_F_NESTED|nl.CTA_PROTOINFO, nil)
+			protoInfoTCP := protoInfo.AddRtAttr(unix.NLA_F_NESTED|nl.CTA_PROTOINFO_TCP, nil)
+			protoInfoTCP.AddRtAttr(nl.CTA_PROTOINFO_TCP_STATE, nl.Uint8Attr(entry.TCPState))
+			req.AddData(protoInfo)
+		}
+	}
+	req.AddData(nl.NewRtAttr(nl.CTA_TIMEOUT, nl.BEUint32Attr(entry.Timeout)))
+
+	_, err := req.Execute(unix.NETLINK_NETFILTER, 0)
+	return err
+}
+
+func tupleAttr(attrType int, ipv4 bool, protocol uint8, srcIP, dstIP net.IP, srcPort, dstPort uint16) *nl.RtAttr {
+	tuple := nl.NewRtAttr(unix.NLA_F_NESTED|attrType, nil)
+	tupleIP := tuple.AddRtAttr(unix.NLA_F_NESTED|nl.CTA_TUPLE_IP, nil)
+	if ipv4 {
+		tupleIP.AddRtAttr(nl.CTA_IP_V4_SRC, srcIP.To4())
+		tupleIP.AddRtAttr(nl.CTA_IP_V4_DST, dstIP.To4())
+	} else {
+		tupleIP.AddRtAttr(nl.CTA_IP_V6_SRC, srcIP.To16())
+		tupleIP.AddRtAttr(nl.CTA_IP_V6_DST, dstIP.To16())
+	}
+	tupleProto := tuple.AddRtAttr(unix.NLA_F_NESTED|nl.CTA_TUPLE_PROTO, nil)
+	tupleProto.AddRtAttr(nl.CTA_PROTO_NUM, []byte{protocol})
+	tupleProto.AddRtAttr(nl.CTA_PROTO_SRC_PORT, nl.BEUint16Attr(srcPort))
+	tupleProto.AddRtAttr(nl.CTA_PROTO_DST_PORT, nl.BEUint16Attr(dstPort))
+	return tuple
+}
+
+// snatAttr builds the attribute which makes the kernel set up SNAT for the entry, so that the packets of the
+// connection are translated the same way as they were on the original Node.
+func snatAttr(ipv4 bool, entry *conntrackEntry) *nl.RtAttr {
+	snat := nl.NewRtAttr(unix.NLA_F_NESTED|ctaNATSrc, nil)
+	if ipv4 {
+		snatIP := entry.SNATIP.To4()
+		snat.AddRtAttr(ctaNATV4MinIP, snatIP)
+		snat.AddRtAttr(ctaNATV4MaxIP, snatIP)
+	} else {
+		snatIP := entry.SNATIP.To16()
+		snat.AddRtAttr(ctaNATV6MinIP, snatIP)
+		snat.AddRtAttr(ctaNATV6MaxIP, snatIP)
+	}
+	natProto := snat.AddRtAttr(unix.NLA_F_NESTED|ctaNATProto, nil)
+	natProto.AddRtAttr(ctaProtoNATPortMin, nl.BEUint16Attr(entry.SNATPort))
+	natProto.AddRtAttr(ctaProtoNATPortMax, nl.BEUint16Attr(entry.SNATPort))
+	return snat
+}
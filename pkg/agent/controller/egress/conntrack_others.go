@@ -0,0 +1,40 @@
+//go:build !linux
+// +build !linux
+
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package egress
+
+import (
+	"errors"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+var errConntrackSyncNotSupported = errors.New("Egress conntrack sync is not supported on this platform")
+
+type unsupportedConntrackClient struct{}
+
+func newConntrackClient() conntrackClient {
+	return &unsupportedConntrackClient{}
+}
+
+func (c *unsupportedConntrackClient) dumpSNATEntries(snatIPs sets.Set[string]) ([]conntrackEntry, error) {
+	return nil, errConntrackSyncNotSupported
+}
+
+func (c *unsupportedConntrackClient) installEntry(entry *conntrackEntry) error {
+	return errConntrackSyncNotSupported
+}
@@ -0,0 +1,247 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package egress
+
+import (
+	"encoding/json"
+	"net"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/agent/memberlist"
+)
+
+const (
+	defaultConntrackSyncInterval = 10 * time.Second
+	// The timeout (in seconds) of the conntrack entries installed on standby Nodes. The entries are refreshed in every
+	// sync, so they expire shortly after the connections are closed on the active Node. After a standby Node takes
+	// over an Egress IP, the kernel resets the timeout of an entry when its connection sees traffic.
+	syncedConntrackEntryTimeout = 60
+	// The maximum number of conntrack entries in a single sync message.
+	maxConntrackEntriesPerMessage = 2000
+	// The size of the queue of received sync messages waiting to be processed.
+	conntrackSyncMessageQueueSize = 100
+)
+
+// conntrackEntry is a SNAT conntrack entry of an Egress IP.
+type conntrackEntry struct {
+	Protocol uint8 `json:"protocol"`
+	// The original direction of the connection, i.e. from the Pod to the external destination.
+	SrcIP   net.IP `json:"srcIP"`
+	DstIP   net.IP `json:"dstIP"`
+	SrcPort uint16 `json:"srcPort"`
+	DstPort uint16 `json:"dstPort"`
+	// The IP and port the connection is SNAT'd to.
+	SNATIP   net.IP `json:"snatIP"`
+	SNATPort uint16 `json:"snatPort"`
+	// Timeout in seconds.
+	Timeout  uint32 `json:"timeout"`
+	Mark     uint32 `json:"mark,omitempty"`
+	TCPState uint8  `json:"tcpState,omitempty"`
+}
+
+type conntrackSyncMessage struct {
+	// The Node sending the message.
+	Node    string           `json:"node"`
+	Entries []conntrackEntry `json:"entries"`
+}
+
+// conntrackClient dumps and installs the SNAT conntrack entries of Egress IPs.
+type conntrackClient interface {
+	// dumpSNATEntries returns the conntrack entries of the connections SNAT'd to the given IPs.
+	dumpSNATEntries(snatIPs sets.Set[string]) ([]conntrackEntry, error)
+	// installEntry creates the conntrack entry, or refreshes its timeout if it already exists.
+	installEntry(entry *conntrackEntry) error
+}
+
+// conntrackSyncCluster is the subset of memberlist.Cluster used by ConntrackSyncer.
+type conntrackSyncCluster interface {
+	SendMessage(nodeName string, msg []byte) error
+	AddClusterMessageHandler(handler memberlist.ClusterMessageHandler)
+	AliveNodes() sets.Set[string]
+}
+
+// standbyNodeGetter returns the Egress IPs scheduled to a Node and the Nodes that would take them over if it failed.
+type standbyNodeGetter interface {
+	GetStandbyNodes(node string) map[string]string
+}
+
+// ConntrackSyncer replicates the SNAT conntrack entries of the Egress IPs scheduled to this Node to their standby
+// Nodes, i.e. the Nodes that would take over the Egress IPs if this Node failed, so that the existing connections
+// can survive Egress failover. The standby Node of an Egress IP is predicted by the Egress IP scheduler, scheduling
+// the Egress IPs with this Node excluded.
+//
+// The messages of memberlist are not authenticated, so the received entries are only installed if they are sent by a
+// live member of the cluster, are SNAT'd to Egress IPs this Node is the standby Node for when the sender fails, and
+// originate from the Pod CIDRs of the sender.
+type ConntrackSyncer struct {
+	nodeName          string
+	cluster           conntrackSyncCluster
+	standbyNodeGetter standbyNodeGetter
+	nodeLister        corelisters.NodeLister
+	conntrackClient   conntrackClient
+	syncInterval      time.Duration
+	// messageCh queues the received sync messages, to avoid blocking memberlist when installing the entries.
+	messageCh chan []byte
+}
+
+func NewConntrackSyncer(nodeName string, cluster conntrackSyncCluster, standbyNodeGetter standbyNodeGetter, nodeLister corelisters.NodeLister) *ConntrackSyncer {
+	s := &ConntrackSyncer{
+		nodeName:          nodeName,
+		cluster:           cluster,
+		standbyNodeGetter: standbyNodeGetter,
+		nodeLister:        nodeLister,
+		conntrackClient:   newConntrackClient(),
+		syncInterval:      defaultConntrackSyncInterval,
+		messageCh:         make(chan []byte, conntrackSyncMessageQueueSize),
+	}
+	cluster.AddClusterMessageHandler(s.onMessage)
+	return s
+}
+
+func (s *ConntrackSyncer) onMessage(msg []byte) {
+	select {
+	case s.messageCh <- msg:
+	default:
+		klog.InfoS("Dropped Egress conntrack sync message as the queue is full")
+	}
+}
+
+// sync sends the SNAT conntrack entries of the local Egress IPs to their standby Nodes.
+func (s *ConntrackSyncer) sync() {
+	ipToStandbyNode := s.standbyNodeGetter.GetStandbyNodes(s.nodeName)
+	if len(ipToStandbyNode) == 0 {
+		return
+	}
+
+	entries, err := s.conntrackClient.dumpSNATEntries(sets.KeySet(ipToStandbyNode))
+	if err != nil {
+		klog.ErrorS(err, "Failed to dump Egress conntrack entries")
+		return
+	}
+	nodeToEntries := map[string][]conntrackEntry{}
+	for _, entry := range entries {
+		node, ok := ipToStandbyNode[entry.SNATIP.String()]
+		if !ok {
+			continue
+		}
+		entry.Timeout = min(entry.Timeout, syncedConntrackEntryTimeout)
+		nodeToEntries[node] = append(nodeToEntries[node], entry)
+	}
+	for node, entries := range nodeToEntries {
+		for start := 0; start < len(entries); start += maxConntrackEntriesPerMessage {
+			end := min(start+maxConntrackEntriesPerMessage, len(entries))
+			msg, err := json.Marshal(&conntrackSyncMessage{Node: s.nodeName, Entries: entries[start:end]})
+			if err != nil {
+				klog.ErrorS(err, "Failed to encode Egress conntrack sync message")
+				return
+			}
+			if err := s.cluster.SendMessage(node, msg); err != nil {
+				klog.ErrorS(err, "Failed to send Egress conntrack entries to standby Node", "node", node)
+				break
+			}
+		}
+		klog.V(4).InfoS("Sent Egress conntrack entries to standby Node", "node", node, "entries", len(entries))
+	}
+}
+
+// getPodCIDRs returns the Pod CIDRs of the given Node.
+func (s *ConntrackSyncer) getPodCIDRs(nodeName string) ([]*net.IPNet, error) {
+	node, err := s.nodeLister.Get(nodeName)
+	if err != nil {
+		return nil, err
+	}
+	podCIDRStrs := node.Spec.PodCIDRs
+	if len(podCIDRStrs) == 0 && node.Spec.PodCIDR != "" {
+		podCIDRStrs = []string{node.Spec.PodCIDR}
+	}
+	var podCIDRs []*net.IPNet
+	for _, podCIDRStr := range podCIDRStrs {
+		_, podCIDR, err := net.ParseCIDR(podCIDRStr)
+		if err != nil {
+			return nil, err
+		}
+		podCIDRs = append(podCIDRs, podCIDR)
+	}
+	return podCIDRs, nil
+}
+
+// installEntries installs the conntrack entries in a sync message received from an active Node. The entries which the
+// active Node is not expected to send to this Node are dropped.
+func (s *ConntrackSyncer) installEntries(msg []byte) {
+	var syncMessage conntrackSyncMessage
+	if err := json.Unmarshal(msg, &syncMessage); err != nil {
+		klog.ErrorS(err, "Failed to decode Egress conntrack sync message")
+		return
+	}
+	if syncMessage.Node == s.nodeName || !s.cluster.AliveNodes().Has(syncMessage.Node) {
+		klog.InfoS("Ignored Egress conntrack sync message from a Node which is not a live member of the cluster", "node", syncMessage.Node)
+		return
+	}
+	podCIDRs, err := s.getPodCIDRs(syncMessage.Node)
+	if err != nil {
+		klog.ErrorS(err, "Failed to get Pod CIDRs of the Node sending Egress conntrack sync message", "node", syncMessage.Node)
+		return
+	}
+	ipToStandbyNode := s.standbyNodeGetter.GetStandbyNodes(syncMessage.Node)
+	isFromPodCIDRs := func(ip net.IP) bool {
+		for _, podCIDR := range podCIDRs {
+			if podCIDR.Contains(ip) {
+				return true
+			}
+		}
+		return false
+	}
+	var failed, dropped int
+	var lastErr error
+	for i := range syncMessage.Entries {
+		entry := &syncMessage.Entries[i]
+		if ipToStandbyNode[entry.SNATIP.String()] != s.nodeName || !isFromPodCIDRs(entry.SrcIP) {
+			dropped++
+			continue
+		}
+		if err := s.conntrackClient.installEntry(entry); err != nil {
+			failed++
+			lastErr = err
+		}
+	}
+	if dropped > 0 {
+		klog.InfoS("Dropped unexpected Egress conntrack entries", "node", syncMessage.Node, "dropped", dropped, "total", len(syncMessage.Entries))
+	}
+	if failed > 0 {
+		klog.ErrorS(lastErr, "Failed to install some Egress conntrack entries", "node", syncMessage.Node, "failed", failed, "total", len(syncMessage.Entries))
+		return
+	}
+	klog.V(4).InfoS("Installed Egress conntrack entries from active Node", "node", syncMessage.Node, "entries", len(syncMessage.Entries)-dropped)
+}
+
+func (s *ConntrackSyncer) Run(stopCh <-chan struct{}) {
+	klog.Info("Starting Egress conntrack syncer")
+	defer klog.Info("Shutting down Egress conntrack syncer")
+
+	go wait.Until(s.sync, s.syncInterval, stopCh)
+	for {
+		select {
+		case <-stopCh:
+			return
+		case msg := <-s.messageCh:
+			s.installEntries(msg)
+		}
+	}
+}
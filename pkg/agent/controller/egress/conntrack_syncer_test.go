@@ -0,0 +1,209 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package egress
+
+import (
+	"encoding/json"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"antrea.io/antrea/pkg/agent/memberlist"
+)
+
+type fakeConntrackClient struct {
+	entries          []conntrackEntry
+	installedEntries []conntrackEntry
+	installErr       error
+}
+
+func (c *fakeConntrackClient) dumpSNATEntries(snatIPs sets.Set[string]) ([]conntrackEntry, error) {
+	var entries []conntrackEntry
+	for _, entry := range c.entries {
+		if snatIPs.Has(entry.SNATIP.String()) {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, nil
+}
+
+func (c *fakeConntrackClient) installEntry(entry *conntrackEntry) error {
+	if c.installErr != nil {
+		return c.installErr
+	}
+	c.installedEntries = append(c.installedEntries, *entry)
+	return nil
+}
+
+type fakeConntrackSyncCluster struct {
+	sentMessages map[string][][]byte
+	handlers     []memberlist.ClusterMessageHandler
+	aliveNodes   sets.Set[string]
+}
+
+func (c *fakeConntrackSyncCluster) SendMessage(nodeName string, msg []byte) error {
+	c.sentMessages[nodeName] = append(c.sentMessages[nodeName], msg)
+	return nil
+}
+
+func (c *fakeConntrackSyncCluster) AddClusterMessageHandler(handler memberlist.ClusterMessageHandler) {
+	c.handlers = append(c.handlers, handler)
+}
+
+func (c *fakeConntrackSyncCluster) AliveNodes() sets.Set[string] {
+	return c.aliveNodes
+}
+
+// newTestNodeLister returns a NodeLister storing fakeNode with Pod CIDRs 10.10.0.0/24 and fd00:10:10::/64.
+func newTestNodeLister() corelisters.NodeLister {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	indexer.Add(&corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: fakeNode},
+		Spec:       corev1.NodeSpec{PodCIDRs: []string{"10.10.0.0/24", "fd00:10:10::/64"}},
+	})
+	return corelisters.NewNodeLister(indexer)
+}
+
+// fakeStandbyNodeGetter stores the Egress IPs scheduled to fakeNode and their standby Nodes.
+type fakeStandbyNodeGetter map[string]string
+
+func (g fakeStandbyNodeGetter) GetStandbyNodes(node string) map[string]string {
+	if node != fakeNode {
+		return nil
+	}
+	return g
+}
+
+func newTestConntrackEntry(srcPort uint16, snatIP string, timeout uint32) conntrackEntry {
+	srcIP, dstIP := net.ParseIP("10.10.0.2"), net.ParseIP("8.8.8.8")
+	if net.ParseIP(snatIP).To4() == nil {
+		srcIP, dstIP = net.ParseIP("fd00:10:10::2"), net.ParseIP("2001:4860:4860::8888")
+	}
+	return conntrackEntry{
+		Protocol: 6,
+		SrcIP:    srcIP,
+		DstIP:    dstIP,
+		SrcPort:  srcPort,
+		DstPort:  443,
+		SNATIP:   net.ParseIP(snatIP),
+		SNATPort: srcPort,
+		Timeout:  timeout,
+		TCPState: 3,
+	}
+}
+
+func decodeConntrackSyncMessages(t *testing.T, msgs [][]byte) []conntrackEntry {
+	var entries []conntrackEntry
+	for _, msg := range msgs {
+		var syncMessage conntrackSyncMessage
+		require.NoError(t, json.Unmarshal(msg, &syncMessage))
+		assert.Equal(t, fakeNode, syncMessage.Node)
+		entries = append(entries, syncMessage.Entries...)
+	}
+	return entries
+}
+
+func TestConntrackSyncerSync(t *testing.T) {
+	entry1 := newTestConntrackEntry(10001, "1.1.1.1", 30)
+	entry2 := newTestConntrackEntry(10002, "1.1.1.1", 86400)
+	entry3 := newTestConntrackEntry(10003, "1.1.1.2", 100)
+	entry4 := newTestConntrackEntry(10004, "1.1.1.3", 100)
+	cluster := &fakeConntrackSyncCluster{sentMessages: map[string][][]byte{}}
+	ctClient := &fakeConntrackClient{entries: []conntrackEntry{entry1, entry2, entry3, entry4}}
+	// No other Node is available for 1.1.1.3.
+	s := NewConntrackSyncer(fakeNode, cluster, fakeStandbyNodeGetter{
+		"1.1.1.1": "node2",
+		"1.1.1.2": "node3",
+	}, newTestNodeLister())
+	s.conntrackClient = ctClient
+	require.Len(t, cluster.handlers, 1)
+
+	s.sync()
+	require.Len(t, cluster.sentMessages, 2)
+	expectedEntry2 := entry2
+	expectedEntry2.Timeout = syncedConntrackEntryTimeout
+	assert.Equal(t, []conntrackEntry{entry1, expectedEntry2}, decodeConntrackSyncMessages(t, cluster.sentMessages["node2"]))
+	expectedEntry3 := entry3
+	expectedEntry3.Timeout = syncedConntrackEntryTimeout
+	assert.Equal(t, []conntrackEntry{expectedEntry3}, decodeConntrackSyncMessages(t, cluster.sentMessages["node3"]))
+}
+
+func TestConntrackSyncerSyncInBatches(t *testing.T) {
+	cluster := &fakeConntrackSyncCluster{sentMessages: map[string][][]byte{}}
+	ctClient := &fakeConntrackClient{}
+	for i := 0; i < maxConntrackEntriesPerMessage+1; i++ {
+		ctClient.entries = append(ctClient.entries, newTestConntrackEntry(uint16(10000+i), "1.1.1.1", 30))
+	}
+	s := NewConntrackSyncer(fakeNode, cluster, fakeStandbyNodeGetter{"1.1.1.1": "node2"}, newTestNodeLister())
+	s.conntrackClient = ctClient
+
+	s.sync()
+	require.Len(t, cluster.sentMessages["node2"], 2)
+	assert.Equal(t, ctClient.entries, decodeConntrackSyncMessages(t, cluster.sentMessages["node2"]))
+}
+
+func TestConntrackSyncerInstallEntries(t *testing.T) {
+	cluster := &fakeConntrackSyncCluster{sentMessages: map[string][][]byte{}, aliveNodes: sets.New[string](fakeNode, "node2", "node3")}
+	ctClient := &fakeConntrackClient{}
+	s := NewConntrackSyncer("node2", cluster, fakeStandbyNodeGetter{
+		"1.1.1.1": "node2",
+		"1.1.1.2": "node3",
+		"fec0::1": "node2",
+	}, newTestNodeLister())
+	s.conntrackClient = ctClient
+
+	entries := []conntrackEntry{newTestConntrackEntry(10001, "1.1.1.1", 30), newTestConntrackEntry(10002, "fec0::1", 60)}
+	msg, err := json.Marshal(&conntrackSyncMessage{Node: fakeNode, Entries: entries})
+	require.NoError(t, err)
+	// Simulate receiving the message from memberlist.
+	cluster.handlers[0](msg)
+	require.Len(t, s.messageCh, 1)
+	s.installEntries(<-s.messageCh)
+	assert.Equal(t, entries, ctClient.installedEntries)
+
+	// Invalid messages are ignored.
+	ctClient.installedEntries = nil
+	s.installEntries([]byte("invalid"))
+	assert.Empty(t, ctClient.installedEntries)
+
+	// Entries of Egress IPs whose standby Node is not this Node, of unknown Egress IPs, or not originating from the Pod
+	// CIDRs of the sender are dropped.
+	entryFromOtherCIDR := newTestConntrackEntry(10005, "1.1.1.1", 30)
+	entryFromOtherCIDR.SrcIP = net.ParseIP("192.168.1.1")
+	msg, err = json.Marshal(&conntrackSyncMessage{Node: fakeNode, Entries: []conntrackEntry{
+		newTestConntrackEntry(10003, "1.1.1.2", 30),
+		newTestConntrackEntry(10004, "1.1.1.3", 30),
+		entryFromOtherCIDR,
+		entries[0],
+	}})
+	require.NoError(t, err)
+	s.installEntries(msg)
+	assert.Equal(t, []conntrackEntry{entries[0]}, ctClient.installedEntries)
+
+	// Messages from Nodes which are not live members are ignored.
+	ctClient.installedEntries = nil
+	cluster.aliveNodes.Delete(fakeNode)
+	msg, err = json.Marshal(&conntrackSyncMessage{Node: fakeNode, Entries: entries})
+	require.NoError(t, err)
+	s.installEntries(msg)
+	assert.Empty(t, ctClient.installedEntries)
+}
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"net"
 	"reflect"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -40,6 +41,7 @@ import (
 	"k8s.io/client-go/util/retry"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
+	utilnet "k8s.io/utils/net"
 
 	"antrea.io/antrea/pkg/agent/client"
 	"antrea.io/antrea/pkg/agent/interfacestore"
@@ -57,7 +59,9 @@ import (
 	crdinformers "antrea.io/antrea/pkg/client/informers/externalversions/crd/v1beta1"
 	crdlisters "antrea.io/antrea/pkg/client/listers/crd/v1beta1"
 	"antrea.io/antrea/pkg/controller/metrics"
+	binding "antrea.io/antrea/pkg/ovs/openflow"
 	"antrea.io/antrea/pkg/util/channel"
+	utilip "antrea.io/antrea/pkg/util/ip"
 	"antrea.io/antrea/pkg/util/k8s"
 )
 
@@ -102,6 +106,19 @@ type egressState struct {
 	pods sets.Set[string]
 	// Rate-limit of this Egress.
 	rateLimitMeter *rateLimitMeter
+	// The actual egress IPs of an ActiveActive Egress. It's nil for other Egresses.
+	egressIPs sets.Set[string]
+	// The group distributing the connections across the egress IPs of an ActiveActive Egress. 0 if not allocated.
+	groupID binding.GroupIDType
+	// The egress IPs in the group of an ActiveActive Egress, whose indexes are committed to the connections using them.
+	// An empty string is an unused index, so that an egress IP keeps its index when other egress IPs are removed.
+	groupIPs []string
+	// Whether the Egress applies only to the traffic to its destinations. Such Egresses are not bound to Pods as they
+	// don't compete with the Egresses applying to all traffic.
+	perDestination bool
+	// The actual destination CIDRs for which we have installed SNAT rules, including the resolved IPs of FQDNs. Used to
+	// check if the flows of the Pods need to be updated.
+	dstCIDRs []*net.IPNet
 }
 
 type rateLimitMeter struct {
@@ -151,6 +168,29 @@ type egressBinding struct {
 	alternativeEgresses sets.Set[string]
 }
 
+// destinationEgress keeps the destination CIDRs and Pods of an Egress with destinations. It's used to resolve the
+// conflicts between the Egresses with overlapping destinations applying to the same Pods.
+type destinationEgress struct {
+	creationTimestamp metav1.Time
+	dstCIDRs          []*net.IPNet
+	pods              sets.Set[string]
+	// The Pods to which the Egress doesn't apply because of other Egresses taking precedence.
+	overriddenPods sets.Set[string]
+}
+
+// FQDNResolver resolves the FQDNs in the destinations of Egresses. It's implemented by the NetworkPolicy controller,
+// which learns the IPs of FQDNs from the DNS responses received by Pods and queries them again when their records
+// expire.
+type FQDNResolver interface {
+	// SubscribeFQDNs tracks the IPs of the FQDNs for the subscriber and intercepts the DNS responses received by the
+	// Pods of podOFPorts. handler is called when the IPs of any of the FQDNs change.
+	SubscribeFQDNs(subscriberID string, fqdns sets.Set[string], podOFPorts sets.Set[int32], handler func()) error
+	// UnsubscribeFQDNs removes the subscriber.
+	UnsubscribeFQDNs(subscriberID string) error
+	// GetFQDNIPs returns the IPs of the FQDNs.
+	GetFQDNIPs(fqdns []string) []net.IP
+}
+
 type EgressController struct {
 	ofClient             openflow.Client
 	routeClient          route.Interface
@@ -178,6 +218,9 @@ type EgressController struct {
 	egressBindings      map[string]*egressBinding
 	egressBindingsMutex sync.RWMutex
 
+	destinationEgresses      map[string]*destinationEgress
+	destinationEgressesMutex sync.Mutex
+
 	egressStates map[string]*egressState
 	// The mutex is to protect the map, not the egressState items. The workqueue guarantees an Egress will only be
 	// processed by a single worker at any time. So the returned EgressState has no race condition.
@@ -208,6 +251,12 @@ type EgressController struct {
 	egressRouteTables map[crdv1b1.SubnetInfo]*egressRouteTable
 
 	linkMonitor linkmonitor.Interface
+
+	// Used to allocate the group IDs of ActiveActive Egresses.
+	groupAllocator openflow.GroupAllocator
+
+	// Used to resolve the FQDNs in the destinations of Egresses. nil if FQDNs can't be resolved.
+	fqdnResolver FQDNResolver
 }
 
 func NewEgressController(
@@ -229,6 +278,8 @@ func NewEgressController(
 	trafficShapingEnabled bool,
 	supportSeparateSubnet bool,
 	linkMonitor linkmonitor.Interface,
+	groupAllocator openflow.GroupAllocator,
+	fqdnResolver FQDNResolver,
 ) (*EgressController, error) {
 	if trafficShapingEnabled && !openflow.OVSMetersAreSupported() {
 		klog.Info("EgressTrafficShaping feature gate is enabled, but it is ignored because OVS meters are not supported.")
@@ -261,6 +312,7 @@ func NewEgressController(
 		egressStates:         map[string]*egressState{},
 		egressIPStates:       map[string]*egressIPState{},
 		egressBindings:       map[string]*egressBinding{},
+		destinationEgresses:  map[string]*destinationEgress{},
 		localIPDetector:      ipassigner.NewLocalIPDetector(),
 		markAllocator:        newIDAllocator(minEgressMark, maxEgressMark),
 		cluster:              cluster,
@@ -278,6 +330,8 @@ func NewEgressController(
 		externalIPPoolListerSynced: externalIPPoolInformer.Informer().HasSynced,
 		supportSeparateSubnet:      supportSeparateSubnet,
 		linkMonitor:                linkMonitor,
+		groupAllocator:             groupAllocator,
+		fqdnResolver:               fqdnResolver,
 	}
 	if supportSeparateSubnet {
 		c.egressRouteTables = map[crdv1b1.SubnetInfo]*egressRouteTable{}
@@ -296,7 +350,7 @@ func NewEgressController(
 	}
 	c.ipAssigner = ipAssigner
 
-	c.egressIPScheduler = NewEgressIPScheduler(cluster, egressInformer, nodeInformers, maxEgressIPsPerNode)
+	c.egressIPScheduler = NewEgressIPScheduler(cluster, egressInformer, externalIPPoolInformer, nodeInformers, maxEgressIPsPerNode)
 
 	c.egressInformer.AddIndexers(
 		cache.Indexers{
@@ -356,6 +410,11 @@ func (c *EgressController) onEgressIPSchedule(egress string) {
 	c.queue.Add(egress)
 }
 
+// onDestinationFQDNUpdate will be called when the resolved IPs of an FQDN in the Egress's destinations change.
+func (c *EgressController) onDestinationFQDNUpdate(egress string) {
+	c.queue.Add(egress)
+}
+
 // onServiceCIDRUpdate will be called when ServiceCIDRs change.
 // It ensures updateServiceCIDRs will be executed once after this call.
 func (c *EgressController) onServiceCIDRUpdate(_ []*net.IPNet) {
@@ -397,21 +456,35 @@ func (c *EgressController) updateServiceCIDRs(stopCh <-chan struct{}) {
 // processPodUpdate will be called when CNIServer publishes a Pod update event.
 // It triggers reconciling the effective Egress of the Pod.
 func (c *EgressController) processPodUpdate(e interface{}) {
-	c.egressBindingsMutex.Lock()
-	defer c.egressBindingsMutex.Unlock()
 	podEvent := e.(types.PodUpdate)
 	pod := k8s.NamespacedName(podEvent.PodNamespace, podEvent.PodName)
-	binding, exists := c.egressBindings[pod]
-	if !exists {
-		return
+	func() {
+		c.egressBindingsMutex.Lock()
+		defer c.egressBindingsMutex.Unlock()
+		binding, exists := c.egressBindings[pod]
+		if !exists {
+			return
+		}
+		c.queue.Add(binding.effectiveEgress)
+	}()
+
+	// Egresses with destinations are not bound to Pods, trigger reconciling the ones applying to the Pod.
+	c.egressGroupsMutex.RLock()
+	defer c.egressGroupsMutex.RUnlock()
+	for egressName, pods := range c.egressGroups {
+		if !pods.Has(pod) {
+			continue
+		}
+		if egress, err := c.egressLister.Get(egressName); err == nil && len(egress.Spec.Destinations) > 0 {
+			c.queue.Add(egressName)
+		}
 	}
-	c.queue.Add(binding.effectiveEgress)
 }
 
 // addEgress processes Egress ADD events.
 func (c *EgressController) addEgress(obj interface{}) {
 	egress := obj.(*crdv1b1.Egress)
-	if egress.Spec.EgressIP == "" {
+	if egress.Spec.EgressIP == "" && len(egress.Spec.EgressIPs) == 0 {
 		return
 	}
 	c.queue.Add(egress.Name)
@@ -426,6 +499,10 @@ func (c *EgressController) updateEgress(old, cur interface{}) {
 	if curEgress.Status.EgressNode == c.nodeName && oldEgress.GetGeneration() == curEgress.GetGeneration() {
 		return
 	}
+	// The status of an ActiveActive Egress is only reported by agents, ignore handling its change.
+	if isEgressActiveActive(curEgress) && oldEgress.GetGeneration() == curEgress.GetGeneration() {
+		return
+	}
 	c.queue.Add(curEgress.Name)
 	klog.V(2).InfoS("Processed Egress UPDATE event", "egress", klog.KObj(curEgress))
 }
@@ -549,6 +626,24 @@ func (c *EgressController) replaceEgressIPs() error {
 			// Egress IPs will be unassigned when the Egresses are deleted.
 			c.newEgressState(egress.Name, egress.Status.EgressIP)
 		}
+		if isEgressSchedulable(egress) && isEgressActiveActive(egress) {
+			pool, err := c.externalIPPoolLister.Get(egress.Spec.ExternalIPPool)
+			if err != nil {
+				continue
+			}
+			var eState *egressState
+			for _, activeIP := range egress.Status.ActiveEgressIPs {
+				if activeIP.EgressNode != c.nodeName {
+					continue
+				}
+				desiredLocalEgressIPs[activeIP.EgressIP] = pool.Spec.SubnetInfo
+				if eState == nil {
+					eState = c.newEgressState(egress.Name, "")
+					eState.egressIPs = sets.New[string]()
+				}
+				eState.egressIPs.Insert(activeIP.EgressIP)
+			}
+		}
 	}
 	if err := c.ipAssigner.InitIPs(desiredLocalEgressIPs); err != nil {
 		return err
@@ -877,6 +972,80 @@ func (c *EgressController) bindPodEgress(pod, egress string) bool {
 	return false
 }
 
+// updateDestinationEgress updates the destination CIDRs and Pods of an Egress with destinations, and returns the Pods
+// to which the Egress doesn't apply because another Egress with overlapping destinations applies to them and takes
+// precedence, i.e. was created earlier, or has a smaller name if they were created at the same time. The other
+// Egresses with destinations sharing Pods with the Egress are requeued if its destination CIDRs or Pods change.
+func (c *EgressController) updateDestinationEgress(egress *crdv1b1.Egress, dstCIDRs []*net.IPNet, pods sets.Set[string]) sets.Set[string] {
+	c.destinationEgressesMutex.Lock()
+	defer c.destinationEgressesMutex.Unlock()
+
+	dstEgress := &destinationEgress{
+		creationTimestamp: egress.CreationTimestamp,
+		dstCIDRs:          dstCIDRs,
+		pods:              pods,
+		overriddenPods:    sets.New[string](),
+	}
+	affectedPods := pods
+	prevDstEgress, exists := c.destinationEgresses[egress.Name]
+	if exists {
+		affectedPods = nil
+		if !ipNetsEqual(prevDstEgress.dstCIDRs, dstCIDRs) || !prevDstEgress.pods.Equal(pods) {
+			affectedPods = prevDstEgress.pods.Union(pods)
+		}
+	}
+	c.destinationEgresses[egress.Name] = dstEgress
+
+	overridingEgresses := sets.New[string]()
+	for name, other := range c.destinationEgresses {
+		if name == egress.Name {
+			continue
+		}
+		if other.pods.HasAny(sets.List(affectedPods)...) {
+			c.queue.Add(name)
+		}
+		if !destinationEgressPrecedes(name, other, egress.Name, dstEgress) || !ipNetsOverlap(other.dstCIDRs, dstCIDRs) {
+			continue
+		}
+		if sharedPods := other.pods.Intersection(pods); sharedPods.Len() > 0 {
+			dstEgress.overriddenPods.Insert(sharedPods.UnsortedList()...)
+			overridingEgresses.Insert(name)
+		}
+	}
+	if dstEgress.overriddenPods.Len() > 0 && (!exists || !prevDstEgress.overriddenPods.Equal(dstEgress.overriddenPods)) {
+		klog.InfoS("Egress doesn't apply to some Pods because of other Egresses with overlapping destinations", "egress", klog.KObj(egress), "pods", sets.List(dstEgress.overriddenPods), "overridingEgresses", sets.List(overridingEgresses))
+		c.record.Eventf(egress, corev1.EventTypeWarning, "DestinationsOverlapped", "Egress doesn't apply to %d Pods on Node %s because Egresses %v with overlapping destinations take precedence", dstEgress.overriddenPods.Len(), c.nodeName, sets.List(overridingEgresses))
+	}
+	return dstEgress.overriddenPods
+}
+
+// deleteDestinationEgress deletes an Egress with destinations, and requeues the other Egresses with destinations
+// sharing Pods with it.
+func (c *EgressController) deleteDestinationEgress(egressName string) {
+	c.destinationEgressesMutex.Lock()
+	defer c.destinationEgressesMutex.Unlock()
+
+	dstEgress, exists := c.destinationEgresses[egressName]
+	if !exists {
+		return
+	}
+	delete(c.destinationEgresses, egressName)
+	for name, other := range c.destinationEgresses {
+		if other.pods.HasAny(sets.List(dstEgress.pods)...) {
+			c.queue.Add(name)
+		}
+	}
+}
+
+// destinationEgressPrecedes returns whether the Egress with destinations named name1 takes precedence over the one
+// named name2.
+func destinationEgressPrecedes(name1 string, egress1 *destinationEgress, name2 string, egress2 *destinationEgress) bool {
+	if !egress1.creationTimestamp.Equal(&egress2.creationTimestamp) {
+		return egress1.creationTimestamp.Before(&egress2.creationTimestamp)
+	}
+	return name1 < name2
+}
+
 // unbindPodEgress unbinds the Pod with the Egress.
 // If the unbound Egress was the effective one for the Pod and there are any alternative ones, it will return the new
 // effective Egress and true. Otherwise it return empty string and false.
@@ -1012,6 +1181,10 @@ func (c *EgressController) syncEgress(egressName string) error {
 		return err
 	}
 
+	if isEgressActiveActive(egress) {
+		return c.syncActiveActiveEgress(egress)
+	}
+
 	var desiredEgressIP string
 	var desiredNode string
 	var scheduleErr error
@@ -1029,9 +1202,11 @@ func (c *EgressController) syncEgress(egressName string) error {
 		desiredEgressIP = egress.Spec.EgressIP
 	}
 
+	perDestination := len(egress.Spec.Destinations) > 0
 	eState, exist := c.getEgressState(egressName)
-	// If the EgressIP changes, uninstalls this Egress first.
-	if exist && eState.egressIP != desiredEgressIP {
+	// If the EgressIP changes, or the Egress was in ActiveActive mode, or whether the Egress has destinations changes,
+	// uninstalls this Egress first.
+	if exist && (eState.egressIP != desiredEgressIP || eState.egressIPs != nil || eState.perDestination != perDestination) {
 		if err := c.uninstallEgress(egressName, eState, egress); err != nil {
 			return err
 		}
@@ -1046,6 +1221,7 @@ func (c *EgressController) syncEgress(egressName string) error {
 	}
 	if !exist {
 		eState = c.newEgressState(egressName, desiredEgressIP)
+		eState.perDestination = perDestination
 	}
 
 	var subnetInfo *crdv1b1.SubnetInfo
@@ -1102,29 +1278,118 @@ func (c *EgressController) syncEgress(egressName string) error {
 		return fmt.Errorf("update Egress %s status error: %v", egressName, err)
 	}
 
+	egressIP := net.ParseIP(eState.egressIP)
+	if eState.perDestination {
+		fqdnIPs, err := c.syncDestinationFQDNs(egress, eState.ofPorts)
+		if err != nil {
+			return err
+		}
+		if err := c.syncPodDestinationFlows(egress, eState, egressIP, getEgressDestinationCIDRs(egress, egressIP, fqdnIPs)); err != nil {
+			return err
+		}
+		// Intercept the DNS responses received by the Pods added to the Egress.
+		_, err = c.syncDestinationFQDNs(egress, eState.ofPorts)
+		return err
+	}
+	return c.syncPodFlows(egressName, eState, nil, func(ofPort uint32) error {
+		return c.ofClient.InstallPodSNATFlows(ofPort, egressIP, mark)
+	})
+}
+
+// syncDestinationFQDNs subscribes to the FQDNs in the destinations of the Egress, intercepting the DNS responses
+// received by the Pods of ofPorts, and returns the IPs of the FQDNs.
+func (c *EgressController) syncDestinationFQDNs(egress *crdv1b1.Egress, ofPorts sets.Set[int32]) ([]net.IP, error) {
+	fqdns := getEgressDestinationFQDNs(egress)
+	if fqdns.Len() == 0 || c.fqdnResolver == nil {
+		if fqdns.Len() > 0 {
+			klog.InfoS("FQDNs can't be resolved, ignoring the FQDN destinations of Egress", "egress", klog.KObj(egress))
+		}
+		return nil, c.unsubscribeDestinationFQDNs(egress.Name)
+	}
+	egressName := egress.Name
+	// Pass a copy of ofPorts as the resolver keeps it.
+	if err := c.fqdnResolver.SubscribeFQDNs(getFQDNSubscriberID(egressName), fqdns, ofPorts.Union(nil), func() {
+		c.onDestinationFQDNUpdate(egressName)
+	}); err != nil {
+		return nil, err
+	}
+	return c.fqdnResolver.GetFQDNIPs(sets.List(fqdns)), nil
+}
+
+func (c *EgressController) unsubscribeDestinationFQDNs(egressName string) error {
+	if c.fqdnResolver == nil {
+		return nil
+	}
+	return c.fqdnResolver.UnsubscribeFQDNs(getFQDNSubscriberID(egressName))
+}
+
+// getFQDNSubscriberID returns the ID of the Egress as an FQDN subscriber, which doesn't collide with the IDs of
+// NetworkPolicy rules.
+func getFQDNSubscriberID(egressName string) string {
+	return "Egress/" + egressName
+}
+
+// syncPodDestinationFlows installs the SNAT flows of an Egress with destinations for its desired Pods, and updates the
+// flows of the existing Pods if the destination CIDRs change. No flows are installed if there is no destination CIDR,
+// e.g. none of the FQDNs has been resolved yet, and for the Pods overridden by other Egresses with overlapping
+// destinations.
+func (c *EgressController) syncPodDestinationFlows(egress *crdv1b1.Egress, eState *egressState, egressIP net.IP, dstCIDRs []*net.IPNet) error {
+	egressName := egress.Name
+	overriddenPods := c.updateDestinationEgress(egress, dstCIDRs, c.getEgressPods(egressName))
+	if !ipNetsEqual(eState.dstCIDRs, dstCIDRs) {
+		for ofPort := range eState.ofPorts {
+			var err error
+			if len(dstCIDRs) == 0 {
+				err = c.ofClient.UninstallPodSNATDestinationFlows(egressName, uint32(ofPort))
+			} else {
+				err = c.ofClient.InstallPodSNATDestinationFlows(egressName, uint32(ofPort), egressIP, eState.mark, dstCIDRs)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		eState.dstCIDRs = dstCIDRs
+	}
+	return c.syncPodFlows(egressName, eState, overriddenPods, func(ofPort uint32) error {
+		if len(dstCIDRs) == 0 {
+			return nil
+		}
+		return c.ofClient.InstallPodSNATDestinationFlows(egressName, ofPort, egressIP, eState.mark, dstCIDRs)
+	})
+}
+
+// getEgressPods returns a copy of the desired Pods of the Egress.
+func (c *EgressController) getEgressPods(egressName string) sets.Set[string] {
+	c.egressGroupsMutex.RLock()
+	defer c.egressGroupsMutex.RUnlock()
+	pods, exist := c.egressGroups[egressName]
+	if !exist {
+		return nil
+	}
+	return pods.Union(nil)
+}
+
+// syncPodFlows installs the SNAT flows for the desired Pods of the Egress with installFlows, except for the Pods in
+// excludedPods, and uninstalls the SNAT flows for the stale and excluded Pods.
+func (c *EgressController) syncPodFlows(egressName string, eState *egressState, excludedPods sets.Set[string], installFlows func(ofPort uint32) error) error {
 	// Copy the previous ofPorts and Pods. They will be used to identify stale ofPorts and Pods.
 	staleOFPorts := eState.ofPorts.Union(nil)
 	stalePods := eState.pods.Union(nil)
 
-	// Get a copy of the desired Pods.
-	pods := func() sets.Set[string] {
-		c.egressGroupsMutex.RLock()
-		defer c.egressGroupsMutex.RUnlock()
-		pods, exist := c.egressGroups[egressName]
-		if !exist {
-			return nil
-		}
-		return pods.Union(nil)
-	}()
+	pods := c.getEgressPods(egressName)
 
-	egressIP := net.ParseIP(eState.egressIP)
 	// Install SNAT flows for desired Pods.
 	for pod := range pods {
 		eState.pods.Insert(pod)
 		stalePods.Delete(pod)
 
-		// If the Egress is not the effective one for the Pod, do nothing.
-		if !c.bindPodEgress(pod, egressName) {
+		if excludedPods.Has(pod) {
+			continue
+		}
+
+		// If the Egress is not the effective one for the Pod, do nothing. Egresses with destinations apply to the Pods
+		// regardless of other Egresses.
+		if !eState.perDestination && !c.bindPodEgress(pod, egressName) {
 			continue
 		}
 
@@ -1142,7 +1407,7 @@ func (c *EgressController) syncEgress(egressName string) error {
 			staleOFPorts.Delete(ofPort)
 			continue
 		}
-		if err := c.ofClient.InstallPodSNATFlows(uint32(ofPort), egressIP, mark); err != nil {
+		if err := installFlows(uint32(ofPort)); err != nil {
 			return err
 		}
 		eState.ofPorts.Insert(ofPort)
@@ -1155,11 +1420,255 @@ func (c *EgressController) syncEgress(egressName string) error {
 	return nil
 }
 
+// syncActiveActiveEgress realizes an ActiveActive Egress. The Egress IPs scheduled to this Node are assigned to it, and
+// the connections of the Egress's Pods are distributed across all of its Egress IPs by an OpenFlow group.
+func (c *EgressController) syncActiveActiveEgress(egress *crdv1b1.Egress) error {
+	egressName := egress.Name
+	var desiredIPToNode map[string]string
+	var scheduleErr error
+	// Only check which Egress IPs should be assigned to this Node when the Egress is schedulable.
+	// Otherwise, users are responsible for assigning the Egress IPs to Nodes.
+	if isEgressSchedulable(egress) {
+		ipToNode, err, scheduled := c.egressIPScheduler.GetEgressIPsAndNodes(egressName)
+		if scheduled {
+			desiredIPToNode = ipToNode
+		} else {
+			scheduleErr = err
+		}
+	} else {
+		desiredIPToNode = make(map[string]string, len(egress.Spec.EgressIPs))
+		for _, egressIP := range egress.Spec.EgressIPs {
+			desiredIPToNode[egressIP] = ""
+		}
+	}
+
+	eState, exist := c.getEgressState(egressName)
+	// If the Egress was not in ActiveActive mode or has no Egress IP now, uninstalls this Egress first.
+	if exist && (eState.egressIPs == nil || len(desiredIPToNode) == 0) {
+		if err := c.uninstallEgress(egressName, eState, egress); err != nil {
+			return err
+		}
+		exist = false
+	}
+	// Do not proceed if there is no Egress IP.
+	if len(desiredIPToNode) == 0 {
+		if err := c.updateEgressStatus(egress, "", scheduleErr); err != nil {
+			return fmt.Errorf("update Egress %s status error: %v", egressName, err)
+		}
+		return nil
+	}
+	if !exist {
+		eState = c.newEgressState(egressName, "")
+		eState.egressIPs = sets.New[string]()
+	}
+
+	desiredEgressIPs := make([]string, 0, len(desiredIPToNode))
+	for egressIP := range desiredIPToNode {
+		desiredEgressIPs = append(desiredEgressIPs, egressIP)
+	}
+	slices.Sort(desiredEgressIPs)
+
+	// Install the group before realizing the Egress IPs, so that it's reinstalled when realizing fails.
+	groupIPs := getGroupIPs(eState.groupIPs, desiredEgressIPs)
+	if eState.groupID == 0 || !slices.Equal(eState.groupIPs, groupIPs) {
+		groupID := eState.groupID
+		if groupID == 0 {
+			groupID = c.groupAllocator.Allocate()
+		}
+		snatIPs := make([]net.IP, len(groupIPs))
+		for i, egressIP := range groupIPs {
+			if egressIP != "" {
+				snatIPs[i] = net.ParseIP(egressIP)
+			}
+		}
+		if err := c.ofClient.InstallEgressGroup(groupID, snatIPs); err != nil {
+			if eState.groupID == 0 {
+				c.groupAllocator.Release(groupID)
+			}
+			return err
+		}
+		eState.groupID = groupID
+		eState.groupIPs = groupIPs
+	}
+
+	hasLocalIP := false
+	for _, node := range desiredIPToNode {
+		if node == c.nodeName {
+			hasLocalIP = true
+			break
+		}
+	}
+	var subnetInfo *crdv1b1.SubnetInfo
+	if c.supportSeparateSubnet && egress.Spec.ExternalIPPool != "" && hasLocalIP {
+		pool, err := c.externalIPPoolLister.Get(egress.Spec.ExternalIPPool)
+		if err != nil {
+			return err
+		}
+		subnetInfo = pool.Spec.SubnetInfo
+	}
+	for _, egressIP := range desiredEgressIPs {
+		if desiredIPToNode[egressIP] == c.nodeName {
+			// Force advertising the IP if it was not reported to be active on this Node in the Egress API.
+			forceAdvertise := !slices.Contains(egress.Status.ActiveEgressIPs, crdv1b1.ActiveEgressIP{EgressIP: egressIP, EgressNode: c.nodeName})
+			assigned, err := c.ipAssigner.AssignIP(egressIP, subnetInfo, forceAdvertise)
+			if err != nil {
+				return err
+			}
+			if assigned {
+				c.record.Eventf(egress, corev1.EventTypeNormal, "IPAssigned", "Assigned Egress %s with IP %s on Node %s", egressName, egressIP, c.nodeName)
+			}
+		} else {
+			// Unassign the Egress IP from the local Node if it was assigned by the agent.
+			unassigned, err := c.ipAssigner.UnassignIP(egressIP)
+			if err != nil {
+				return err
+			}
+			if unassigned {
+				c.record.Eventf(egress, corev1.EventTypeNormal, "IPUnassigned", "Unassigned Egress %s with IP %s from Node %s", egressName, egressIP, c.nodeName)
+			}
+		}
+		if _, err := c.realizeEgressIP(egressName, egressIP, subnetInfo); err != nil {
+			return err
+		}
+		eState.egressIPs.Insert(egressIP)
+	}
+	// Unrealize the stale Egress IPs.
+	for egressIP := range eState.egressIPs {
+		if _, exists := desiredIPToNode[egressIP]; exists {
+			continue
+		}
+		if err := c.unrealizeActiveActiveEgressIP(egressName, eState, egress, egressIP); err != nil {
+			return err
+		}
+	}
+
+	if err := c.updateActiveActiveEgressStatus(egress, desiredIPToNode); err != nil {
+		return fmt.Errorf("update Egress %s status error: %v", egressName, err)
+	}
+
+	// The Egress IPs of an ActiveActive Egress are of the same IP family.
+	ipProtocol := binding.ProtocolIP
+	if utilnet.IsIPv6String(desiredEgressIPs[0]) {
+		ipProtocol = binding.ProtocolIPv6
+	}
+	return c.syncPodFlows(egressName, eState, nil, func(ofPort uint32) error {
+		return c.ofClient.InstallPodSNATGroupFlows(ofPort, eState.groupID, ipProtocol)
+	})
+}
+
+// getGroupIPs returns the egress IPs of the group of an ActiveActive Egress, indexed like in prevGroupIPs. The indexes
+// of the removed egress IPs become unused, and the new egress IPs take the lowest unused indexes, so that the existing
+// connections keep using their egress IPs.
+func getGroupIPs(prevGroupIPs []string, desiredEgressIPs []string) []string {
+	newEgressIPs := sets.New[string](desiredEgressIPs...)
+	groupIPs := make([]string, len(prevGroupIPs))
+	for i, egressIP := range prevGroupIPs {
+		if newEgressIPs.Has(egressIP) {
+			groupIPs[i] = egressIP
+			newEgressIPs.Delete(egressIP)
+		}
+	}
+	// desiredEgressIPs is sorted, which makes the indexes of the new egress IPs deterministic.
+	for _, egressIP := range desiredEgressIPs {
+		if !newEgressIPs.Has(egressIP) {
+			continue
+		}
+		if i := slices.Index(groupIPs, ""); i >= 0 {
+			groupIPs[i] = egressIP
+		} else {
+			groupIPs = append(groupIPs, egressIP)
+		}
+	}
+	for len(groupIPs) > 0 && groupIPs[len(groupIPs)-1] == "" {
+		groupIPs = groupIPs[:len(groupIPs)-1]
+	}
+	return groupIPs
+}
+
+// unrealizeActiveActiveEgressIP unrealizes an Egress IP of an ActiveActive Egress and unassigns it from the local Node
+// if it was assigned by the agent.
+func (c *EgressController) unrealizeActiveActiveEgressIP(egressName string, eState *egressState, egress *crdv1b1.Egress, egressIP string) error {
+	if err := c.unrealizeEgressIP(egressName, egressIP); err != nil {
+		return err
+	}
+	unassigned, err := c.ipAssigner.UnassignIP(egressIP)
+	if err != nil {
+		return err
+	}
+	if unassigned && egress != nil {
+		c.record.Eventf(egress, corev1.EventTypeNormal, "IPUnassigned", "Unassigned Egress %s with IP %s from Node %s", egressName, egressIP, c.nodeName)
+	}
+	eState.egressIPs.Delete(egressIP)
+	return nil
+}
+
+// updateActiveActiveEgressStatus updates the status of an ActiveActive Egress. Each agent reports the Egress IPs that
+// are active on its Node, and removes the stale entries of the Egress IPs no longer scheduled to the reported Nodes.
+func (c *EgressController) updateActiveActiveEgressStatus(egress *crdv1b1.Egress, desiredIPToNode map[string]string) error {
+	getDesiredActiveEgressIPs := func(status *crdv1b1.EgressStatus) []crdv1b1.ActiveEgressIP {
+		var activeEgressIPs []crdv1b1.ActiveEgressIP
+		for _, activeEgressIP := range status.ActiveEgressIPs {
+			// The entries of this Node are generated below.
+			if activeEgressIP.EgressNode == c.nodeName {
+				continue
+			}
+			if node, exists := desiredIPToNode[activeEgressIP.EgressIP]; exists && (node == "" || node == activeEgressIP.EgressNode) {
+				activeEgressIPs = append(activeEgressIPs, activeEgressIP)
+			}
+		}
+		for egressIP, node := range desiredIPToNode {
+			if (node == "" || node == c.nodeName) && c.localIPDetector.IsLocalIP(egressIP) {
+				activeEgressIPs = append(activeEgressIPs, crdv1b1.ActiveEgressIP{EgressIP: egressIP, EgressNode: c.nodeName})
+			}
+		}
+		slices.SortFunc(activeEgressIPs, func(a, b crdv1b1.ActiveEgressIP) int {
+			return strings.Compare(a.EgressIP, b.EgressIP)
+		})
+		return activeEgressIPs
+	}
+
+	toUpdate := egress.DeepCopy()
+	var updateErr, getErr error
+	if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		activeEgressIPs := getDesiredActiveEgressIPs(&toUpdate.Status)
+		if slices.Equal(toUpdate.Status.ActiveEgressIPs, activeEgressIPs) && toUpdate.Status.EgressIP == "" && toUpdate.Status.EgressNode == "" {
+			return nil
+		}
+		toUpdate.Status.EgressIP = ""
+		toUpdate.Status.EgressNode = ""
+		toUpdate.Status.ActiveEgressIPs = activeEgressIPs
+
+		klog.V(2).InfoS("Updating Egress status", "Egress", egress.Name, "activeEgressIPs", activeEgressIPs)
+		_, updateErr = c.crdClient.CrdV1beta1().Egresses().UpdateStatus(context.TODO(), toUpdate, metav1.UpdateOptions{})
+		if updateErr != nil && errors.IsConflict(updateErr) {
+			if toUpdate, getErr = c.crdClient.CrdV1beta1().Egresses().Get(context.TODO(), egress.Name, metav1.GetOptions{}); getErr != nil {
+				return getErr
+			}
+		}
+		// Return the error from UPDATE.
+		return updateErr
+	}); err != nil {
+		return err
+	}
+	klog.V(2).InfoS("Updated Egress status", "Egress", egress.Name)
+	metrics.AntreaEgressStatusUpdates.Inc()
+	return nil
+}
+
 func (c *EgressController) uninstallEgress(egressName string, eState *egressState, egress *crdv1b1.Egress) error {
 	// Uninstall all of its Pod flows.
 	if err := c.uninstallPodFlows(egressName, eState, eState.ofPorts, eState.pods); err != nil {
 		return err
 	}
+	if eState.perDestination {
+		if err := c.unsubscribeDestinationFQDNs(egressName); err != nil {
+			return err
+		}
+		c.deleteDestinationEgress(egressName)
+	}
+	if eState.egressIPs != nil {
+		return c.uninstallActiveActiveEgress(egressName, eState, egress)
+	}
 	// Release the EgressIP's mark if the Egress is the last one referring to it.
 	if err := c.unrealizeEgressIP(egressName, eState.egressIP); err != nil {
 		return err
@@ -1183,9 +1692,35 @@ func (c *EgressController) uninstallEgress(egressName string, eState *egressStat
 	return nil
 }
 
+// uninstallActiveActiveEgress reverts what syncActiveActiveEgress does, except for the Pod flows.
+func (c *EgressController) uninstallActiveActiveEgress(egressName string, eState *egressState, egress *crdv1b1.Egress) error {
+	for egressIP := range eState.egressIPs {
+		if err := c.unrealizeActiveActiveEgressIP(egressName, eState, egress, egressIP); err != nil {
+			return err
+		}
+	}
+	if eState.groupID != 0 {
+		if err := c.ofClient.UninstallEgressGroup(eState.groupID); err != nil {
+			return err
+		}
+		c.groupAllocator.Release(eState.groupID)
+		eState.groupID = 0
+		eState.groupIPs = nil
+	}
+	// Remove the Egress's state.
+	c.deleteEgressState(egressName)
+	return nil
+}
+
 func (c *EgressController) uninstallPodFlows(egressName string, egressState *egressState, ofPorts sets.Set[int32], pods sets.Set[string]) error {
 	for ofPort := range ofPorts {
-		if err := c.ofClient.UninstallPodSNATFlows(uint32(ofPort)); err != nil {
+		var err error
+		if egressState.perDestination {
+			err = c.ofClient.UninstallPodSNATDestinationFlows(egressName, uint32(ofPort))
+		} else {
+			err = c.ofClient.UninstallPodSNATFlows(uint32(ofPort))
+		}
+		if err != nil {
 			return err
 		}
 		egressState.ofPorts.Delete(ofPort)
@@ -1198,6 +1733,9 @@ func (c *EgressController) uninstallPodFlows(egressName string, egressState *egr
 	newEffectiveEgresses := sets.New[string]()
 	for pod := range pods {
 		delete(egressState.pods, pod)
+		if egressState.perDestination {
+			continue
+		}
 		newEffectiveEgress, exists := c.unbindPodEgress(pod, egressName)
 		if exists {
 			newEffectiveEgresses.Insert(newEffectiveEgress)
@@ -1366,6 +1904,12 @@ func (c *EgressController) GetEgressIPByMark(mark uint32) (string, error) {
 	return "", fmt.Errorf("no EgressIP associated with mark %v", mark)
 }
 
+// GetStandbyNodes returns the Egress IPs scheduled to the Node, mapped to the Nodes they would be scheduled to if the
+// Node failed.
+func (c *EgressController) GetStandbyNodes(node string) map[string]string {
+	return c.egressIPScheduler.GetStandbyNodes(node)
+}
+
 // GetEgress returns effective EgressName, EgressIP and EgressNode name of Egress applied on a Pod.
 func (c *EgressController) GetEgress(ns, podName string) (string, string, string, error) {
 	if c == nil {
@@ -1393,9 +1937,77 @@ func (c *EgressController) GetEgress(ns, podName string) (string, string, string
 	return egressName, egressIP, egressNode, nil
 }
 
-// An Egress is schedulable if its Egress IP is allocated from ExternalIPPool.
+// An Egress is schedulable if its Egress IPs are allocated from ExternalIPPool.
 func isEgressSchedulable(egress *crdv1b1.Egress) bool {
-	return egress.Spec.EgressIP != "" && egress.Spec.ExternalIPPool != ""
+	return (egress.Spec.EgressIP != "" || len(egress.Spec.EgressIPs) > 0) && egress.Spec.ExternalIPPool != ""
+}
+
+// getEgressDestinationFQDNs returns the FQDNs in the destinations of the Egress.
+func getEgressDestinationFQDNs(egress *crdv1b1.Egress) sets.Set[string] {
+	fqdns := sets.New[string]()
+	for _, destination := range egress.Spec.Destinations {
+		if destination.FQDN != "" {
+			fqdns.Insert(destination.FQDN)
+		}
+	}
+	return fqdns
+}
+
+// getEgressDestinationCIDRs returns the CIDRs in the destinations of the Egress and the resolved IPs of the FQDNs in
+// them, which are of the same IP family as the Egress IP. Redundant CIDRs are removed and the result is sorted.
+func getEgressDestinationCIDRs(egress *crdv1b1.Egress, egressIP net.IP, fqdnIPs []net.IP) []*net.IPNet {
+	isIPv6 := utilnet.IsIPv6(egressIP)
+	var cidrs []*net.IPNet
+	for _, destination := range egress.Spec.Destinations {
+		if destination.CIDR == "" {
+			continue
+		}
+		_, cidr, err := net.ParseCIDR(destination.CIDR)
+		if err != nil {
+			klog.ErrorS(err, "Invalid destination CIDR of Egress", "egress", klog.KObj(egress), "cidr", destination.CIDR)
+			continue
+		}
+		if utilnet.IsIPv6CIDR(cidr) == isIPv6 {
+			cidrs = append(cidrs, cidr)
+		}
+	}
+	for _, ip := range fqdnIPs {
+		if utilnet.IsIPv6(ip) != isIPv6 {
+			continue
+		}
+		if isIPv6 {
+			cidrs = append(cidrs, &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)})
+		} else {
+			cidrs = append(cidrs, &net.IPNet{IP: ip.To4(), Mask: net.CIDRMask(32, 32)})
+		}
+	}
+	cidrs = utilip.MergeCIDRs(cidrs)
+	slices.SortFunc(cidrs, func(a, b *net.IPNet) int {
+		return strings.Compare(a.String(), b.String())
+	})
+	return cidrs
+}
+
+// ipNetsOverlap returns whether any CIDR in ipNets1 overlaps with any CIDR in ipNets2.
+func ipNetsOverlap(ipNets1, ipNets2 []*net.IPNet) bool {
+	for _, ipNet1 := range ipNets1 {
+		for _, ipNet2 := range ipNets2 {
+			if ipNet1.Contains(ipNet2.IP) || ipNet2.Contains(ipNet1.IP) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func ipNetsEqual(ipNets1, ipNets2 []*net.IPNet) bool {
+	return slices.EqualFunc(ipNets1, ipNets2, func(ipNet1, ipNet2 *net.IPNet) bool {
+		return ipNet1.String() == ipNet2.String()
+	})
+}
+
+func isEgressActiveActive(egress *crdv1b1.Egress) bool {
+	return egress.Spec.Mode == crdv1b1.EgressModeActiveActive
 }
 
 // compareEgressStatus compares two Egress Statuses, ignoring LastTransitionTime and conditions other than IPAssigned, returns true if they are equal.
@@ -1406,7 +2018,8 @@ func compareEgressStatus(currentStatus, desiredStatus *crdv1b1.EgressStatus) boo
 	if currentStatus == nil || desiredStatus == nil {
 		return false
 	}
-	if currentStatus.EgressIP != desiredStatus.EgressIP || currentStatus.EgressNode != desiredStatus.EgressNode {
+	if currentStatus.EgressIP != desiredStatus.EgressIP || currentStatus.EgressNode != desiredStatus.EgressNode ||
+		!slices.Equal(currentStatus.ActiveEgressIPs, desiredStatus.ActiveEgressIPs) {
 		return false
 	}
 	currentIPAssignedCondition := crdv1b1.GetEgressCondition(currentStatus.Conditions, crdv1b1.IPAssigned)
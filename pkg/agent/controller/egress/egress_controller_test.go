@@ -41,6 +41,7 @@ import (
 	"antrea.io/antrea/pkg/agent/ipassigner/linkmonitor"
 	ipassignertest "antrea.io/antrea/pkg/agent/ipassigner/testing"
 	"antrea.io/antrea/pkg/agent/memberlist"
+	"antrea.io/antrea/pkg/agent/openflow"
 	openflowtest "antrea.io/antrea/pkg/agent/openflow/testing"
 	routetest "antrea.io/antrea/pkg/agent/route/testing"
 	servicecidrtest "antrea.io/antrea/pkg/agent/servicecidr/testing"
@@ -52,6 +53,7 @@ import (
 	fakeversioned "antrea.io/antrea/pkg/client/clientset/versioned/fake"
 	"antrea.io/antrea/pkg/client/clientset/versioned/scheme"
 	crdinformers "antrea.io/antrea/pkg/client/informers/externalversions"
+	binding "antrea.io/antrea/pkg/ovs/openflow"
 	"antrea.io/antrea/pkg/util/channel"
 	"antrea.io/antrea/pkg/util/ip"
 	"antrea.io/antrea/pkg/util/k8s"
@@ -136,6 +138,49 @@ func (c *fakeSingleNodeCluster) AliveNodes() sets.Set[string] {
 
 func (c *fakeSingleNodeCluster) AddClusterEventHandler(handler memberlist.ClusterNodeEventHandler) {}
 
+type fakeFQDNResolver struct {
+	fqdnIPs     map[string][]net.IP
+	subscribers map[string]func()
+	// The Pods whose DNS responses are intercepted for each subscriber.
+	subscriberOFPorts map[string]sets.Set[int32]
+}
+
+func newFakeFQDNResolver() *fakeFQDNResolver {
+	return &fakeFQDNResolver{
+		fqdnIPs:           map[string][]net.IP{},
+		subscribers:       map[string]func(){},
+		subscriberOFPorts: map[string]sets.Set[int32]{},
+	}
+}
+
+func (r *fakeFQDNResolver) SubscribeFQDNs(subscriberID string, fqdns sets.Set[string], podOFPorts sets.Set[int32], handler func()) error {
+	r.subscribers[subscriberID] = handler
+	r.subscriberOFPorts[subscriberID] = podOFPorts
+	return nil
+}
+
+func (r *fakeFQDNResolver) UnsubscribeFQDNs(subscriberID string) error {
+	delete(r.subscribers, subscriberID)
+	delete(r.subscriberOFPorts, subscriberID)
+	return nil
+}
+
+func (r *fakeFQDNResolver) GetFQDNIPs(fqdns []string) []net.IP {
+	var ips []net.IP
+	for _, fqdn := range fqdns {
+		ips = append(ips, r.fqdnIPs[fqdn]...)
+	}
+	return ips
+}
+
+// setFQDNIPs updates the IPs of the FQDN and notifies all subscribers.
+func (r *fakeFQDNResolver) setFQDNIPs(fqdn string, ips ...net.IP) {
+	r.fqdnIPs[fqdn] = ips
+	for _, handler := range r.subscribers {
+		handler()
+	}
+}
+
 func mockNewIPAssigner(ipAssigner ipassigner.IPAssigner) func() {
 	originalNewIPAssigner := newIPAssigner
 	newIPAssigner = func(_, _ string, _ linkmonitor.Interface) (ipassigner.IPAssigner, error) {
@@ -157,6 +202,7 @@ type fakeController struct {
 	mockIPAssigner           *ipassignertest.MockIPAssigner
 	mockServiceCIDRInterface *servicecidrtest.MockInterface
 	podUpdateChannel         *channel.SubscribableChannel
+	fakeFQDNResolver         *fakeFQDNResolver
 }
 
 func newFakeController(t *testing.T, initObjects []runtime.Object) *fakeController {
@@ -187,6 +233,7 @@ func newFakeController(t *testing.T, initObjects []runtime.Object) *fakeControll
 	podUpdateChannel := channel.NewSubscribableChannel("PodUpdate", 100)
 	mockServiceCIDRProvider := servicecidrtest.NewMockInterface(controller)
 	mockServiceCIDRProvider.EXPECT().AddEventHandler(gomock.Any())
+	fqdnResolver := newFakeFQDNResolver()
 	egressController, _ := NewEgressController(mockOFClient,
 		k8sClient,
 		&antreaClientGetter{clientset},
@@ -205,6 +252,8 @@ func newFakeController(t *testing.T, initObjects []runtime.Object) *fakeControll
 		true,
 		true,
 		nil,
+		openflow.NewGroupAllocator(),
+		fqdnResolver,
 	)
 	egressController.localIPDetector = localIPDetector
 	return &fakeController{
@@ -218,6 +267,7 @@ func newFakeController(t *testing.T, initObjects []runtime.Object) *fakeControll
 		mockIPAssigner:           mockIPAssigner,
 		mockServiceCIDRInterface: mockServiceCIDRProvider,
 		podUpdateChannel:         podUpdateChannel,
+		fakeFQDNResolver:         fqdnResolver,
 	}
 }
 
@@ -1422,6 +1472,270 @@ func TestSyncOverlappingEgress(t *testing.T) {
 	assert.Len(t, c.egressIPStates, 0)
 }
 
+func TestSyncActiveActiveEgress(t *testing.T) {
+	egress := &crdv1b1.Egress{
+		ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+		Spec: crdv1b1.EgressSpec{
+			Mode:      crdv1b1.EgressModeActiveActive,
+			EgressIPs: []string{fakeRemoteEgressIP1, fakeLocalEgressIP1},
+		},
+	}
+	egressGroup := &cpv1b2.EgressGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+		GroupMembers: []cpv1b2.GroupMember{
+			{Pod: &cpv1b2.PodReference{Name: "pod1", Namespace: "ns1"}},
+			{Pod: &cpv1b2.PodReference{Name: "pod2", Namespace: "ns2"}},
+		},
+	}
+	c := newFakeController(t, []runtime.Object{egress})
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	c.crdInformerFactory.Start(stopCh)
+	c.informerFactory.Start(stopCh)
+	c.crdInformerFactory.WaitForCacheSync(stopCh)
+	c.informerFactory.WaitForCacheSync(stopCh)
+	c.addEgressGroup(egressGroup)
+	checkQueueItemExistence(t, c.queue, egress.Name)
+
+	groupID := c.groupAllocator.Next()
+	c.mockOFClient.EXPECT().InstallEgressGroup(groupID, []net.IP{net.ParseIP(fakeLocalEgressIP1), net.ParseIP(fakeRemoteEgressIP1)})
+	c.mockOFClient.EXPECT().InstallSNATMarkFlows(net.ParseIP(fakeLocalEgressIP1), uint32(1))
+	c.mockRouteClient.EXPECT().AddSNATRule(net.ParseIP(fakeLocalEgressIP1), uint32(1))
+	c.mockOFClient.EXPECT().InstallPodSNATGroupFlows(uint32(1), groupID, binding.ProtocolIP)
+	c.mockOFClient.EXPECT().InstallPodSNATGroupFlows(uint32(2), groupID, binding.ProtocolIP)
+	c.mockIPAssigner.EXPECT().UnassignIP(fakeLocalEgressIP1)
+	c.mockIPAssigner.EXPECT().UnassignIP(fakeRemoteEgressIP1)
+	assert.NoError(t, c.syncEgress(egress.Name))
+
+	// Only the Egress IP on this Node is reported by this Node.
+	gotEgress, err := c.crdClient.CrdV1beta1().Egresses().Get(context.TODO(), egress.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []crdv1b1.ActiveEgressIP{{EgressIP: fakeLocalEgressIP1, EgressNode: fakeNode}}, gotEgress.Status.ActiveEgressIPs)
+
+	// After deleting the Egress, its flows, group and Egress IPs should be removed.
+	c.mockOFClient.EXPECT().UninstallPodSNATFlows(uint32(1))
+	c.mockOFClient.EXPECT().UninstallPodSNATFlows(uint32(2))
+	c.mockOFClient.EXPECT().UninstallSNATMarkFlows(uint32(1))
+	c.mockRouteClient.EXPECT().DeleteSNATRule(uint32(1))
+	c.mockOFClient.EXPECT().UninstallEgressGroup(groupID)
+	c.mockIPAssigner.EXPECT().UnassignIP(fakeLocalEgressIP1)
+	c.mockIPAssigner.EXPECT().UnassignIP(fakeRemoteEgressIP1)
+	c.crdClient.CrdV1beta1().Egresses().Delete(context.TODO(), egress.Name, metav1.DeleteOptions{})
+	assert.Eventually(t, func() bool {
+		_, err := c.egressLister.Get(egress.Name)
+		return err != nil
+	}, time.Second, time.Millisecond*100)
+	assert.NoError(t, c.syncEgress(egress.Name))
+
+	assert.Len(t, c.egressBindings, 0)
+	assert.Len(t, c.egressStates, 0)
+	assert.Len(t, c.egressIPStates, 0)
+	assert.Equal(t, groupID, c.groupAllocator.Next())
+}
+
+func TestGetGroupIPs(t *testing.T) {
+	tests := []struct {
+		name             string
+		prevGroupIPs     []string
+		desiredEgressIPs []string
+		expectedGroupIPs []string
+	}{
+		{
+			name:             "new group",
+			desiredEgressIPs: []string{"1.1.1.1", "1.1.1.2"},
+			expectedGroupIPs: []string{"1.1.1.1", "1.1.1.2"},
+		},
+		{
+			name:             "remove IP",
+			prevGroupIPs:     []string{"1.1.1.1", "1.1.1.2", "1.1.1.3"},
+			desiredEgressIPs: []string{"1.1.1.1", "1.1.1.3"},
+			expectedGroupIPs: []string{"1.1.1.1", "", "1.1.1.3"},
+		},
+		{
+			name:             "remove last IPs",
+			prevGroupIPs:     []string{"1.1.1.1", "", "1.1.1.3"},
+			desiredEgressIPs: []string{"1.1.1.1"},
+			expectedGroupIPs: []string{"1.1.1.1"},
+		},
+		{
+			name:             "add IPs",
+			prevGroupIPs:     []string{"1.1.1.1", "", "1.1.1.3"},
+			desiredEgressIPs: []string{"1.1.1.1", "1.1.1.3", "1.1.1.4", "1.1.1.5"},
+			expectedGroupIPs: []string{"1.1.1.1", "1.1.1.4", "1.1.1.3", "1.1.1.5"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedGroupIPs, getGroupIPs(tt.prevGroupIPs, tt.desiredEgressIPs))
+		})
+	}
+}
+
+func TestSyncEgressWithDestinations(t *testing.T) {
+	egress1 := &crdv1b1.Egress{
+		ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+		Spec:       crdv1b1.EgressSpec{EgressIP: fakeLocalEgressIP1},
+	}
+	egressGroup1 := &cpv1b2.EgressGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+		GroupMembers: []cpv1b2.GroupMember{
+			{Pod: &cpv1b2.PodReference{Name: "pod1", Namespace: "ns1"}},
+			{Pod: &cpv1b2.PodReference{Name: "pod2", Namespace: "ns2"}},
+		},
+	}
+	// egress2 shares a Pod with egress1 but only applies to its destinations.
+	egress2 := &crdv1b1.Egress{
+		ObjectMeta: metav1.ObjectMeta{Name: "egressB", UID: "uidB"},
+		Spec: crdv1b1.EgressSpec{
+			EgressIP: fakeRemoteEgressIP1,
+			Destinations: []crdv1b1.EgressDestination{
+				{CIDR: "10.20.0.0/16"},
+				{CIDR: "fec0::/64"},
+				{FQDN: "www.example.com"},
+			},
+		},
+	}
+	egressGroup2 := &cpv1b2.EgressGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "egressB", UID: "uidB"},
+		GroupMembers: []cpv1b2.GroupMember{
+			{Pod: &cpv1b2.PodReference{Name: "pod1", Namespace: "ns1"}},
+			{Pod: &cpv1b2.PodReference{Name: "pod3", Namespace: "ns3"}},
+		},
+	}
+	c := newFakeController(t, []runtime.Object{egress1, egress2})
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	c.crdInformerFactory.Start(stopCh)
+	c.informerFactory.Start(stopCh)
+	c.crdInformerFactory.WaitForCacheSync(stopCh)
+	c.informerFactory.WaitForCacheSync(stopCh)
+	c.addEgressGroup(egressGroup1)
+	c.addEgressGroup(egressGroup2)
+	checkQueueItemExistence(t, c.queue, egress1.Name, egress2.Name)
+
+	c.mockOFClient.EXPECT().InstallSNATMarkFlows(net.ParseIP(fakeLocalEgressIP1), uint32(1))
+	c.mockOFClient.EXPECT().InstallPodSNATFlows(uint32(1), net.ParseIP(fakeLocalEgressIP1), uint32(1))
+	c.mockOFClient.EXPECT().InstallPodSNATFlows(uint32(2), net.ParseIP(fakeLocalEgressIP1), uint32(1))
+	c.mockRouteClient.EXPECT().AddSNATRule(net.ParseIP(fakeLocalEgressIP1), uint32(1))
+	c.mockIPAssigner.EXPECT().UnassignIP(fakeLocalEgressIP1)
+	assert.NoError(t, c.syncEgress(egress1.Name))
+
+	// The FQDN hasn't been resolved, only the IPv4 CIDR is expected. pod1 is expected to enforce egress2 for the
+	// destination though it has enforced egress1.
+	c.mockOFClient.EXPECT().InstallPodSNATDestinationFlows(egress2.Name, uint32(1), net.ParseIP(fakeRemoteEgressIP1), uint32(0), []*net.IPNet{ip.MustParseCIDR("10.20.0.0/16")})
+	c.mockOFClient.EXPECT().InstallPodSNATDestinationFlows(egress2.Name, uint32(3), net.ParseIP(fakeRemoteEgressIP1), uint32(0), []*net.IPNet{ip.MustParseCIDR("10.20.0.0/16")})
+	c.mockIPAssigner.EXPECT().UnassignIP(fakeRemoteEgressIP1)
+	assert.NoError(t, c.syncEgress(egress2.Name))
+	assert.Equal(t, "egressA", c.egressBindings["ns1/pod1"].effectiveEgress)
+	assert.Empty(t, c.egressBindings["ns1/pod1"].alternativeEgresses)
+	// The DNS responses received by the Pods of egress2 are expected to be intercepted.
+	assert.Equal(t, sets.New[int32](1, 3), c.fakeFQDNResolver.subscriberOFPorts["Egress/egressB"])
+
+	// After the FQDN is resolved, egress2 is expected to be triggered for resync and its flows updated.
+	c.fakeFQDNResolver.setFQDNIPs("www.example.com", net.ParseIP("172.16.1.1"))
+	checkQueueItemExistence(t, c.queue, egress2.Name)
+	expectedCIDRs := []*net.IPNet{ip.MustParseCIDR("10.20.0.0/16"), ip.MustParseCIDR("172.16.1.1/32")}
+	c.mockOFClient.EXPECT().InstallPodSNATDestinationFlows(egress2.Name, uint32(1), net.ParseIP(fakeRemoteEgressIP1), uint32(0), expectedCIDRs)
+	c.mockOFClient.EXPECT().InstallPodSNATDestinationFlows(egress2.Name, uint32(3), net.ParseIP(fakeRemoteEgressIP1), uint32(0), expectedCIDRs)
+	c.mockIPAssigner.EXPECT().UnassignIP(fakeRemoteEgressIP1)
+	assert.NoError(t, c.syncEgress(egress2.Name))
+
+	// After deleting egress2, its flows should be removed, and egress1 shouldn't be triggered for resync.
+	c.mockOFClient.EXPECT().UninstallPodSNATDestinationFlows(egress2.Name, uint32(1))
+	c.mockOFClient.EXPECT().UninstallPodSNATDestinationFlows(egress2.Name, uint32(3))
+	c.mockIPAssigner.EXPECT().UnassignIP(fakeRemoteEgressIP1)
+	c.crdClient.CrdV1beta1().Egresses().Delete(context.TODO(), egress2.Name, metav1.DeleteOptions{})
+	assert.Eventually(t, func() bool {
+		_, err := c.egressLister.Get(egress2.Name)
+		return err != nil
+	}, time.Second, time.Millisecond*100)
+	checkQueueItemExistence(t, c.queue, egress2.Name)
+	assert.NoError(t, c.syncEgress(egress2.Name))
+	require.Equal(t, 0, c.queue.Len())
+	assert.Empty(t, c.fakeFQDNResolver.subscribers)
+	assert.Len(t, c.egressStates, 1)
+}
+
+func TestSyncEgressWithOverlappingDestinations(t *testing.T) {
+	creationTime := time.Now()
+	egress1 := &crdv1b1.Egress{
+		ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA", CreationTimestamp: metav1.NewTime(creationTime)},
+		Spec: crdv1b1.EgressSpec{
+			EgressIP:     fakeLocalEgressIP1,
+			Destinations: []crdv1b1.EgressDestination{{CIDR: "10.20.0.0/16"}},
+		},
+	}
+	egressGroup1 := &cpv1b2.EgressGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+		GroupMembers: []cpv1b2.GroupMember{
+			{Pod: &cpv1b2.PodReference{Name: "pod1", Namespace: "ns1"}},
+		},
+	}
+	// egress2 is created later than egress1 and its destinations overlap with egress1's.
+	egress2 := &crdv1b1.Egress{
+		ObjectMeta: metav1.ObjectMeta{Name: "egressB", UID: "uidB", CreationTimestamp: metav1.NewTime(creationTime.Add(time.Second))},
+		Spec: crdv1b1.EgressSpec{
+			EgressIP:     fakeRemoteEgressIP1,
+			Destinations: []crdv1b1.EgressDestination{{CIDR: "10.20.1.0/24"}},
+		},
+	}
+	egressGroup2 := &cpv1b2.EgressGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "egressB", UID: "uidB"},
+		GroupMembers: []cpv1b2.GroupMember{
+			{Pod: &cpv1b2.PodReference{Name: "pod1", Namespace: "ns1"}},
+			{Pod: &cpv1b2.PodReference{Name: "pod2", Namespace: "ns2"}},
+		},
+	}
+	c := newFakeController(t, []runtime.Object{egress1, egress2})
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	c.crdInformerFactory.Start(stopCh)
+	c.informerFactory.Start(stopCh)
+	c.crdInformerFactory.WaitForCacheSync(stopCh)
+	c.informerFactory.WaitForCacheSync(stopCh)
+	c.addEgressGroup(egressGroup1)
+	c.addEgressGroup(egressGroup2)
+	checkQueueItemExistence(t, c.queue, egress1.Name, egress2.Name)
+
+	// egress2 is synced first, pod1 is not overridden as egress1 is unknown yet.
+	dstCIDRs2 := []*net.IPNet{ip.MustParseCIDR("10.20.1.0/24")}
+	c.mockOFClient.EXPECT().InstallPodSNATDestinationFlows(egress2.Name, uint32(1), net.ParseIP(fakeRemoteEgressIP1), uint32(0), dstCIDRs2)
+	c.mockOFClient.EXPECT().InstallPodSNATDestinationFlows(egress2.Name, uint32(2), net.ParseIP(fakeRemoteEgressIP1), uint32(0), dstCIDRs2)
+	c.mockIPAssigner.EXPECT().UnassignIP(fakeRemoteEgressIP1)
+	assert.NoError(t, c.syncEgress(egress2.Name))
+
+	// Syncing egress1 is expected to trigger resyncing egress2, which removes the flows of pod1.
+	dstCIDRs1 := []*net.IPNet{ip.MustParseCIDR("10.20.0.0/16")}
+	c.mockOFClient.EXPECT().InstallSNATMarkFlows(net.ParseIP(fakeLocalEgressIP1), uint32(1))
+	c.mockRouteClient.EXPECT().AddSNATRule(net.ParseIP(fakeLocalEgressIP1), uint32(1))
+	c.mockOFClient.EXPECT().InstallPodSNATDestinationFlows(egress1.Name, uint32(1), net.ParseIP(fakeLocalEgressIP1), uint32(1), dstCIDRs1)
+	c.mockIPAssigner.EXPECT().UnassignIP(fakeLocalEgressIP1)
+	assert.NoError(t, c.syncEgress(egress1.Name))
+	checkQueueItemExistence(t, c.queue, egress2.Name)
+	c.mockOFClient.EXPECT().UninstallPodSNATDestinationFlows(egress2.Name, uint32(1))
+	c.mockIPAssigner.EXPECT().UnassignIP(fakeRemoteEgressIP1)
+	assert.NoError(t, c.syncEgress(egress2.Name))
+	assert.Equal(t, sets.New[int32](2), c.egressStates[egress2.Name].ofPorts)
+
+	// After deleting egress1, egress2 is expected to apply to pod1 again.
+	c.mockOFClient.EXPECT().UninstallPodSNATDestinationFlows(egress1.Name, uint32(1))
+	c.mockOFClient.EXPECT().UninstallSNATMarkFlows(uint32(1))
+	c.mockRouteClient.EXPECT().DeleteSNATRule(uint32(1))
+	c.mockIPAssigner.EXPECT().UnassignIP(fakeLocalEgressIP1)
+	c.crdClient.CrdV1beta1().Egresses().Delete(context.TODO(), egress1.Name, metav1.DeleteOptions{})
+	assert.Eventually(t, func() bool {
+		_, err := c.egressLister.Get(egress1.Name)
+		return err != nil
+	}, time.Second, time.Millisecond*100)
+	checkQueueItemExistence(t, c.queue, egress1.Name)
+	assert.NoError(t, c.syncEgress(egress1.Name))
+	checkQueueItemExistence(t, c.queue, egress2.Name)
+	c.mockOFClient.EXPECT().InstallPodSNATDestinationFlows(egress2.Name, uint32(1), net.ParseIP(fakeRemoteEgressIP1), uint32(0), dstCIDRs2)
+	c.mockIPAssigner.EXPECT().UnassignIP(fakeRemoteEgressIP1)
+	assert.NoError(t, c.syncEgress(egress2.Name))
+	assert.Equal(t, sets.New[int32](1, 2), c.egressStates[egress2.Name].ofPorts)
+}
+
 func addPodInterface(ifaceStore interfacestore.InterfaceStore, podNamespace, podName string, ofPort int32) {
 	containerName := k8s.NamespacedName(podNamespace, podName)
 	ifaceStore.AddInterface(&interfacestore.InterfaceConfig{
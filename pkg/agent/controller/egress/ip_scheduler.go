@@ -15,15 +15,22 @@
 package egress
 
 import (
+	"cmp"
+	"maps"
+	"math"
+	"reflect"
+	"slices"
 	"sort"
 	"strconv"
 	"sync"
 	"sync/atomic"
 
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
 	corev1informers "k8s.io/client-go/informers/core/v1"
+	corev1listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
@@ -47,17 +54,23 @@ type scheduleEventHandler func(egress string)
 type scheduleResult struct {
 	ip   string
 	node string
-	err  error
+	// ipToNode is the effective Egress IPs and their Nodes of an ActiveActive Egress.
+	ipToNode map[string]string
+	err      error
 }
 
-// egressIPScheduler is responsible for scheduling Egress IPs to appropriate Nodes according to the Node selector of the
-// IP pool, taking Node's capacity into consideration.
+// egressIPScheduler is responsible for scheduling Egress IPs to appropriate Nodes according to the Node selector and
+// the scheduling constraints of the IP pool, taking Node's capacity into consideration.
 type egressIPScheduler struct {
 	// cluster is responsible for selecting a Node for a given IP and pool.
 	cluster memberlist.Interface
 
-	egressLister       crdlisters.EgressLister
-	egressListerSynced cache.InformerSynced
+	egressLister               crdlisters.EgressLister
+	egressListerSynced         cache.InformerSynced
+	externalIPPoolLister       crdlisters.ExternalIPPoolLister
+	externalIPPoolListerSynced cache.InformerSynced
+	nodeLister                 corev1listers.NodeLister
+	nodeListerSynced           cache.InformerSynced
 
 	// queue is used to trigger scheduling. Triggering multiple times before the item is consumed will only cause one
 	// execution of scheduling.
@@ -80,16 +93,20 @@ type egressIPScheduler struct {
 	nodeToMaxEgressIPsMutex sync.RWMutex
 }
 
-func NewEgressIPScheduler(cluster memberlist.Interface, egressInformer crdinformers.EgressInformer, nodeInformer corev1informers.NodeInformer, maxEgressIPsPerNode int) *egressIPScheduler {
+func NewEgressIPScheduler(cluster memberlist.Interface, egressInformer crdinformers.EgressInformer, externalIPPoolInformer crdinformers.ExternalIPPoolInformer, nodeInformer corev1informers.NodeInformer, maxEgressIPsPerNode int) *egressIPScheduler {
 	s := &egressIPScheduler{
-		cluster:             cluster,
-		egressLister:        egressInformer.Lister(),
-		egressListerSynced:  egressInformer.Informer().HasSynced,
-		scheduleResults:     map[string]*scheduleResult{},
-		scheduledOnce:       &atomic.Bool{},
-		maxEgressIPsPerNode: maxEgressIPsPerNode,
-		nodeToMaxEgressIPs:  map[string]int{},
-		queue:               workqueue.NewTyped[string](),
+		cluster:                    cluster,
+		egressLister:               egressInformer.Lister(),
+		egressListerSynced:         egressInformer.Informer().HasSynced,
+		externalIPPoolLister:       externalIPPoolInformer.Lister(),
+		externalIPPoolListerSynced: externalIPPoolInformer.Informer().HasSynced,
+		nodeLister:                 nodeInformer.Lister(),
+		nodeListerSynced:           nodeInformer.Informer().HasSynced,
+		scheduleResults:            map[string]*scheduleResult{},
+		scheduledOnce:              &atomic.Bool{},
+		maxEgressIPsPerNode:        maxEgressIPsPerNode,
+		nodeToMaxEgressIPs:         map[string]int{},
+		queue:                      workqueue.NewTyped[string](),
 	}
 	egressInformer.Informer().AddEventHandlerWithResyncPeriod(
 		cache.ResourceEventHandlerFuncs{
@@ -102,13 +119,23 @@ func NewEgressIPScheduler(cluster memberlist.Interface, egressInformer crdinform
 	nodeInformer.Informer().AddEventHandlerWithResyncPeriod(
 		cache.ResourceEventHandlerFuncs{
 			AddFunc: s.updateNode,
-			UpdateFunc: func(_, newObj interface{}) {
+			UpdateFunc: func(oldObj, newObj interface{}) {
 				s.updateNode(newObj)
+				if s.schedulingConstraintsChanged(oldObj.(*corev1.Node), newObj.(*corev1.Node)) {
+					s.queue.Add(workItem)
+				}
 			},
 			DeleteFunc: s.deleteNode,
 		},
 		resyncPeriod,
 	)
+	// The addition and deletion of ExternalIPPools are handled by the cluster event handler.
+	externalIPPoolInformer.Informer().AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			UpdateFunc: s.updateExternalIPPool,
+		},
+		resyncPeriod,
+	)
 
 	s.cluster.AddClusterEventHandler(func(poolName string) {
 		// Trigger scheduling regardless of which pool is changed.
@@ -151,6 +178,22 @@ func (s *egressIPScheduler) updateNode(obj interface{}) {
 	}
 }
 
+// schedulingConstraintsChanged returns whether a Node update affects the scheduling constraints of ExternalIPPools.
+// The labels of Nodes are used by all the constraints, while the Egress traffic load of Nodes is only used by the
+// ExternalIPPools preferring low traffic, so that a load change doesn't reschedule the Egresses when there is none.
+func (s *egressIPScheduler) schedulingConstraintsChanged(oldNode, curNode *corev1.Node) bool {
+	if !reflect.DeepEqual(oldNode.Labels, curNode.Labels) {
+		return true
+	}
+	if oldNode.Annotations[types.NodeEgressTrafficLoadAnnotationKey] == curNode.Annotations[types.NodeEgressTrafficLoadAnnotationKey] {
+		return false
+	}
+	pools, _ := s.externalIPPoolLister.List(labels.Everything())
+	return slices.ContainsFunc(pools, func(pool *crdv1b1.ExternalIPPool) bool {
+		return pool.Spec.Scheduling != nil && pool.Spec.Scheduling.PreferLowTraffic
+	})
+}
+
 // deleteNode processes Node DELETE events.
 func (s *egressIPScheduler) deleteNode(obj interface{}) {
 	node, ok := obj.(*corev1.Node)
@@ -169,6 +212,17 @@ func (s *egressIPScheduler) deleteNode(obj interface{}) {
 	s.deleteMaxEgressIPsByNode(node.Name)
 }
 
+// updateExternalIPPool processes ExternalIPPool UPDATE events.
+func (s *egressIPScheduler) updateExternalIPPool(old, cur interface{}) {
+	oldPool := old.(*crdv1b1.ExternalIPPool)
+	curPool := cur.(*crdv1b1.ExternalIPPool)
+	if reflect.DeepEqual(oldPool.Spec.Scheduling, curPool.Spec.Scheduling) {
+		return
+	}
+	s.queue.Add(workItem)
+	klog.V(2).InfoS("ExternalIPPool UPDATE event triggered Egress IP scheduling", "externalIPPool", klog.KObj(curPool))
+}
+
 // addEgress processes Egress ADD events.
 func (s *egressIPScheduler) addEgress(obj interface{}) {
 	egress := obj.(*crdv1b1.Egress)
@@ -186,7 +240,8 @@ func (s *egressIPScheduler) updateEgress(old, cur interface{}) {
 	if !isEgressSchedulable(oldEgress) && !isEgressSchedulable(curEgress) {
 		return
 	}
-	if oldEgress.Spec.EgressIP == curEgress.Spec.EgressIP && oldEgress.Spec.ExternalIPPool == curEgress.Spec.ExternalIPPool {
+	if oldEgress.Spec.EgressIP == curEgress.Spec.EgressIP && oldEgress.Spec.ExternalIPPool == curEgress.Spec.ExternalIPPool &&
+		oldEgress.Spec.Mode == curEgress.Spec.Mode && slices.Equal(oldEgress.Spec.EgressIPs, curEgress.Spec.EgressIPs) {
 		return
 	}
 	s.queue.Add(workItem)
@@ -220,7 +275,7 @@ func (s *egressIPScheduler) Run(stopCh <-chan struct{}) {
 	defer klog.InfoS("Shutting down Egress IP scheduler")
 	defer s.queue.ShutDown()
 
-	if !cache.WaitForCacheSync(stopCh, s.egressListerSynced) {
+	if !cache.WaitForCacheSync(stopCh, s.egressListerSynced, s.externalIPPoolListerSynced, s.nodeListerSynced) {
 		return
 	}
 
@@ -263,6 +318,21 @@ func (s *egressIPScheduler) GetEgressIPAndNode(egress string) (string, string, e
 	return result.ip, result.node, nil, true
 }
 
+// GetEgressIPsAndNodes returns the effective Egress IPs and their Nodes of an ActiveActive Egress.
+func (s *egressIPScheduler) GetEgressIPsAndNodes(egress string) (map[string]string, error, bool) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	result, exists := s.scheduleResults[egress]
+	if !exists {
+		return nil, nil, false
+	}
+	if result.err != nil {
+		return nil, result.err, false
+	}
+	return maps.Clone(result.ipToNode), nil, true
+}
+
 // EgressesByCreationTimestamp sorts a list of Egresses by creation timestamp.
 type EgressesByCreationTimestamp []*crdv1b1.Egress
 
@@ -321,9 +391,10 @@ func (s *egressIPScheduler) getMaxEgressIPsByNode(nodeName string) int {
 }
 
 // schedule takes the spec of Egress and ExternalIPPool and the state of memberlist cluster as inputs, generates
-// scheduling results deterministically. When every Node's capacity is sufficient, each Egress's schedule is independent
-// and is only determined by the consistent hash map. When any Node's capacity is insufficient, one Egress's schedule
-// may be affected by Egresses created before it. It will be triggerred when any schedulable Egress changes or the state
+// scheduling results deterministically. When every Node's capacity is sufficient and the ExternalIPPool has no
+// scheduling constraints, each Egress's schedule is independent and is only determined by the consistent hash map.
+// When any Node's capacity is insufficient or the ExternalIPPool spreads IPs across topology domains, one Egress's
+// schedule may be affected by Egresses created before it. It will be triggerred when any schedulable Egress changes or the state
 // of memberlist cluster changes, and will notify Egress schedule event subscribers of Egresses that are rescheduled.
 //
 // Note that it's possible that different agents decide different IP - Node assignment because their caches of Egress or
@@ -331,8 +402,59 @@ func (s *egressIPScheduler) getMaxEgressIPsByNode(nodeName string) int {
 // and correct IP assignment when their caches converge.
 func (s *egressIPScheduler) schedule() {
 	var egressesToUpdate []string
+	newResults := s.computeScheduleResults("")
+	for egress, result := range newResults {
+		if result.err == nil {
+			continue
+		}
+		if result.err == memberlist.ErrNoNodeAvailable {
+			klog.InfoS("No Node is eligible for Egress", "egress", klog.KRef("", egress))
+		} else {
+			klog.ErrorS(result.err, "Failed to select Node for Egress", "egress", klog.KRef("", egress))
+		}
+	}
+
+	func() {
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+
+		// Identify Egresses whose schedule results are updated.
+		prevResults := s.scheduleResults
+		for egress, result := range newResults {
+			prevResult, exists := prevResults[egress]
+			if !exists || prevResult.ip != result.ip || prevResult.node != result.node || prevResult.err != result.err ||
+				!maps.Equal(prevResult.ipToNode, result.ipToNode) {
+				egressesToUpdate = append(egressesToUpdate, egress)
+			}
+			delete(prevResults, egress)
+		}
+		for egress := range prevResults {
+			egressesToUpdate = append(egressesToUpdate, egress)
+		}
+
+		// Record the new results.
+		s.scheduleResults = newResults
+	}()
+
+	for _, egress := range egressesToUpdate {
+		for _, handler := range s.eventHandlers {
+			handler(egress)
+		}
+	}
+
+	s.scheduledOnce.Store(true)
+}
+
+// computeScheduleResults schedules all schedulable Egresses and returns their results, without recording them. If
+// excludedNode is not empty, the Node is not selected for any Egress IP, which predicts the results after the Node
+// fails.
+func (s *egressIPScheduler) computeScheduleResults(excludedNode string) map[string]*scheduleResult {
 	newResults := map[string]*scheduleResult{}
 	nodeToIPs := map[string]sets.Set[string]{}
+	// poolToDomainIPs tracks the IPs assigned to each topology domain of ExternalIPPools spreading IPs across topology
+	// domains.
+	poolToDomainIPs := map[string]map[string]sets.Set[string]{}
+	ipToNode := map[string]string{}
 	egresses, _ := s.egressLister.List(labels.Everything())
 	// Sort Egresses by creation timestamp to make the result deterministic and prioritize objected created earlier
 	// when the total capacity is insufficient.
@@ -343,67 +465,300 @@ func (s *egressIPScheduler) schedule() {
 			continue
 		}
 
-		maxEgressIPsFilter := func(node string) bool {
-			// Count the Egress IPs that are already assigned to this Node.
-			ipsOnNode, _ := nodeToIPs[node]
-			numIPs := ipsOnNode.Len()
-			// Check if this Node can accommodate the new Egress IP.
-			if !ipsOnNode.Has(egress.Spec.EgressIP) {
-				numIPs += 1
+		pool, _ := s.externalIPPoolLister.Get(egress.Spec.ExternalIPPool)
+		// scheduleIP selects a Node for an IP of the Egress and records the assignment.
+		scheduleIP := func(ip string, filters ...func(string) bool) (string, error) {
+			maxEgressIPsFilter := func(node string) bool {
+				if node == excludedNode {
+					return false
+				}
+				// Count the Egress IPs that are already assigned to this Node.
+				ipsOnNode, _ := nodeToIPs[node]
+				numIPs := ipsOnNode.Len()
+				// Check if this Node can accommodate the new Egress IP.
+				if !ipsOnNode.Has(ip) {
+					numIPs += 1
+				}
+				if numIPs > s.getMaxEgressIPsByNode(node) {
+					return false
+				}
+				for _, filter := range filters {
+					if !filter(node) {
+						return false
+					}
+				}
+				return true
 			}
-			return numIPs <= s.getMaxEgressIPsByNode(node)
-		}
-		node, err := s.cluster.SelectNodeForIP(egress.Spec.EgressIP, egress.Spec.ExternalIPPool, maxEgressIPsFilter)
-		if err != nil {
-			if err == memberlist.ErrNoNodeAvailable {
-				klog.InfoS("No Node is eligible for Egress", "egress", klog.KObj(egress))
+			var node string
+			var err error
+			if pool == nil || pool.Spec.Scheduling == nil {
+				node, err = s.cluster.SelectNodeForIP(ip, egress.Spec.ExternalIPPool, maxEgressIPsFilter)
+			} else if scheduledNode, exists := ipToNode[ip]; exists {
+				// Egresses sharing the same IP must be scheduled to the same Node.
+				node = scheduledNode
 			} else {
-				klog.ErrorS(err, "Failed to select Node for Egress", "egress", klog.KObj(egress))
+				domainIPs, exists := poolToDomainIPs[pool.Name]
+				if !exists {
+					domainIPs = map[string]sets.Set[string]{}
+					poolToDomainIPs[pool.Name] = domainIPs
+				}
+				node, err = s.selectNodeWithConstraints(ip, pool, domainIPs, nodeToIPs, maxEgressIPsFilter)
+			}
+			if err != nil {
+				return "", err
+			}
+
+			ips, exists := nodeToIPs[node]
+			if !exists {
+				ips = sets.New[string]()
+				nodeToIPs[node] = ips
+			}
+			ips.Insert(ip)
+			ipToNode[ip] = node
+
+			if pool != nil && pool.Spec.Scheduling != nil && pool.Spec.Scheduling.TopologySpread != nil {
+				if nodeObj, err := s.nodeLister.Get(node); err == nil {
+					domain := nodeObj.Labels[pool.Spec.Scheduling.TopologySpread.TopologyKey]
+					domainIPs := poolToDomainIPs[pool.Name]
+					if domainIPs[domain] == nil {
+						domainIPs[domain] = sets.New[string]()
+					}
+					domainIPs[domain].Insert(ip)
+				}
 			}
+			return node, nil
+		}
+
+		var result *scheduleResult
+		var err error
+		if isEgressActiveActive(egress) {
+			result, err = s.scheduleActiveActiveEgress(egress, ipToNode, scheduleIP)
+		} else {
+			var node string
+			node, err = scheduleIP(egress.Spec.EgressIP)
+			result = &scheduleResult{
+				ip:   egress.Spec.EgressIP,
+				node: node,
+			}
+		}
+		if err != nil {
 			// Store error in its result to differentiate scheduling error from unprocessed case.
 			newResults[egress.Name] = &scheduleResult{err: err}
 			continue
 		}
-		result := &scheduleResult{
-			ip:   egress.Spec.EgressIP,
-			node: node,
-		}
 		newResults[egress.Name] = result
+	}
+
+	return newResults
+}
 
-		ips, exists := nodeToIPs[node]
+// GetStandbyNodes returns the Egress IPs scheduled to the Node, mapped to the Nodes they would be scheduled to if the
+// Node failed. The Egress IPs which couldn't be scheduled to any other Node are not included.
+func (s *egressIPScheduler) GetStandbyNodes(node string) map[string]string {
+	localIPs := sets.New[string]()
+	func() {
+		s.mutex.RLock()
+		defer s.mutex.RUnlock()
+		for _, result := range s.scheduleResults {
+			if result.err != nil {
+				continue
+			}
+			if result.node == node {
+				localIPs.Insert(result.ip)
+			}
+			for ip, ipNode := range result.ipToNode {
+				if ipNode == node {
+					localIPs.Insert(ip)
+				}
+			}
+		}
+	}()
+	if localIPs.Len() == 0 {
+		return nil
+	}
+	ipToStandbyNode := map[string]string{}
+	for _, result := range s.computeScheduleResults(node) {
+		if result.err != nil {
+			continue
+		}
+		if localIPs.Has(result.ip) {
+			ipToStandbyNode[result.ip] = result.node
+		}
+		for ip, ipNode := range result.ipToNode {
+			if localIPs.Has(ip) {
+				ipToStandbyNode[ip] = ipNode
+			}
+		}
+	}
+	return ipToStandbyNode
+}
+
+// scheduleActiveActiveEgress schedules each Egress IP of an ActiveActive Egress with scheduleIP. The Egress IPs are
+// spread across Nodes when possible, so that the traffic of the Egress is distributed across as many Nodes as possible.
+// An Egress IP that can't be scheduled is left out of the result, and an error is returned only when none of the Egress
+// IPs can be scheduled.
+func (s *egressIPScheduler) scheduleActiveActiveEgress(egress *crdv1b1.Egress, ipToNode map[string]string, scheduleIP func(string, ...func(string) bool) (string, error)) (*scheduleResult, error) {
+	result := &scheduleResult{ipToNode: map[string]string{}}
+	nodes := sets.New[string]()
+	var err error
+	for _, ip := range egress.Spec.EgressIPs {
+		node, exists := ipToNode[ip]
 		if !exists {
-			ips = sets.New[string]()
-			nodeToIPs[node] = ips
+			// Prefer the Nodes which don't have other Egress IPs of the Egress.
+			node, err = scheduleIP(ip, func(node string) bool {
+				return !nodes.Has(node)
+			})
+			if err == memberlist.ErrNoNodeAvailable {
+				node, err = scheduleIP(ip)
+			}
+			if err != nil {
+				klog.InfoS("Failed to select Node for Egress IP", "egress", klog.KObj(egress), "ip", ip, "err", err)
+				continue
+			}
 		}
-		ips.Insert(egress.Spec.EgressIP)
+		result.ipToNode[ip] = node
+		nodes.Insert(node)
 	}
+	if len(result.ipToNode) == 0 {
+		if err == nil {
+			err = memberlist.ErrNoNodeAvailable
+		}
+		return nil, err
+	}
+	return result, nil
+}
 
-	func() {
-		s.mutex.Lock()
-		defer s.mutex.Unlock()
+// selectNodeWithConstraints selects a Node for the IP among the Nodes selected by the ExternalIPPool, taking the
+// scheduling constraints of the ExternalIPPool into consideration. The eligible Nodes are grouped by preference, and
+// the consistent hash of the IP selects a Node from the most preferred group having a Node available, so that the IP
+// doesn't move when a Node of the same or a less preferred group joins or leaves. nodeToIPs are the IPs already
+// assigned to each Node in this scheduling pass.
+func (s *egressIPScheduler) selectNodeWithConstraints(ip string, pool *crdv1b1.ExternalIPPool, domainIPs map[string]sets.Set[string], nodeToIPs map[string]sets.Set[string], filter func(string) bool) (string, error) {
+	nodeSelector, err := metav1.LabelSelectorAsSelector(&pool.Spec.NodeSelector)
+	if err != nil {
+		return "", err
+	}
+	nodes, err := s.nodeLister.List(nodeSelector)
+	if err != nil {
+		return "", err
+	}
+	aliveNodes := s.cluster.AliveNodes()
+	var candidates []*corev1.Node
+	for _, node := range nodes {
+		if aliveNodes.Has(node.Name) && filter(node.Name) {
+			candidates = append(candidates, node)
+		}
+	}
+	if spread := pool.Spec.Scheduling.TopologySpread; spread != nil {
+		candidates = filterNodesByTopologySpread(candidates, spread, domainIPs)
+	}
+	for _, group := range groupNodesByPreference(candidates, pool.Spec.Scheduling, nodeToIPs) {
+		node, err := s.cluster.SelectNodeForIP(ip, pool.Name, filter, func(node string) bool {
+			return group.Has(node)
+		})
+		if err == nil {
+			return node, nil
+		}
+		if err != memberlist.ErrNoNodeAvailable {
+			return "", err
+		}
+	}
+	return "", memberlist.ErrNoNodeAvailable
+}
 
-		// Identify Egresses whose schedule results are updated.
-		prevResults := s.scheduleResults
-		for egress, result := range newResults {
-			prevResult, exists := prevResults[egress]
-			if !exists || prevResult.ip != result.ip || prevResult.node != result.node || prevResult.err != result.err {
-				egressesToUpdate = append(egressesToUpdate, egress)
-			}
-			delete(prevResults, egress)
+// filterNodesByTopologySpread returns the Nodes in the topology domains which can accommodate one more IP without
+// exceeding the max skew. Only the topology domains having Nodes available are taken into account, and Nodes without
+// the topology label are filtered out.
+func filterNodesByTopologySpread(nodes []*corev1.Node, spread *crdv1b1.TopologySpread, domainIPs map[string]sets.Set[string]) []*corev1.Node {
+	maxSkew := 1
+	if spread.MaxSkew > 0 {
+		maxSkew = int(spread.MaxSkew)
+	}
+	domainToNodes := map[string][]*corev1.Node{}
+	for _, node := range nodes {
+		domain, exists := node.Labels[spread.TopologyKey]
+		if !exists {
+			continue
 		}
-		for egress := range prevResults {
-			egressesToUpdate = append(egressesToUpdate, egress)
+		domainToNodes[domain] = append(domainToNodes[domain], node)
+	}
+	minIPs := math.MaxInt
+	for domain := range domainToNodes {
+		minIPs = min(minIPs, domainIPs[domain].Len())
+	}
+	var filtered []*corev1.Node
+	for domain, domainNodes := range domainToNodes {
+		if domainIPs[domain].Len()+1-minIPs <= maxSkew {
+			filtered = append(filtered, domainNodes...)
 		}
+	}
+	return filtered
+}
 
-		// Record the new results.
-		s.scheduleResults = newResults
-	}()
+// nodePreference is the preference of a Node, determined by the NodeAffinity weights and the Egress traffic load.
+type nodePreference struct {
+	weight int64
+	load   uint64
+}
 
-	for _, egress := range egressesToUpdate {
-		for _, handler := range s.eventHandlers {
-			handler(egress)
+// groupNodesByPreference groups the Nodes by their preference, the most preferred group first. With PreferLowTraffic,
+// each IP already assigned to a Node in nodeToIPs adds one to its load, so that the IPs are spread across the Nodes
+// with a similar load instead of all being assigned to the Node with the lowest load.
+func groupNodesByPreference(nodes []*corev1.Node, scheduling *crdv1b1.ExternalIPPoolScheduling, nodeToIPs map[string]sets.Set[string]) []sets.Set[string] {
+	affinitySelectors := make([]labels.Selector, len(scheduling.NodeAffinity))
+	for i := range scheduling.NodeAffinity {
+		selector, err := metav1.LabelSelectorAsSelector(&scheduling.NodeAffinity[i].NodeSelector)
+		if err != nil {
+			klog.ErrorS(err, "Invalid NodeSelector in NodeAffinity of ExternalIPPool, ignoring it")
+			selector = labels.Nothing()
+		}
+		affinitySelectors[i] = selector
+	}
+	groups := map[nodePreference]sets.Set[string]{}
+	for _, node := range nodes {
+		var preference nodePreference
+		for i, selector := range affinitySelectors {
+			if selector.Matches(labels.Set(node.Labels)) {
+				preference.weight += int64(scheduling.NodeAffinity[i].Weight)
+			}
+		}
+		if scheduling.PreferLowTraffic {
+			preference.load = getEgressTrafficLoad(node) + uint64(nodeToIPs[node.Name].Len())
+		}
+		if groups[preference] == nil {
+			groups[preference] = sets.New[string]()
+		}
+		groups[preference].Insert(node.Name)
+	}
+	preferences := make([]nodePreference, 0, len(groups))
+	for preference := range groups {
+		preferences = append(preferences, preference)
+	}
+	// Higher weight first, then lower load first.
+	slices.SortFunc(preferences, func(a, b nodePreference) int {
+		if a.weight != b.weight {
+			return cmp.Compare(b.weight, a.weight)
 		}
+		return cmp.Compare(a.load, b.load)
+	})
+	result := make([]sets.Set[string], 0, len(preferences))
+	for _, preference := range preferences {
+		result = append(result, groups[preference])
 	}
+	return result
+}
 
-	s.scheduledOnce.Store(true)
+// getEgressTrafficLoad gets the Egress traffic load of a Node from its annotation. A Node without the annotation or
+// with an invalid annotation has a load of 0.
+func getEgressTrafficLoad(node *corev1.Node) uint64 {
+	loadStr, exists := node.Annotations[types.NodeEgressTrafficLoadAnnotationKey]
+	if !exists {
+		return 0
+	}
+	load, err := strconv.ParseUint(loadStr, 10, 64)
+	if err != nil {
+		klog.V(2).InfoS("The Node's egress-traffic-load annotation was invalid", "node", node.Name, "value", loadStr)
+		return 0
+	}
+	return load
 }
@@ -57,6 +57,7 @@ func newFakeMemberlistCluster(nodes []string) *fakeMemberlistCluster {
 func (f *fakeMemberlistCluster) updateNodes(nodes []string) {
 	hashMap := memberlist.NewNodeConsistentHashMap()
 	hashMap.Add(nodes...)
+	f.nodes = nodes
 	f.hashMap = hashMap
 	for _, h := range f.eventHandlers {
 		h("dummy")
@@ -193,11 +194,12 @@ func TestSchedule(t *testing.T) {
 			crdClient := fakeversioned.NewSimpleClientset(egresses...)
 			crdInformerFactory := crdinformers.NewSharedInformerFactory(crdClient, 0)
 			egressInformer := crdInformerFactory.Crd().V1beta1().Egresses()
+			externalIPPoolInformer := crdInformerFactory.Crd().V1beta1().ExternalIPPools()
 			clientset := fake.NewSimpleClientset()
 			informerFactory := informers.NewSharedInformerFactory(clientset, 0)
 			nodeInformer := informerFactory.Core().V1().Nodes()
 
-			s := NewEgressIPScheduler(fakeCluster, egressInformer, nodeInformer, tt.maxEgressIPsPerNode)
+			s := NewEgressIPScheduler(fakeCluster, egressInformer, externalIPPoolInformer, nodeInformer, tt.maxEgressIPsPerNode)
 			s.nodeToMaxEgressIPs = tt.nodeToMaxEgressIPs
 			stopCh := make(chan struct{})
 			defer close(stopCh)
@@ -212,6 +214,467 @@ func TestSchedule(t *testing.T) {
 	}
 }
 
+func TestScheduleActiveActive(t *testing.T) {
+	egresses := []runtime.Object{
+		&crdv1b1.Egress{
+			ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA", CreationTimestamp: metav1.NewTime(time.Unix(1, 0))},
+			Spec:       crdv1b1.EgressSpec{EgressIP: "1.1.1.1", ExternalIPPool: "pool1"},
+		},
+		&crdv1b1.Egress{
+			ObjectMeta: metav1.ObjectMeta{Name: "egressB", UID: "uidB", CreationTimestamp: metav1.NewTime(time.Unix(2, 0))},
+			Spec: crdv1b1.EgressSpec{
+				Mode:           crdv1b1.EgressModeActiveActive,
+				EgressIPs:      []string{"1.1.1.11", "1.1.1.21", "1.1.1.31"},
+				ExternalIPPool: "pool1",
+			},
+		},
+	}
+	tests := []struct {
+		name                string
+		nodes               []string
+		maxEgressIPsPerNode int
+		expectedResults     map[string]*scheduleResult
+	}{
+		{
+			name:                "sufficient capacity",
+			nodes:               []string{"node1", "node2", "node3"},
+			maxEgressIPsPerNode: 3,
+			// The Egress IPs of egressB are spread across Nodes.
+			expectedResults: map[string]*scheduleResult{
+				"egressA": {
+					node: "node1",
+					ip:   "1.1.1.1",
+				},
+				"egressB": {
+					ipToNode: map[string]string{
+						"1.1.1.11": "node3",
+						"1.1.1.21": "node1",
+						"1.1.1.31": "node2",
+					},
+				},
+			},
+		},
+		{
+			name:                "more Egress IPs than Nodes",
+			nodes:               []string{"node1", "node2"},
+			maxEgressIPsPerNode: 3,
+			expectedResults: map[string]*scheduleResult{
+				"egressA": {
+					node: "node1",
+					ip:   "1.1.1.1",
+				},
+				"egressB": {
+					ipToNode: map[string]string{
+						"1.1.1.11": "node2",
+						"1.1.1.21": "node1",
+						"1.1.1.31": "node1",
+					},
+				},
+			},
+		},
+		{
+			name:                "insufficient cluster capacity",
+			nodes:               []string{"node1", "node2"},
+			maxEgressIPsPerNode: 1,
+			// Only one Egress IP of egressB was scheduled due to insufficient capacity.
+			expectedResults: map[string]*scheduleResult{
+				"egressA": {
+					node: "node1",
+					ip:   "1.1.1.1",
+				},
+				"egressB": {
+					ipToNode: map[string]string{
+						"1.1.1.11": "node2",
+					},
+				},
+			},
+		},
+		{
+			name:                "no capacity",
+			nodes:               []string{"node1"},
+			maxEgressIPsPerNode: 1,
+			expectedResults: map[string]*scheduleResult{
+				"egressA": {
+					node: "node1",
+					ip:   "1.1.1.1",
+				},
+				"egressB": {
+					err: memberlist.ErrNoNodeAvailable,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeCluster := newFakeMemberlistCluster(tt.nodes)
+			crdClient := fakeversioned.NewSimpleClientset(egresses...)
+			crdInformerFactory := crdinformers.NewSharedInformerFactory(crdClient, 0)
+			egressInformer := crdInformerFactory.Crd().V1beta1().Egresses()
+			externalIPPoolInformer := crdInformerFactory.Crd().V1beta1().ExternalIPPools()
+			clientset := fake.NewSimpleClientset()
+			informerFactory := informers.NewSharedInformerFactory(clientset, 0)
+			nodeInformer := informerFactory.Core().V1().Nodes()
+
+			s := NewEgressIPScheduler(fakeCluster, egressInformer, externalIPPoolInformer, nodeInformer, tt.maxEgressIPsPerNode)
+			stopCh := make(chan struct{})
+			defer close(stopCh)
+			crdInformerFactory.Start(stopCh)
+			informerFactory.Start(stopCh)
+			crdInformerFactory.WaitForCacheSync(stopCh)
+			informerFactory.WaitForCacheSync(stopCh)
+
+			s.schedule()
+			assert.Equal(t, tt.expectedResults, s.scheduleResults)
+
+			ipToNode, err, scheduled := s.GetEgressIPsAndNodes("egressB")
+			assert.Equal(t, tt.expectedResults["egressB"].ipToNode, ipToNode)
+			assert.Equal(t, tt.expectedResults["egressB"].err, err)
+			assert.Equal(t, tt.expectedResults["egressB"].err == nil, scheduled)
+		})
+	}
+}
+
+func newSchedulingNode(name string, labels map[string]string, egressTrafficLoad string) *corev1.Node {
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Labels:      labels,
+			Annotations: map[string]string{},
+		},
+	}
+	if egressTrafficLoad != "" {
+		node.Annotations[agenttypes.NodeEgressTrafficLoadAnnotationKey] = egressTrafficLoad
+	}
+	return node
+}
+
+func TestScheduleWithConstraints(t *testing.T) {
+	egresses := []runtime.Object{
+		&crdv1b1.Egress{
+			ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA", CreationTimestamp: metav1.NewTime(time.Unix(1, 0))},
+			Spec:       crdv1b1.EgressSpec{EgressIP: "1.1.1.1", ExternalIPPool: "pool1"},
+		},
+		&crdv1b1.Egress{
+			ObjectMeta: metav1.ObjectMeta{Name: "egressB", UID: "uidB", CreationTimestamp: metav1.NewTime(time.Unix(2, 0))},
+			Spec:       crdv1b1.EgressSpec{EgressIP: "1.1.1.11", ExternalIPPool: "pool1"},
+		},
+		&crdv1b1.Egress{
+			ObjectMeta: metav1.ObjectMeta{Name: "egressC", UID: "uidC", CreationTimestamp: metav1.NewTime(time.Unix(3, 0))},
+			Spec:       crdv1b1.EgressSpec{EgressIP: "1.1.1.21", ExternalIPPool: "pool1"},
+		},
+		&crdv1b1.Egress{
+			ObjectMeta: metav1.ObjectMeta{Name: "egressD", UID: "uidD", CreationTimestamp: metav1.NewTime(time.Unix(4, 0))},
+			Spec:       crdv1b1.EgressSpec{EgressIP: "1.1.1.1", ExternalIPPool: "pool1"},
+		},
+	}
+	zoneA := map[string]string{"topology.kubernetes.io/zone": "zone-a"}
+	zoneB := map[string]string{"topology.kubernetes.io/zone": "zone-b"}
+	zoneC := map[string]string{"topology.kubernetes.io/zone": "zone-c"}
+	topologySpread := &crdv1b1.TopologySpread{TopologyKey: "topology.kubernetes.io/zone"}
+	tests := []struct {
+		name                string
+		nodes               []*corev1.Node
+		aliveNodes          []string
+		maxEgressIPsPerNode int
+		scheduling          *crdv1b1.ExternalIPPoolScheduling
+		expectedResults     map[string]*scheduleResult
+	}{
+		{
+			name: "node affinity",
+			nodes: []*corev1.Node{
+				newSchedulingNode("node1", nil, ""),
+				newSchedulingNode("node2", map[string]string{"egress": "preferred"}, ""),
+				newSchedulingNode("node3", map[string]string{"egress": "preferred", "tier": "gold"}, ""),
+			},
+			maxEgressIPsPerNode: 3,
+			scheduling: &crdv1b1.ExternalIPPoolScheduling{
+				NodeAffinity: []crdv1b1.WeightedNodeSelector{
+					{Weight: 10, NodeSelector: metav1.LabelSelector{MatchLabels: map[string]string{"egress": "preferred"}}},
+					{Weight: 50, NodeSelector: metav1.LabelSelector{MatchLabels: map[string]string{"tier": "gold"}}},
+				},
+			},
+			expectedResults: map[string]*scheduleResult{
+				"egressA": {node: "node3", ip: "1.1.1.1"},
+				"egressB": {node: "node3", ip: "1.1.1.11"},
+				"egressC": {node: "node3", ip: "1.1.1.21"},
+				"egressD": {node: "node3", ip: "1.1.1.1"},
+			},
+		},
+		{
+			name: "node affinity with insufficient capacity",
+			nodes: []*corev1.Node{
+				newSchedulingNode("node1", nil, ""),
+				newSchedulingNode("node2", map[string]string{"egress": "preferred"}, ""),
+				newSchedulingNode("node3", map[string]string{"egress": "preferred", "tier": "gold"}, ""),
+			},
+			maxEgressIPsPerNode: 1,
+			scheduling: &crdv1b1.ExternalIPPoolScheduling{
+				NodeAffinity: []crdv1b1.WeightedNodeSelector{
+					{Weight: 10, NodeSelector: metav1.LabelSelector{MatchLabels: map[string]string{"egress": "preferred"}}},
+					{Weight: 50, NodeSelector: metav1.LabelSelector{MatchLabels: map[string]string{"tier": "gold"}}},
+				},
+			},
+			expectedResults: map[string]*scheduleResult{
+				"egressA": {node: "node3", ip: "1.1.1.1"},
+				"egressB": {node: "node2", ip: "1.1.1.11"},
+				"egressC": {node: "node1", ip: "1.1.1.21"},
+				"egressD": {node: "node3", ip: "1.1.1.1"},
+			},
+		},
+		{
+			name: "prefer low traffic",
+			nodes: []*corev1.Node{
+				newSchedulingNode("node1", nil, "100"),
+				newSchedulingNode("node2", nil, "10"),
+				newSchedulingNode("node3", nil, "50"),
+			},
+			maxEgressIPsPerNode: 2,
+			scheduling:          &crdv1b1.ExternalIPPoolScheduling{PreferLowTraffic: true},
+			expectedResults: map[string]*scheduleResult{
+				"egressA": {node: "node2", ip: "1.1.1.1"},
+				"egressB": {node: "node2", ip: "1.1.1.11"},
+				"egressC": {node: "node3", ip: "1.1.1.21"},
+				"egressD": {node: "node2", ip: "1.1.1.1"},
+			},
+		},
+		{
+			name: "topology spread",
+			nodes: []*corev1.Node{
+				newSchedulingNode("node1", zoneA, ""),
+				newSchedulingNode("node2", zoneA, ""),
+				newSchedulingNode("node3", zoneB, ""),
+				newSchedulingNode("node4", nil, ""),
+			},
+			maxEgressIPsPerNode: 3,
+			scheduling:          &crdv1b1.ExternalIPPoolScheduling{TopologySpread: topologySpread},
+			expectedResults: map[string]*scheduleResult{
+				"egressA": {node: "node1", ip: "1.1.1.1"},
+				"egressB": {node: "node3", ip: "1.1.1.11"},
+				"egressC": {node: "node1", ip: "1.1.1.21"},
+				"egressD": {node: "node1", ip: "1.1.1.1"},
+			},
+		},
+		{
+			name: "topology spread after zone failure",
+			nodes: []*corev1.Node{
+				newSchedulingNode("node1", zoneA, ""),
+				newSchedulingNode("node2", zoneA, ""),
+				newSchedulingNode("node3", zoneB, ""),
+				newSchedulingNode("node4", zoneB, ""),
+				newSchedulingNode("node5", zoneC, ""),
+				newSchedulingNode("node6", zoneC, ""),
+			},
+			aliveNodes:          []string{"node3", "node4", "node5", "node6"},
+			maxEgressIPsPerNode: 3,
+			scheduling:          &crdv1b1.ExternalIPPoolScheduling{TopologySpread: topologySpread},
+			expectedResults: map[string]*scheduleResult{
+				"egressA": {node: "node5", ip: "1.1.1.1"},
+				"egressB": {node: "node3", ip: "1.1.1.11"},
+				"egressC": {node: "node5", ip: "1.1.1.21"},
+				"egressD": {node: "node5", ip: "1.1.1.1"},
+			},
+		},
+		{
+			name: "no Node with topology label",
+			nodes: []*corev1.Node{
+				newSchedulingNode("node1", nil, ""),
+				newSchedulingNode("node2", nil, ""),
+			},
+			maxEgressIPsPerNode: 3,
+			scheduling:          &crdv1b1.ExternalIPPoolScheduling{TopologySpread: topologySpread},
+			expectedResults: map[string]*scheduleResult{
+				"egressA": {err: memberlist.ErrNoNodeAvailable},
+				"egressB": {err: memberlist.ErrNoNodeAvailable},
+				"egressC": {err: memberlist.ErrNoNodeAvailable},
+				"egressD": {err: memberlist.ErrNoNodeAvailable},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var nodeObjs []runtime.Object
+			var nodeNames []string
+			for _, node := range tt.nodes {
+				nodeObjs = append(nodeObjs, node)
+				nodeNames = append(nodeNames, node.Name)
+			}
+			aliveNodes := tt.aliveNodes
+			if aliveNodes == nil {
+				aliveNodes = nodeNames
+			}
+			pool := &crdv1b1.ExternalIPPool{
+				ObjectMeta: metav1.ObjectMeta{Name: "pool1"},
+				Spec:       crdv1b1.ExternalIPPoolSpec{Scheduling: tt.scheduling},
+			}
+			fakeCluster := newFakeMemberlistCluster(aliveNodes)
+			crdClient := fakeversioned.NewSimpleClientset(append(egresses, pool)...)
+			crdInformerFactory := crdinformers.NewSharedInformerFactory(crdClient, 0)
+			egressInformer := crdInformerFactory.Crd().V1beta1().Egresses()
+			externalIPPoolInformer := crdInformerFactory.Crd().V1beta1().ExternalIPPools()
+			clientset := fake.NewSimpleClientset(nodeObjs...)
+			informerFactory := informers.NewSharedInformerFactory(clientset, 0)
+			nodeInformer := informerFactory.Core().V1().Nodes()
+
+			s := NewEgressIPScheduler(fakeCluster, egressInformer, externalIPPoolInformer, nodeInformer, tt.maxEgressIPsPerNode)
+			stopCh := make(chan struct{})
+			defer close(stopCh)
+			crdInformerFactory.Start(stopCh)
+			informerFactory.Start(stopCh)
+			crdInformerFactory.WaitForCacheSync(stopCh)
+			informerFactory.WaitForCacheSync(stopCh)
+
+			s.schedule()
+			assert.Equal(t, tt.expectedResults, s.scheduleResults)
+		})
+	}
+}
+
+func TestSchedulePreferLowTrafficWithMoreIPsThanNodes(t *testing.T) {
+	var egresses []runtime.Object
+	for i := 0; i < 5; i++ {
+		egresses = append(egresses, &crdv1b1.Egress{
+			ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("egress-%d", i), UID: types.UID(fmt.Sprintf("uid-%d", i)), CreationTimestamp: metav1.NewTime(time.Unix(int64(i), 0))},
+			Spec:       crdv1b1.EgressSpec{EgressIP: fmt.Sprintf("1.1.1.%d", i+1), ExternalIPPool: "pool1"},
+		})
+	}
+	pool := &crdv1b1.ExternalIPPool{
+		ObjectMeta: metav1.ObjectMeta{Name: "pool1"},
+		Spec:       crdv1b1.ExternalIPPoolSpec{Scheduling: &crdv1b1.ExternalIPPoolScheduling{PreferLowTraffic: true}},
+	}
+	fakeCluster := newFakeMemberlistCluster([]string{"node1", "node2", "node3"})
+	crdClient := fakeversioned.NewSimpleClientset(append(egresses, pool)...)
+	crdInformerFactory := crdinformers.NewSharedInformerFactory(crdClient, 0)
+	egressInformer := crdInformerFactory.Crd().V1beta1().Egresses()
+	externalIPPoolInformer := crdInformerFactory.Crd().V1beta1().ExternalIPPools()
+	clientset := fake.NewSimpleClientset(
+		newSchedulingNode("node1", nil, ""),
+		newSchedulingNode("node2", nil, "0"),
+		newSchedulingNode("node3", nil, "1"),
+	)
+	informerFactory := informers.NewSharedInformerFactory(clientset, 0)
+	nodeInformer := informerFactory.Core().V1().Nodes()
+
+	s := NewEgressIPScheduler(fakeCluster, egressInformer, externalIPPoolInformer, nodeInformer, 10)
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	crdInformerFactory.Start(stopCh)
+	informerFactory.Start(stopCh)
+	crdInformerFactory.WaitForCacheSync(stopCh)
+	informerFactory.WaitForCacheSync(stopCh)
+
+	s.schedule()
+	// The IPs are spread across the Nodes according to their load, instead of all being assigned to the Nodes with
+	// the lowest load.
+	nodeToNumIPs := map[string]int{}
+	for _, result := range s.scheduleResults {
+		assert.NoError(t, result.err)
+		nodeToNumIPs[result.node]++
+	}
+	assert.Equal(t, map[string]int{"node1": 2, "node2": 2, "node3": 1}, nodeToNumIPs)
+}
+
+func TestSchedulingConstraintsChanged(t *testing.T) {
+	oldNode := newSchedulingNode("node1", map[string]string{"zone": "a"}, "1")
+	tests := []struct {
+		name             string
+		preferLowTraffic bool
+		curNode          *corev1.Node
+		expected         bool
+	}{
+		{
+			name:     "labels changed",
+			curNode:  newSchedulingNode("node1", map[string]string{"zone": "b"}, "1"),
+			expected: true,
+		},
+		{
+			name:     "nothing changed",
+			curNode:  newSchedulingNode("node1", map[string]string{"zone": "a"}, "1"),
+			expected: false,
+		},
+		{
+			name:     "load changed without pool preferring low traffic",
+			curNode:  newSchedulingNode("node1", map[string]string{"zone": "a"}, "2"),
+			expected: false,
+		},
+		{
+			name:             "load changed with pool preferring low traffic",
+			preferLowTraffic: true,
+			curNode:          newSchedulingNode("node1", map[string]string{"zone": "a"}, "2"),
+			expected:         true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pools := []runtime.Object{&crdv1b1.ExternalIPPool{ObjectMeta: metav1.ObjectMeta{Name: "pool1"}}}
+			if tt.preferLowTraffic {
+				pools = append(pools, &crdv1b1.ExternalIPPool{
+					ObjectMeta: metav1.ObjectMeta{Name: "pool2"},
+					Spec:       crdv1b1.ExternalIPPoolSpec{Scheduling: &crdv1b1.ExternalIPPoolScheduling{PreferLowTraffic: true}},
+				})
+			}
+			fakeCluster := newFakeMemberlistCluster([]string{"node1"})
+			crdClient := fakeversioned.NewSimpleClientset(pools...)
+			crdInformerFactory := crdinformers.NewSharedInformerFactory(crdClient, 0)
+			egressInformer := crdInformerFactory.Crd().V1beta1().Egresses()
+			externalIPPoolInformer := crdInformerFactory.Crd().V1beta1().ExternalIPPools()
+			informerFactory := informers.NewSharedInformerFactory(fake.NewSimpleClientset(), 0)
+			nodeInformer := informerFactory.Core().V1().Nodes()
+
+			s := NewEgressIPScheduler(fakeCluster, egressInformer, externalIPPoolInformer, nodeInformer, 10)
+			stopCh := make(chan struct{})
+			defer close(stopCh)
+			crdInformerFactory.Start(stopCh)
+			crdInformerFactory.WaitForCacheSync(stopCh)
+
+			assert.Equal(t, tt.expected, s.schedulingConstraintsChanged(oldNode, tt.curNode))
+		})
+	}
+}
+
+func TestGetStandbyNodes(t *testing.T) {
+	egresses := []runtime.Object{
+		&crdv1b1.Egress{
+			ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA", CreationTimestamp: metav1.NewTime(time.Unix(1, 0))},
+			Spec:       crdv1b1.EgressSpec{EgressIP: "1.1.1.1", ExternalIPPool: "pool1"},
+		},
+		&crdv1b1.Egress{
+			ObjectMeta: metav1.ObjectMeta{Name: "egressB", UID: "uidB", CreationTimestamp: metav1.NewTime(time.Unix(2, 0))},
+			Spec:       crdv1b1.EgressSpec{EgressIP: "1.1.1.11", ExternalIPPool: "pool1"},
+		},
+		&crdv1b1.Egress{
+			ObjectMeta: metav1.ObjectMeta{Name: "egressC", UID: "uidC", CreationTimestamp: metav1.NewTime(time.Unix(3, 0))},
+			Spec:       crdv1b1.EgressSpec{EgressIP: "1.1.1.21", ExternalIPPool: "pool1"},
+		},
+	}
+	fakeCluster := newFakeMemberlistCluster([]string{"node1", "node2", "node3"})
+	crdClient := fakeversioned.NewSimpleClientset(egresses...)
+	crdInformerFactory := crdinformers.NewSharedInformerFactory(crdClient, 0)
+	egressInformer := crdInformerFactory.Crd().V1beta1().Egresses()
+	externalIPPoolInformer := crdInformerFactory.Crd().V1beta1().ExternalIPPools()
+	clientset := fake.NewSimpleClientset()
+	informerFactory := informers.NewSharedInformerFactory(clientset, 0)
+	nodeInformer := informerFactory.Core().V1().Nodes()
+
+	s := NewEgressIPScheduler(fakeCluster, egressInformer, externalIPPoolInformer, nodeInformer, 1)
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	crdInformerFactory.Start(stopCh)
+	informerFactory.Start(stopCh)
+	crdInformerFactory.WaitForCacheSync(stopCh)
+	informerFactory.WaitForCacheSync(stopCh)
+
+	// Each Node can accommodate one Egress IP: egressA is scheduled to node1, egressB to node3, and egressC to node2.
+	s.schedule()
+	// If node1 failed, egressA would be moved to node2, and egressC would have no Node available.
+	assert.Equal(t, map[string]string{"1.1.1.1": "node2"}, s.GetStandbyNodes("node1"))
+	// If node2 failed, node1 and node3 would be full with egressA and egressB.
+	assert.Empty(t, s.GetStandbyNodes("node2"))
+	assert.Nil(t, s.GetStandbyNodes("node4"))
+	// The actual results are not changed.
+	assert.Equal(t, "node1", s.scheduleResults["egressA"].node)
+	assert.Equal(t, "node2", s.scheduleResults["egressC"].node)
+}
+
 func BenchmarkSchedule(b *testing.B) {
 	var egresses []runtime.Object
 	for i := 0; i < 1000; i++ {
@@ -228,11 +691,12 @@ func BenchmarkSchedule(b *testing.B) {
 	crdClient := fakeversioned.NewSimpleClientset(egresses...)
 	crdInformerFactory := crdinformers.NewSharedInformerFactory(crdClient, 0)
 	egressInformer := crdInformerFactory.Crd().V1beta1().Egresses()
+	externalIPPoolInformer := crdInformerFactory.Crd().V1beta1().ExternalIPPools()
 	clientset := fake.NewSimpleClientset()
 	informerFactory := informers.NewSharedInformerFactory(clientset, 0)
 	nodeInformer := informerFactory.Core().V1().Nodes()
 
-	s := NewEgressIPScheduler(fakeCluster, egressInformer, nodeInformer, 10)
+	s := NewEgressIPScheduler(fakeCluster, egressInformer, externalIPPoolInformer, nodeInformer, 10)
 	stopCh := make(chan struct{})
 	defer close(stopCh)
 	crdInformerFactory.Start(stopCh)
@@ -278,11 +742,12 @@ func TestRun(t *testing.T) {
 	crdClient := fakeversioned.NewSimpleClientset(egresses...)
 	crdInformerFactory := crdinformers.NewSharedInformerFactory(crdClient, 0)
 	egressInformer := crdInformerFactory.Crd().V1beta1().Egresses()
+	externalIPPoolInformer := crdInformerFactory.Crd().V1beta1().ExternalIPPools()
 	clientset := fake.NewSimpleClientset(node1, node2)
 	informerFactory := informers.NewSharedInformerFactory(clientset, 0)
 	nodeInformer := informerFactory.Core().V1().Nodes()
 
-	s := NewEgressIPScheduler(fakeCluster, egressInformer, nodeInformer, 2)
+	s := NewEgressIPScheduler(fakeCluster, egressInformer, externalIPPoolInformer, nodeInformer, 2)
 	egressUpdates := make(chan string, 10)
 	s.AddEventHandler(func(egress string) {
 		egressUpdates <- egress
@@ -27,6 +27,7 @@ import (
 	"k8s.io/klog/v2"
 	"k8s.io/utils/clock"
 
+	"antrea.io/antrea/pkg/agent/openflow"
 	"antrea.io/antrea/pkg/agent/types"
 )
 
@@ -273,3 +274,65 @@ func (l *l7VlanIDAllocator) query(ruleID string) uint32 {
 	}
 	return 0
 }
+
+// meterIDAllocator provides interfaces to allocate and release OVS meter IDs for rules with rate limits. It also caches
+// the mapping of rule IDs to allocated meter IDs and provides an interface for a rule to query its allocated meter ID.
+type meterIDAllocator struct {
+	sync.RWMutex
+
+	idCounter       uint32
+	recycled        []uint32
+	ruleIDToMeterID map[string]uint32
+}
+
+func newMeterIDAllocator() *meterIDAllocator {
+	return &meterIDAllocator{
+		// The first allocated meter ID is MinPolicyRuleMeterID.
+		idCounter:       openflow.MinPolicyRuleMeterID - 1,
+		ruleIDToMeterID: make(map[string]uint32),
+	}
+}
+
+func (m *meterIDAllocator) allocate(ruleID string) uint32 {
+	m.Lock()
+	defer m.Unlock()
+
+	if meterID, ok := m.ruleIDToMeterID[ruleID]; ok {
+		return meterID
+	}
+
+	var meterID uint32
+	if len(m.recycled) != 0 {
+		meterID = m.recycled[len(m.recycled)-1]
+		m.recycled = m.recycled[:len(m.recycled)-1]
+	} else {
+		m.idCounter += 1
+		meterID = m.idCounter
+	}
+	m.ruleIDToMeterID[ruleID] = meterID
+	return meterID
+}
+
+func (m *meterIDAllocator) release(ruleID string) {
+	m.Lock()
+	defer m.Unlock()
+
+	meterID, ok := m.ruleIDToMeterID[ruleID]
+	if !ok {
+		return
+	}
+
+	m.recycled = append(m.recycled, meterID)
+	delete(m.ruleIDToMeterID, ruleID)
+}
+
+func (m *meterIDAllocator) query(ruleID string) uint32 {
+	m.RLock()
+	defer m.RUnlock()
+
+	meterID, ok := m.ruleIDToMeterID[ruleID]
+	if ok {
+		return meterID
+	}
+	return 0
+}
@@ -26,6 +26,7 @@ import (
 	"k8s.io/utils/clock"
 	clocktesting "k8s.io/utils/clock/testing"
 
+	"antrea.io/antrea/pkg/agent/openflow"
 	"antrea.io/antrea/pkg/agent/types"
 	"antrea.io/antrea/pkg/apis/controlplane/v1beta2"
 )
@@ -310,3 +311,26 @@ func TestVlanIDAllocator(t *testing.T) {
 	assert.Equal(t, vlanID4, vlanIDAllocator.query(ruleID4))
 	assert.Equal(t, vlanID1, vlanID4)
 }
+
+func TestMeterIDAllocator(t *testing.T) {
+	meterIDAllocator := newMeterIDAllocator()
+	ruleID1 := "rule1"
+	ruleID2 := "rule2"
+	ruleID3 := "rule3"
+
+	meterID1 := meterIDAllocator.allocate(ruleID1)
+	assert.Equal(t, uint32(openflow.MinPolicyRuleMeterID), meterID1)
+	assert.Equal(t, meterID1, meterIDAllocator.query(ruleID1))
+	// Allocating again for the same rule returns the same meter ID.
+	assert.Equal(t, meterID1, meterIDAllocator.allocate(ruleID1))
+
+	meterID2 := meterIDAllocator.allocate(ruleID2)
+	assert.Equal(t, meterID1+1, meterID2)
+
+	meterIDAllocator.release(ruleID1)
+	assert.Equal(t, uint32(0), meterIDAllocator.query(ruleID1))
+
+	meterID3 := meterIDAllocator.allocate(ruleID3)
+	assert.Equal(t, meterID1, meterID3)
+	assert.Equal(t, meterID2, meterIDAllocator.query(ruleID2))
+}
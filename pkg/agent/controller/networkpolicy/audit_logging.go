@@ -32,6 +32,7 @@ import (
 	"antrea.io/antrea/pkg/agent/interfacestore"
 	"antrea.io/antrea/pkg/agent/openflow"
 	"antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
 	binding "antrea.io/antrea/pkg/ovs/openflow"
 	"antrea.io/antrea/pkg/util/ip"
 	"antrea.io/antrea/pkg/util/logdir"
@@ -152,9 +153,9 @@ func buildLogMsg(ob *logInfo) string {
 
 // LogDedupPacket logs information in ob based on disposition and duplication conditions.
 func (l *AuditLogger) LogDedupPacket(ob *logInfo) {
-	// Deduplicate non-Allow packet log.
+	// Deduplicate non-Allow and non-Audit packet log.
 	logMsg := buildLogMsg(ob)
-	if ob.disposition == openflow.DispositionToString[openflow.DispositionAllow] {
+	if ob.disposition == openflow.DispositionToString[openflow.DispositionAllow] || ob.disposition == string(crdv1beta1.RuleActionAudit) {
 		l.npLogger.Print(logMsg)
 	} else {
 		// Increase count if duplicated within 1 sec, create buffer otherwise.
@@ -281,6 +282,12 @@ func getNetworkPolicyInfo(pktIn *ofctrl.PacketIn, packet *binding.Packet, c *Con
 	ob.ofPriority = ofPriority
 	ob.ruleName = ruleName
 	ob.logLabel = logLabel
+	// Rules with the Audit action are realized as Allow rules in the datapath, use the rule action as disposition.
+	if disposition == openflow.DispositionAllow {
+		if rule := c.GetRuleByFlowID(conjID); rule != nil && rule.Action != nil && *rule.Action == crdv1beta1.RuleActionAudit {
+			ob.disposition = string(crdv1beta1.RuleActionAudit)
+		}
+	}
 	// Fill in placeholders for Antrea-native policies without log labels,
 	// K8s NetworkPolicies without rule names or log labels.
 	fillLogInfoPlaceholders([]*string{&ob.ruleName, &ob.logLabel, &ob.ofPriority})
@@ -38,8 +38,10 @@ import (
 	"antrea.io/antrea/pkg/agent/interfacestore"
 	"antrea.io/antrea/pkg/agent/openflow"
 	openflowtesting "antrea.io/antrea/pkg/agent/openflow/testing"
+	agenttypes "antrea.io/antrea/pkg/agent/types"
 	"antrea.io/antrea/pkg/agent/util"
 	"antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
 	binding "antrea.io/antrea/pkg/ovs/openflow"
 	"antrea.io/antrea/pkg/util/ip"
 )
@@ -237,6 +239,8 @@ func TestGetNetworkPolicyInfo(t *testing.T) {
 	// openflow.APConjIDField, openflow.TFEgressConjIDField, openflow.TFIngressConjIDField
 	// the data itself is not relevant
 	conjunctionData := []byte{0x11, 0x11, 0x11, 0x11}
+	conjunctionID := binary.BigEndian.Uint32(conjunctionData)
+	auditAction := crdv1beta1.RuleActionAudit
 	srcIP := net.ParseIP("192.168.1.1")
 	destIP := net.ParseIP("192.168.1.2")
 	testPacket := &binding.Packet{
@@ -268,6 +272,7 @@ func TestGetNetworkPolicyInfo(t *testing.T) {
 		wantOb          *logInfo
 		wantErr         error
 		tableIDInReg    *uint8
+		rulesByFlowID   map[uint32]*agenttypes.PolicyRule
 	}{
 		{
 			name:    "ANNP Allow Ingress",
@@ -399,6 +404,29 @@ func TestGetNetworkPolicyInfo(t *testing.T) {
 			},
 			tableIDInReg: &antreaIngressRuleTableID,
 		},
+		{
+			name:    "Antrea-native Policy Audit from output table",
+			tableID: openflow.OutputTable.GetID(),
+			expectedCalls: func(mockClient *openflowtesting.MockClientMockRecorder) {
+				mockClient.GetPolicyInfoFromConjunction(gomock.Any()).Return(
+					true, testANNPRef, testPriority, testRule, testLogLabel)
+			},
+			dispositionData: allowDispositionData,
+			wantOb: &logInfo{
+				tableName:    openflow.AntreaPolicyIngressRuleTable.GetName(),
+				disposition:  string(crdv1beta1.RuleActionAudit),
+				npRef:        testANNPRef.ToString(),
+				ofPriority:   testPriority,
+				ruleName:     testRule,
+				direction:    "Ingress",
+				appliedToRef: "default/destPod",
+				logLabel:     testLogLabel,
+			},
+			tableIDInReg: &antreaIngressRuleTableID,
+			rulesByFlowID: map[uint32]*agenttypes.PolicyRule{
+				conjunctionID: {Action: &auditAction},
+			},
+		},
 		{
 			name:    "Antrea-native Policy Drop from output table",
 			tableID: openflow.OutputTable.GetID(),
@@ -469,9 +497,12 @@ func TestGetNetworkPolicyInfo(t *testing.T) {
 			if tc.expectedCalls != nil {
 				tc.expectedCalls(testClientInterface.EXPECT())
 			}
+			reconciler := newMockReconciler()
+			reconciler.rulesByFlowID = tc.rulesByFlowID
 			c := &Controller{
-				ofClient:   testClientInterface,
-				ifaceStore: ifaceStore,
+				ofClient:      testClientInterface,
+				ifaceStore:    ifaceStore,
+				podReconciler: reconciler,
 			}
 			tc.ob = new(logInfo)
 			gotErr := getNetworkPolicyInfo(pktIn, testPacket, c, tc.ob)
@@ -100,6 +100,10 @@ type rule struct {
 	EnableLogging bool
 	// LogLabel is a string associated to the NetworkPolicy rule. Used for logging.
 	LogLabel string
+	// Schedule of this rule. The rule is only enforced when it's active. nil if the rule is always enforced.
+	Schedule *v1beta.RuleSchedule
+	// RateLimit of the traffic allowed by this rule. nil if the traffic is not rate-limited.
+	RateLimit *v1beta.RuleRateLimit
 }
 
 func (r *rule) Less(r2 *rule) bool {
@@ -152,6 +156,8 @@ type CompletedRule struct {
 	TargetMembers v1beta.GroupMemberSet
 	// Vlan ID allocated for this rule if this rule is for L7 NetworkPolicy.
 	L7RuleVlanID *uint32
+	// ID of the OF meter allocated for this rule if the traffic allowed by this rule is rate-limited.
+	RateLimitMeterID *uint32
 }
 
 // String returns the string representation of the CompletedRule.
@@ -721,6 +727,8 @@ func toRule(r *v1beta.NetworkPolicyRule, policy *v1beta.NetworkPolicy, maxPriori
 		SourceRef:       policy.SourceRef,
 		EnableLogging:   r.EnableLogging,
 		LogLabel:        r.LogLabel,
+		Schedule:        r.Schedule,
+		RateLimit:       r.RateLimit,
 	}
 	rule.ID = hashRule(rule)
 	rule.PolicyName = policy.Name
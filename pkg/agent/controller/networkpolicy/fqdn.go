@@ -122,6 +122,14 @@ type ruleSyncTracker struct {
 	dirtyRules sets.Set[string]
 }
 
+// fqdnSubscriber is a subscriber of FQDNs other than FQDN rules, e.g. an
+// Egress with FQDN destinations, which is notified when the IPs of its FQDNs
+// change.
+type fqdnSubscriber struct {
+	fqdns   sets.Set[string]
+	handler func()
+}
+
 type fqdnController struct {
 	// ofClient is the Openflow interface.
 	ofClient openflow.Client
@@ -144,7 +152,7 @@ type fqdnController struct {
 	// The mapping between FQDN rule IDs and the Pod's ofPort IDs that the rule selects.
 	fqdnRuleToSelectedPods map[string]sets.Set[int32]
 
-	// Mutex for fqdnToSelectorItem, selectorItemToFQDN and selectorItemToRuleIDs.
+	// Mutex for fqdnToSelectorItem, selectorItemToFQDN, selectorItemToRuleIDs and fqdnSubscribers.
 	fqdnSelectorMutex sync.Mutex
 	// fqdnToSelectorItem stores known FQDNSelectorItems that selects the FQDN, for each
 	// FQDN tracked by this controller.
@@ -159,6 +167,9 @@ type fqdnController struct {
 	gwPort                uint32
 	// clock allows injecting a custom (fake) clock in unit tests.
 	clock clock.Clock
+	// fqdnSubscribers stores the subscribers of FQDNs other than FQDN rules, keyed by
+	// their IDs which are used like rule IDs in selectorItemToRuleIDs.
+	fqdnSubscribers map[string]*fqdnSubscriber
 }
 
 func newFQDNController(client openflow.Client, allocator *idAllocator, dnsServerOverride string, dirtyRuleHandler func(string), v4Enabled, v6Enabled bool, gwPort uint32, clock clock.WithTicker, fqdnCacheMinTTL uint32) (*fqdnController, error) {
@@ -179,6 +190,7 @@ func newFQDNController(client openflow.Client, allocator *idAllocator, dnsServer
 		fqdnToSelectorItem:     map[string]sets.Set[fqdnSelectorItem]{},
 		selectorItemToFQDN:     map[fqdnSelectorItem]sets.Set[string]{},
 		selectorItemToRuleIDs:  map[fqdnSelectorItem]sets.Set[string]{},
+		fqdnSubscribers:        map[string]*fqdnSubscriber{},
 		ipv4Enabled:            v4Enabled,
 		ipv6Enabled:            v6Enabled,
 		gwPort:                 gwPort,
@@ -366,6 +378,43 @@ func (f *fqdnController) deleteFQDNSelector(ruleID string, fqdns []string) {
 	}
 }
 
+// addFQDNSubscriber adds or updates a subscriber of FQDNs other than FQDN rules.
+// The DNS responses received by the Pods of podOFAddrs are intercepted like for
+// FQDN rules, but they are not held until the subscriber handles the update.
+// handler is called when the IPs of any of the FQDNs change, it must not block.
+func (f *fqdnController) addFQDNSubscriber(subscriberID string, fqdns sets.Set[string], podOFAddrs sets.Set[int32], handler func()) error {
+	oldFQDNs := func() sets.Set[string] {
+		f.fqdnSelectorMutex.Lock()
+		defer f.fqdnSelectorMutex.Unlock()
+		var oldFQDNs sets.Set[string]
+		if subscriber, exists := f.fqdnSubscribers[subscriberID]; exists {
+			oldFQDNs = subscriber.fqdns
+		}
+		f.fqdnSubscribers[subscriberID] = &fqdnSubscriber{fqdns: fqdns, handler: handler}
+		return oldFQDNs
+	}()
+	// Only update the selectors of the changed FQDNs, to keep the cached IPs of the others.
+	f.deleteFQDNSelector(subscriberID, sets.List(oldFQDNs.Difference(fqdns)))
+	f.addFQDNSelector(subscriberID, sets.List(fqdns.Difference(oldFQDNs)))
+	return f.updateRuleSelectedPods(subscriberID, podOFAddrs)
+}
+
+// deleteFQDNSubscriber removes a subscriber added by addFQDNSubscriber.
+func (f *fqdnController) deleteFQDNSubscriber(subscriberID string) error {
+	subscriber, exists := func() (*fqdnSubscriber, bool) {
+		f.fqdnSelectorMutex.Lock()
+		defer f.fqdnSelectorMutex.Unlock()
+		subscriber, exists := f.fqdnSubscribers[subscriberID]
+		delete(f.fqdnSubscribers, subscriberID)
+		return subscriber, exists
+	}()
+	if !exists {
+		return nil
+	}
+	f.deleteFQDNSelector(subscriberID, sets.List(subscriber.fqdns))
+	return f.deleteRuleSelectedPods(subscriberID)
+}
+
 // cleanupFQDNSelectorItem handles a fqdnSelectorItem delete event.
 func (f *fqdnController) cleanupFQDNSelectorItem(fs fqdnSelectorItem) {
 	for fqdn := range f.selectorItemToFQDN[fs] {
@@ -534,6 +583,17 @@ func (f *fqdnController) syncDirtyRules(fqdn string, waitCh chan error, addressU
 	for selectorItem := range f.fqdnToSelectorItem[fqdn] {
 		utilsets.MergeString(dirtyRules, f.selectorItemToRuleIDs[selectorItem])
 	}
+	// Subscribers other than FQDN rules are only notified of address updates, they
+	// never block packetOut.
+	for ruleID := range dirtyRules {
+		if subscriber, exists := f.fqdnSubscribers[ruleID]; exists {
+			if addressUpdate {
+				klog.V(4).InfoS("Notifying FQDN subscriber of address updates", "subscriberID", ruleID)
+				subscriber.handler()
+			}
+			dirtyRules.Delete(ruleID)
+		}
+	}
 	if waitCh == nil {
 		if addressUpdate {
 			for ruleID := range dirtyRules {
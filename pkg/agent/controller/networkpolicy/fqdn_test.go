@@ -748,6 +748,40 @@ func TestOnDNSResponse(t *testing.T) {
 	}
 }
 
+func TestFQDNSubscriber(t *testing.T) {
+	controller := gomock.NewController(t)
+	f, c := newMockFQDNController(t, controller, nil, nil, 0)
+	subscriberID := "Egress/egressA"
+	testFQDN := "db.antrea.io"
+	notified := 0
+	handler := func() { notified++ }
+
+	c.EXPECT().AddAddressToDNSConjunction(dnsInterceptRuleID, gomock.Any())
+	require.NoError(t, f.addFQDNSubscriber(subscriberID, sets.New[string](testFQDN), sets.New[int32](1), handler))
+	assert.Equal(t, sets.New[string](subscriberID), f.selectorItemToRuleIDs[fqdnSelectorItem{matchName: testFQDN}])
+
+	// The DNS response intercepted for the Pod is not held for the subscriber.
+	waitCh := make(chan error, 1)
+	f.onDNSResponse(testFQDN, map[string]ipWithExpiration{
+		"10.10.10.10": {ip: net.ParseIP("10.10.10.10"), expirationTime: time.Now().Add(10 * time.Second)},
+	}, waitCh)
+	assert.NoError(t, <-waitCh)
+	assert.Equal(t, 1, notified)
+	assert.Equal(t, []net.IP{net.ParseIP("10.10.10.10")}, f.getIPsForFQDNSelectors([]string{testFQDN}))
+
+	// The same IPs don't notify the subscriber again.
+	f.onDNSResponse(testFQDN, map[string]ipWithExpiration{
+		"10.10.10.10": {ip: net.ParseIP("10.10.10.10"), expirationTime: time.Now().Add(10 * time.Second)},
+	}, nil)
+	assert.Equal(t, 1, notified)
+
+	c.EXPECT().DeleteAddressFromDNSConjunction(dnsInterceptRuleID, gomock.Any())
+	require.NoError(t, f.deleteFQDNSubscriber(subscriberID))
+	assert.Empty(t, f.fqdnSubscribers)
+	assert.Empty(t, f.selectorItemToRuleIDs)
+	assert.Empty(t, f.dnsEntryCache)
+}
+
 // TestParseDNSResponseOnFQDNCacheMinTTL tests the behavior of the parseDNSResponse function when
 // handling DNS responses with varying TTL values, and checks if the TTL used for caching respects
 // the minimum TTL (fqdnCacheMinTTL) for a given Fully Qualified Domain Name (FQDN).
@@ -0,0 +1,160 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package l7engine
+
+import (
+	"regexp"
+	"strings"
+
+	v1beta "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+)
+
+// matchContent checks whether a value matches the content of an L7 protocol field, with the same
+// semantics as the Suricata keywords generated by convertContent.
+func matchContent(content, value string) bool {
+	anyPrefix := strings.HasPrefix(content, "*")
+	if anyPrefix {
+		content = content[1:]
+	}
+	anySuffix := strings.HasSuffix(content, "*")
+	if anySuffix {
+		content = content[:len(content)-1]
+	}
+	switch {
+	case anyPrefix && anySuffix:
+		return strings.Contains(value, content)
+	case anyPrefix:
+		return strings.HasSuffix(value, content)
+	case anySuffix:
+		return strings.HasPrefix(value, content)
+	default:
+		return value == content
+	}
+}
+
+// matchValue checks whether a value matches an exact value or a regular expression, with the same semantics
+// as the pcre keywords generated for HTTP header and query parameter matches. It matches any value if neither
+// is provided.
+func matchValue(exact, regex, value string) bool {
+	if exact != "" {
+		return value == exact
+	}
+	if regex != "" {
+		matched, _ := regexp.MatchString("^(?:"+regex+")$", value)
+		return matched
+	}
+	return true
+}
+
+func matchQueryParam(param v1beta.HTTPQueryParamMatch, query string) bool {
+	query, _, _ = strings.Cut(query, "#")
+	for _, pair := range strings.Split(query, "&") {
+		name, value, hasValue := strings.Cut(pair, "=")
+		if name != param.Name {
+			continue
+		}
+		if (param.Value == "" && param.Regex == "") || (hasValue && matchValue(param.Value, param.Regex, value)) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchHTTP checks whether an HTTP request matches an HTTP protocol. Host is the only header carried by the HTTP
+// requests evaluated here, so if the request matches all the other fields of the HTTP protocol, but the HTTP protocol
+// matches other headers, whether it matches is unknown.
+func matchHTTP(http *v1beta.HTTPProtocol, method, host, path string) (matched, unknown bool) {
+	if http.Path != "" && !matchContent(http.Path, path) {
+		return false, false
+	}
+	uriPath, query, _ := strings.Cut(path, "?")
+	if http.PathRegex != "" && !matchValue("", http.PathRegex, uriPath) {
+		return false, false
+	}
+	hasOtherHeaders := false
+	for _, header := range http.Headers {
+		if !strings.EqualFold(header.Name, "Host") {
+			hasOtherHeaders = true
+		} else if !matchValue(header.Value, header.Regex, host) {
+			return false, false
+		}
+	}
+	for _, param := range http.QueryParams {
+		if !matchQueryParam(param, query) {
+			return false, false
+		}
+	}
+	// The method keyword generated by convertProtocolHTTP is a pattern-matching.
+	if http.Method != "" && !strings.Contains(method, http.Method) {
+		return false, false
+	}
+	// Suricata normalizes the http.host buffer to lowercase.
+	if http.Host != "" && !matchContent(http.Host, strings.ToLower(host)) {
+		return false, false
+	}
+	if hasOtherHeaders {
+		return false, true
+	}
+	return true, false
+}
+
+// MatchHTTPRequest evaluates an HTTP request against the L7 protocols of a rule, in the same way as
+// the Suricata rules generated for them. It returns the L7 protocol which allows the request, or nil
+// if the request is not allowed by any of them. It also returns the HTTP protocols which match the
+// request, except for headers other than Host, which can't be evaluated as the request doesn't carry
+// them.
+func MatchHTTPRequest(l7Protocols []v1beta.L7Protocol, method, host, path string) (*v1beta.L7Protocol, []*v1beta.L7Protocol) {
+	var unknown []*v1beta.L7Protocol
+	for i := range l7Protocols {
+		http := l7Protocols[i].HTTP
+		if http == nil {
+			continue
+		}
+		matched, isUnknown := matchHTTP(http, method, host, path)
+		if matched {
+			return &l7Protocols[i], nil
+		}
+		if isUnknown {
+			unknown = append(unknown, &l7Protocols[i])
+		}
+	}
+	return nil, unknown
+}
+
+// MatchTLSClientHello evaluates a TLS ClientHello message against the L7 protocols of a rule, in the
+// same way as the Suricata rules generated for them. It returns the L7 protocol which allows the
+// message, or nil if the message is rejected.
+func MatchTLSClientHello(l7Protocols []v1beta.L7Protocol, sni string) *v1beta.L7Protocol {
+	for i := range l7Protocols {
+		if tls := l7Protocols[i].TLS; tls != nil && (tls.SNI == "" || matchContent(tls.SNI, sni)) {
+			return &l7Protocols[i]
+		}
+	}
+	return nil
+}
+
+// UnsupportedL7Protocols returns the L7 protocols of a rule which can't be evaluated against an HTTP
+// request or a TLS ClientHello message, i.e. gRPC, Kafka and DNS. The requests of these protocols
+// can't be described by an HTTP request or a TLS ClientHello, so the verdict of the L7 engine is
+// unknown when none of the other L7 protocols allows the message.
+func UnsupportedL7Protocols(l7Protocols []v1beta.L7Protocol) []*v1beta.L7Protocol {
+	var unsupported []*v1beta.L7Protocol
+	for i := range l7Protocols {
+		if l7Protocols[i].GRPC != nil || l7Protocols[i].Kafka != nil || l7Protocols[i].DNS != nil {
+			unsupported = append(unsupported, &l7Protocols[i])
+		}
+	}
+	return unsupported
+}
@@ -0,0 +1,184 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package l7engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	v1beta "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+)
+
+func TestMatchContent(t *testing.T) {
+	testCases := []struct {
+		content  string
+		value    string
+		expected bool
+	}{
+		{content: "/index.html", value: "/index.html", expected: true},
+		{content: "/index.html", value: "/index.html?a=b", expected: false},
+		{content: "/api/v2/*", value: "/api/v2/pods", expected: true},
+		{content: "/api/v2/*", value: "/api/v1/pods", expected: false},
+		{content: "*.foo.com", value: "www.foo.com", expected: true},
+		{content: "*.foo.com", value: "www.foo.com.cn", expected: false},
+		{content: "*/v2/*", value: "/api/v2/pods", expected: true},
+		{content: "*/v2/*", value: "/api/v1/pods", expected: false},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, matchContent(tc.content, tc.value), "content %s, value %s", tc.content, tc.value)
+	}
+}
+
+func TestMatchHTTPRequest(t *testing.T) {
+	l7Protocols := []v1beta.L7Protocol{
+		{TLS: &v1beta.TLSProtocol{SNI: "*.foo.com"}},
+		{HTTP: &v1beta.HTTPProtocol{Method: "GET", Path: "/api/*"}},
+		{HTTP: &v1beta.HTTPProtocol{Host: "*.foo.com"}},
+	}
+	testCases := []struct {
+		name            string
+		l7Protocols     []v1beta.L7Protocol
+		method          string
+		host            string
+		path            string
+		expected        *v1beta.L7Protocol
+		expectedUnknown []*v1beta.L7Protocol
+	}{
+		{
+			name:        "match method and path",
+			l7Protocols: l7Protocols,
+			method:      "GET",
+			host:        "bar.com",
+			path:        "/api/v2",
+			expected:    &l7Protocols[1],
+		},
+		{
+			name:        "match host case-insensitively",
+			l7Protocols: l7Protocols,
+			method:      "POST",
+			host:        "WWW.Foo.com",
+			path:        "/api/v2",
+			expected:    &l7Protocols[2],
+		},
+		{
+			name:        "no match",
+			l7Protocols: l7Protocols,
+			method:      "POST",
+			host:        "bar.com",
+			path:        "/api/v2",
+		},
+		{
+			name:        "match any HTTP request",
+			l7Protocols: []v1beta.L7Protocol{{HTTP: &v1beta.HTTPProtocol{}}},
+			method:      "DELETE",
+			host:        "bar.com",
+			path:        "/",
+			expected:    &v1beta.L7Protocol{HTTP: &v1beta.HTTPProtocol{}},
+		},
+		{
+			name:        "TLS only",
+			l7Protocols: []v1beta.L7Protocol{{TLS: &v1beta.TLSProtocol{}}},
+			method:      "GET",
+			host:        "bar.com",
+			path:        "/",
+		},
+		{
+			name:        "match path regex and query params",
+			l7Protocols: []v1beta.L7Protocol{{HTTP: &v1beta.HTTPProtocol{PathRegex: "/api/v[0-9]+", QueryParams: []v1beta.HTTPQueryParamMatch{{Name: "id", Regex: "[0-9]+"}, {Name: "debug"}}}}},
+			method:      "GET",
+			host:        "bar.com",
+			path:        "/api/v2?debug&id=42",
+			expected:    &v1beta.L7Protocol{HTTP: &v1beta.HTTPProtocol{PathRegex: "/api/v[0-9]+", QueryParams: []v1beta.HTTPQueryParamMatch{{Name: "id", Regex: "[0-9]+"}, {Name: "debug"}}}},
+		},
+		{
+			name:        "path regex must match the whole path",
+			l7Protocols: []v1beta.L7Protocol{{HTTP: &v1beta.HTTPProtocol{PathRegex: "/api/v[0-9]+"}}},
+			method:      "GET",
+			host:        "bar.com",
+			path:        "/api/v2/users",
+		},
+		{
+			name:        "query param value mismatch",
+			l7Protocols: []v1beta.L7Protocol{{HTTP: &v1beta.HTTPProtocol{QueryParams: []v1beta.HTTPQueryParamMatch{{Name: "version", Value: "v1"}}}}},
+			method:      "GET",
+			host:        "bar.com",
+			path:        "/?version=v2",
+		},
+		{
+			name:        "match Host header",
+			l7Protocols: []v1beta.L7Protocol{{HTTP: &v1beta.HTTPProtocol{Headers: []v1beta.HTTPHeaderMatch{{Name: "host", Regex: ".*\\.foo\\.com"}}}}},
+			method:      "GET",
+			host:        "www.foo.com",
+			path:        "/",
+			expected:    &v1beta.L7Protocol{HTTP: &v1beta.HTTPProtocol{Headers: []v1beta.HTTPHeaderMatch{{Name: "host", Regex: ".*\\.foo\\.com"}}}},
+		},
+		{
+			name:            "other headers can't be evaluated",
+			l7Protocols:     []v1beta.L7Protocol{{HTTP: &v1beta.HTTPProtocol{Method: "GET", Headers: []v1beta.HTTPHeaderMatch{{Name: "X-Tenant-ID"}}}}},
+			method:          "GET",
+			host:            "bar.com",
+			path:            "/",
+			expectedUnknown: []*v1beta.L7Protocol{{HTTP: &v1beta.HTTPProtocol{Method: "GET", Headers: []v1beta.HTTPHeaderMatch{{Name: "X-Tenant-ID"}}}}},
+		},
+		{
+			name:        "other headers with other fields mismatch",
+			l7Protocols: []v1beta.L7Protocol{{HTTP: &v1beta.HTTPProtocol{Method: "POST", Headers: []v1beta.HTTPHeaderMatch{{Name: "X-Tenant-ID"}}}}},
+			method:      "GET",
+			host:        "bar.com",
+			path:        "/",
+		},
+		{
+			name: "match despite other headers in another protocol",
+			l7Protocols: []v1beta.L7Protocol{
+				{HTTP: &v1beta.HTTPProtocol{Headers: []v1beta.HTTPHeaderMatch{{Name: "X-Tenant-ID"}}}},
+				{HTTP: &v1beta.HTTPProtocol{Method: "GET"}},
+			},
+			method:   "GET",
+			host:     "bar.com",
+			path:     "/",
+			expected: &v1beta.L7Protocol{HTTP: &v1beta.HTTPProtocol{Method: "GET"}},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			matched, unknown := MatchHTTPRequest(tc.l7Protocols, tc.method, tc.host, tc.path)
+			assert.Equal(t, tc.expected, matched)
+			assert.Equal(t, tc.expectedUnknown, unknown)
+		})
+	}
+}
+
+func TestMatchTLSClientHello(t *testing.T) {
+	l7Protocols := []v1beta.L7Protocol{
+		{HTTP: &v1beta.HTTPProtocol{}},
+		{TLS: &v1beta.TLSProtocol{SNI: "*.foo.com"}},
+	}
+	assert.Equal(t, &l7Protocols[1], MatchTLSClientHello(l7Protocols, "www.foo.com"))
+	assert.Nil(t, MatchTLSClientHello(l7Protocols, "www.bar.com"))
+	assert.Equal(t, &v1beta.L7Protocol{TLS: &v1beta.TLSProtocol{}}, MatchTLSClientHello([]v1beta.L7Protocol{{TLS: &v1beta.TLSProtocol{}}}, "www.bar.com"))
+}
+
+func TestUnsupportedL7Protocols(t *testing.T) {
+	l7Protocols := []v1beta.L7Protocol{
+		{HTTP: &v1beta.HTTPProtocol{}},
+		{GRPC: &v1beta.GRPCProtocol{Service: "helloworld.Greeter"}},
+		{TLS: &v1beta.TLSProtocol{SNI: "*.foo.com"}},
+		{Kafka: &v1beta.KafkaProtocol{APIKey: "produce"}},
+		{DNS: &v1beta.DNSProtocol{QueryName: "*.foo.com"}},
+	}
+	assert.Equal(t, []*v1beta.L7Protocol{&l7Protocols[1], &l7Protocols[3], &l7Protocols[4]}, UnsupportedL7Protocols(l7Protocols))
+	assert.Empty(t, UnsupportedL7Protocols(l7Protocols[:1]))
+}
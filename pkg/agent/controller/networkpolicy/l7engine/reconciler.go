@@ -17,22 +17,27 @@ package l7engine
 import (
 	"bytes"
 	"context"
+	"encoding/binary"
 	"encoding/json"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
+	"slices"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/spf13/afero"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/klog/v2"
 
 	"antrea.io/antrea/pkg/agent/config"
 	v1beta "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
 	"antrea.io/antrea/pkg/util/logdir"
 )
 
@@ -46,12 +51,41 @@ const (
 
 	suricataCommandSocket = "/var/run/suricata/suricata-command.socket"
 
-	protocolHTTP = "http"
-	protocolTLS  = "tls"
+	protocolHTTP  = "http"
+	protocolTLS   = "tls"
+	protocolGRPC  = "grpc"
+	protocolKafka = "kafka"
+	protocolDNS   = "dns"
 
 	scCmdOK = "OK"
 )
 
+// suricataRuleProtocols maps the L7 protocols which don't have a Suricata protocol with the same name to the protocols
+// used in their Suricata rules. gRPC is matched as HTTP/2, and Kafka, which Suricata cannot parse, is matched on the
+// raw TCP payload.
+var suricataRuleProtocols = map[string]string{
+	protocolGRPC:  "http2",
+	protocolKafka: "tcp",
+}
+
+// kafkaAPIKeys maps the Kafka API keys supported in L7 NetworkPolicies to their numeric values in Kafka requests.
+var kafkaAPIKeys = map[crdv1beta1.KafkaAPIKey]int{
+	crdv1beta1.KafkaAPIKeyProduce:         0,
+	crdv1beta1.KafkaAPIKeyFetch:           1,
+	crdv1beta1.KafkaAPIKeyListOffsets:     2,
+	crdv1beta1.KafkaAPIKeyMetadata:        3,
+	crdv1beta1.KafkaAPIKeyOffsetCommit:    8,
+	crdv1beta1.KafkaAPIKeyOffsetFetch:     9,
+	crdv1beta1.KafkaAPIKeyFindCoordinator: 10,
+	crdv1beta1.KafkaAPIKeyJoinGroup:       11,
+	crdv1beta1.KafkaAPIKeyHeartbeat:       12,
+	crdv1beta1.KafkaAPIKeyLeaveGroup:      13,
+	crdv1beta1.KafkaAPIKeySyncGroup:       14,
+	crdv1beta1.KafkaAPIKeyApiVersions:     18,
+	crdv1beta1.KafkaAPIKeyCreateTopics:    19,
+	crdv1beta1.KafkaAPIKeyDeleteTopics:    20,
+}
+
 type scCmdRet struct {
 	Message string `json:"message"`
 	Return  string `json:"return"`
@@ -83,6 +117,8 @@ outputs:
             extended: yes
         - tls:
             extended: yes
+        - http2
+        - dns
   - eve-log:
       enabled: yes
       filetype: unix_stream
@@ -169,18 +205,43 @@ func NewReconciler() *Reconciler {
 	}
 }
 
-func generateTenantRulesData(policyName string, protoKeywords map[string]sets.Set[string]) *bytes.Buffer {
+func generateTenantRulesData(policyName string, protoKeywords map[string]sets.Set[string], ports string) *bytes.Buffer {
 	rulesData := bytes.NewBuffer(nil)
 	sid := 1
 
 	// Generate default reject rule.
 	allKeywords := fmt.Sprintf(`msg: "Reject by %s"; flow: to_server, established; sid: %d;`, policyName, sid)
 	rule := fmt.Sprintf("reject ip any any -> any any (%s)\n", allKeywords)
+	if _, ok := protoKeywords[protocolKafka]; ok {
+		// Kafka clients start each connection with an ApiVersions request. It must be neither rejected nor passed, as
+		// passing a request passes the whole connection, so it is excluded from the default reject rule and the
+		// connection is matched against the rules with its next request. As Kafka can only be used with TCP ports, the
+		// default reject rule is limited to the ports of the rule if they are known.
+		allKeywords = fmt.Sprintf(`msg: "Reject by %s"; flow: to_server, established; byte_test: 2, !=, %d, 4; sid: %d;`,
+			policyName, kafkaAPIKeys[crdv1beta1.KafkaAPIKeyApiVersions], sid)
+		if ports == "any" {
+			rule = fmt.Sprintf("reject ip any any -> any any (%s)\n", allKeywords)
+		} else {
+			rule = fmt.Sprintf("reject tcp any any -> any %s (%s)\n", ports, allKeywords)
+		}
+	}
 	rulesData.WriteString(rule)
 	sid++
+	// The default reject rule only applies to established flows, which doesn't cover the first DNS query of a UDP
+	// flow. Generate a reject rule for DNS queries explicitly if DNS is allowed by the rule.
+	if _, ok := protoKeywords[protocolDNS]; ok {
+		allKeywords = fmt.Sprintf(`msg: "Reject by %s"; flow: to_server; sid: %d;`, policyName, sid)
+		rule = fmt.Sprintf("reject dns any any -> any any (%s)\n", allKeywords)
+		rulesData.WriteString(rule)
+		sid++
+	}
 
 	// Generate rules.
 	for proto, keywordsSet := range protoKeywords {
+		ruleProto := proto
+		if p, ok := suricataRuleProtocols[proto]; ok {
+			ruleProto = p
+		}
 		for keywords := range keywordsSet {
 			// It is a convention that the sid is provided as the last keyword (or second-to-last if there is a rev)
 			// of a rule.
@@ -189,7 +250,7 @@ func generateTenantRulesData(policyName string, protoKeywords map[string]sets.Se
 			} else {
 				allKeywords = fmt.Sprintf(`msg: "Allow %s by %s"; sid: %d;`, proto, policyName, sid)
 			}
-			rule = fmt.Sprintf("pass %s any any -> any any (%s)\n", proto, allKeywords)
+			rule = fmt.Sprintf("pass %s any any -> any any (%s)\n", ruleProto, allKeywords)
 			rulesData.WriteString(rule)
 			sid++
 		}
@@ -238,17 +299,92 @@ func convertContent(content string) string {
 	return fmt.Sprintf(`content:"%s";%s%s`, content, startsWith, endsWith)
 }
 
+// escapePCRE escapes the characters of a regular expression which have a special meaning in the pcre keyword
+// of Suricata rules, i.e. the semicolon and the double quote which terminate the keyword, and the slash which
+// delimits the expression. They are replaced with equivalent escape sequences of PCRE.
+func escapePCRE(regex string) string {
+	var b strings.Builder
+	for i := 0; i < len(regex); i++ {
+		c := regex[i]
+		if c == '\\' && i+1 < len(regex) {
+			i++
+			c = regex[i]
+			if c != ';' && c != '"' {
+				b.WriteByte('\\')
+				b.WriteByte(c)
+				continue
+			}
+		}
+		switch c {
+		case ';':
+			b.WriteString(`\x3b`)
+		case '"':
+			b.WriteString(`\x22`)
+		case '/':
+			b.WriteString(`\/`)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+func convertPCRE(buffer, regex string) string {
+	return fmt.Sprintf(`%s; pcre:"/%s/";`, buffer, escapePCRE(regex))
+}
+
+// The http.uri buffer contains the normalized URI, including the query string if any. The whole path must
+// match the regular expression.
+func convertHTTPPathRegex(pathRegex string) string {
+	return convertPCRE("http.uri", fmt.Sprintf(`^(?:%s)(?:\?|$)`, pathRegex))
+}
+
+// Each line of the http.header buffer is "<name>: <value>\r\n", with the name of the header as sent by the
+// client. Header names are case-insensitive.
+func convertHTTPHeaderMatch(header v1beta.HTTPHeaderMatch) string {
+	regex := fmt.Sprintf(`(?:^|\r\n)(?i:%s):[ \t]*`, regexp.QuoteMeta(header.Name))
+	if header.Value != "" {
+		regex += regexp.QuoteMeta(header.Value) + `[ \t]*(?:\r\n|$)`
+	} else if header.Regex != "" {
+		regex += fmt.Sprintf(`(?:%s)[ \t]*(?:\r\n|$)`, header.Regex)
+	}
+	return convertPCRE("http.header", regex)
+}
+
+// The http.uri.raw buffer contains the URI as sent by the client, so query parameters are matched without
+// being decoded.
+func convertHTTPQueryParamMatch(param v1beta.HTTPQueryParamMatch) string {
+	regex := `[?&]` + regexp.QuoteMeta(param.Name)
+	if param.Value != "" {
+		regex += "=" + regexp.QuoteMeta(param.Value) + `(?:[&#]|$)`
+	} else if param.Regex != "" {
+		regex += fmt.Sprintf(`=(?:%s)(?:[&#]|$)`, param.Regex)
+	} else {
+		regex += `(?:[=&#]|$)`
+	}
+	return convertPCRE("http.uri.raw", regex)
+}
+
 func convertProtocolHTTP(http *v1beta.HTTPProtocol) string {
 	var keywords []string
 	if http.Path != "" {
 		keywords = append(keywords, fmt.Sprintf("http.uri; %s", convertContent(http.Path)))
 	}
+	if http.PathRegex != "" {
+		keywords = append(keywords, convertHTTPPathRegex(http.PathRegex))
+	}
 	if http.Method != "" {
 		keywords = append(keywords, fmt.Sprintf(`http.method; content:"%s";`, http.Method))
 	}
 	if http.Host != "" {
 		keywords = append(keywords, fmt.Sprintf("http.host; %s", convertContent(http.Host)))
 	}
+	for _, header := range http.Headers {
+		keywords = append(keywords, convertHTTPHeaderMatch(header))
+	}
+	for _, param := range http.QueryParams {
+		keywords = append(keywords, convertHTTPQueryParamMatch(param))
+	}
 	return strings.Join(keywords, " ")
 }
 
@@ -260,13 +396,176 @@ func convertProtocolTLS(tls *v1beta.TLSProtocol) string {
 	return strings.Join(keywords, " ")
 }
 
+// A gRPC request is an HTTP/2 request whose path is "/<service>/<method>" and whose content-type starts with
+// "application/grpc".
+func convertProtocolGRPC(grpc *v1beta.GRPCProtocol) string {
+	var keywords []string
+	switch {
+	case grpc.Service != "" && grpc.Method != "":
+		keywords = append(keywords, fmt.Sprintf(`http.uri; content:"/%s/%s"; startswith; endswith;`, grpc.Service, grpc.Method))
+	case grpc.Service != "":
+		keywords = append(keywords, fmt.Sprintf(`http.uri; content:"/%s/"; startswith;`, grpc.Service))
+	case grpc.Method != "":
+		keywords = append(keywords, fmt.Sprintf(`http.uri; content:"/%s"; endswith;`, grpc.Method))
+	}
+	keywords = append(keywords, `http.request_header; content:"content-type: application/grpc"; startswith;`)
+	return strings.Join(keywords, " ")
+}
+
+// kafkaTopicLayout describes where the topic name is in the requests of an API key whose versions are between
+// minVersion and maxVersion. keywords match the request body from the end of the client ID, which is the last field
+// of the request header, up to the topic name, which starts distance bytes after the last match. Only requests
+// containing a single topic are matched.
+type kafkaTopicLayout struct {
+	minVersion int
+	maxVersion int
+	keywords   []string
+	distance   int
+	// compact indicates the topic name is encoded as a compact string, used by flexible API versions.
+	compact bool
+}
+
+// kafkaTopicLayouts maps the Kafka API keys supporting topic matching to the layouts of their requests. Produce and
+// Fetch requests of version 13 and later refer to topics by IDs, so they can't be matched by topic.
+var kafkaTopicLayouts = map[crdv1beta1.KafkaAPIKey][]kafkaTopicLayout{
+	crdv1beta1.KafkaAPIKeyProduce: {
+		// The transactional ID, which is null, followed by acks, timeout and a topics array of length 1.
+		{minVersion: 3, maxVersion: 8, keywords: []string{
+			`content:"|ff ff|"; distance: 0; within: 2;`,
+			`content:"|00 00 00 01|"; distance: 6; within: 10;`,
+		}},
+		// The transactional ID, which is not null, followed by acks, timeout and a topics array of length 1.
+		{minVersion: 3, maxVersion: 8, keywords: []string{
+			`byte_jump: 2, 0, relative;`,
+			`content:"|00 00 00 01|"; distance: 6; within: 10;`,
+		}},
+		// The header tagged fields, which are empty, the transactional ID, which is null, followed by acks, timeout
+		// and a compact topics array of length 1.
+		{minVersion: 9, maxVersion: 12, compact: true, keywords: []string{
+			`content:"|00 00|"; distance: 0; within: 2;`,
+			`content:"|02|"; distance: 6; within: 7;`,
+		}},
+		// The header tagged fields, which are empty, the transactional ID, which is not null and at most 126 bytes
+		// long, followed by acks, timeout and a compact topics array of length 1.
+		{minVersion: 9, maxVersion: 12, compact: true, keywords: []string{
+			`content:"|00|"; distance: 0; within: 1;`,
+			`byte_test: 1, >, 0, 0, relative;`,
+			`byte_test: 1, <, 128, 0, relative;`,
+			`byte_jump: 1, 0, relative, post_offset -1;`,
+			`content:"|02|"; distance: 6; within: 7;`,
+		}},
+	},
+	crdv1beta1.KafkaAPIKeyFetch: {
+		// The replica ID, max wait, min bytes, max bytes and isolation level, followed by a topics array of length 1.
+		{minVersion: 4, maxVersion: 6, keywords: []string{
+			`content:"|00 00 00 01|"; distance: 17; within: 21;`,
+		}},
+		// The replica ID, max wait, min bytes, max bytes, isolation level, session ID and session epoch, followed by
+		// a topics array of length 1.
+		{minVersion: 7, maxVersion: 11, keywords: []string{
+			`content:"|00 00 00 01|"; distance: 25; within: 29;`,
+		}},
+		// The header tagged fields, which are empty, the replica ID, max wait, min bytes, max bytes, isolation level,
+		// session ID and session epoch, followed by a compact topics array of length 1.
+		{minVersion: 12, maxVersion: 12, compact: true, keywords: []string{
+			`content:"|00|"; distance: 0; within: 1;`,
+			`content:"|02|"; distance: 25; within: 26;`,
+		}},
+	},
+	crdv1beta1.KafkaAPIKeyMetadata: {
+		// A topics array of length 1.
+		{minVersion: 0, maxVersion: 8, keywords: []string{
+			`content:"|00 00 00 01|"; distance: 0; within: 4;`,
+		}},
+		// The header tagged fields, which are empty, followed by a compact topics array of length 1.
+		{minVersion: 9, maxVersion: 9, compact: true, keywords: []string{
+			`content:"|00 02|"; distance: 0; within: 2;`,
+		}},
+		// The header tagged fields, which are empty, followed by a compact topics array of length 1, whose topic name
+		// comes after the topic ID.
+		{minVersion: 10, maxVersion: 12, compact: true, distance: 16, keywords: []string{
+			`content:"|00 02|"; distance: 0; within: 2;`,
+		}},
+	},
+}
+
+// Suricata doesn't parse Kafka, so Kafka requests are matched on the raw TCP payload. A Kafka request starts with a
+// 4-byte length, the 2-byte API key, the 2-byte API version, the 4-byte correlation ID, and the client ID, which is a
+// string with a 2-byte length. A topic is matched by its position after the client ID, which depends on the API key and
+// version of the request, so a set of keywords is generated for each layout of the requests of the API key. Topic
+// names are encoded with a 2-byte length in the legacy encoding, or with an unsigned varint of length+1 in the compact
+// encoding.
+func convertProtocolKafka(kafka *v1beta.KafkaProtocol) []string {
+	keywords := []string{"flow: to_server, established;"}
+	if kafka.APIKey != "" {
+		keywords = append(keywords, fmt.Sprintf("byte_test: 2, =, %d, 4;", kafkaAPIKeys[kafka.APIKey]))
+	}
+	if kafka.Topic == "" {
+		return []string{strings.Join(keywords, " ")}
+	}
+	var allKeywords []string
+	for _, layout := range kafkaTopicLayouts[kafka.APIKey] {
+		var length []byte
+		if layout.compact {
+			length = binary.AppendUvarint(nil, uint64(len(kafka.Topic)+1))
+		} else {
+			length = binary.BigEndian.AppendUint16(nil, uint16(len(kafka.Topic)))
+		}
+		layoutKeywords := append(slices.Clone(keywords),
+			fmt.Sprintf("byte_test: 2, >=, %d, 6;", layout.minVersion),
+			fmt.Sprintf("byte_test: 2, <=, %d, 6;", layout.maxVersion),
+			// Skip the client ID.
+			"byte_jump: 2, 12;")
+		layoutKeywords = append(layoutKeywords, layout.keywords...)
+		layoutKeywords = append(layoutKeywords, fmt.Sprintf(`content:"|% x|%s"; distance: %d; within: %d;`,
+			length, kafka.Topic, layout.distance, layout.distance+len(length)+len(kafka.Topic)))
+		allKeywords = append(allKeywords, strings.Join(layoutKeywords, " "))
+	}
+	return allKeywords
+}
+
+// convertPorts converts the ports of a rule to the destination ports of Suricata rules. It returns "any" if the rule
+// doesn't restrict TCP ports, or if it uses named ports, which are resolved per Pod.
+func convertPorts(services []v1beta.Service) string {
+	var ports []string
+	for _, service := range services {
+		if service.Protocol != nil && *service.Protocol != v1beta.ProtocolTCP {
+			continue
+		}
+		if service.Port == nil || service.Port.Type == intstr.String {
+			return "any"
+		}
+		if service.EndPort != nil {
+			ports = append(ports, fmt.Sprintf("%d:%d", service.Port.IntVal, *service.EndPort))
+		} else {
+			ports = append(ports, fmt.Sprintf("%d", service.Port.IntVal))
+		}
+	}
+	switch len(ports) {
+	case 0:
+		return "any"
+	case 1:
+		return ports[0]
+	default:
+		return fmt.Sprintf("[%s]", strings.Join(ports, ","))
+	}
+}
+
+func convertProtocolDNS(dns *v1beta.DNSProtocol) string {
+	var keywords []string
+	if dns.QueryName != "" {
+		keywords = append(keywords, fmt.Sprintf("dns.query; %s", convertContent(dns.QueryName)))
+	}
+	return strings.Join(keywords, " ")
+}
+
 func (r *Reconciler) StartSuricataOnce() {
 	r.once.Do(func() {
 		r.startSuricata()
 	})
 }
 
-func (r *Reconciler) AddRule(ruleID, policyName string, vlanID uint32, l7Protocols []v1beta.L7Protocol) error {
+func (r *Reconciler) AddRule(ruleID, policyName string, vlanID uint32, l7Protocols []v1beta.L7Protocol, services []v1beta.Service) error {
 	start := time.Now()
 	defer func() {
 		klog.V(5).Infof("AddRule took %v", time.Since(start))
@@ -291,12 +590,33 @@ func (r *Reconciler) AddRule(ruleID, policyName string, vlanID uint32, l7Protoco
 			}
 			protoKeywords[protocolTLS].Insert(tlsKeywords)
 		}
+		if protocol.GRPC != nil {
+			grpcKeywords := convertProtocolGRPC(protocol.GRPC)
+			if _, ok := protoKeywords[protocolGRPC]; !ok {
+				protoKeywords[protocolGRPC] = sets.New[string]()
+			}
+			protoKeywords[protocolGRPC].Insert(grpcKeywords)
+		}
+		if protocol.Kafka != nil {
+			kafkaKeywords := convertProtocolKafka(protocol.Kafka)
+			if _, ok := protoKeywords[protocolKafka]; !ok {
+				protoKeywords[protocolKafka] = sets.New[string]()
+			}
+			protoKeywords[protocolKafka].Insert(kafkaKeywords...)
+		}
+		if protocol.DNS != nil {
+			dnsKeywords := convertProtocolDNS(protocol.DNS)
+			if _, ok := protoKeywords[protocolDNS]; !ok {
+				protoKeywords[protocolDNS] = sets.New[string]()
+			}
+			protoKeywords[protocolDNS].Insert(dnsKeywords)
+		}
 	}
 
 	klog.InfoS("Reconciling L7 rule", "RuleID", ruleID, "PolicyName", policyName)
 	// Write the Suricata rules to file.
 	rulesPath := generateTenantRulesPath(vlanID)
-	rulesData := generateTenantRulesData(policyName, protoKeywords)
+	rulesData := generateTenantRulesData(policyName, protoKeywords, convertPorts(services))
 	if err := writeConfigFile(rulesPath, rulesData); err != nil {
 		return fmt.Errorf("failed to write Suricata rules data to file %s for L7 rule %s of %s, err: %w", rulesPath, ruleID, policyName, err)
 	}
@@ -15,13 +15,16 @@
 package l7engine
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	v1beta "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
 )
 
 type fakeSuricata struct {
@@ -81,6 +84,49 @@ func TestConvertProtocolHTTP(t *testing.T) {
 			},
 			expected: `http.host; content:".foo.";`,
 		},
+		{
+			name: "with path regex",
+			http: &v1beta.HTTPProtocol{
+				Method:    "GET",
+				PathRegex: "/api/v[0-9]+/users/[^/;]+",
+			},
+			expected: `http.uri; pcre:"/^(?:\/api\/v[0-9]+\/users\/[^\/\x3b]+)(?:\?|$)/"; http.method; content:"GET";`,
+		},
+		{
+			name: "with header exact value and presence",
+			http: &v1beta.HTTPProtocol{
+				Path: "/internal/*",
+				Headers: []v1beta.HTTPHeaderMatch{
+					{Name: "X-Tenant-ID", Value: "tenant.a"},
+					{Name: "Authorization"},
+				},
+			},
+			expected: `http.uri; content:"/internal/"; startswith; ` +
+				`http.header; pcre:"/(?:^|\r\n)(?i:X-Tenant-ID):[ \t]*tenant\.a[ \t]*(?:\r\n|$)/"; ` +
+				`http.header; pcre:"/(?:^|\r\n)(?i:Authorization):[ \t]*/";`,
+		},
+		{
+			name: "with header regex",
+			http: &v1beta.HTTPProtocol{
+				Headers: []v1beta.HTTPHeaderMatch{
+					{Name: "User-Agent", Regex: `curl/\d+\.\d+ "test"`},
+				},
+			},
+			expected: `http.header; pcre:"/(?:^|\r\n)(?i:User-Agent):[ \t]*(?:curl\/\d+\.\d+ \x22test\x22)[ \t]*(?:\r\n|$)/";`,
+		},
+		{
+			name: "with query params",
+			http: &v1beta.HTTPProtocol{
+				QueryParams: []v1beta.HTTPQueryParamMatch{
+					{Name: "version", Value: "v1"},
+					{Name: "id", Regex: "[0-9]+"},
+					{Name: "debug"},
+				},
+			},
+			expected: `http.uri.raw; pcre:"/[?&]version=v1(?:[&#]|$)/"; ` +
+				`http.uri.raw; pcre:"/[?&]id=(?:[0-9]+)(?:[&#]|$)/"; ` +
+				`http.uri.raw; pcre:"/[?&]debug(?:[=&#]|$)/";`,
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -89,6 +135,25 @@ func TestConvertProtocolHTTP(t *testing.T) {
 	}
 }
 
+func TestEscapePCRE(t *testing.T) {
+	testCases := []struct {
+		regex    string
+		expected string
+	}{
+		{regex: `/a/b`, expected: `\/a\/b`},
+		{regex: `\/a`, expected: `\/a`},
+		{regex: `a;b\;c`, expected: `a\x3bb\x3bc`},
+		{regex: `"a\"`, expected: `\x22a\x22`},
+		{regex: `a\\;`, expected: `a\\\x3b`},
+		{regex: `\d+\.\w*`, expected: `\d+\.\w*`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.regex, func(t *testing.T) {
+			assert.Equal(t, tc.expected, escapePCRE(tc.regex))
+		})
+	}
+}
+
 func TestConvertProtocolTLS(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -115,6 +180,174 @@ func TestConvertProtocolTLS(t *testing.T) {
 	}
 }
 
+func TestConvertProtocolGRPC(t *testing.T) {
+	testCases := []struct {
+		name     string
+		grpc     *v1beta.GRPCProtocol
+		expected string
+	}{
+		{
+			name:     "without service,method",
+			grpc:     &v1beta.GRPCProtocol{},
+			expected: `http.request_header; content:"content-type: application/grpc"; startswith;`,
+		},
+		{
+			name: "with service,method",
+			grpc: &v1beta.GRPCProtocol{
+				Service: "helloworld.Greeter",
+				Method:  "SayHello",
+			},
+			expected: `http.uri; content:"/helloworld.Greeter/SayHello"; startswith; endswith; http.request_header; content:"content-type: application/grpc"; startswith;`,
+		},
+		{
+			name: "with service",
+			grpc: &v1beta.GRPCProtocol{
+				Service: "helloworld.Greeter",
+			},
+			expected: `http.uri; content:"/helloworld.Greeter/"; startswith; http.request_header; content:"content-type: application/grpc"; startswith;`,
+		},
+		{
+			name: "with method",
+			grpc: &v1beta.GRPCProtocol{
+				Method: "SayHello",
+			},
+			expected: `http.uri; content:"/SayHello"; endswith; http.request_header; content:"content-type: application/grpc"; startswith;`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, convertProtocolGRPC(tc.grpc))
+		})
+	}
+}
+
+func TestConvertProtocolKafka(t *testing.T) {
+	testCases := []struct {
+		name     string
+		kafka    *v1beta.KafkaProtocol
+		expected []string
+	}{
+		{
+			name: "with API key",
+			kafka: &v1beta.KafkaProtocol{
+				APIKey: crdv1beta1.KafkaAPIKeyFetch,
+			},
+			expected: []string{`flow: to_server, established; byte_test: 2, =, 1, 4;`},
+		},
+		{
+			name: "with API key,topic",
+			kafka: &v1beta.KafkaProtocol{
+				APIKey: crdv1beta1.KafkaAPIKeyMetadata,
+				Topic:  "orders",
+			},
+			expected: []string{
+				`flow: to_server, established; byte_test: 2, =, 3, 4; byte_test: 2, >=, 0, 6; byte_test: 2, <=, 8, 6; byte_jump: 2, 12; content:"|00 00 00 01|"; distance: 0; within: 4; content:"|00 06|orders"; distance: 0; within: 8;`,
+				`flow: to_server, established; byte_test: 2, =, 3, 4; byte_test: 2, >=, 9, 6; byte_test: 2, <=, 9, 6; byte_jump: 2, 12; content:"|00 02|"; distance: 0; within: 2; content:"|07|orders"; distance: 0; within: 7;`,
+				`flow: to_server, established; byte_test: 2, =, 3, 4; byte_test: 2, >=, 10, 6; byte_test: 2, <=, 12, 6; byte_jump: 2, 12; content:"|00 02|"; distance: 0; within: 2; content:"|07|orders"; distance: 16; within: 23;`,
+			},
+		},
+		{
+			name: "with produce API key,long topic",
+			kafka: &v1beta.KafkaProtocol{
+				APIKey: crdv1beta1.KafkaAPIKeyProduce,
+				Topic:  strings.Repeat("a", 200),
+			},
+			expected: []string{
+				`flow: to_server, established; byte_test: 2, =, 0, 4; byte_test: 2, >=, 3, 6; byte_test: 2, <=, 8, 6; byte_jump: 2, 12; content:"|ff ff|"; distance: 0; within: 2; content:"|00 00 00 01|"; distance: 6; within: 10; content:"|00 c8|` + strings.Repeat("a", 200) + `"; distance: 0; within: 202;`,
+				`flow: to_server, established; byte_test: 2, =, 0, 4; byte_test: 2, >=, 3, 6; byte_test: 2, <=, 8, 6; byte_jump: 2, 12; byte_jump: 2, 0, relative; content:"|00 00 00 01|"; distance: 6; within: 10; content:"|00 c8|` + strings.Repeat("a", 200) + `"; distance: 0; within: 202;`,
+				`flow: to_server, established; byte_test: 2, =, 0, 4; byte_test: 2, >=, 9, 6; byte_test: 2, <=, 12, 6; byte_jump: 2, 12; content:"|00 00|"; distance: 0; within: 2; content:"|02|"; distance: 6; within: 7; content:"|c9 01|` + strings.Repeat("a", 200) + `"; distance: 0; within: 202;`,
+				`flow: to_server, established; byte_test: 2, =, 0, 4; byte_test: 2, >=, 9, 6; byte_test: 2, <=, 12, 6; byte_jump: 2, 12; content:"|00|"; distance: 0; within: 1; byte_test: 1, >, 0, 0, relative; byte_test: 1, <, 128, 0, relative; byte_jump: 1, 0, relative, post_offset -1; content:"|02|"; distance: 6; within: 7; content:"|c9 01|` + strings.Repeat("a", 200) + `"; distance: 0; within: 202;`,
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, convertProtocolKafka(tc.kafka))
+		})
+	}
+}
+
+func TestConvertPorts(t *testing.T) {
+	protocolTCP := v1beta.ProtocolTCP
+	protocolUDP := v1beta.ProtocolUDP
+	port53 := intstr.FromInt(53)
+	port9092 := intstr.FromInt(9092)
+	portKafka := intstr.FromString("kafka")
+	endPort9094 := int32(9094)
+	testCases := []struct {
+		name     string
+		services []v1beta.Service
+		expected string
+	}{
+		{
+			name:     "without ports",
+			expected: "any",
+		},
+		{
+			name: "with port",
+			services: []v1beta.Service{
+				{Protocol: &protocolTCP, Port: &port9092},
+			},
+			expected: "9092",
+		},
+		{
+			name: "with port range and UDP port",
+			services: []v1beta.Service{
+				{Protocol: &protocolUDP, Port: &port53},
+				{Port: &port9092, EndPort: &endPort9094},
+				{Protocol: &protocolTCP, Port: &port53},
+			},
+			expected: "[9092:9094,53]",
+		},
+		{
+			name: "with named port",
+			services: []v1beta.Service{
+				{Protocol: &protocolTCP, Port: &port9092},
+				{Protocol: &protocolTCP, Port: &portKafka},
+			},
+			expected: "any",
+		},
+		{
+			name: "with protocol only",
+			services: []v1beta.Service{
+				{Protocol: &protocolTCP},
+			},
+			expected: "any",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, convertPorts(tc.services))
+		})
+	}
+}
+
+func TestConvertProtocolDNS(t *testing.T) {
+	testCases := []struct {
+		name     string
+		dns      *v1beta.DNSProtocol
+		expected string
+	}{
+		{
+			name:     "without query name",
+			dns:      &v1beta.DNSProtocol{},
+			expected: "",
+		},
+		{
+			name: "with query name suffix",
+			dns: &v1beta.DNSProtocol{
+				QueryName: "*.foo.com",
+			},
+			expected: `dns.query; content:".foo.com"; endswith;`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, convertProtocolDNS(tc.dns))
+		})
+	}
+}
+
 func TestStartSuricata(t *testing.T) {
 	defaultFS = afero.NewMemMapFs()
 	defer func() {
@@ -144,11 +377,16 @@ func TestRuleLifecycle(t *testing.T) {
 	ruleID := "123456"
 	vlanID := uint32(1)
 	policyName := "AntreaNetworkPolicy:test-l7"
+	protocolTCP := v1beta.ProtocolTCP
+	port9092 := intstr.FromInt(9092)
+	port9093 := intstr.FromInt(9093)
+	endPort9094 := int32(9094)
 
 	testCases := []struct {
 		name                 string
 		l7Protocols          []v1beta.L7Protocol
 		updatedL7Protocols   []v1beta.L7Protocol
+		services             []v1beta.Service
 		expectedRules        string
 		expectedUpdatedRules string
 	}{
@@ -171,6 +409,65 @@ func TestRuleLifecycle(t *testing.T) {
 			expectedRules:        `pass http any any -> any any (msg: "Allow http by AntreaNetworkPolicy:test-l7"; http.uri; content:"/index.html"; startswith; endswith; http.method; content:"GET"; http.host; content:"www.google.com"; startswith; endswith; sid: 2;)`,
 			expectedUpdatedRules: `pass http any any -> any any (msg: "Allow http by AntreaNetworkPolicy:test-l7"; sid: 2;)`,
 		},
+		{
+			name: "protocol DNS",
+			l7Protocols: []v1beta.L7Protocol{
+				{
+					DNS: &v1beta.DNSProtocol{
+						QueryName: "www.google.com",
+					},
+				},
+			},
+			updatedL7Protocols: []v1beta.L7Protocol{
+				{
+					DNS: &v1beta.DNSProtocol{},
+				},
+			},
+			expectedRules: `reject dns any any -> any any (msg: "Reject by AntreaNetworkPolicy:test-l7"; flow: to_server; sid: 2;)
+pass dns any any -> any any (msg: "Allow dns by AntreaNetworkPolicy:test-l7"; dns.query; content:"www.google.com"; startswith; endswith; sid: 3;)`,
+			expectedUpdatedRules: `pass dns any any -> any any (msg: "Allow dns by AntreaNetworkPolicy:test-l7"; sid: 3;)`,
+		},
+		{
+			name: "protocol Kafka",
+			l7Protocols: []v1beta.L7Protocol{
+				{
+					Kafka: &v1beta.KafkaProtocol{
+						APIKey: crdv1beta1.KafkaAPIKeyMetadata,
+					},
+				},
+			},
+			updatedL7Protocols: []v1beta.L7Protocol{
+				{
+					Kafka: &v1beta.KafkaProtocol{
+						APIKey: crdv1beta1.KafkaAPIKeyFetch,
+					},
+				},
+			},
+			services: []v1beta.Service{
+				{Protocol: &protocolTCP, Port: &port9092},
+				{Port: &port9093, EndPort: &endPort9094},
+			},
+			expectedRules: `reject tcp any any -> any [9092,9093:9094] (msg: "Reject by AntreaNetworkPolicy:test-l7"; flow: to_server, established; byte_test: 2, !=, 18, 4; sid: 1;)
+pass tcp any any -> any any (msg: "Allow kafka by AntreaNetworkPolicy:test-l7"; flow: to_server, established; byte_test: 2, =, 3, 4; sid: 2;)`,
+			expectedUpdatedRules: `pass tcp any any -> any any (msg: "Allow kafka by AntreaNetworkPolicy:test-l7"; flow: to_server, established; byte_test: 2, =, 1, 4; sid: 2;)`,
+		},
+		{
+			name: "protocol gRPC",
+			l7Protocols: []v1beta.L7Protocol{
+				{
+					GRPC: &v1beta.GRPCProtocol{
+						Service: "helloworld.Greeter",
+					},
+				},
+			},
+			updatedL7Protocols: []v1beta.L7Protocol{
+				{
+					GRPC: &v1beta.GRPCProtocol{},
+				},
+			},
+			expectedRules:        `pass http2 any any -> any any (msg: "Allow grpc by AntreaNetworkPolicy:test-l7"; http.uri; content:"/helloworld.Greeter/"; startswith; http.request_header; content:"content-type: application/grpc"; startswith; sid: 2;)`,
+			expectedUpdatedRules: `pass http2 any any -> any any (msg: "Allow grpc by AntreaNetworkPolicy:test-l7"; http.request_header; content:"content-type: application/grpc"; startswith; sid: 2;)`,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -189,7 +486,7 @@ func TestRuleLifecycle(t *testing.T) {
 			fe.startSuricataFn = fs.startSuricataFn
 
 			// Test add a L7 NetworkPolicy.
-			assert.NoError(t, fe.AddRule(ruleID, policyName, vlanID, tc.l7Protocols))
+			assert.NoError(t, fe.AddRule(ruleID, policyName, vlanID, tc.l7Protocols, tc.services))
 
 			rulesPath := generateTenantRulesPath(vlanID)
 			ok, err := afero.FileContainsBytes(defaultFS, rulesPath, []byte(tc.expectedRules))
@@ -206,7 +503,10 @@ func TestRuleLifecycle(t *testing.T) {
 			assert.Equal(t, expectedScCommands, fs.calledScCommands)
 
 			// Update the added L7 NetworkPolicy.
-			assert.NoError(t, fe.AddRule(ruleID, policyName, vlanID, tc.updatedL7Protocols))
+			assert.NoError(t, fe.AddRule(ruleID, policyName, vlanID, tc.updatedL7Protocols, tc.services))
+			ok, err = afero.FileContainsBytes(defaultFS, rulesPath, []byte(tc.expectedUpdatedRules))
+			assert.NoError(t, err)
+			assert.True(t, ok)
 			expectedScCommands.Insert("reload-tenant 1 /etc/suricata/antrea-tenant-1.yaml")
 			assert.Equal(t, expectedScCommands, fs.calledScCommands)
 
@@ -17,6 +17,7 @@ package networkpolicy
 import (
 	"context"
 	"fmt"
+	"math"
 	"net"
 	"reflect"
 	"sync"
@@ -24,11 +25,13 @@ import (
 
 	"antrea.io/ofnet/ofctrl"
 	"github.com/spf13/afero"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apimachinery/pkg/runtime/serializer/protobuf"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/util/workqueue"
@@ -46,8 +49,10 @@ import (
 	"antrea.io/antrea/pkg/agent/types"
 	"antrea.io/antrea/pkg/apis/controlplane/install"
 	"antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
 	"antrea.io/antrea/pkg/querier"
 	"antrea.io/antrea/pkg/util/channel"
+	"antrea.io/antrea/pkg/util/schedule"
 	utilwait "antrea.io/antrea/pkg/util/wait"
 )
 
@@ -73,7 +78,7 @@ const (
 )
 
 type L7RuleReconciler interface {
-	AddRule(ruleID, policyName string, vlanID uint32, l7Protocols []v1beta2.L7Protocol) error
+	AddRule(ruleID, policyName string, vlanID uint32, l7Protocols []v1beta2.L7Protocol, services []v1beta2.Service) error
 	DeleteRule(ruleID string, vlanID uint32) error
 }
 
@@ -140,6 +145,9 @@ type Controller struct {
 	l7RuleReconciler L7RuleReconciler
 	// l7VlanIDAllocator allocates a VLAN ID for every L7 rule.
 	l7VlanIDAllocator *l7VlanIDAllocator
+	// meterIDAllocator allocates an OVS meter ID for every rule with a rate limit. It's nil if OVS meters are not
+	// supported, in which case rate limits are ignored.
+	meterIDAllocator *meterIDAllocator
 	// ofClient registers packetin for Antrea Policy logging.
 	ofClient    openflow.Client
 	auditLogger *AuditLogger
@@ -235,6 +243,11 @@ func NewNetworkPolicyController(antreaClientGetter client.AntreaClientProvider,
 		if c.ofClient != nil {
 			c.ofClient.RegisterPacketInHandler(uint8(openflow.PacketInCategoryDNS), c.fqdnController)
 		}
+		if openflow.OVSMetersAreSupported() {
+			c.meterIDAllocator = newMeterIDAllocator()
+		} else {
+			klog.InfoS("OVS meters are not supported, rate limits of Antrea-native policy rules will be ignored")
+		}
 	}
 	c.podReconciler = newPodReconciler(ofClient, ifaceStore, idAllocator, c.fqdnController, groupCounters,
 		v4Enabled, v6Enabled, antreaPolicyEnabled, multicastEnabled)
@@ -590,6 +603,36 @@ func (c *Controller) GetRuleByFlowID(ruleFlowID uint32) *types.PolicyRule {
 	return rule
 }
 
+// SubscribeFQDNs makes the controller track the IPs of FQDNs for a subscriber other
+// than NetworkPolicy rules, e.g. an Egress with FQDN destinations. The IPs are
+// learned from the DNS responses received by the Pods of podOFPorts, and the FQDNs
+// are queried again when their records expire. handler is called when the IPs of
+// any of the FQDNs change, it must not block. subscriberID must not collide with
+// rule IDs, and calling it again with the same subscriberID replaces the FQDNs and
+// Pods of the subscriber.
+func (c *Controller) SubscribeFQDNs(subscriberID string, fqdns sets.Set[string], podOFPorts sets.Set[int32], handler func()) error {
+	if c.fqdnController == nil {
+		return fmt.Errorf("FQDNs can't be resolved when AntreaPolicy is disabled")
+	}
+	return c.fqdnController.addFQDNSubscriber(subscriberID, fqdns, podOFPorts, handler)
+}
+
+// UnsubscribeFQDNs removes the subscriber added by SubscribeFQDNs.
+func (c *Controller) UnsubscribeFQDNs(subscriberID string) error {
+	if c.fqdnController == nil {
+		return nil
+	}
+	return c.fqdnController.deleteFQDNSubscriber(subscriberID)
+}
+
+// GetFQDNIPs returns the IPs of the FQDNs tracked by the controller.
+func (c *Controller) GetFQDNIPs(fqdns []string) []net.IP {
+	if c.fqdnController == nil {
+		return nil
+	}
+	return c.fqdnController.getIPsForFQDNSelectors(fqdns)
+}
+
 func (c *Controller) GetControllerConnectionStatus() bool {
 	// When the watchers are connected, controller connection status is true. Otherwise, it is false.
 	return c.addressGroupWatcher.isConnected() && c.appliedToGroupWatcher.isConnected() && c.networkPolicyWatcher.isConnected()
@@ -762,28 +805,14 @@ func (c *Controller) syncRule(key string) error {
 	rule, effective, realizable := c.ruleCache.GetCompletedRule(key)
 	if !effective {
 		klog.V(2).InfoS("Rule was not effective, removing it", "ruleID", key)
-		// Uncertain whether this rule applies to a Node or Pod, but it's safe to delete it redundantly.
-		if err := c.podReconciler.Forget(key); err != nil {
+		if err := c.uninstallRule(key); err != nil {
 			return err
 		}
-		if c.nodeNetworkPolicyEnabled {
-			if err := c.nodeReconciler.Forget(key); err != nil {
-				return err
-			}
-		}
 		if c.statusManagerEnabled {
 			// We don't know whether this is a rule owned by Antrea Policy, but
 			// harmless to delete it.
 			c.statusManager.DeleteRuleRealization(key)
 		}
-		if c.l7NetworkPolicyEnabled {
-			if vlanID := c.l7VlanIDAllocator.query(key); vlanID != 0 {
-				if err := c.l7RuleReconciler.DeleteRule(key, vlanID); err != nil {
-					return err
-				}
-				c.l7VlanIDAllocator.release(key)
-			}
-		}
 		return nil
 	}
 	// If the rule is not realizable, we can simply skip it as it will be marked as dirty
@@ -793,6 +822,18 @@ func (c *Controller) syncRule(key string) error {
 		return nil
 	}
 
+	if !c.isRuleActive(rule) {
+		klog.V(2).InfoS("Rule is outside of its schedule, removing it", "ruleID", key)
+		if err := c.uninstallRule(key); err != nil {
+			return err
+		}
+		// The rule is realized as an inactive rule.
+		if c.statusManagerEnabled && v1beta2.IsSourceAntreaNativePolicy(rule.SourceRef) {
+			c.statusManager.SetRuleRealization(key, rule.PolicyUID)
+		}
+		return nil
+	}
+
 	isNodeNetworkPolicy := rule.isNodeNetworkPolicyRule()
 	if !c.nodeNetworkPolicyEnabled && isNodeNetworkPolicy {
 		klog.Warningf("Feature gate NodeNetworkPolicy is not enabled, skipping ruleID %s", key)
@@ -804,7 +845,13 @@ func (c *Controller) syncRule(key string) error {
 		vlanID := c.l7VlanIDAllocator.allocate(key)
 		rule.L7RuleVlanID = &vlanID
 
-		if err := c.l7RuleReconciler.AddRule(key, rule.SourceRef.ToString(), vlanID, rule.L7Protocols); err != nil {
+		if err := c.l7RuleReconciler.AddRule(key, rule.SourceRef.ToString(), vlanID, rule.L7Protocols, rule.Services); err != nil {
+			return err
+		}
+	}
+
+	if !isNodeNetworkPolicy {
+		if err := c.realizeRuleRateLimit(rule); err != nil {
 			return err
 		}
 	}
@@ -830,6 +877,118 @@ func (c *Controller) syncRule(key string) error {
 	return nil
 }
 
+// uninstallRule removes the realization of a rule from the reconcilers.
+func (c *Controller) uninstallRule(key string) error {
+	// Uncertain whether this rule applies to a Node or Pod, but it's safe to delete it redundantly.
+	if err := c.podReconciler.Forget(key); err != nil {
+		return err
+	}
+	if c.nodeNetworkPolicyEnabled {
+		if err := c.nodeReconciler.Forget(key); err != nil {
+			return err
+		}
+	}
+	if c.l7NetworkPolicyEnabled {
+		if vlanID := c.l7VlanIDAllocator.query(key); vlanID != 0 {
+			if err := c.l7RuleReconciler.DeleteRule(key, vlanID); err != nil {
+				return err
+			}
+			c.l7VlanIDAllocator.release(key)
+		}
+	}
+	if c.meterIDAllocator != nil {
+		if meterID := c.meterIDAllocator.query(key); meterID != 0 {
+			if err := c.ofClient.UninstallPolicyRuleMeter(meterID); err != nil {
+				return err
+			}
+			c.meterIDAllocator.release(key)
+		}
+	}
+	return nil
+}
+
+// realizeRuleRateLimit installs the OVS meter enforcing the rate limit of a rule and sets the meter ID in the rule, so
+// that the reconciler can apply the meter to the traffic allowed by the rule. The rate limit is ignored if OVS meters
+// are not supported.
+func (c *Controller) realizeRuleRateLimit(rule *CompletedRule) error {
+	if rule.RateLimit == nil {
+		return nil
+	}
+	if c.meterIDAllocator == nil {
+		klog.InfoS("OVS meters are not supported, ignoring rate limit of rule", "ruleID", rule.ID)
+		return nil
+	}
+	rate, burst, packetsPerSecond, err := parseRuleRateLimit(rule.RateLimit)
+	if err != nil {
+		// It should not happen as the rate limit has been validated by antrea-controller. Enforce the rule without
+		// the rate limit to avoid dropping the traffic allowed by the rule.
+		klog.ErrorS(err, "Invalid rate limit of rule, ignoring it", "ruleID", rule.ID)
+		return nil
+	}
+	meterID := c.meterIDAllocator.allocate(rule.ID)
+	if err := c.ofClient.InstallPolicyRuleMeter(meterID, rate, burst, packetsPerSecond); err != nil {
+		return err
+	}
+	rule.RateLimitMeterID = &meterID
+	return nil
+}
+
+// parseRuleRateLimit parses a v1beta2.RuleRateLimit to the rate and burst of an OVS meter. The rate and burst are in
+// kbps if packetsPerSecond is false, otherwise in pps. The burst defaults to the rate if not specified.
+func parseRuleRateLimit(rateLimit *v1beta2.RuleRateLimit) (rate, burst uint32, packetsPerSecond bool, err error) {
+	packetsPerSecond = rateLimit.Unit == crdv1beta1.RateLimitUnitPacketsPerSecond
+	toMeterValue := func(value string) (uint32, error) {
+		q, err := resource.ParseQuantity(value)
+		if err != nil {
+			return 0, err
+		}
+		v := q.Value()
+		if !packetsPerSecond {
+			// OVS meters take bit rates in kbps.
+			v = v / 1000
+		}
+		if v < 1 {
+			v = 1
+		} else if v > math.MaxUint32 {
+			v = math.MaxUint32
+		}
+		return uint32(v), nil
+	}
+	if rate, err = toMeterValue(rateLimit.Rate); err != nil {
+		return 0, 0, false, fmt.Errorf("invalid rate %q: %w", rateLimit.Rate, err)
+	}
+	burst = rate
+	if rateLimit.Burst != "" {
+		if burst, err = toMeterValue(rateLimit.Burst); err != nil {
+			return 0, 0, false, fmt.Errorf("invalid burst %q: %w", rateLimit.Burst, err)
+		}
+	}
+	return rate, burst, packetsPerSecond, nil
+}
+
+// isRuleActive returns whether a rule should be enforced at present according to its schedule. If the rule will be
+// activated or deactivated later, it's queued again at that time.
+func (c *Controller) isRuleActive(rule *CompletedRule) bool {
+	if rule.Schedule == nil {
+		return true
+	}
+	s, err := schedule.FromWindows(rule.Schedule.TimeZone, rule.Schedule.Windows, func(w v1beta2.ScheduleWindow) (string, string) {
+		return w.Start, w.Duration
+	})
+	if err != nil {
+		// It should not happen as the schedule has been validated by antrea-controller. Enforce the rule anyway to
+		// avoid opening a hole in the policy.
+		klog.ErrorS(err, "Invalid schedule of rule, enforcing it", "ruleID", rule.ID)
+		return true
+	}
+	now := time.Now()
+	active, next := s.State(now)
+	if !next.IsZero() {
+		c.queue.AddAfter(rule.ID, next.Sub(now))
+	}
+	return active
+}
+
 // syncRules calls the reconciler to sync all the rules after watchers complete full sync.
 // After flows for those init events are installed, subsequent rules will be handled asynchronously
 // by the syncRule() function.
@@ -848,6 +1007,11 @@ func (c *Controller) syncRules(keys []string) error {
 			klog.Infof("Rule %s is not effective on this Node", key)
 		} else if !realizable {
 			klog.Errorf("Rule %s is effective but not realizable", key)
+		} else if !c.isRuleActive(rule) {
+			klog.V(2).InfoS("Rule is outside of its schedule, skipping", "ruleID", key)
+			if c.statusManagerEnabled && v1beta2.IsSourceAntreaNativePolicy(rule.SourceRef) {
+				c.statusManager.SetRuleRealization(key, rule.PolicyUID)
+			}
 		} else {
 			isNodeNetworkPolicy := rule.isNodeNetworkPolicyRule()
 			if !c.nodeNetworkPolicyEnabled && isNodeNetworkPolicy {
@@ -859,13 +1023,16 @@ func (c *Controller) syncRules(keys []string) error {
 				vlanID := c.l7VlanIDAllocator.allocate(key)
 				rule.L7RuleVlanID = &vlanID
 
-				if err := c.l7RuleReconciler.AddRule(key, rule.SourceRef.ToString(), vlanID, rule.L7Protocols); err != nil {
+				if err := c.l7RuleReconciler.AddRule(key, rule.SourceRef.ToString(), vlanID, rule.L7Protocols, rule.Services); err != nil {
 					return err
 				}
 			}
 			if isNodeNetworkPolicy {
 				allNodeRules = append(allNodeRules, rule)
 			} else {
+				if err := c.realizeRuleRateLimit(rule); err != nil {
+					return err
+				}
 				allPodRules = append(allPodRules, rule)
 			}
 		}
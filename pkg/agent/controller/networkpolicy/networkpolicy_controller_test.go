@@ -122,6 +122,7 @@ type mockReconciler struct {
 	updated        chan string
 	deleted        chan string
 	fqdnController *fqdnController
+	rulesByFlowID  map[uint32]*agenttypes.PolicyRule
 }
 
 func newMockReconciler() *mockReconciler {
@@ -166,8 +167,9 @@ func (r *mockReconciler) RegisterFQDNController(fc *fqdnController) {
 	r.fqdnController = fc
 }
 
-func (r *mockReconciler) GetRuleByFlowID(_ uint32) (*agenttypes.PolicyRule, bool, error) {
-	return nil, false, nil
+func (r *mockReconciler) GetRuleByFlowID(ruleFlowID uint32) (*agenttypes.PolicyRule, bool, error) {
+	rule, exists := r.rulesByFlowID[ruleFlowID]
+	return rule, exists, nil
 }
 
 func (r *mockReconciler) getLastRealized(ruleID string) (*CompletedRule, bool) {
@@ -903,3 +905,105 @@ func TestValidate(t *testing.T) {
 		t.Fatalf("groupAddress %s expect %v, but got %v", groupAddress2, v1beta1.RuleActionDrop, item.RuleAction)
 	}
 }
+
+func TestIsRuleActive(t *testing.T) {
+	controller, _, _ := newTestController()
+	tests := []struct {
+		name     string
+		schedule *v1beta2.RuleSchedule
+		expected bool
+	}{
+		{
+			name:     "no schedule",
+			expected: true,
+		},
+		{
+			name: "inside window",
+			schedule: &v1beta2.RuleSchedule{
+				Windows: []v1beta2.ScheduleWindow{{Start: "* * * * *", Duration: "1h"}},
+			},
+			expected: true,
+		},
+		{
+			name: "outside window",
+			schedule: &v1beta2.RuleSchedule{
+				Windows: []v1beta2.ScheduleWindow{{Start: "0 0 30 2 *", Duration: "1h"}},
+			},
+			expected: false,
+		},
+		{
+			name: "invalid schedule",
+			schedule: &v1beta2.RuleSchedule{
+				Windows:  []v1beta2.ScheduleWindow{{Start: "0 0 30 2 *", Duration: "1h"}},
+				TimeZone: "Mars/Olympus_Mons",
+			},
+			expected: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &CompletedRule{rule: &rule{ID: "rule1", Schedule: tt.schedule}}
+			assert.Equal(t, tt.expected, controller.isRuleActive(r))
+		})
+	}
+}
+
+func TestParseRuleRateLimit(t *testing.T) {
+	tests := []struct {
+		name                     string
+		rateLimit                *v1beta2.RuleRateLimit
+		expectedRate             uint32
+		expectedBurst            uint32
+		expectedPacketsPerSecond bool
+		expectedErr              string
+	}{
+		{
+			name:          "bps without burst",
+			rateLimit:     &v1beta2.RuleRateLimit{Rate: "100M"},
+			expectedRate:  100000,
+			expectedBurst: 100000,
+		},
+		{
+			name:          "bps with burst",
+			rateLimit:     &v1beta2.RuleRateLimit{Rate: "1G", Burst: "200M", Unit: v1beta1.RateLimitUnitBitsPerSecond},
+			expectedRate:  1000000,
+			expectedBurst: 200000,
+		},
+		{
+			name:          "bps lower than 1 kbps",
+			rateLimit:     &v1beta2.RuleRateLimit{Rate: "500"},
+			expectedRate:  1,
+			expectedBurst: 1,
+		},
+		{
+			name:                     "pps",
+			rateLimit:                &v1beta2.RuleRateLimit{Rate: "10k", Burst: "500", Unit: v1beta1.RateLimitUnitPacketsPerSecond},
+			expectedRate:             10000,
+			expectedBurst:            500,
+			expectedPacketsPerSecond: true,
+		},
+		{
+			name:        "invalid rate",
+			rateLimit:   &v1beta2.RuleRateLimit{Rate: "100Mbps"},
+			expectedErr: "invalid rate \"100Mbps\"",
+		},
+		{
+			name:        "invalid burst",
+			rateLimit:   &v1beta2.RuleRateLimit{Rate: "100M", Burst: "x"},
+			expectedErr: "invalid burst \"x\"",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate, burst, packetsPerSecond, err := parseRuleRateLimit(tt.rateLimit)
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedRate, rate)
+			assert.Equal(t, tt.expectedBurst, burst)
+			assert.Equal(t, tt.expectedPacketsPerSecond, packetsPerSecond)
+		})
+	}
+}
@@ -706,6 +706,11 @@ func buildCoreIPTRules(ipProtocol iptables.Protocol,
 			Done().
 			GetRule())
 	}
+	// The packets matching a rule without target, i.e. a rule with the Audit action, are only logged and continue to
+	// be evaluated against the following rules.
+	if iptRuleTarget == "" {
+		return rules
+	}
 	rules = append(rules, builder.SetTarget(iptRuleTarget).
 		SetComment(iptRuleComment).
 		Done().
@@ -743,6 +748,9 @@ func buildServiceIPTRules(ipProtocol iptables.Protocol,
 				Done().
 				GetRule())
 		}
+		if ruleTarget == "" {
+			continue
+		}
 		rules = append(rules, copiedBuilder.SetTarget(ruleTarget).
 			Done().
 			GetRule())
@@ -750,6 +758,8 @@ func buildServiceIPTRules(ipProtocol iptables.Protocol,
 	return rules
 }
 
+// ruleActionToIPTTarget returns the iptables target of a rule action. Rules with the Audit action are not terminal, so
+// they have no target and only log the matching packets.
 func ruleActionToIPTTarget(ruleAction *secv1beta1.RuleAction) string {
 	var target string
 	switch *ruleAction {
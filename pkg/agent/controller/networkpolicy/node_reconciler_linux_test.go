@@ -32,6 +32,8 @@ import (
 
 var (
 	ruleActionAllow = secv1beta1.RuleActionAllow
+	ruleActionDrop  = secv1beta1.RuleActionDrop
+	ruleActionAudit = secv1beta1.RuleActionAudit
 
 	ipv4Net1 = newCIDR("192.168.1.0/24")
 	ipv6Net1 = newCIDR("fec0::192:168:1:0/124")
@@ -71,6 +73,8 @@ var (
 	ingressRuleID1 = "ingressRule1"
 	ingressRuleID2 = "ingressRule2"
 	ingressRuleID3 = "ingressRule3"
+	ingressRuleID4 = "ingressRule4"
+	ingressRuleID5 = "ingressRule5"
 	egressRuleID1  = "egressRule1"
 	egressRuleID2  = "egressRule2"
 	ingressRule1   = &CompletedRule{
@@ -129,6 +133,42 @@ var (
 		FromAddresses: nil,
 		ToAddresses:   nil,
 	}
+	ingressRule4 = &CompletedRule{
+		rule: &rule{
+			ID:             ingressRuleID4,
+			Name:           "ingress-rule-04",
+			PolicyName:     "ingress-policy",
+			Direction:      v1beta2.DirectionIn,
+			Services:       []v1beta2.Service{serviceTCP443},
+			Action:         &ruleActionAudit,
+			Priority:       1,
+			PolicyPriority: &policyPriority1,
+			TierPriority:   &tierPriority1,
+			SourceRef:      &cnp1,
+			EnableLogging:  true,
+			LogLabel:       "",
+		},
+		FromAddresses: addressGroup1,
+		ToAddresses:   nil,
+	}
+	ingressRule5 = &CompletedRule{
+		rule: &rule{
+			ID:             ingressRuleID5,
+			Name:           "ingress-rule-05",
+			PolicyName:     "ingress-policy",
+			Direction:      v1beta2.DirectionIn,
+			Services:       []v1beta2.Service{serviceTCP443},
+			Action:         &ruleActionDrop,
+			Priority:       2,
+			PolicyPriority: &policyPriority1,
+			TierPriority:   &tierPriority1,
+			SourceRef:      &cnp1,
+			EnableLogging:  false,
+			LogLabel:       "",
+		},
+		FromAddresses: addressGroup1,
+		ToAddresses:   nil,
+	}
 	ingressRule3WithFromAnyAddress        = ingressRule3
 	updatedIngressRule3WithOneFromAddress = &CompletedRule{
 		rule: &rule{
@@ -661,6 +701,42 @@ func TestNodeReconcilerReconcileAndForget(t *testing.T) {
 				ingressRuleID3,
 			},
 		},
+		{
+			name:        "IPv4, add an Audit ingress rule above a Drop ingress rule, then forget the Audit rule",
+			ipv4Enabled: true,
+			ipv6Enabled: false,
+			expectedCalls: func(mockRouteClient *routetest.MockInterfaceMockRecorder) {
+				coreRules4 := [][]string{
+					{
+						`-A ANTREA-POL-INGRESS-RULES -s 1.1.1.1/32 -p tcp --dport 443 -j LOG --log-prefix "Antrea:I:Audit:"`,
+					},
+				}
+				// The packets logged by the Audit rule are still dropped by the Drop rule.
+				coreRules45 := [][]string{
+					{
+						`-A ANTREA-POL-INGRESS-RULES -s 1.1.1.1/32 -p tcp --dport 443 -j LOG --log-prefix "Antrea:I:Audit:"`,
+						`-A ANTREA-POL-INGRESS-RULES -s 1.1.1.1/32 -p tcp --dport 443 -j DROP -m comment --comment "Antrea: for rule ingress-rule-05, policy AntreaClusterNetworkPolicy:name1"`,
+					},
+				}
+				coreRules5 := [][]string{
+					{
+						`-A ANTREA-POL-INGRESS-RULES -s 1.1.1.1/32 -p tcp --dport 443 -j DROP -m comment --comment "Antrea: for rule ingress-rule-05, policy AntreaClusterNetworkPolicy:name1"`,
+					},
+				}
+				gomock.InOrder(
+					mockRouteClient.AddOrUpdateNodeNetworkPolicyIPTables([]string{"ANTREA-POL-INGRESS-RULES"}, coreRules4, false),
+					mockRouteClient.AddOrUpdateNodeNetworkPolicyIPTables([]string{"ANTREA-POL-INGRESS-RULES"}, coreRules45, false),
+					mockRouteClient.AddOrUpdateNodeNetworkPolicyIPTables([]string{"ANTREA-POL-INGRESS-RULES"}, coreRules5, false),
+				)
+			},
+			rulesToAdd: []*CompletedRule{
+				ingressRule4,
+				ingressRule5,
+			},
+			rulesToForget: []string{
+				ingressRuleID4,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -571,19 +571,20 @@ func (r *podReconciler) computeOFRulesForAdd(rule *CompletedRule, ofPriority *ui
 				lastRealized.podOFPorts[svcKey] = ofPorts
 			}
 			ofRuleByServicesMap[svcKey] = &types.PolicyRule{
-				Direction:     v1beta2.DirectionIn,
-				From:          from,
-				To:            toAddresses,
-				Service:       filterUnresolvablePort(servicesMap[svcKey]),
-				L7Protocols:   rule.L7Protocols,
-				L7RuleVlanID:  rule.L7RuleVlanID,
-				Action:        rule.Action,
-				Name:          rule.Name,
-				Priority:      ofPriority,
-				TableID:       table,
-				PolicyRef:     rule.SourceRef,
-				EnableLogging: rule.EnableLogging,
-				LogLabel:      rule.LogLabel,
+				Direction:        v1beta2.DirectionIn,
+				From:             from,
+				To:               toAddresses,
+				Service:          filterUnresolvablePort(servicesMap[svcKey]),
+				L7Protocols:      rule.L7Protocols,
+				L7RuleVlanID:     rule.L7RuleVlanID,
+				RateLimitMeterID: rule.RateLimitMeterID,
+				Action:           rule.Action,
+				Name:             rule.Name,
+				Priority:         ofPriority,
+				TableID:          table,
+				PolicyRef:        rule.SourceRef,
+				EnableLogging:    rule.EnableLogging,
+				LogLabel:         rule.LogLabel,
 			}
 		}
 	} else {
@@ -602,19 +603,20 @@ func (r *podReconciler) computeOFRulesForAdd(rule *CompletedRule, ofPriority *ui
 		memberByServicesMap, servicesMap := groupMembersByServices(rule.Services, rule.ToAddresses)
 		for svcKey, members := range memberByServicesMap {
 			ofRuleByServicesMap[svcKey] = &types.PolicyRule{
-				Direction:     v1beta2.DirectionOut,
-				From:          from,
-				To:            groupMembersToOFAddresses(members),
-				Service:       filterUnresolvablePort(servicesMap[svcKey]),
-				L7Protocols:   rule.L7Protocols,
-				L7RuleVlanID:  rule.L7RuleVlanID,
-				Action:        rule.Action,
-				Priority:      ofPriority,
-				Name:          rule.Name,
-				TableID:       table,
-				PolicyRef:     rule.SourceRef,
-				EnableLogging: rule.EnableLogging,
-				LogLabel:      rule.LogLabel,
+				Direction:        v1beta2.DirectionOut,
+				From:             from,
+				To:               groupMembersToOFAddresses(members),
+				Service:          filterUnresolvablePort(servicesMap[svcKey]),
+				L7Protocols:      rule.L7Protocols,
+				L7RuleVlanID:     rule.L7RuleVlanID,
+				RateLimitMeterID: rule.RateLimitMeterID,
+				Action:           rule.Action,
+				Priority:         ofPriority,
+				Name:             rule.Name,
+				TableID:          table,
+				PolicyRef:        rule.SourceRef,
+				EnableLogging:    rule.EnableLogging,
+				LogLabel:         rule.LogLabel,
 			}
 		}
 
@@ -728,18 +730,19 @@ func (r *podReconciler) update(lastRealized *podPolicyLastRealized, newRule *Com
 		// Install a new Openflow rule if this group doesn't exist, otherwise do incremental update.
 		if !exists {
 			ofRule := &types.PolicyRule{
-				Direction:     v1beta2.DirectionIn,
-				To:            ofPortsToOFAddresses(newOFPorts),
-				Service:       newRule.Services,
-				L7Protocols:   newRule.L7Protocols,
-				L7RuleVlanID:  newRule.L7RuleVlanID,
-				Action:        newRule.Action,
-				Priority:      ofPriority,
-				FlowID:        ofID,
-				TableID:       table,
-				PolicyRef:     newRule.SourceRef,
-				EnableLogging: newRule.EnableLogging,
-				LogLabel:      newRule.LogLabel,
+				Direction:        v1beta2.DirectionIn,
+				To:               ofPortsToOFAddresses(newOFPorts),
+				Service:          newRule.Services,
+				L7Protocols:      newRule.L7Protocols,
+				L7RuleVlanID:     newRule.L7RuleVlanID,
+				RateLimitMeterID: newRule.RateLimitMeterID,
+				Action:           newRule.Action,
+				Priority:         ofPriority,
+				FlowID:           ofID,
+				TableID:          table,
+				PolicyRef:        newRule.SourceRef,
+				EnableLogging:    newRule.EnableLogging,
+				LogLabel:         newRule.LogLabel,
 			}
 			err := r.idAllocator.allocateForRule(ofRule)
 			if err != nil {
@@ -785,19 +788,20 @@ func (r *podReconciler) update(lastRealized *podPolicyLastRealized, newRule *Com
 			// Install a new Openflow rule if this group doesn't exist, otherwise do incremental update.
 			if !exists {
 				ofRule := &types.PolicyRule{
-					Direction:     v1beta2.DirectionIn,
-					From:          append(from1, from2...),
-					To:            toAddresses,
-					Service:       filterUnresolvablePort(servicesMap[svcKey]),
-					L7Protocols:   newRule.L7Protocols,
-					L7RuleVlanID:  newRule.L7RuleVlanID,
-					Action:        newRule.Action,
-					Priority:      ofPriority,
-					FlowID:        ofID,
-					TableID:       table,
-					PolicyRef:     newRule.SourceRef,
-					EnableLogging: newRule.EnableLogging,
-					LogLabel:      newRule.LogLabel,
+					Direction:        v1beta2.DirectionIn,
+					From:             append(from1, from2...),
+					To:               toAddresses,
+					Service:          filterUnresolvablePort(servicesMap[svcKey]),
+					L7Protocols:      newRule.L7Protocols,
+					L7RuleVlanID:     newRule.L7RuleVlanID,
+					RateLimitMeterID: newRule.RateLimitMeterID,
+					Action:           newRule.Action,
+					Priority:         ofPriority,
+					FlowID:           ofID,
+					TableID:          table,
+					PolicyRef:        newRule.SourceRef,
+					EnableLogging:    newRule.EnableLogging,
+					LogLabel:         newRule.LogLabel,
 				}
 				err := r.idAllocator.allocateForRule(ofRule)
 				if err != nil {
@@ -857,19 +861,20 @@ func (r *podReconciler) update(lastRealized *podPolicyLastRealized, newRule *Com
 			ofID, exists := lastRealized.ofIDs[svcKey]
 			if !exists {
 				ofRule := &types.PolicyRule{
-					Direction:     v1beta2.DirectionOut,
-					From:          from,
-					To:            groupMembersToOFAddresses(members),
-					Service:       filterUnresolvablePort(servicesMap[svcKey]),
-					L7Protocols:   newRule.L7Protocols,
-					L7RuleVlanID:  newRule.L7RuleVlanID,
-					Action:        newRule.Action,
-					Priority:      ofPriority,
-					FlowID:        ofID,
-					TableID:       table,
-					PolicyRef:     newRule.SourceRef,
-					EnableLogging: newRule.EnableLogging,
-					LogLabel:      newRule.LogLabel,
+					Direction:        v1beta2.DirectionOut,
+					From:             from,
+					To:               groupMembersToOFAddresses(members),
+					Service:          filterUnresolvablePort(servicesMap[svcKey]),
+					L7Protocols:      newRule.L7Protocols,
+					L7RuleVlanID:     newRule.L7RuleVlanID,
+					RateLimitMeterID: newRule.RateLimitMeterID,
+					Action:           newRule.Action,
+					Priority:         ofPriority,
+					FlowID:           ofID,
+					TableID:          table,
+					PolicyRef:        newRule.SourceRef,
+					EnableLogging:    newRule.EnableLogging,
+					LogLabel:         newRule.LogLabel,
 				}
 				// If the PolicyRule for the original services doesn't exist and IPBlocks is present, it means the
 				// podReconciler hasn't installed flows for IPBlocks, then it must be added to the new PolicyRule.
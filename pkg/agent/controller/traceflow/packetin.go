@@ -19,6 +19,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"strings"
 	"time"
 
 	"antrea.io/libOpenflow/openflow15"
@@ -30,7 +31,9 @@ import (
 	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
 
+	"antrea.io/antrea/pkg/agent/controller/networkpolicy/l7engine"
 	"antrea.io/antrea/pkg/agent/openflow"
+	cpv1beta "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
 	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
 	binding "antrea.io/antrea/pkg/ovs/openflow"
 )
@@ -224,6 +227,13 @@ func (c *Controller) parsePacketIn(pktIn *ofctrl.PacketIn) (*crdv1beta1.Traceflo
 				}
 			}
 			obs = append(obs, *ob)
+			if l7Ob := c.getL7EngineObservation(tf, egressInfo); l7Ob != nil {
+				obs = append(obs, *l7Ob)
+				// The packet rejected by the L7 engine is not forwarded any further.
+				if l7Ob.Action == crdv1beta1.ActionRejected {
+					return tf, c.newNodeResult(obs), capturedPacket, nil
+				}
+			}
 		}
 	}
 
@@ -243,6 +253,12 @@ func (c *Controller) parsePacketIn(pktIn *ofctrl.PacketIn) (*crdv1beta1.Traceflo
 			}
 		}
 		obs = append(obs, *ob)
+		if l7Ob := c.getL7EngineObservation(tf, ingressInfo); l7Ob != nil {
+			obs = append(obs, *l7Ob)
+			if l7Ob.Action == crdv1beta1.ActionRejected {
+				return tf, c.newNodeResult(obs), capturedPacket, nil
+			}
+		}
 	}
 
 	// Get drop table.
@@ -350,8 +366,11 @@ func (c *Controller) parsePacketIn(pktIn *ofctrl.PacketIn) (*crdv1beta1.Traceflo
 		obs = append(obs, *ob)
 	}
 
-	nodeResult := crdv1beta1.NodeResult{Node: c.nodeConfig.Name, Timestamp: time.Now().Unix(), Observations: obs}
-	return tf, &nodeResult, capturedPacket, nil
+	return tf, c.newNodeResult(obs), capturedPacket, nil
+}
+
+func (c *Controller) newNodeResult(obs []crdv1beta1.Observation) *crdv1beta1.NodeResult {
+	return &crdv1beta1.NodeResult{Node: c.nodeConfig.Name, Timestamp: time.Now().Unix(), Observations: obs}
 }
 
 func getMatchPktMarkField(matchers *ofctrl.Matchers) *ofctrl.MatchField {
@@ -465,6 +484,113 @@ func getNetworkPolicyObservation(tableID uint8, ingress bool) *crdv1beta1.Observ
 	return ob
 }
 
+// getL7EngineObservation returns the verdict of the L7 engine for the L7 payload of the Traceflow
+// packet, if the packet is allowed by an L7 NetworkPolicy rule. The packet is never inspected by
+// the L7 engine itself as it doesn't belong to an established connection, so the L7 payload is
+// evaluated against the L7 protocols of the rule in the same way as the L7 engine does. If it's
+// not allowed by any of them, but the rule has gRPC, Kafka or DNS protocols, or HTTP protocols
+// matching headers other than Host, which can't be evaluated against the payload, the verdict is
+// unknown: the observation has no action and lists these protocols in its L7Rule.
+func (c *Controller) getL7EngineObservation(tf *crdv1beta1.Traceflow, ruleFlowID uint32) *crdv1beta1.Observation {
+	payload := tf.Spec.Packet.L7Payload
+	if payload == nil {
+		return nil
+	}
+	ruleRef := c.networkPolicyQuerier.GetRuleByFlowID(ruleFlowID)
+	if ruleRef == nil || len(ruleRef.L7Protocols) == 0 {
+		return nil
+	}
+	ob := &crdv1beta1.Observation{
+		Component:         crdv1beta1.ComponentL7Engine,
+		Action:            crdv1beta1.ActionRejected,
+		NetworkPolicyRule: ruleRef.Name,
+	}
+	if ruleRef.PolicyRef != nil {
+		ob.NetworkPolicy = ruleRef.PolicyRef.ToString()
+	}
+	var matched *cpv1beta.L7Protocol
+	var unknown []*cpv1beta.L7Protocol
+	if payload.HTTP != nil {
+		method, path := payload.HTTP.Method, payload.HTTP.Path
+		if method == "" {
+			method = "GET"
+		}
+		if path == "" {
+			path = "/"
+		}
+		ob.ComponentInfo = "PredictedHTTP"
+		matched, unknown = l7engine.MatchHTTPRequest(ruleRef.L7Protocols, method, payload.HTTP.Host, path)
+	} else if payload.TLS != nil {
+		ob.ComponentInfo = "PredictedTLS"
+		matched = l7engine.MatchTLSClientHello(ruleRef.L7Protocols, payload.TLS.SNI)
+	}
+	if matched != nil {
+		ob.Action = crdv1beta1.ActionForwarded
+		ob.L7Rule = l7ProtocolToString(matched)
+	} else if unknown = append(unknown, l7engine.UnsupportedL7Protocols(ruleRef.L7Protocols)...); len(unknown) > 0 {
+		ob.Action = ""
+		l7Rules := make([]string, 0, len(unknown))
+		for _, l7Protocol := range unknown {
+			l7Rules = append(l7Rules, l7ProtocolToString(l7Protocol))
+		}
+		ob.L7Rule = strings.Join(l7Rules, " ")
+	}
+	return ob
+}
+
+// l7ProtocolToString formats an L7 protocol of a NetworkPolicy rule, e.g. "http(method=GET,path=/api/*)".
+func l7ProtocolToString(l7Protocol *cpv1beta.L7Protocol) string {
+	var protocol string
+	var fields []string
+	addField := func(name, value string) {
+		if value != "" {
+			fields = append(fields, name+"="+value)
+		}
+	}
+	// A value match is rendered as "name=value", a regex match as "name~regex" and a presence match as "name".
+	addMatch := func(name, value, regex string) {
+		switch {
+		case value != "":
+			fields = append(fields, name+"="+value)
+		case regex != "":
+			fields = append(fields, name+"~"+regex)
+		default:
+			fields = append(fields, name)
+		}
+	}
+	if http := l7Protocol.HTTP; http != nil {
+		protocol = "http"
+		addField("host", http.Host)
+		addField("method", http.Method)
+		addField("path", http.Path)
+		addField("pathRegex", http.PathRegex)
+		for _, h := range http.Headers {
+			addMatch("header:"+h.Name, h.Value, h.Regex)
+		}
+		for _, q := range http.QueryParams {
+			addMatch("query:"+q.Name, q.Value, q.Regex)
+		}
+	} else if tls := l7Protocol.TLS; tls != nil {
+		protocol = "tls"
+		addField("sni", tls.SNI)
+	} else if grpc := l7Protocol.GRPC; grpc != nil {
+		protocol = "grpc"
+		addField("service", grpc.Service)
+		addField("method", grpc.Method)
+	} else if kafka := l7Protocol.Kafka; kafka != nil {
+		protocol = "kafka"
+		addField("apiKey", string(kafka.APIKey))
+		addField("topic", kafka.Topic)
+	} else if dns := l7Protocol.DNS; dns != nil {
+		protocol = "dns"
+		addField("queryName", dns.QueryName)
+	}
+	if len(fields) == 0 {
+		return protocol
+	}
+	return fmt.Sprintf("%s(%s)", protocol, strings.Join(fields, ","))
+}
+
 func isValidCtNw(ipStr string) bool {
 	ip := net.ParseIP(ipStr)
 	if ip == nil {
@@ -618,6 +618,289 @@ func TestParsePacketIn(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:       "HTTP request at destination Node allowed by L7 rule",
+			nodeConfig: &config.NodeConfig{},
+			tfState: &traceflowState{
+				name: "traceflow-pod-to-pod",
+				tag:  1,
+			},
+			pktIn: &ofctrl.PacketIn{
+				PacketIn: &openflow15.PacketIn{
+					TableId: openflow.IngressRuleTable.GetID(),
+					Match: openflow15.Match{
+						Fields: []openflow15.MatchField{*matchTFIngressConjID},
+					},
+					Data: util.NewBuffer(pktBytesPodToPod),
+				},
+			},
+			expectedCalls: func(npQuerier *queriertest.MockAgentNetworkPolicyInfoQuerier, egressQuerier *queriertest.MockEgressQuerier) {
+				npQuerier.EXPECT().GetNetworkPolicyByRuleFlowID(uint32(1)).Return(
+					&v1beta2.NetworkPolicyReference{
+						Type: v1beta2.AntreaClusterNetworkPolicy,
+						Name: "acnp-l7",
+					},
+				)
+				npQuerier.EXPECT().GetRuleByFlowID(uint32(1)).Return(
+					&types.PolicyRule{
+						Name: "ingress-l7-rule",
+						PolicyRef: &v1beta2.NetworkPolicyReference{
+							Type: v1beta2.AntreaClusterNetworkPolicy,
+							Name: "acnp-l7",
+						},
+						L7Protocols: []v1beta2.L7Protocol{
+							{HTTP: &v1beta2.HTTPProtocol{Method: "POST"}},
+							{HTTP: &v1beta2.HTTPProtocol{Method: "GET", Path: "/api/*"}},
+						},
+					},
+				).Times(2)
+			},
+			expectedTf: &crdv1beta1.Traceflow{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "traceflow-pod-to-pod",
+				},
+				Spec: crdv1beta1.TraceflowSpec{
+					Source: crdv1beta1.Source{
+						Namespace: pod1.Namespace,
+						Pod:       pod1.Name,
+					},
+					Destination: crdv1beta1.Destination{
+						Namespace: pod2.Namespace,
+						Pod:       pod2.Name,
+					},
+					Packet: crdv1beta1.Packet{
+						TransportHeader: crdv1beta1.TransportHeader{
+							TCP: &crdv1beta1.TCPHeader{DstPort: 80},
+						},
+						L7Payload: &crdv1beta1.L7Payload{
+							HTTP: &crdv1beta1.HTTPRequest{Host: "www.foo.com", Path: "/api/v2/pods"},
+						},
+					},
+				},
+				Status: crdv1beta1.TraceflowStatus{
+					Phase:        crdv1beta1.Running,
+					DataplaneTag: 1,
+				},
+			},
+			expectedNodeResult: &crdv1beta1.NodeResult{
+				Observations: []crdv1beta1.Observation{
+					{
+						Component: crdv1beta1.ComponentForwarding,
+						Action:    crdv1beta1.ActionReceived,
+					},
+					{
+						Component:         crdv1beta1.ComponentNetworkPolicy,
+						ComponentInfo:     openflow.IngressRuleTable.GetName(),
+						Action:            crdv1beta1.ActionForwarded,
+						NetworkPolicy:     string(v1beta2.AntreaClusterNetworkPolicy) + ":acnp-l7",
+						NetworkPolicyRule: "ingress-l7-rule",
+					},
+					{
+						Component:         crdv1beta1.ComponentL7Engine,
+						ComponentInfo:     "PredictedHTTP",
+						Action:            crdv1beta1.ActionForwarded,
+						NetworkPolicy:     string(v1beta2.AntreaClusterNetworkPolicy) + ":acnp-l7",
+						NetworkPolicyRule: "ingress-l7-rule",
+						L7Rule:            "http(method=GET,path=/api/*)",
+					},
+				},
+			},
+		},
+		{
+			name: "TLS ClientHello at destination Node rejected by L7 rule",
+			networkConfig: &config.NetworkConfig{
+				TrafficEncapMode: 0,
+			},
+			nodeConfig: &config.NodeConfig{
+				TunnelOFPort: 3,
+				GatewayConfig: &config.GatewayConfig{
+					OFPort: 1,
+				},
+			},
+			tfState: &traceflowState{
+				name: "traceflow-pod-to-pod",
+				tag:  1,
+			},
+			pktIn: &ofctrl.PacketIn{
+				PacketIn: &openflow15.PacketIn{
+					TableId: openflow.OutputTable.GetID(),
+					Match: openflow15.Match{
+						Fields: []openflow15.MatchField{*matchTFIngressConjID, *matchOutPort},
+					},
+					Data: util.NewBuffer(pktBytesPodToPod),
+				},
+			},
+			expectedCalls: func(npQuerier *queriertest.MockAgentNetworkPolicyInfoQuerier, egressQuerier *queriertest.MockEgressQuerier) {
+				npQuerier.EXPECT().GetNetworkPolicyByRuleFlowID(uint32(1)).Return(
+					&v1beta2.NetworkPolicyReference{
+						Type: v1beta2.AntreaClusterNetworkPolicy,
+						Name: "acnp-l7",
+					},
+				)
+				npQuerier.EXPECT().GetRuleByFlowID(uint32(1)).Return(
+					&types.PolicyRule{
+						Name: "ingress-l7-rule",
+						PolicyRef: &v1beta2.NetworkPolicyReference{
+							Type: v1beta2.AntreaClusterNetworkPolicy,
+							Name: "acnp-l7",
+						},
+						L7Protocols: []v1beta2.L7Protocol{
+							{TLS: &v1beta2.TLSProtocol{SNI: "*.foo.com"}},
+						},
+					},
+				).Times(2)
+			},
+			expectedTf: &crdv1beta1.Traceflow{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "traceflow-pod-to-pod",
+				},
+				Spec: crdv1beta1.TraceflowSpec{
+					Source: crdv1beta1.Source{
+						Namespace: pod1.Namespace,
+						Pod:       pod1.Name,
+					},
+					Destination: crdv1beta1.Destination{
+						Namespace: pod2.Namespace,
+						Pod:       pod2.Name,
+					},
+					Packet: crdv1beta1.Packet{
+						TransportHeader: crdv1beta1.TransportHeader{
+							TCP: &crdv1beta1.TCPHeader{DstPort: 80},
+						},
+						L7Payload: &crdv1beta1.L7Payload{
+							TLS: &crdv1beta1.TLSClientHello{SNI: "www.bar.com"},
+						},
+					},
+				},
+				Status: crdv1beta1.TraceflowStatus{
+					Phase:        crdv1beta1.Running,
+					DataplaneTag: 1,
+				},
+			},
+			expectedNodeResult: &crdv1beta1.NodeResult{
+				Observations: []crdv1beta1.Observation{
+					{
+						Component: crdv1beta1.ComponentForwarding,
+						Action:    crdv1beta1.ActionReceived,
+					},
+					{
+						Component:         crdv1beta1.ComponentNetworkPolicy,
+						ComponentInfo:     openflow.IngressRuleTable.GetName(),
+						Action:            crdv1beta1.ActionForwarded,
+						NetworkPolicy:     string(v1beta2.AntreaClusterNetworkPolicy) + ":acnp-l7",
+						NetworkPolicyRule: "ingress-l7-rule",
+					},
+					{
+						Component:         crdv1beta1.ComponentL7Engine,
+						ComponentInfo:     "PredictedTLS",
+						Action:            crdv1beta1.ActionRejected,
+						NetworkPolicy:     string(v1beta2.AntreaClusterNetworkPolicy) + ":acnp-l7",
+						NetworkPolicyRule: "ingress-l7-rule",
+					},
+				},
+			},
+		},
+		{
+			name: "HTTP request at destination Node with unknown L7 verdict",
+			networkConfig: &config.NetworkConfig{
+				TrafficEncapMode: 0,
+			},
+			nodeConfig: &config.NodeConfig{
+				TunnelOFPort: 3,
+				GatewayConfig: &config.GatewayConfig{
+					OFPort: 1,
+				},
+			},
+			tfState: &traceflowState{
+				name: "traceflow-pod-to-pod",
+				tag:  1,
+			},
+			pktIn: &ofctrl.PacketIn{
+				PacketIn: &openflow15.PacketIn{
+					TableId: openflow.OutputTable.GetID(),
+					Match: openflow15.Match{
+						Fields: []openflow15.MatchField{*matchTFIngressConjID, *matchOutPort},
+					},
+					Data: util.NewBuffer(pktBytesPodToPod),
+				},
+			},
+			expectedCalls: func(npQuerier *queriertest.MockAgentNetworkPolicyInfoQuerier, egressQuerier *queriertest.MockEgressQuerier) {
+				npQuerier.EXPECT().GetNetworkPolicyByRuleFlowID(uint32(1)).Return(
+					&v1beta2.NetworkPolicyReference{
+						Type: v1beta2.AntreaClusterNetworkPolicy,
+						Name: "acnp-l7",
+					},
+				)
+				npQuerier.EXPECT().GetRuleByFlowID(uint32(1)).Return(
+					&types.PolicyRule{
+						Name: "ingress-l7-rule",
+						PolicyRef: &v1beta2.NetworkPolicyReference{
+							Type: v1beta2.AntreaClusterNetworkPolicy,
+							Name: "acnp-l7",
+						},
+						L7Protocols: []v1beta2.L7Protocol{
+							{HTTP: &v1beta2.HTTPProtocol{Method: "POST"}},
+							{HTTP: &v1beta2.HTTPProtocol{Headers: []v1beta2.HTTPHeaderMatch{{Name: "X-Tenant-ID", Value: "a"}}}},
+							{GRPC: &v1beta2.GRPCProtocol{Service: "helloworld.Greeter"}},
+						},
+					},
+				).Times(2)
+			},
+			expectedTf: &crdv1beta1.Traceflow{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "traceflow-pod-to-pod",
+				},
+				Spec: crdv1beta1.TraceflowSpec{
+					Source: crdv1beta1.Source{
+						Namespace: pod1.Namespace,
+						Pod:       pod1.Name,
+					},
+					Destination: crdv1beta1.Destination{
+						Namespace: pod2.Namespace,
+						Pod:       pod2.Name,
+					},
+					Packet: crdv1beta1.Packet{
+						TransportHeader: crdv1beta1.TransportHeader{
+							TCP: &crdv1beta1.TCPHeader{DstPort: 80},
+						},
+						L7Payload: &crdv1beta1.L7Payload{
+							HTTP: &crdv1beta1.HTTPRequest{Path: "/helloworld.Greeter/SayHello"},
+						},
+					},
+				},
+				Status: crdv1beta1.TraceflowStatus{
+					Phase:        crdv1beta1.Running,
+					DataplaneTag: 1,
+				},
+			},
+			expectedNodeResult: &crdv1beta1.NodeResult{
+				Observations: []crdv1beta1.Observation{
+					{
+						Component: crdv1beta1.ComponentForwarding,
+						Action:    crdv1beta1.ActionReceived,
+					},
+					{
+						Component:         crdv1beta1.ComponentNetworkPolicy,
+						ComponentInfo:     openflow.IngressRuleTable.GetName(),
+						Action:            crdv1beta1.ActionForwarded,
+						NetworkPolicy:     string(v1beta2.AntreaClusterNetworkPolicy) + ":acnp-l7",
+						NetworkPolicyRule: "ingress-l7-rule",
+					},
+					{
+						Component:         crdv1beta1.ComponentL7Engine,
+						ComponentInfo:     "PredictedHTTP",
+						NetworkPolicy:     string(v1beta2.AntreaClusterNetworkPolicy) + ":acnp-l7",
+						NetworkPolicyRule: "ingress-l7-rule",
+						L7Rule:            "http(header:X-Tenant-ID=a) grpc(service=helloworld.Greeter)",
+					},
+					{
+						Component:     crdv1beta1.ComponentForwarding,
+						ComponentInfo: openflow.OutputTable.GetName(),
+						Action:        crdv1beta1.ActionDelivered,
+					},
+				},
+			},
+		},
 		{
 			name:       "packet at source Node dropped by acnp egress rule",
 			nodeConfig: &config.NodeConfig{},
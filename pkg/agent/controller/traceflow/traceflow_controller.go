@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"strconv"
 	"sync"
 	"time"
 
@@ -265,6 +266,11 @@ func (c *Controller) syncTraceflow(traceflowName string) error {
 
 	switch tf.Status.Phase {
 	case crdv1beta1.Running:
+		if tf.Spec.MultiPath || tf.Spec.Schedule != nil {
+			// A multi-path or recurring Traceflow is traced by the Traceflows created for
+			// each Service endpoint or run.
+			break
+		}
 		if tf.Status.DataplaneTag != 0 {
 			start := false
 			c.runningTraceflowsMutex.Lock()
@@ -359,6 +365,17 @@ func (c *Controller) startTraceflow(tf *crdv1beta1.Traceflow) error {
 	if err != nil {
 		return err
 	}
+	// The Traceflow packet sent to a Service is load-balanced on the sender Node, where it must
+	// be sent to the endpoint probed by the Traceflow if any.
+	if endpoint, ok := tf.Annotations[crdv1beta1.TraceflowEndpointAnnotationKey]; ok && isSender && !liveTraffic {
+		endpointIP, endpointPort, err := parseTraceflowEndpoint(endpoint)
+		if err != nil {
+			return err
+		}
+		if err = c.ofClient.InstallTraceflowServiceEndpointFlows(uint8(tfState.tag), packet, endpointIP, endpointPort, uint16(timeout)); err != nil {
+			return err
+		}
+	}
 
 	// Skip packet injection if the source Pod is not found on the local Node.
 	if !liveTraffic && isSender {
@@ -378,6 +395,24 @@ func (c *Controller) startTraceflow(tf *crdv1beta1.Traceflow) error {
 	return err
 }
 
+// parseTraceflowEndpoint parses the value of the annotation of a Traceflow probing an endpoint of
+// its destination Service.
+func parseTraceflowEndpoint(endpoint string) (net.IP, uint16, error) {
+	host, port, err := net.SplitHostPort(endpoint)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid Service endpoint %s: %w", endpoint, err)
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return nil, 0, fmt.Errorf("invalid Service endpoint IP %s", host)
+	}
+	portNum, err := strconv.ParseUint(port, 10, 16)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid Service endpoint port %s: %w", port, err)
+	}
+	return ip, uint16(portNum), nil
+}
+
 func (c *Controller) validateTraceflow(tf *crdv1beta1.Traceflow) error {
 	if tf.Spec.Destination.Service != "" && !c.enableAntreaProxy {
 		return errors.New("using Service destination requires AntreaProxy enabled")
@@ -695,6 +695,73 @@ func TestStartTraceflow(t *testing.T) {
 				}, ofPortPod1, int32(-1))
 			},
 		},
+		{
+			name: "Pod-to-Service traceflow to an endpoint",
+			tf: &crdv1beta1.Traceflow{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "tf3",
+					UID:         "uid3",
+					Annotations: map[string]string{crdv1beta1.TraceflowEndpointAnnotationKey: "192.168.12.1:8080"},
+				},
+				Spec: crdv1beta1.TraceflowSpec{
+					Source: crdv1beta1.Source{
+						Namespace: pod1.Namespace,
+						Pod:       pod1.Name,
+					},
+					Destination: crdv1beta1.Destination{
+						Namespace: svc1.Namespace,
+						Service:   svc1.Name,
+					},
+				},
+				Status: crdv1beta1.TraceflowStatus{
+					Phase:        crdv1beta1.Running,
+					DataplaneTag: 1,
+				},
+			},
+			expectedCalls: func(mockOFClient *openflowtest.MockClient) {
+				packet := &binding.Packet{
+					SourceIP:        net.ParseIP(pod1IPv4),
+					SourceMAC:       pod1MAC,
+					DestinationIP:   net.ParseIP(svc1IPv4).To4(),
+					DestinationPort: 80,
+					IPProto:         protocol.Type_TCP,
+					TTL:             64,
+					TCPFlags:        uint8(2),
+				}
+				mockOFClient.EXPECT().InstallTraceflowFlows(uint8(1), false, false, false, nil, ofPortPod1, uint16(crdv1beta1.DefaultTraceflowTimeout))
+				mockOFClient.EXPECT().InstallTraceflowServiceEndpointFlows(uint8(1), packet, net.ParseIP("192.168.12.1"), uint16(8080), uint16(crdv1beta1.DefaultTraceflowTimeout))
+				mockOFClient.EXPECT().SendTraceflowPacket(uint8(1), packet, ofPortPod1, int32(-1))
+			},
+		},
+		{
+			name: "Pod-to-Service traceflow to an invalid endpoint",
+			tf: &crdv1beta1.Traceflow{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "tf4",
+					UID:         "uid4",
+					Annotations: map[string]string{crdv1beta1.TraceflowEndpointAnnotationKey: "192.168.12.1"},
+				},
+				Spec: crdv1beta1.TraceflowSpec{
+					Source: crdv1beta1.Source{
+						Namespace: pod1.Namespace,
+						Pod:       pod1.Name,
+					},
+					Destination: crdv1beta1.Destination{
+						Namespace: svc1.Namespace,
+						Service:   svc1.Name,
+					},
+				},
+				Status: crdv1beta1.TraceflowStatus{
+					Phase:        crdv1beta1.Running,
+					DataplaneTag: 1,
+				},
+			},
+			expectedCalls: func(mockOFClient *openflowtest.MockClient) {
+				mockOFClient.EXPECT().InstallTraceflowFlows(uint8(1), false, false, false, nil, ofPortPod1, uint16(crdv1beta1.DefaultTraceflowTimeout))
+				mockOFClient.EXPECT().UninstallTraceflowFlows(uint8(1))
+			},
+			expectedErr: "invalid Service endpoint 192.168.12.1",
+		},
 		{
 			name: "live traceflow receive only",
 			tf: &crdv1beta1.Traceflow{
@@ -720,6 +787,10 @@ func TestStartTraceflow(t *testing.T) {
 	for _, tt := range tcs {
 		t.Run(tt.name, func(t *testing.T) {
 			tfc := newFakeTraceflowController(t, []runtime.Object{tt.tf}, nil, tt.nodeConfig)
+			stopCh := make(chan struct{})
+			defer close(stopCh)
+			tfc.informerFactory.Start(stopCh)
+			tfc.informerFactory.WaitForCacheSync(stopCh)
 			if tt.expectedCalls != nil {
 				tt.expectedCalls(tfc.mockOFClient)
 			}
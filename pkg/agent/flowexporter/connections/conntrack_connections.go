@@ -29,6 +29,8 @@ import (
 	"antrea.io/antrea/pkg/agent/metrics"
 	"antrea.io/antrea/pkg/agent/openflow"
 	"antrea.io/antrea/pkg/agent/proxy"
+	"antrea.io/antrea/pkg/agent/types"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
 	"antrea.io/antrea/pkg/querier"
 	"antrea.io/antrea/pkg/util/podstore"
 )
@@ -213,7 +215,7 @@ func (cs *ConntrackConnectionStore) addNetworkPolicyMetadata(conn *flowexporter.
 				conn.IngressNetworkPolicyNamespace = policy.Namespace
 				conn.IngressNetworkPolicyType = flowexporter.PolicyTypeToUint8(policy.Type)
 				conn.IngressNetworkPolicyRuleName = rule.Name
-				conn.IngressNetworkPolicyRuleAction = registry.NetworkPolicyRuleActionAllow
+				conn.IngressNetworkPolicyRuleAction = committedRuleActionToUint8(rule)
 			}
 		}
 		if egressOfID != 0 {
@@ -228,12 +230,22 @@ func (cs *ConntrackConnectionStore) addNetworkPolicyMetadata(conn *flowexporter.
 				conn.EgressNetworkPolicyNamespace = policy.Namespace
 				conn.EgressNetworkPolicyType = flowexporter.PolicyTypeToUint8(policy.Type)
 				conn.EgressNetworkPolicyRuleName = rule.Name
-				conn.EgressNetworkPolicyRuleAction = registry.NetworkPolicyRuleActionAllow
+				conn.EgressNetworkPolicyRuleAction = committedRuleActionToUint8(rule)
 			}
 		}
 	}
 }
 
+// committedRuleActionToUint8 returns the action of a rule whose ID is stored in the label of a committed connection.
+// Such connections are allowed by either a K8s NetworkPolicy rule or an Antrea-native policy rule with the Allow or
+// Audit action.
+func committedRuleActionToUint8(rule *types.PolicyRule) uint8 {
+	if rule.Action != nil && *rule.Action == crdv1beta1.RuleActionAudit {
+		return flowexporter.RuleActionToUint8(string(*rule.Action))
+	}
+	return registry.NetworkPolicyRuleActionAllow
+}
+
 // AddOrUpdateConn updates the connection if it is already present, i.e., update timestamp, counters etc.,
 // or adds a new connection with the resolved K8s metadata.
 func (cs *ConntrackConnectionStore) AddOrUpdateConn(conn *flowexporter.Connection) {
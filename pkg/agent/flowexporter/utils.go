@@ -21,6 +21,7 @@ import (
 	"github.com/vmware/go-ipfix/pkg/registry"
 
 	"antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	"antrea.io/antrea/pkg/ipfix"
 )
 
 const (
@@ -79,6 +80,8 @@ func RuleActionToUint8(action string) uint8 {
 		return registry.NetworkPolicyRuleActionDrop
 	case "Reject":
 		return registry.NetworkPolicyRuleActionReject
+	case "Audit":
+		return ipfix.NetworkPolicyRuleActionAudit
 	default:
 		return registry.NetworkPolicyRuleActionNoAction
 	}
@@ -77,6 +77,7 @@ func TestRuleActionToUint8(t *testing.T) {
 		{"Allow", 1},
 		{"Drop", 2},
 		{"Reject", 3},
+		{"Audit", 4},
 		{"", 0},
 	} {
 		result := RuleActionToUint8(tc.action)
@@ -20,6 +20,7 @@ import (
 	"io"
 	"net"
 	"reflect"
+	"slices"
 	"sync"
 	"time"
 
@@ -86,6 +87,9 @@ var linuxNodeSelector = labels.SelectorFromSet(labels.Set{corev1.LabelOSStable:
 
 type ClusterNodeEventHandler func(objName string)
 
+// ClusterMessageHandler handles a message sent by another Node via SendMessage.
+type ClusterMessageHandler func(msg []byte)
+
 type Interface interface {
 	ShouldSelectIP(ip string, pool string, filters ...func(node string) bool) (bool, error)
 	SelectNodeForIP(ip, externalIPPool string, filters ...func(string) bool) (string, error)
@@ -98,6 +102,7 @@ type Memberlist interface {
 	Members() []*memberlist.Node
 	Leave(timeout time.Duration) error
 	Shutdown() error
+	SendReliable(to *memberlist.Node, msg []byte) error
 }
 
 // Cluster implements ClusterInterface.
@@ -122,6 +127,9 @@ type Cluster struct {
 	// each Node should check whether it is now responsible for some of the Egresses from that Node.
 	clusterNodeEventHandlers []ClusterNodeEventHandler
 
+	// clusterMessageHandlers contains the handlers which will run when a message sent by another Node is received.
+	clusterMessageHandlers []ClusterMessageHandler
+
 	nodeInformer     coreinformers.NodeInformer
 	nodeLister       corelisters.NodeLister
 	nodeListerSynced cache.InformerSynced
@@ -174,6 +182,7 @@ func NewCluster(
 		// Setting it to a non-zero value to allow reclaiming Nodes with different addresses for Node IP update case.
 		conf.DeadNodeReclaimTime = 10 * time.Millisecond
 		conf.Events = &memberlist.ChannelEventDelegate{Ch: nodeEventCh}
+		conf.Delegate = &messageDelegate{cluster: c}
 		conf.LogOutput = io.Discard
 		klog.V(1).InfoS("Creating new memberlist cluster", "name", conf.Name, "addr", conf.AdvertiseAddr, "port", conf.AdvertisePort, "deadNodeReclaimTime", conf.DeadNodeReclaimTime)
 
@@ -579,6 +588,54 @@ func (c *Cluster) notify(objName string) {
 	}
 }
 
+// SendMessage sends a message to the given Node reliably. The message is handled by the ClusterMessageHandlers of the
+// Node.
+func (c *Cluster) SendMessage(nodeName string, msg []byte) error {
+	for _, member := range c.mList.Members() {
+		if member.Name == nodeName {
+			return c.mList.SendReliable(member, msg)
+		}
+	}
+	return fmt.Errorf("no alive member found for Node %s", nodeName)
+}
+
+// AddClusterMessageHandler adds a ClusterMessageHandler, which will run when a message sent by another Node is
+// received.
+func (c *Cluster) AddClusterMessageHandler(handler ClusterMessageHandler) {
+	c.clusterMessageHandlers = append(c.clusterMessageHandlers, handler)
+}
+
+func (c *Cluster) handleMessage(msg []byte) {
+	for _, handler := range c.clusterMessageHandlers {
+		handler(msg)
+	}
+}
+
+// messageDelegate implements memberlist.Delegate to receive the messages sent by other Nodes. No metadata or state is
+// exchanged via it.
+type messageDelegate struct {
+	cluster *Cluster
+}
+
+func (d *messageDelegate) NodeMeta(limit int) []byte {
+	return nil
+}
+
+func (d *messageDelegate) NotifyMsg(msg []byte) {
+	// The buffer may be reused by memberlist after the call returns.
+	d.cluster.handleMessage(slices.Clone(msg))
+}
+
+func (d *messageDelegate) GetBroadcasts(overhead, limit int) [][]byte {
+	return nil
+}
+
+func (d *messageDelegate) LocalState(join bool) []byte {
+	return nil
+}
+
+func (d *messageDelegate) MergeRemoteState(buf []byte, join bool) {}
+
 // AddClusterEventHandler adds a clusterNodeEventHandler, which will run when consistentHashMap is updated,
 // due to an ExternalIPPool or Node event.
 func (c *Cluster) AddClusterEventHandler(handler ClusterNodeEventHandler) {
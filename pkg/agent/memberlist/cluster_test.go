@@ -679,3 +679,33 @@ func TestCluster_RejoinNodes(t *testing.T) {
 	mockMemberlist.EXPECT().Join([]string{"10.0.0.2"})
 	fakeCluster.cluster.RejoinNodes()
 }
+
+func TestCluster_SendMessage(t *testing.T) {
+	localNodeConfig := &config.NodeConfig{
+		Name:         "node1",
+		NodeIPv4Addr: ip.MustParseCIDR("10.0.0.1/24"),
+	}
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	controller := gomock.NewController(t)
+	mockMemberlist := NewMockMemberlist(controller)
+	fakeCluster, _ := newFakeCluster(localNodeConfig, stopCh, mockMemberlist)
+
+	node2 := &memberlist.Node{Name: "node2"}
+	msg := []byte("foo")
+	mockMemberlist.EXPECT().Members().Return([]*memberlist.Node{{Name: "node1"}, node2}).Times(2)
+	mockMemberlist.EXPECT().SendReliable(node2, msg)
+	assert.NoError(t, fakeCluster.cluster.SendMessage("node2", msg))
+	assert.EqualError(t, fakeCluster.cluster.SendMessage("node3", msg), "no alive member found for Node node3")
+
+	var receivedMsgs [][]byte
+	fakeCluster.cluster.AddClusterMessageHandler(func(msg []byte) {
+		receivedMsgs = append(receivedMsgs, msg)
+	})
+	delegate := &messageDelegate{cluster: fakeCluster.cluster}
+	buf := []byte("bar")
+	delegate.NotifyMsg(buf)
+	// The received message should be a copy of the buffer.
+	buf[0] = 'c'
+	assert.Equal(t, [][]byte{[]byte("bar")}, receivedMsgs)
+}
@@ -99,6 +99,20 @@ func (mr *MockMemberlistMockRecorder) Members() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Members", reflect.TypeOf((*MockMemberlist)(nil).Members))
 }
 
+// SendReliable mocks base method.
+func (m *MockMemberlist) SendReliable(to *memberlist.Node, msg []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendReliable", to, msg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendReliable indicates an expected call of SendReliable.
+func (mr *MockMemberlistMockRecorder) SendReliable(to, msg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendReliable", reflect.TypeOf((*MockMemberlist)(nil).SendReliable), to, msg)
+}
+
 // Shutdown mocks base method.
 func (m *MockMemberlist) Shutdown() error {
 	m.ctrl.T.Helper()
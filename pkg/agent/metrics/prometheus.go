@@ -183,6 +183,40 @@ var (
 			StabilityLevel: metrics.ALPHA,
 		},
 	)
+
+	NodeLatencyRTT = metrics.NewHistogramVec(
+		&metrics.HistogramOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "node_latency_rtt_seconds",
+			Help:           "The round-trip time of the latency probes sent to peer Nodes by NodeLatencyMonitor, partitioned by peer Node and IP family.",
+			Buckets:        metrics.ExponentialBuckets(0.0001, 2, 15),
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"peer_node", "ip_family"},
+	)
+
+	NodeLatencyPacketLossRatio = metrics.NewGaugeVec(
+		&metrics.GaugeOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "node_latency_packet_loss_ratio",
+			Help:           "The ratio of the recent latency probes sent to peer Nodes by NodeLatencyMonitor which got no reply, partitioned by peer Node and IP family.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"peer_node", "ip_family"},
+	)
+
+	NodeLatencyJitter = metrics.NewGaugeVec(
+		&metrics.GaugeOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "node_latency_jitter_seconds",
+			Help:           "The jitter of the round-trip time of the latency probes sent to peer Nodes by NodeLatencyMonitor, partitioned by peer Node and IP family.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"peer_node", "ip_family"},
+	)
 )
 
 func InitializePrometheusMetrics() {
@@ -192,6 +226,7 @@ func InitializePrometheusMetrics() {
 	InitializeNetworkPolicyMetrics()
 	InitializeOVSMetrics()
 	InitializeConnectionMetrics()
+	InitializeNodeLatencyMetrics()
 }
 
 func InitializePodMetrics() {
@@ -263,3 +298,15 @@ func InitializeConnectionMetrics() {
 		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_conntrack_max_connection_count")
 	}
 }
+
+func InitializeNodeLatencyMetrics() {
+	if err := legacyregistry.Register(NodeLatencyRTT); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_node_latency_rtt_seconds")
+	}
+	if err := legacyregistry.Register(NodeLatencyPacketLossRatio); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_node_latency_packet_loss_ratio")
+	}
+	if err := legacyregistry.Register(NodeLatencyJitter); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_node_latency_jitter_seconds")
+	}
+}
@@ -16,6 +16,7 @@ package monitortool
 
 import (
 	"errors"
+	"math/bits"
 	"net"
 	"sync"
 	"time"
@@ -53,6 +54,54 @@ type NodeIPLatencyEntry struct {
 	LastRecvTime time.Time
 	// The last valid rtt of the connection
 	LastMeasuredRTT time.Duration
+	// The jitter of the rtt of the connection, computed as the smoothed mean
+	// deviation of the difference between consecutive rtts (see RFC 3550).
+	Jitter time.Duration
+
+	// lostProbes records whether each of the most recent probes was lost,
+	// the bit of a probe being set when it got no reply. Only the lowest
+	// packetLossWindowSize bits are used.
+	lostProbes uint32
+	// probeCount is the number of probes recorded in lostProbes, capped to
+	// packetLossWindowSize.
+	probeCount int
+}
+
+// packetLossWindowSize is the number of the most recent probes used to
+// compute the packet loss ratio of a connection.
+const packetLossWindowSize = 20
+
+// recordProbe records whether a probe was lost in the packet loss window.
+func (e *NodeIPLatencyEntry) recordProbe(lost bool) {
+	e.lostProbes = (e.lostProbes << 1) & (1<<packetLossWindowSize - 1)
+	if lost {
+		e.lostProbes |= 1
+	}
+	if e.probeCount < packetLossWindowSize {
+		e.probeCount++
+	}
+}
+
+// PacketLossRatio returns the ratio of the probes in the packet loss window
+// which got no reply.
+func (e *NodeIPLatencyEntry) PacketLossRatio() float64 {
+	if e.probeCount == 0 {
+		return 0
+	}
+	return float64(bits.OnesCount32(e.lostProbes)) / float64(e.probeCount)
+}
+
+// updateJitter updates the jitter of the connection with a new rtt. It must be
+// called before LastMeasuredRTT is updated.
+func (e *NodeIPLatencyEntry) updateJitter(rtt time.Duration) {
+	if e.LastMeasuredRTT == 0 {
+		return
+	}
+	diff := rtt - e.LastMeasuredRTT
+	if diff < 0 {
+		diff = -diff
+	}
+	e.Jitter += (diff - e.Jitter) / 16
 }
 
 // NewLatencyStore creates a new LatencyStore.
@@ -66,8 +115,7 @@ func NewLatencyStore(isNetworkPolicyOnly bool) *LatencyStore {
 	return store
 }
 
-// getNodeIPLatencyEntry returns the NodeIPLatencyEntry for the given Node IP
-// For now, it is only used for testing purposes.
+// getNodeIPLatencyEntry returns a copy of the NodeIPLatencyEntry for the given Node IP.
 func (s *LatencyStore) getNodeIPLatencyEntry(nodeIP string) (NodeIPLatencyEntry, bool) {
 	s.mutex.RLock()
 	defer s.mutex.RUnlock()
@@ -217,6 +265,18 @@ func getPodCIDRsOnNode(node *corev1.Node) []string {
 	return []string{node.Spec.PodCIDR}
 }
 
+// listNodeTargetIPs returns a copy of the map of Node name to Node IP(s).
+func (s *LatencyStore) listNodeTargetIPs() map[string][]net.IP {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	nodeTargetIPs := make(map[string][]net.IP, len(s.nodeTargetIPsMap))
+	for nodeName, ips := range s.nodeTargetIPsMap {
+		nodeTargetIPs[nodeName] = ips
+	}
+	return nodeTargetIPs
+}
+
 // ListNodeIPs returns the list of all Node IPs in the latency store.
 func (s *LatencyStore) ListNodeIPs() []net.IP {
 	s.mutex.RLock()
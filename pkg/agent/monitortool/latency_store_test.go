@@ -173,3 +173,35 @@ func TestLatencyStore_ListNodeIPs(t *testing.T) {
 		})
 	}
 }
+
+func TestNodeIPLatencyEntry_PacketLossRatio(t *testing.T) {
+	e := &NodeIPLatencyEntry{}
+	assert.Equal(t, float64(0), e.PacketLossRatio())
+	e.recordProbe(true)
+	e.recordProbe(false)
+	e.recordProbe(false)
+	e.recordProbe(false)
+	assert.Equal(t, 0.25, e.PacketLossRatio())
+	// Only the most recent probes are taken into account.
+	for i := 0; i < packetLossWindowSize; i++ {
+		e.recordProbe(i%2 == 0)
+	}
+	assert.Equal(t, 0.5, e.PacketLossRatio())
+	for i := 0; i < packetLossWindowSize; i++ {
+		e.recordProbe(false)
+	}
+	assert.Equal(t, float64(0), e.PacketLossRatio())
+}
+
+func TestNodeIPLatencyEntry_updateJitter(t *testing.T) {
+	e := &NodeIPLatencyEntry{}
+	// The jitter cannot be computed from the first rtt.
+	e.updateJitter(10 * time.Millisecond)
+	assert.Equal(t, time.Duration(0), e.Jitter)
+	e.LastMeasuredRTT = 10 * time.Millisecond
+	e.updateJitter(26 * time.Millisecond)
+	assert.Equal(t, 1*time.Millisecond, e.Jitter)
+	e.LastMeasuredRTT = 26 * time.Millisecond
+	e.updateJitter(9 * time.Millisecond)
+	assert.Equal(t, 2*time.Millisecond, e.Jitter)
+}
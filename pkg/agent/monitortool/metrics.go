@@ -0,0 +1,170 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitortool
+
+import (
+	"hash/fnv"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"antrea.io/antrea/pkg/agent/metrics"
+)
+
+const (
+	ipFamilyIPv4 = "ipv4"
+	ipFamilyIPv6 = "ipv6"
+)
+
+// peerSeries identifies the metrics series of a peer Node for an IP family.
+type peerSeries struct {
+	nodeName string
+	ipFamily string
+}
+
+func (s peerSeries) labels() map[string]string {
+	return map[string]string{"peer_node": s.nodeName, "ip_family": s.ipFamily}
+}
+
+// latencyMetrics exports the latency measurements of the peer Nodes as Prometheus metrics. To bound
+// the cardinality of the metrics in large clusters, the metrics can be exported for a sample of the
+// peer Nodes only.
+type latencyMetrics struct {
+	mutex sync.RWMutex
+	// nodeName is the name of the current Node.
+	nodeName string
+	// sampleSize is the maximum number of peer Nodes for which metrics are exported. 0 means no
+	// limit.
+	sampleSize int
+	// peerNodes is the map of the target IP of each sampled peer Node to the name of the Node.
+	peerNodes map[string]string
+}
+
+func newLatencyMetrics(nodeName string) *latencyMetrics {
+	return &latencyMetrics{
+		nodeName:  nodeName,
+		peerNodes: map[string]string{},
+	}
+}
+
+func getIPFamily(ip net.IP) string {
+	if ip.To4() != nil {
+		return ipFamilyIPv4
+	}
+	return ipFamilyIPv6
+}
+
+func (lm *latencyMetrics) setSampleSize(sampleSize int) {
+	lm.mutex.Lock()
+	defer lm.mutex.Unlock()
+	lm.sampleSize = sampleSize
+}
+
+// observeRTT records a rtt measured for a target IP in the rtt histogram, if the target IP belongs
+// to a sampled peer Node.
+func (lm *latencyMetrics) observeRTT(peerIP string, rtt time.Duration) {
+	lm.mutex.RLock()
+	defer lm.mutex.RUnlock()
+	nodeName, ok := lm.peerNodes[peerIP]
+	if !ok {
+		return
+	}
+	metrics.NodeLatencyRTT.WithLabelValues(nodeName, getIPFamily(net.ParseIP(peerIP))).Observe(rtt.Seconds())
+}
+
+// samplePeerNodes returns at most sampleSize peer Nodes. Peer Nodes are selected using rendezvous
+// hashing: the sample of a Node is stable when other Nodes are added or removed, and different
+// Nodes sample different peers, so that all pairs of Nodes are likely to be covered across the
+// cluster.
+func samplePeerNodes(nodeName string, peerNodes []string, sampleSize int) []string {
+	if sampleSize <= 0 || len(peerNodes) <= sampleSize {
+		return peerNodes
+	}
+	hashes := make(map[string]uint64, len(peerNodes))
+	for _, peerNode := range peerNodes {
+		h := fnv.New64a()
+		h.Write([]byte(nodeName + "/" + peerNode))
+		hashes[peerNode] = h.Sum64()
+	}
+	sampled := make([]string, len(peerNodes))
+	copy(sampled, peerNodes)
+	sort.Slice(sampled, func(i, j int) bool {
+		return hashes[sampled[i]] < hashes[sampled[j]]
+	})
+	return sampled[:sampleSize]
+}
+
+// update samples the peer Nodes, deletes the metrics of the Nodes which are no longer sampled, and
+// updates the packet loss ratio and jitter metrics of the sampled Nodes.
+func (lm *latencyMetrics) update(store *LatencyStore) {
+	nodeTargetIPs := store.listNodeTargetIPs()
+	delete(nodeTargetIPs, lm.nodeName)
+	peerNodeNames := make([]string, 0, len(nodeTargetIPs))
+	for nodeName := range nodeTargetIPs {
+		peerNodeNames = append(peerNodeNames, nodeName)
+	}
+
+	lm.mutex.Lock()
+	defer lm.mutex.Unlock()
+
+	peerNodes := make(map[string]string)
+	for _, nodeName := range samplePeerNodes(lm.nodeName, peerNodeNames, lm.sampleSize) {
+		for _, ip := range nodeTargetIPs[nodeName] {
+			peerNodes[ip.String()] = nodeName
+		}
+	}
+	series := lm.getSeries(peerNodes)
+	for s := range lm.getSeries(lm.peerNodes).Difference(series) {
+		deleteSeries(s)
+	}
+	lm.peerNodes = peerNodes
+
+	for peerIP, nodeName := range peerNodes {
+		entry, ok := store.getNodeIPLatencyEntry(peerIP)
+		if !ok {
+			continue
+		}
+		ipFamily := getIPFamily(net.ParseIP(peerIP))
+		metrics.NodeLatencyPacketLossRatio.WithLabelValues(nodeName, ipFamily).Set(entry.PacketLossRatio())
+		metrics.NodeLatencyJitter.WithLabelValues(nodeName, ipFamily).Set(entry.Jitter.Seconds())
+	}
+}
+
+func (lm *latencyMetrics) getSeries(peerNodes map[string]string) sets.Set[peerSeries] {
+	series := sets.New[peerSeries]()
+	for peerIP, nodeName := range peerNodes {
+		series.Insert(peerSeries{nodeName: nodeName, ipFamily: getIPFamily(net.ParseIP(peerIP))})
+	}
+	return series
+}
+
+// reset deletes the metrics of all peer Nodes, when NodeLatencyMonitor is disabled.
+func (lm *latencyMetrics) reset() {
+	lm.mutex.Lock()
+	defer lm.mutex.Unlock()
+	for s := range lm.getSeries(lm.peerNodes) {
+		deleteSeries(s)
+	}
+	lm.peerNodes = map[string]string{}
+}
+
+func deleteSeries(s peerSeries) {
+	metrics.NodeLatencyRTT.Delete(s.labels())
+	metrics.NodeLatencyPacketLossRatio.Delete(s.labels())
+	metrics.NodeLatencyJitter.Delete(s.labels())
+}
@@ -0,0 +1,112 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitortool
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/component-base/metrics/legacyregistry"
+
+	"antrea.io/antrea/pkg/agent/metrics"
+)
+
+func init() {
+	metrics.InitializeNodeLatencyMetrics()
+}
+
+func TestSamplePeerNodes(t *testing.T) {
+	var peerNodes []string
+	for i := 0; i < 100; i++ {
+		peerNodes = append(peerNodes, fmt.Sprintf("node%d", i))
+	}
+	assert.Equal(t, peerNodes, samplePeerNodes("node-a", peerNodes, 0))
+	assert.Equal(t, peerNodes, samplePeerNodes("node-a", peerNodes, 100))
+
+	sampled := samplePeerNodes("node-a", peerNodes, 10)
+	assert.Len(t, sampled, 10)
+	// The sample is stable when other peer Nodes are removed.
+	assert.Equal(t, sampled, samplePeerNodes("node-a", sampled, 10))
+	assert.Equal(t, sampled[:5], samplePeerNodes("node-a", sampled, 5))
+	// Different Nodes sample different peers.
+	assert.NotEqual(t, sampled, samplePeerNodes("node-b", peerNodes, 10))
+}
+
+func TestLatencyMetrics(t *testing.T) {
+	lm := newLatencyMetrics("node1")
+	defer lm.reset()
+	store := &LatencyStore{
+		nodeIPLatencyMap: map[string]*NodeIPLatencyEntry{
+			"10.0.2.1":                    {LastMeasuredRTT: 2 * time.Millisecond, Jitter: time.Millisecond, lostProbes: 0b1, probeCount: 4},
+			"2001:ab03:cd04:55ee:100b::1": {LastMeasuredRTT: 3 * time.Millisecond},
+		},
+		nodeTargetIPsMap: map[string][]net.IP{
+			"node2": {net.ParseIP("10.0.2.1"), net.ParseIP("2001:ab03:cd04:55ee:100b::1")},
+		},
+	}
+	// No metrics are exported for a peer Node until the metrics are updated.
+	lm.observeRTT("10.0.2.1", 2*time.Millisecond)
+	lm.update(store)
+	lm.observeRTT("10.0.2.1", 2*time.Millisecond)
+	lm.observeRTT("2001:ab03:cd04:55ee:100b::1", 3*time.Millisecond)
+
+	expected := `
+	# HELP antrea_agent_node_latency_jitter_seconds [ALPHA] The jitter of the round-trip time of the latency probes sent to peer Nodes by NodeLatencyMonitor, partitioned by peer Node and IP family.
+	# TYPE antrea_agent_node_latency_jitter_seconds gauge
+	antrea_agent_node_latency_jitter_seconds{ip_family="ipv4",peer_node="node2"} 0.001
+	antrea_agent_node_latency_jitter_seconds{ip_family="ipv6",peer_node="node2"} 0
+	# HELP antrea_agent_node_latency_packet_loss_ratio [ALPHA] The ratio of the recent latency probes sent to peer Nodes by NodeLatencyMonitor which got no reply, partitioned by peer Node and IP family.
+	# TYPE antrea_agent_node_latency_packet_loss_ratio gauge
+	antrea_agent_node_latency_packet_loss_ratio{ip_family="ipv4",peer_node="node2"} 0.25
+	antrea_agent_node_latency_packet_loss_ratio{ip_family="ipv6",peer_node="node2"} 0
+	`
+	assert.NoError(t, testutil.GatherAndCompare(legacyregistry.DefaultGatherer, strings.NewReader(expected),
+		"antrea_agent_node_latency_jitter_seconds", "antrea_agent_node_latency_packet_loss_ratio"))
+	assert.Equal(t, 2, testutil.CollectAndCount(metrics.NodeLatencyRTT, "antrea_agent_node_latency_rtt_seconds"))
+
+	// The metrics of a deleted peer Node are deleted.
+	store.nodeTargetIPsMap = map[string][]net.IP{}
+	lm.update(store)
+	assert.Equal(t, 0, testutil.CollectAndCount(metrics.NodeLatencyRTT, "antrea_agent_node_latency_rtt_seconds"))
+	assert.Equal(t, 0, testutil.CollectAndCount(metrics.NodeLatencyJitter, "antrea_agent_node_latency_jitter_seconds"))
+	assert.Equal(t, 0, testutil.CollectAndCount(metrics.NodeLatencyPacketLossRatio, "antrea_agent_node_latency_packet_loss_ratio"))
+}
+
+func TestLatencyMetricsSampling(t *testing.T) {
+	lm := newLatencyMetrics("node1")
+	defer lm.reset()
+	lm.setSampleSize(2)
+	store := &LatencyStore{
+		nodeIPLatencyMap: map[string]*NodeIPLatencyEntry{},
+		nodeTargetIPsMap: map[string][]net.IP{},
+	}
+	for i := 2; i <= 10; i++ {
+		ip := fmt.Sprintf("10.0.%d.1", i)
+		store.nodeIPLatencyMap[ip] = &NodeIPLatencyEntry{}
+		store.nodeTargetIPsMap[fmt.Sprintf("node%d", i)] = []net.IP{net.ParseIP(ip)}
+	}
+	lm.update(store)
+	assert.Len(t, lm.peerNodes, 2)
+	assert.Equal(t, 2, testutil.CollectAndCount(metrics.NodeLatencyJitter, "antrea_agent_node_latency_jitter_seconds"))
+
+	lm.reset()
+	assert.Empty(t, lm.peerNodes)
+	assert.Equal(t, 0, testutil.CollectAndCount(metrics.NodeLatencyJitter, "antrea_agent_node_latency_jitter_seconds"))
+}
@@ -16,6 +16,7 @@ package monitortool
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
 	"net"
 	"sync"
@@ -45,30 +46,47 @@ var icmpEchoID = rand.Int31n(1 << 16)
 const (
 	ipv4ProtocolICMPRaw = "ip4:icmp"
 	ipv6ProtocolICMPRaw = "ip6:ipv6-icmp"
+	ipv4ProtocolUDP     = "udp4"
+	ipv6ProtocolUDP     = "udp6"
+	protocolUDP         = "udp"
 	protocolICMP        = 1
 	protocolICMPv6      = 58
+
+	// defaultProbePort is the default destination port of UDP and TCP probes.
+	defaultProbePort = 10352
 )
 
 type PacketListener interface {
 	ListenPacket(network, address string) (net.PacketConn, error)
 }
 
-type ICMPListener struct{}
+// ProbeListener listens for ICMP messages for raw ICMP networks, and for UDP datagrams otherwise.
+type ProbeListener struct{}
 
-func (l *ICMPListener) ListenPacket(network, address string) (net.PacketConn, error) {
-	return icmp.ListenPacket(network, address)
+func (l *ProbeListener) ListenPacket(network, address string) (net.PacketConn, error) {
+	if network == ipv4ProtocolICMPRaw || network == ipv6ProtocolICMPRaw {
+		return icmp.ListenPacket(network, address)
+	}
+	return net.ListenPacket(network, address)
 }
 
 // NodeLatencyMonitor is a tool to monitor the latency of the Node.
 type NodeLatencyMonitor struct {
 	// latencyStore is the cache to store the latency of each Nodes.
 	latencyStore *LatencyStore
+	// metrics exports the latency of the sampled peer Nodes as Prometheus metrics.
+	metrics *latencyMetrics
 	// latencyConfigChanged is the channel to notify the latency config changed.
 	latencyConfigChanged chan latencyConfig
 	// isIPv4Enabled is the flag to indicate whether the IPv4 is enabled.
 	isIPv4Enabled bool
 	// isIPv6Enabled is the flag to indicate whether the IPv6 is enabled.
 	isIPv6Enabled bool
+	// interfaceMTU is the MTU of Pod interfaces, used to pad MTU-sized probes.
+	interfaceMTU int
+	// probeConfig is the config of the probes which are being sent. It is only accessed by the
+	// monitorLoop goroutine.
+	probeConfig *latencyConfig
 
 	// antreaClientProvider provides interfaces to get antreaClient, which will be used to report the statistics
 	antreaClientProvider client.AntreaClientProvider
@@ -90,6 +108,15 @@ type latencyConfig struct {
 	Enable bool
 	// Interval is the interval time to ping all Nodes.
 	Interval time.Duration
+	// MetricsPeerSampleSize is the maximum number of peer Nodes for which Prometheus metrics are
+	// exported. 0 means no limit.
+	MetricsPeerSampleSize int
+	// Protocol is the protocol of the probes.
+	Protocol v1alpha1.NodeLatencyProbeProtocol
+	// Port is the destination port of UDP and TCP probes.
+	Port int
+	// MTUSizedProbes is the flag to pad the probes to the MTU of Pod interfaces.
+	MTUSizedProbes bool
 }
 
 // NewNodeLatencyMonitor creates a new NodeLatencyMonitor.
@@ -98,17 +125,20 @@ func NewNodeLatencyMonitor(
 	nodeInformer coreinformers.NodeInformer,
 	nlmInformer crdinformers.NodeLatencyMonitorInformer,
 	nodeConfig *config.NodeConfig,
-	trafficEncapMode config.TrafficEncapModeType,
+	networkConfig *config.NetworkConfig,
 ) *NodeLatencyMonitor {
+	trafficEncapMode := networkConfig.TrafficEncapMode
 	m := &NodeLatencyMonitor{
 		latencyStore:         NewLatencyStore(trafficEncapMode.IsNetworkPolicyOnly()),
+		metrics:              newLatencyMetrics(nodeConfig.Name),
 		latencyConfigChanged: make(chan latencyConfig),
 		antreaClientProvider: antreaClientProvider,
 		nodeInformerSynced:   nodeInformer.Informer().HasSynced,
 		nlmInformerSynced:    nlmInformer.Informer().HasSynced,
 		nodeName:             nodeConfig.Name,
+		interfaceMTU:         networkConfig.InterfaceMTU,
 		clock:                clock.RealClock{},
-		listener:             &ICMPListener{},
+		listener:             &ProbeListener{},
 	}
 
 	m.isIPv4Enabled, _ = config.IsIPv4Enabled(nodeConfig, trafficEncapMode)
@@ -207,8 +237,18 @@ func (m *NodeLatencyMonitor) updateLatencyConfig(nlm *v1alpha1.NodeLatencyMonito
 	pingInterval := time.Duration(nlm.Spec.PingIntervalSeconds) * time.Second
 
 	latencyConfig := latencyConfig{
-		Enable:   true,
-		Interval: pingInterval,
+		Enable:                true,
+		Interval:              pingInterval,
+		MetricsPeerSampleSize: int(nlm.Spec.MetricsPeerSampleSize),
+		Protocol:              nlm.Spec.ProbeProtocol,
+		Port:                  int(nlm.Spec.ProbePort),
+		MTUSizedProbes:        nlm.Spec.MTUSizedProbes,
+	}
+	if latencyConfig.Protocol == "" {
+		latencyConfig.Protocol = v1alpha1.NodeLatencyProbeProtocolICMP
+	}
+	if latencyConfig.Port == 0 {
+		latencyConfig.Port = defaultProbePort
 	}
 
 	m.latencyConfigChanged <- latencyConfig
@@ -239,7 +279,7 @@ func (m *NodeLatencyMonitor) sendPing(socket net.PacketConn, addr net.IP) error
 	body := &icmp.Echo{
 		ID:   int(icmpEchoID),
 		Seq:  int(seqID),
-		Data: []byte(timeStart.Format(time.RFC3339Nano)),
+		Data: m.newProbeData(nil, timeStart, addr.To4() == nil),
 	}
 	msg := icmp.Message{
 		Type: requestType,
@@ -259,13 +299,39 @@ func (m *NodeLatencyMonitor) sendPing(socket net.PacketConn, addr net.IP) error
 		return err
 	}
 
-	// Create or update the latency store
+	m.recordSend(addr.String(), timeStart)
+	return nil
+}
+
+// recordSend updates the latency store when a probe is sent to a peer IP.
+func (m *NodeLatencyMonitor) recordSend(peerIP string, sendTime time.Time) {
 	mutator := func(entry *NodeIPLatencyEntry) {
-		entry.LastSendTime = timeStart
+		// The previous probe is considered lost if no reply was received
+		// for it before this probe is sent.
+		if !entry.LastSendTime.IsZero() {
+			entry.recordProbe(entry.LastRecvTime.Before(entry.LastSendTime))
+		}
+		entry.LastSendTime = sendTime
 	}
-	m.latencyStore.SetNodeIPLatencyEntry(addr.String(), mutator)
+	m.latencyStore.SetNodeIPLatencyEntry(peerIP, mutator)
+}
 
-	return nil
+// recordRTT updates the latency store when the reply to a probe sent at sentTime is received
+// from a peer IP.
+func (m *NodeLatencyMonitor) recordRTT(peerIP string, sentTime time.Time) {
+	// Calculate the round-trip time
+	end := m.clock.Now()
+	rtt := end.Sub(sentTime)
+	klog.V(4).InfoS("Updating latency entry for Node IP", "IP", peerIP, "lastSendTime", sentTime, "lastRecvTime", end, "RTT", rtt)
+
+	// Update the latency store
+	mutator := func(entry *NodeIPLatencyEntry) {
+		entry.updateJitter(rtt)
+		entry.LastRecvTime = end
+		entry.LastMeasuredRTT = rtt
+	}
+	m.latencyStore.SetNodeIPLatencyEntry(peerIP, mutator)
+	m.metrics.observeRTT(peerIP, rtt)
 }
 
 func (m *NodeLatencyMonitor) handlePing(buffer []byte, peerIP string, isIPv4 bool) {
@@ -318,23 +384,13 @@ func (m *NodeLatencyMonitor) handlePing(buffer []byte, peerIP string, isIPv4 boo
 	klog.V(4).InfoS("Received ICMP message", "IP", peerIP, "msg", msg)
 
 	// Parse the time from the ICMP data
-	sentTime, err := time.Parse(time.RFC3339Nano, string(echo.Data))
+	sentTime, err := parseProbeData(echo.Data)
 	if err != nil {
 		klog.ErrorS(err, "Failed to parse time from ICMP data")
 		return
 	}
 
-	// Calculate the round-trip time
-	end := m.clock.Now()
-	rtt := end.Sub(sentTime)
-	klog.V(4).InfoS("Updating latency entry for Node IP", "IP", peerIP, "lastSendTime", sentTime, "lastRecvTime", end, "RTT", rtt)
-
-	// Update the latency store
-	mutator := func(entry *NodeIPLatencyEntry) {
-		entry.LastRecvTime = end
-		entry.LastMeasuredRTT = rtt
-	}
-	m.latencyStore.SetNodeIPLatencyEntry(peerIP, mutator)
+	m.recordRTT(peerIP, sentTime)
 }
 
 // recvPings receives ICMP messages.
@@ -358,18 +414,22 @@ func (m *NodeLatencyMonitor) recvPings(socket net.PacketConn, isIPv4 bool) {
 // pingAll sends ICMP messages to all the Nodes.
 func (m *NodeLatencyMonitor) pingAll(ipv4Socket, ipv6Socket net.PacketConn) {
 	klog.V(4).InfoS("Pinging all Nodes")
+	send, protocol := m.sendPing, v1alpha1.NodeLatencyProbeProtocolICMP
+	if m.probeConfig != nil && m.probeConfig.Protocol == v1alpha1.NodeLatencyProbeProtocolUDP {
+		send, protocol = m.sendUDPProbe, v1alpha1.NodeLatencyProbeProtocolUDP
+	}
 	nodeIPs := m.latencyStore.ListNodeIPs()
 	for _, toIP := range nodeIPs {
 		if toIP.To4() != nil && ipv4Socket != nil {
-			if err := m.sendPing(ipv4Socket, toIP); err != nil {
-				klog.ErrorS(err, "Cannot send ICMP message to Node IP", "IP", toIP)
+			if err := send(ipv4Socket, toIP); err != nil {
+				klog.ErrorS(err, "Cannot send probe to Node IP", "IP", toIP, "protocol", protocol)
 			}
 		} else if toIP.To16() != nil && ipv6Socket != nil {
-			if err := m.sendPing(ipv6Socket, toIP); err != nil {
-				klog.ErrorS(err, "Cannot send ICMP message to Node IP", "IP", toIP)
+			if err := send(ipv6Socket, toIP); err != nil {
+				klog.ErrorS(err, "Cannot send probe to Node IP", "IP", toIP, "protocol", protocol)
 			}
 		} else {
-			klog.V(3).InfoS("Cannot send ICMP message to Node IP because socket is not initialized for IP family", "IP", toIP)
+			klog.V(3).InfoS("Cannot send probe to Node IP because socket is not initialized for IP family", "IP", toIP, "protocol", protocol)
 		}
 	}
 	klog.V(4).InfoS("Done pinging all Nodes")
@@ -413,21 +473,109 @@ func (m *NodeLatencyMonitor) monitorLoop(stopCh <-chan struct{}) {
 	klog.InfoS("NodeLatencyMonitor is running")
 	var ticker clock.Ticker
 	var tickerCh <-chan time.Time
-	var ipv4Socket, ipv6Socket net.PacketConn
+	var ipv4Socket, ipv6Socket, responderSocket net.PacketConn
+	// tcpProbeCtx is cancelled to stop the ongoing TCP probes.
+	var tcpProbeCtx context.Context
+	var cancelTCPProbes context.CancelFunc
 	var err error
 
-	defer func() {
-		if ipv4Socket != nil {
-			ipv4Socket.Close()
+	wg := sync.WaitGroup{}
+	// stopProbes stops sending and receiving probes.
+	stopProbes := func() {
+		// We close the sockets as a signal to recvPing that it needs to stop.
+		// Note that at that point, we are guaranteed that there is no ongoing Write
+		// to the socket, because pingAll runs in the same goroutine as this code.
+		for _, socket := range []net.PacketConn{ipv4Socket, ipv6Socket, responderSocket} {
+			if socket != nil {
+				socket.Close()
+			}
 		}
-		if ipv6Socket != nil {
-			ipv6Socket.Close()
+		if cancelTCPProbes != nil {
+			cancelTCPProbes()
 		}
+		// After closing the sockets, wait for the recvPing goroutines to return
+		wg.Wait()
+		ipv4Socket = nil
+		ipv6Socket = nil
+		responderSocket = nil
+		tcpProbeCtx = nil
+		cancelTCPProbes = nil
+		m.probeConfig = nil
+	}
+	defer func() {
+		stopProbes()
 		if ticker != nil {
 			ticker.Stop()
 		}
 	}()
 
+	// startProbes creates the sockets required to send and receive probes.
+	startProbes := func(latencyConfig latencyConfig) error {
+		m.probeConfig = &latencyConfig
+		switch latencyConfig.Protocol {
+		case v1alpha1.NodeLatencyProbeProtocolICMP:
+			if m.isIPv4Enabled {
+				// Create a new socket for IPv4 when it is IPv4-only
+				if ipv4Socket, err = m.listener.ListenPacket(ipv4ProtocolICMPRaw, "0.0.0.0"); err != nil {
+					return fmt.Errorf("failed to create ICMP socket for IPv4: %w", err)
+				}
+				wg.Add(1)
+				go func(socket net.PacketConn) {
+					defer wg.Done()
+					m.recvPings(socket, true)
+				}(ipv4Socket)
+			}
+			if m.isIPv6Enabled {
+				// Create a new socket for IPv6 when it is IPv6-only
+				if ipv6Socket, err = m.listener.ListenPacket(ipv6ProtocolICMPRaw, "::"); err != nil {
+					return fmt.Errorf("failed to create ICMP socket for IPv6: %w", err)
+				}
+				wg.Add(1)
+				go func(socket net.PacketConn) {
+					defer wg.Done()
+					m.recvPings(socket, false)
+				}(ipv6Socket)
+			}
+		case v1alpha1.NodeLatencyProbeProtocolUDP:
+			// The responder socket echoes the probes sent by other Nodes.
+			if responderSocket, err = m.listener.ListenPacket(protocolUDP, fmt.Sprintf(":%d", latencyConfig.Port)); err != nil {
+				return fmt.Errorf("failed to create UDP socket for probe responder: %w", err)
+			}
+			wg.Add(1)
+			go func(socket net.PacketConn) {
+				defer wg.Done()
+				m.serveUDPProbes(socket)
+			}(responderSocket)
+			if m.isIPv4Enabled {
+				if ipv4Socket, err = m.listener.ListenPacket(ipv4ProtocolUDP, "0.0.0.0:0"); err != nil {
+					return fmt.Errorf("failed to create UDP socket for IPv4: %w", err)
+				}
+				wg.Add(1)
+				go func(socket net.PacketConn) {
+					defer wg.Done()
+					m.recvUDPProbes(socket)
+				}(ipv4Socket)
+			}
+			if m.isIPv6Enabled {
+				if ipv6Socket, err = m.listener.ListenPacket(ipv6ProtocolUDP, "[::]:0"); err != nil {
+					return fmt.Errorf("failed to create UDP socket for IPv6: %w", err)
+				}
+				wg.Add(1)
+				go func(socket net.PacketConn) {
+					defer wg.Done()
+					m.recvUDPProbes(socket)
+				}(ipv6Socket)
+			}
+		case v1alpha1.NodeLatencyProbeProtocolTCP:
+			// TCP probes don't need a socket to be created in advance, as a new connection is
+			// initiated for each probe.
+			tcpProbeCtx, cancelTCPProbes = context.WithCancel(context.Background())
+		default:
+			return fmt.Errorf("unsupported probe protocol %s", latencyConfig.Protocol)
+		}
+		return nil
+	}
+
 	// Update current ticker based on the latencyConfig
 	updateTicker := func(interval time.Duration) {
 		if ticker != nil {
@@ -437,54 +585,45 @@ func (m *NodeLatencyMonitor) monitorLoop(stopCh <-chan struct{}) {
 		tickerCh = ticker.C()
 	}
 
-	wg := sync.WaitGroup{}
 	// Start the pingAll goroutine
 	for {
 		select {
 		case <-tickerCh:
 			// Try to send pingAll signal
-			m.pingAll(ipv4Socket, ipv6Socket)
+			if m.probeConfig.Protocol == v1alpha1.NodeLatencyProbeProtocolTCP {
+				m.probeAllTCP(tcpProbeCtx, &wg)
+			} else {
+				m.pingAll(ipv4Socket, ipv6Socket)
+			}
 			// We no not delete IPs from nodeIPLatencyMap as part of the Node delete event handler
 			// to avoid consistency issues and because it would not be sufficient to avoid stale entries completely.
 			// This means that we have to periodically invoke DeleteStaleNodeIPs to avoid stale entries in the map.
 			m.latencyStore.DeleteStaleNodeIPs()
+			m.metrics.update(m.latencyStore)
 			m.report()
 		case <-stopCh:
 			return
 		case latencyConfig := <-m.latencyConfigChanged:
-			klog.InfoS("NodeLatencyMonitor configuration has changed", "enabled", latencyConfig.Enable, "interval", latencyConfig.Interval)
+			klog.InfoS("NodeLatencyMonitor configuration has changed", "enabled", latencyConfig.Enable, "interval", latencyConfig.Interval, "metricsPeerSampleSize", latencyConfig.MetricsPeerSampleSize,
+				"protocol", latencyConfig.Protocol, "port", latencyConfig.Port, "mtuSizedProbes", latencyConfig.MTUSizedProbes)
 			// Start or stop the pingAll goroutine based on the latencyConfig
 			if latencyConfig.Enable {
 				// latencyConfig changed
 				updateTicker(latencyConfig.Interval)
+				m.metrics.setSampleSize(latencyConfig.MetricsPeerSampleSize)
 
-				// If the recvPing socket is closed,
-				// recreate it if it is closed(CRD is deleted).
-				if ipv4Socket == nil && m.isIPv4Enabled {
-					// Create a new socket for IPv4 when it is IPv4-only
-					ipv4Socket, err = m.listener.ListenPacket(ipv4ProtocolICMPRaw, "0.0.0.0")
-					if err != nil {
-						klog.ErrorS(err, "Failed to create ICMP socket for IPv4")
-						return
-					}
-					wg.Add(1)
-					go func() {
-						defer wg.Done()
-						m.recvPings(ipv4Socket, true)
-					}()
+				// The sockets are recreated when the probe protocol or port is changed, and
+				// created if they are closed (CRD is deleted).
+				if m.probeConfig != nil && (m.probeConfig.Protocol != latencyConfig.Protocol || m.probeConfig.Port != latencyConfig.Port) {
+					stopProbes()
 				}
-				if ipv6Socket == nil && m.isIPv6Enabled {
-					// Create a new socket for IPv6 when it is IPv6-only
-					ipv6Socket, err = m.listener.ListenPacket(ipv6ProtocolICMPRaw, "::")
-					if err != nil {
-						klog.ErrorS(err, "Failed to create ICMP socket for IPv6")
+				if m.probeConfig == nil {
+					if err := startProbes(latencyConfig); err != nil {
+						klog.ErrorS(err, "Failed to start NodeLatencyMonitor probes")
 						return
 					}
-					wg.Add(1)
-					go func() {
-						defer wg.Done()
-						m.recvPings(ipv6Socket, false)
-					}()
+				} else {
+					*m.probeConfig = latencyConfig
 				}
 			} else {
 				// latencyConfig deleted
@@ -494,20 +633,8 @@ func (m *NodeLatencyMonitor) monitorLoop(stopCh <-chan struct{}) {
 				}
 				tickerCh = nil
 
-				// We close the sockets as a signal to recvPing that it needs to stop.
-				// Note that at that point, we are guaranteed that there is no ongoing Write
-				// to the socket, because pingAll runs in the same goroutine as this code.
-				if ipv4Socket != nil {
-					ipv4Socket.Close()
-				}
-				if ipv6Socket != nil {
-					ipv6Socket.Close()
-				}
-
-				// After closing the sockets, wait for the recvPing goroutines to return
-				wg.Wait()
-				ipv4Socket = nil
-				ipv6Socket = nil
+				stopProbes()
+				m.metrics.reset()
 			}
 		}
 	}
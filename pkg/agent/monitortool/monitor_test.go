@@ -100,6 +100,8 @@ var (
 	node3 = makeNode("node3", []string{"192.168.77.103", "192:168:77::103"}, []string{"10.0.3.0/24", "2001:ab03:cd04:55ee:100c::/80"})
 )
 
+const testInterfaceMTU = 1450
+
 type testAddr struct {
 	network string
 	address string
@@ -201,7 +203,10 @@ func newTestMonitor(
 	crdInformerFactory := crdinformers.NewSharedInformerFactory(crdClientset, 0)
 	nlmInformer := crdInformerFactory.Crd().V1alpha1().NodeLatencyMonitors()
 	antreaClientProvider := &antreaClientGetter{crdClientset}
-	m := NewNodeLatencyMonitor(antreaClientProvider, nodeInformer, nlmInformer, nodeConfig, trafficEncapMode)
+	m := NewNodeLatencyMonitor(antreaClientProvider, nodeInformer, nlmInformer, nodeConfig, &config.NetworkConfig{
+		TrafficEncapMode: trafficEncapMode,
+		InterfaceMTU:     testInterfaceMTU,
+	})
 	fakeClock := newFakeClock(t, clockT)
 	m.clock = fakeClock
 	mockListener := monitortesting.NewMockPacketListener(ctrl)
@@ -244,6 +249,43 @@ func TestEnableMonitor(t *testing.T) {
 	assert.False(t, pConnIPv6.IsClosed())
 }
 
+func TestUpdateMonitorProbeProtocol(t *testing.T) {
+	ctx := context.Background()
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	udpNLM := nlm.DeepCopy()
+	udpNLM.Spec.ProbeProtocol = crdv1alpha1.NodeLatencyProbeProtocolUDP
+	m := newTestMonitor(t, nodeConfigDualStack, config.TrafficEncapModeEncap, time.Now(), nil, []runtime.Object{udpNLM})
+	m.crdInformerFactory.Start(stopCh)
+	m.informerFactory.Start(stopCh)
+	m.crdInformerFactory.WaitForCacheSync(stopCh)
+	m.informerFactory.WaitForCacheSync(stopCh)
+
+	pConnResponder := nettest.NewPacketConn(&testAddr{network: protocolUDP, address: "[::]:10352"}, nil, nil)
+	m.mockListener.EXPECT().ListenPacket(protocolUDP, ":10352").Return(pConnResponder, nil)
+	pConnIPv4 := nettest.NewPacketConn(&testAddr{network: ipv4ProtocolUDP, address: "0.0.0.0:0"}, nil, nil)
+	m.mockListener.EXPECT().ListenPacket(ipv4ProtocolUDP, "0.0.0.0:0").Return(pConnIPv4, nil)
+	pConnIPv6 := nettest.NewPacketConn(&testAddr{network: ipv6ProtocolUDP, address: "[::]:0"}, nil, nil)
+	m.mockListener.EXPECT().ListenPacket(ipv6ProtocolUDP, "[::]:0").Return(pConnIPv6, nil)
+
+	go m.Run(stopCh)
+	require.Eventually(t, m.ctrl.Satisfied, 2*time.Second, 10*time.Millisecond)
+
+	// The UDP sockets are closed when switching to TCP probes, which don't need sockets.
+	tcpNLM := nlm.DeepCopy()
+	tcpNLM.Spec.ProbeProtocol = crdv1alpha1.NodeLatencyProbeProtocolTCP
+	tcpNLM.Generation = 1
+	_, err := m.crdClientset.CrdV1alpha1().NodeLatencyMonitors().Update(ctx, tcpNLM, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	assert.EventuallyWithT(t, func(t *assert.CollectT) {
+		assert.True(t, pConnResponder.IsClosed())
+		assert.True(t, pConnIPv4.IsClosed())
+		assert.True(t, pConnIPv6.IsClosed())
+	}, 2*time.Second, 10*time.Millisecond)
+}
+
 // collectProbePackets takes as input a channel used to receive packets, and returns a function that
 // can be called to collect received packets. It is useful to write assertions in tests that
 // validate the list of received packets. collectProbePackets starts a goroutine in the background,
@@ -0,0 +1,180 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitortool
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+const (
+	// udpProbeMagic is the prefix of the payload of UDP probes, followed by the type of the probe.
+	udpProbeMagic          = "ANLM"
+	udpProbeRequest   byte = 1
+	udpProbeReply     byte = 2
+	udpProbeHeaderLen      = len(udpProbeMagic) + 1
+
+	ipv4HeaderLen = 20
+	ipv6HeaderLen = 40
+	// Both the ICMP echo header and the UDP header are 8 bytes.
+	probeHeaderLen = 8
+)
+
+// newProbeData returns the payload of a probe sent at sendTime, which is the given prefix followed by
+// the send time. When MTUSizedProbes is set, the payload is padded with zeros so that the probe
+// packet is as large as the MTU of Pod interfaces.
+func (m *NodeLatencyMonitor) newProbeData(prefix []byte, sendTime time.Time, isIPv6 bool) []byte {
+	data := append(prefix, sendTime.Format(time.RFC3339Nano)...)
+	if m.probeConfig == nil || !m.probeConfig.MTUSizedProbes {
+		return data
+	}
+	size := m.interfaceMTU - ipv4HeaderLen - probeHeaderLen
+	if isIPv6 {
+		size = m.interfaceMTU - ipv6HeaderLen - probeHeaderLen
+	}
+	if size > len(data) {
+		data = append(data, make([]byte, size-len(data))...)
+	}
+	return data
+}
+
+// parseProbeData returns the send time of a probe from its payload, without the prefix.
+func parseProbeData(data []byte) (time.Time, error) {
+	return time.Parse(time.RFC3339Nano, string(bytes.TrimRight(data, "\x00")))
+}
+
+// sendUDPProbe sends a UDP probe to the target IP address, which is echoed by the Antrea Agent
+// running on the peer Node.
+func (m *NodeLatencyMonitor) sendUDPProbe(socket net.PacketConn, addr net.IP) error {
+	timeStart := m.clock.Now()
+	data := m.newProbeData(append([]byte(udpProbeMagic), udpProbeRequest), timeStart, addr.To4() == nil)
+	udpAddr := &net.UDPAddr{IP: addr, Port: m.probeConfig.Port}
+	klog.V(4).InfoS("Sending UDP probe", "addr", udpAddr, "length", len(data))
+	if _, err := socket.WriteTo(data, udpAddr); err != nil {
+		return err
+	}
+	m.recordSend(addr.String(), timeStart)
+	return nil
+}
+
+// handleUDPProbe handles a UDP probe received on a probe responder socket. It returns the reply to
+// send back, or nil if the packet is not a valid probe request.
+func handleUDPProbe(buffer []byte) []byte {
+	if len(buffer) <= udpProbeHeaderLen || string(buffer[:len(udpProbeMagic)]) != udpProbeMagic || buffer[len(udpProbeMagic)] != udpProbeRequest {
+		return nil
+	}
+	reply := make([]byte, len(buffer))
+	copy(reply, buffer)
+	reply[len(udpProbeMagic)] = udpProbeReply
+	return reply
+}
+
+// serveUDPProbes echoes the UDP probes sent by the other Nodes. The reply is as large as the probe,
+// so that MTU-sized probes validate the MTU in both directions.
+func (m *NodeLatencyMonitor) serveUDPProbes(socket net.PacketConn) {
+	readBuffer := make([]byte, 65535)
+	for {
+		n, peer, err := socket.ReadFrom(readBuffer)
+		if err != nil {
+			klog.ErrorS(err, "Failed to read UDP probe")
+			return
+		}
+		reply := handleUDPProbe(readBuffer[:n])
+		if reply == nil {
+			klog.V(5).InfoS("Ignoring invalid UDP probe", "peer", peer)
+			continue
+		}
+		if _, err := socket.WriteTo(reply, peer); err != nil {
+			klog.V(2).InfoS("Failed to reply to UDP probe", "peer", peer, "err", err)
+		}
+	}
+}
+
+// handleUDPProbeReply handles the reply to a UDP probe received from a peer IP.
+func (m *NodeLatencyMonitor) handleUDPProbeReply(buffer []byte, peerIP string) {
+	if len(buffer) <= udpProbeHeaderLen || string(buffer[:len(udpProbeMagic)]) != udpProbeMagic || buffer[len(udpProbeMagic)] != udpProbeReply {
+		klog.V(5).InfoS("Ignoring invalid UDP probe reply", "IP", peerIP)
+		return
+	}
+	sentTime, err := parseProbeData(buffer[udpProbeHeaderLen:])
+	if err != nil {
+		klog.ErrorS(err, "Failed to parse time from UDP probe reply")
+		return
+	}
+	m.recordRTT(peerIP, sentTime)
+}
+
+// recvUDPProbes receives the replies to UDP probes.
+func (m *NodeLatencyMonitor) recvUDPProbes(socket net.PacketConn) {
+	// We only need the beginning of the reply, the padding is dropped.
+	readBuffer := make([]byte, 128)
+	for {
+		n, peer, err := socket.ReadFrom(readBuffer)
+		if err != nil {
+			klog.ErrorS(err, "Failed to read UDP probe reply")
+			return
+		}
+		udpAddr, ok := peer.(*net.UDPAddr)
+		if !ok {
+			continue
+		}
+		m.handleUDPProbeReply(readBuffer[:n], udpAddr.IP.String())
+	}
+}
+
+// probeAllTCP sends TCP SYN probes to all the Nodes. Each probe is sent in a separate goroutine,
+// which is tracked by the provided WaitGroup and stopped when ctx is cancelled.
+func (m *NodeLatencyMonitor) probeAllTCP(ctx context.Context, wg *sync.WaitGroup) {
+	klog.V(4).InfoS("Probing all Nodes with TCP")
+	port, timeout := m.probeConfig.Port, m.probeConfig.Interval
+	nodeIPs := m.latencyStore.ListNodeIPs()
+	for _, toIP := range nodeIPs {
+		if toIP.To4() != nil && !m.isIPv4Enabled || toIP.To4() == nil && !m.isIPv6Enabled {
+			klog.V(3).InfoS("Cannot send probe to Node IP because IP family is not enabled", "IP", toIP, "protocol", "TCP")
+			continue
+		}
+		timeStart := m.clock.Now()
+		m.recordSend(toIP.String(), timeStart)
+		wg.Add(1)
+		go func(addr net.IP) {
+			defer wg.Done()
+			m.sendTCPProbe(ctx, addr, port, timeout, timeStart)
+		}(toIP)
+	}
+}
+
+// sendTCPProbe initiates a TCP connection to the target IP address, and measures the time until the
+// SYN-ACK is received. If nothing listens on the port, the RST sent by the peer Node is used
+// instead.
+func (m *NodeLatencyMonitor) sendTCPProbe(ctx context.Context, addr net.IP, port int, timeout time.Duration, timeStart time.Time) {
+	dialer := net.Dialer{Timeout: timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(addr.String(), strconv.Itoa(port)))
+	if err != nil && !errors.Is(err, syscall.ECONNREFUSED) {
+		klog.V(4).InfoS("TCP probe got no reply", "IP", addr, "err", err)
+		return
+	}
+	if conn != nil {
+		conn.Close()
+	}
+	m.recordRTT(addr.String(), timeStart)
+}
@@ -0,0 +1,143 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitortool
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv4"
+
+	"antrea.io/antrea/pkg/agent/config"
+	"antrea.io/antrea/pkg/agent/util/nettest"
+	crdv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
+)
+
+func TestNewProbeData(t *testing.T) {
+	now := time.Now()
+	m := newTestMonitor(t, nodeConfigDualStack, config.TrafficEncapModeEncap, now, nil, nil)
+	timestamp := now.Format(time.RFC3339Nano)
+	assert.Equal(t, []byte(timestamp), m.newProbeData(nil, now, false))
+
+	m.probeConfig = &latencyConfig{Protocol: crdv1alpha1.NodeLatencyProbeProtocolICMP, MTUSizedProbes: true}
+	data := m.newProbeData(nil, now, false)
+	// The probe packet should be as large as the MTU of Pod interfaces.
+	assert.Len(t, data, testInterfaceMTU-ipv4HeaderLen-probeHeaderLen)
+	sentTime, err := parseProbeData(data)
+	require.NoError(t, err)
+	assert.True(t, now.Equal(sentTime))
+	assert.Len(t, m.newProbeData(nil, now, true), testInterfaceMTU-ipv6HeaderLen-probeHeaderLen)
+}
+
+func TestSendPingMTUSized(t *testing.T) {
+	now := time.Now()
+	m := newTestMonitor(t, nodeConfigDualStack, config.TrafficEncapModeEncap, now, nil, nil)
+	m.probeConfig = &latencyConfig{Protocol: crdv1alpha1.NodeLatencyProbeProtocolICMP, MTUSizedProbes: true}
+	outCh := make(chan *nettest.Packet, 1)
+	pConn := nettest.NewPacketConn(testAddrIPv4, nil, outCh)
+	require.NoError(t, m.sendPing(pConn, net.ParseIP("10.0.2.1")))
+	p := <-outCh
+	assert.Len(t, p.Bytes, testInterfaceMTU-ipv4HeaderLen)
+
+	// The reply only includes the beginning of the padded payload, as the receive buffer is small.
+	request, err := icmp.ParseMessage(protocolICMP, p.Bytes)
+	require.NoError(t, err)
+	replyBytes := MustMarshal(&icmp.Message{Type: ipv4.ICMPTypeEchoReply, Body: request.Body})
+	m.clock.Step(time.Second)
+	m.handlePing(replyBytes[:128], "10.0.2.1", true)
+	entry, ok := m.latencyStore.getNodeIPLatencyEntry("10.0.2.1")
+	require.True(t, ok)
+	assert.Equal(t, time.Second, entry.LastMeasuredRTT)
+}
+
+func TestUDPProbe(t *testing.T) {
+	now := time.Now()
+	m := newTestMonitor(t, nodeConfigDualStack, config.TrafficEncapModeEncap, now, nil, nil)
+	m.probeConfig = &latencyConfig{Protocol: crdv1alpha1.NodeLatencyProbeProtocolUDP, Port: defaultProbePort, MTUSizedProbes: true}
+	outCh := make(chan *nettest.Packet, 1)
+	pConn := nettest.NewPacketConn(&testAddr{network: ipv6ProtocolUDP, address: "[::]:0"}, nil, outCh)
+	peerIP := "2001:ab03:cd04:55ee:100b::1"
+	require.NoError(t, m.sendUDPProbe(pConn, net.ParseIP(peerIP)))
+	p := <-outCh
+	assert.Equal(t, "[2001:ab03:cd04:55ee:100b::1]:10352", p.Addr.String())
+	assert.Len(t, p.Bytes, testInterfaceMTU-ipv6HeaderLen-probeHeaderLen)
+	entry, ok := m.latencyStore.getNodeIPLatencyEntry(peerIP)
+	require.True(t, ok)
+	assert.Equal(t, now, entry.LastSendTime)
+
+	// A probe reply is not a valid probe request.
+	reply := handleUDPProbe(p.Bytes)
+	require.NotNil(t, reply)
+	assert.Len(t, reply, len(p.Bytes))
+	assert.Nil(t, handleUDPProbe(reply))
+	assert.Nil(t, handleUDPProbe([]byte("foobar")))
+
+	m.clock.Step(time.Second)
+	// An invalid reply is ignored.
+	m.handleUDPProbeReply(p.Bytes, peerIP)
+	entry, _ = m.latencyStore.getNodeIPLatencyEntry(peerIP)
+	assert.Zero(t, entry.LastMeasuredRTT)
+	m.handleUDPProbeReply(reply[:128], peerIP)
+	entry, _ = m.latencyStore.getNodeIPLatencyEntry(peerIP)
+	assert.Equal(t, m.clock.Now(), entry.LastRecvTime)
+	assert.Equal(t, time.Second, entry.LastMeasuredRTT)
+}
+
+func TestSendTCPProbe(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	openPort := listener.Addr().(*net.TCPAddr).Port
+	// Get a port on which nothing listens, for which the probe gets a RST.
+	closedListener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	closedPort := closedListener.Addr().(*net.TCPAddr).Port
+	closedListener.Close()
+	defer listener.Close()
+
+	for name, port := range map[string]int{"open port": openPort, "closed port": closedPort} {
+		t.Run(name, func(t *testing.T) {
+			now := time.Now()
+			m := newTestMonitor(t, nodeConfigIPv4, config.TrafficEncapModeEncap, now, nil, nil)
+			m.sendTCPProbe(context.Background(), net.ParseIP("127.0.0.1"), port, time.Second, now.Add(-time.Second))
+			entry, ok := m.latencyStore.getNodeIPLatencyEntry("127.0.0.1")
+			require.True(t, ok)
+			assert.Equal(t, now, entry.LastRecvTime)
+			assert.Equal(t, time.Second, entry.LastMeasuredRTT)
+		})
+	}
+}
+
+func TestProbeAllTCP(t *testing.T) {
+	now := time.Now()
+	m := newTestMonitor(t, nodeConfigIPv4, config.TrafficEncapModeEncap, now, nil, nil)
+	m.probeConfig = &latencyConfig{Protocol: crdv1alpha1.NodeLatencyProbeProtocolTCP, Port: defaultProbePort, Interval: time.Second}
+	m.latencyStore.addNode(node2)
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	m.probeAllTCP(ctx, &wg)
+	// The probes are cancelled, and no reply is recorded.
+	cancel()
+	wg.Wait()
+	// The IPv6 gateway IP is not probed as IPv6 is not enabled.
+	assert.ElementsMatch(t, []string{"10.0.2.1"}, m.latencyStore.getNodeIPLatencyKeys())
+	entry, _ := m.latencyStore.getNodeIPLatencyEntry("10.0.2.1")
+	assert.Equal(t, now, entry.LastSendTime)
+}
@@ -147,6 +147,15 @@ type Client interface {
 	// are removed from PolicyRule.From, else from PolicyRule.To.
 	DeletePolicyRuleAddress(ruleID uint32, addrType types.AddressType, addresses []types.Address, priority *uint16) error
 
+	// InstallPolicyRuleMeter installs or updates an OF meter with specific meterID, rate and burst used to rate-limit
+	// the traffic allowed by NetworkPolicy rules. The rate and burst are in packets per second and packets if
+	// packetsPerSecond is true, in kbps and kb otherwise. It must be called before installing the flows of the rules
+	// referring to the meter.
+	InstallPolicyRuleMeter(meterID, rate, burst uint32, packetsPerSecond bool) error
+
+	// UninstallPolicyRuleMeter removes the OF meter installed by InstallPolicyRuleMeter.
+	UninstallPolicyRuleMeter(meterID uint32) error
+
 	// InstallSNATBypassServiceFlows installs flows to prevent traffic destined for the specified Service CIDRs from
 	// being SNAT'd. Otherwise, such Pod-to-Service traffic would be forwarded to Egress Node and be load-balanced
 	// remotely, as opposed to locally, when AntreaProxy is asked to skip some Services or is not running at all.
@@ -176,6 +185,39 @@ type Client interface {
 	// UninstallPodSNATFlows removes the SNAT flows for the local Pod.
 	UninstallPodSNATFlows(ofPort uint32) error
 
+	// InstallPodSNATDestinationFlows installs the SNAT flows for a local Pod
+	// which apply only to the traffic destined for the given CIDRs of the
+	// Egress. The flows take precedence over the flows installed by
+	// InstallPodSNATFlows and InstallPodSNATGroupFlows. Calling it again with
+	// the same Egress name and ofPort replaces the existing flows.
+	InstallPodSNATDestinationFlows(egressName string, ofPort uint32, snatIP net.IP, snatMark uint32, dstCIDRs []*net.IPNet) error
+
+	// UninstallPodSNATDestinationFlows removes the SNAT flows installed by
+	// InstallPodSNATDestinationFlows for the Egress and the local Pod.
+	UninstallPodSNATDestinationFlows(egressName string, ofPort uint32) error
+
+	// InstallEgressGroup installs a group for an ActiveActive Egress, which
+	// distributes new connections across the Egress IPs based on the hash
+	// of their 5-tuple. Each Egress IP is a bucket of the group: the packets
+	// are SNAT'd locally if the Egress IP is on the local Node, otherwise
+	// they are tunnelled to the remote Node using the Egress IP as the
+	// tunnel destination. The index of the selected Egress IP in snatIPs
+	// is committed to the connection, so that the following packets of the
+	// connection keep using the Egress IP when the group changes. A nil IP
+	// in snatIPs is an unused index.
+	InstallEgressGroup(groupID binding.GroupIDType, snatIPs []net.IP) error
+
+	// UninstallEgressGroup removes the group and the flows installed by
+	// InstallEgressGroup.
+	UninstallEgressGroup(groupID binding.GroupIDType) error
+
+	// InstallPodSNATGroupFlows installs the SNAT flows for a local Pod
+	// selected by an ActiveActive Egress. The installed flow sends the
+	// egress packets of the given IP protocol from the ofPort to the group
+	// and the flows installed by InstallEgressGroup. The flows can be
+	// removed by UninstallPodSNATFlows.
+	InstallPodSNATGroupFlows(ofPort uint32, groupID binding.GroupIDType, ipProtocol binding.Protocol) error
+
 	// InstallEgressQoS installs an OF meter with specific meterID, rate
 	// and burst used for QoS of Egress and a QoS flow that direct packets
 	// into the meter.
@@ -234,6 +276,10 @@ type Client interface {
 	// InstallTraceflowFlows installs flows for a Traceflow request.
 	InstallTraceflowFlows(dataplaneTag uint8, liveTraffic, droppedOnly, receiverOnly bool, packet *binding.Packet, ofPort uint32, timeoutSeconds uint16) error
 
+	// InstallTraceflowServiceEndpointFlows installs flows for a Traceflow request so that the
+	// Traceflow packet sent to a Service is load-balanced to the given Endpoint.
+	InstallTraceflowServiceEndpointFlows(dataplaneTag uint8, packet *binding.Packet, endpointIP net.IP, endpointPort uint16, timeoutSeconds uint16) error
+
 	// UninstallTraceflowFlows uninstalls flows for a Traceflow request.
 	UninstallTraceflowFlows(dataplaneTag uint8) error
 
@@ -970,7 +1016,7 @@ func (c *client) generatePipelines() {
 	c.traceableFeatures = append(c.traceableFeatures, c.featureNetworkPolicy)
 
 	if c.enableEgress {
-		c.featureEgress = newFeatureEgress(c.cookieAllocator, c.ipProtocols, c.nodeConfig, c.egressConfig, c.ovsMetersAreSupported && c.enableEgressTrafficShaping)
+		c.featureEgress = newFeatureEgress(c.cookieAllocator, c.ipProtocols, c.bridge, c.nodeConfig, c.egressConfig, c.connectUplinkToBridge, c.ovsMetersAreSupported && c.enableEgressTrafficShaping)
 		c.activatedFeatures = append(c.activatedFeatures, c.featureEgress)
 	}
 
@@ -1081,6 +1127,65 @@ func (c *client) UninstallPodSNATFlows(ofPort uint32) error {
 	return c.deleteFlows(c.featureEgress.cachedFlows, cacheKey)
 }
 
+func (c *client) InstallPodSNATDestinationFlows(egressName string, ofPort uint32, snatIP net.IP, snatMark uint32, dstCIDRs []*net.IPNet) error {
+	flows := c.featureEgress.snatDestinationRuleFlows(ofPort, snatIP, snatMark, c.nodeConfig.GatewayConfig.MAC, dstCIDRs)
+	cacheKey := fmt.Sprintf("pd%x/%s", ofPort, egressName)
+	c.replayMutex.RLock()
+	defer c.replayMutex.RUnlock()
+	return c.modifyFlows(c.featureEgress.cachedFlows, cacheKey, flows)
+}
+
+func (c *client) UninstallPodSNATDestinationFlows(egressName string, ofPort uint32) error {
+	cacheKey := fmt.Sprintf("pd%x/%s", ofPort, egressName)
+	c.replayMutex.RLock()
+	defer c.replayMutex.RUnlock()
+	return c.deleteFlows(c.featureEgress.cachedFlows, cacheKey)
+}
+
+func (c *client) InstallEgressGroup(groupID binding.GroupIDType, snatIPs []net.IP) error {
+	c.replayMutex.RLock()
+	defer c.replayMutex.RUnlock()
+
+	group := c.featureEgress.egressGroup(groupID, snatIPs)
+	_, installed := c.featureEgress.cachedGroups.Load(groupID)
+	if !installed {
+		if err := c.ofEntryOperations.AddOFEntries([]binding.OFEntry{group}); err != nil {
+			return fmt.Errorf("error when installing Egress Group %d: %w", groupID, err)
+		}
+	} else {
+		if err := c.ofEntryOperations.ModifyOFEntries([]binding.OFEntry{group}); err != nil {
+			return fmt.Errorf("error when modifying Egress Group %d: %w", groupID, err)
+		}
+	}
+	c.featureEgress.cachedGroups.Store(groupID, group)
+	return c.modifyFlows(c.featureEgress.cachedFlows, fmt.Sprintf("g%x", groupID), c.featureEgress.egressGroupFlows(groupID, snatIPs))
+}
+
+func (c *client) UninstallEgressGroup(groupID binding.GroupIDType) error {
+	c.replayMutex.RLock()
+	defer c.replayMutex.RUnlock()
+
+	if err := c.deleteFlows(c.featureEgress.cachedFlows, fmt.Sprintf("g%x", groupID)); err != nil {
+		return err
+	}
+	gCache, ok := c.featureEgress.cachedGroups.Load(groupID)
+	if ok {
+		if err := c.ofEntryOperations.DeleteOFEntries([]binding.OFEntry{gCache.(binding.Group)}); err != nil {
+			return fmt.Errorf("error when deleting Egress Group %d: %w", groupID, err)
+		}
+		c.featureEgress.cachedGroups.Delete(groupID)
+	}
+	return nil
+}
+
+func (c *client) InstallPodSNATGroupFlows(ofPort uint32, groupID binding.GroupIDType, ipProtocol binding.Protocol) error {
+	flows := []binding.Flow{c.featureEgress.snatGroupRuleFlow(ofPort, groupID, ipProtocol)}
+	cacheKey := fmt.Sprintf("p%x", ofPort)
+	c.replayMutex.RLock()
+	defer c.replayMutex.RUnlock()
+	return c.addFlows(c.featureEgress.cachedFlows, cacheKey, flows)
+}
+
 func (c *client) InstallEgressQoS(meterID, rate, burst uint32) error {
 	c.replayMutex.RLock()
 	defer c.replayMutex.RUnlock()
@@ -1127,6 +1232,44 @@ func (c *client) UninstallEgressQoS(meterID uint32) error {
 	return nil
 }
 
+func (c *client) InstallPolicyRuleMeter(meterID, rate, burst uint32, packetsPerSecond bool) error {
+	c.replayMutex.RLock()
+	defer c.replayMutex.RUnlock()
+
+	meterFlags := ofctrl.MeterBurst | ofctrl.MeterKbps
+	if packetsPerSecond {
+		meterFlags = ofctrl.MeterBurst | ofctrl.MeterPktps
+	}
+	meter := c.genOFMeter(binding.MeterIDType(meterID), meterFlags, rate, burst)
+	_, installed := c.featureNetworkPolicy.cachedMeter.Load(meterID)
+	if !installed {
+		if err := meter.Add(); err != nil {
+			return fmt.Errorf("error when installing NetworkPolicy rule OF Meter %d: %w", meterID, err)
+		}
+	} else {
+		if err := meter.Modify(); err != nil {
+			return fmt.Errorf("error when modifying NetworkPolicy rule OF Meter %d: %w", meterID, err)
+		}
+	}
+	c.featureNetworkPolicy.cachedMeter.Store(meterID, meter)
+	return nil
+}
+
+func (c *client) UninstallPolicyRuleMeter(meterID uint32) error {
+	c.replayMutex.RLock()
+	defer c.replayMutex.RUnlock()
+
+	mCache, ok := c.featureNetworkPolicy.cachedMeter.Load(meterID)
+	if ok {
+		meter := mCache.(binding.Meter)
+		if err := meter.Delete(); err != nil {
+			return fmt.Errorf("error when deleting NetworkPolicy rule OF Meter %d: %w", meterID, err)
+		}
+		c.featureNetworkPolicy.cachedMeter.Delete(meterID)
+	}
+	return nil
+}
+
 func (c *client) ReplayFlows() {
 	c.replayMutex.Lock()
 	defer c.replayMutex.Unlock()
@@ -1253,9 +1396,15 @@ func (c *client) InstallTraceflowFlows(dataplaneTag uint8, liveTraffic, droppedO
 	return c.addFlows(c.featureTraceflow.cachedFlows, cacheKey, flows)
 }
 
+func (c *client) InstallTraceflowServiceEndpointFlows(dataplaneTag uint8, packet *binding.Packet, endpointIP net.IP, endpointPort uint16, timeoutSeconds uint16) error {
+	cacheKey := fmt.Sprintf("%x-endpoint", dataplaneTag)
+	flow := c.featureService.serviceEndpointTraceflowFlow(dataplaneTag, packet, endpointIP, endpointPort, timeoutSeconds)
+	return c.addFlows(c.featureTraceflow.cachedFlows, cacheKey, []binding.Flow{flow})
+}
+
 func (c *client) UninstallTraceflowFlows(dataplaneTag uint8) error {
-	cacheKey := fmt.Sprintf("%x", dataplaneTag)
-	return c.deleteFlows(c.featureTraceflow.cachedFlows, cacheKey)
+	cacheKeys := []string{fmt.Sprintf("%x", dataplaneTag), fmt.Sprintf("%x-endpoint", dataplaneTag)}
+	return c.deleteFlowsWithMultipleKeys(c.featureTraceflow.cachedFlows, cacheKeys)
 }
 
 // setBasePacketOutBuilder sets base IP properties of a packetOutBuilder which can have more packet data added.
@@ -1740,6 +1740,187 @@ func Test_client_InstallPodSNATFlows(t *testing.T) {
 	}
 }
 
+func Test_client_InstallPodSNATDestinationFlows(t *testing.T) {
+	snatIP := net.ParseIP("192.168.77.101")
+	ofPort := uint32(100)
+	egressName := "egress1"
+	_, dstCIDR1, _ := net.ParseCIDR("10.20.0.0/16")
+	_, dstCIDR2, _ := net.ParseCIDR("172.16.1.1/32")
+
+	testCases := []struct {
+		name                 string
+		snatMark             uint32
+		expectedFlows        []string
+		expectedUpdatedFlows []string
+	}{
+		{
+			name:     "SNAT on Local",
+			snatMark: uint32(100),
+			expectedFlows: []string{
+				"cookie=0x1040000000000, table=EgressMark, priority=201,ct_state=+trk,ip,in_port=100,nw_dst=10.20.0.0/16 actions=set_field:0x64/0xff->pkt_mark,set_field:0x20/0xf0->reg0,goto_table:L2ForwardingCalc",
+				"cookie=0x1040000000000, table=EgressMark, priority=201,ct_state=+trk,ip,in_port=100,nw_dst=172.16.1.1 actions=set_field:0x64/0xff->pkt_mark,set_field:0x20/0xf0->reg0,goto_table:L2ForwardingCalc",
+			},
+			expectedUpdatedFlows: []string{
+				"cookie=0x1040000000000, table=EgressMark, priority=201,ct_state=+trk,ip,in_port=100,nw_dst=172.16.1.1 actions=set_field:0x64/0xff->pkt_mark,set_field:0x20/0xf0->reg0,goto_table:L2ForwardingCalc",
+			},
+		},
+		{
+			name: "SNAT on Remote",
+			expectedFlows: []string{
+				"cookie=0x1040000000000, table=EgressMark, priority=201,ip,in_port=100,nw_dst=10.20.0.0/16 actions=set_field:0a:00:00:00:00:01->eth_src,set_field:aa:bb:cc:dd:ee:ff->eth_dst,set_field:192.168.77.101->tun_dst,set_field:0x10/0xf0->reg0,set_field:0x80000/0x80000->reg0,goto_table:L2ForwardingCalc",
+				"cookie=0x1040000000000, table=EgressMark, priority=201,ip,in_port=100,nw_dst=172.16.1.1 actions=set_field:0a:00:00:00:00:01->eth_src,set_field:aa:bb:cc:dd:ee:ff->eth_dst,set_field:192.168.77.101->tun_dst,set_field:0x10/0xf0->reg0,set_field:0x80000/0x80000->reg0,goto_table:L2ForwardingCalc",
+			},
+			expectedUpdatedFlows: []string{
+				"cookie=0x1040000000000, table=EgressMark, priority=201,ip,in_port=100,nw_dst=172.16.1.1 actions=set_field:0a:00:00:00:00:01->eth_src,set_field:aa:bb:cc:dd:ee:ff->eth_dst,set_field:192.168.77.101->tun_dst,set_field:0x10/0xf0->reg0,set_field:0x80000/0x80000->reg0,goto_table:L2ForwardingCalc",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			m := opstest.NewMockOFEntryOperations(ctrl)
+			fc := newFakeClient(m, true, true, config.K8sNode, config.TrafficEncapModeEncap)
+			defer resetPipelines()
+
+			m.EXPECT().AddAll(gomock.Any()).Return(nil).Times(1)
+			m.EXPECT().BundleOps(gomock.Len(0), gomock.Len(1), gomock.Len(1)).Return(nil).Times(1)
+			m.EXPECT().DeleteAll(gomock.Any()).Return(nil).Times(1)
+			cacheKey := fmt.Sprintf("pd%x/%s", ofPort, egressName)
+
+			assert.NoError(t, fc.InstallPodSNATDestinationFlows(egressName, ofPort, snatIP, tc.snatMark, []*net.IPNet{dstCIDR1, dstCIDR2}))
+			fCacheI, ok := fc.featureEgress.cachedFlows.Load(cacheKey)
+			require.True(t, ok)
+			assert.ElementsMatch(t, tc.expectedFlows, getFlowStrings(fCacheI))
+
+			assert.NoError(t, fc.InstallPodSNATDestinationFlows(egressName, ofPort, snatIP, tc.snatMark, []*net.IPNet{dstCIDR2}))
+			fCacheI, ok = fc.featureEgress.cachedFlows.Load(cacheKey)
+			require.True(t, ok)
+			assert.ElementsMatch(t, tc.expectedUpdatedFlows, getFlowStrings(fCacheI))
+
+			assert.NoError(t, fc.UninstallPodSNATDestinationFlows(egressName, ofPort))
+			_, ok = fc.featureEgress.cachedFlows.Load(cacheKey)
+			require.False(t, ok)
+		})
+	}
+}
+
+func Test_client_InstallEgressGroup(t *testing.T) {
+	groupID := binding.GroupIDType(100)
+
+	testCases := []struct {
+		name          string
+		snatIPs       []net.IP
+		expectedGroup string
+		expectedFlows []string
+	}{
+		{
+			name:    "IPv4 Egress IPs",
+			snatIPs: []net.IP{net.ParseIP("192.168.77.101"), nil, net.ParseIP("192.168.77.103")},
+			expectedGroup: "group_id=100,type=select," +
+				"bucket=bucket_id:0,weight:100,actions=set_field:0x1/0xffff->reg11,set_field:192.168.77.101->tun_dst,set_field:0x8000/0x8000->reg0,resubmit:EgressMark," +
+				"bucket=bucket_id:1,weight:100,actions=set_field:0x3/0xffff->reg11,set_field:192.168.77.103->tun_dst,set_field:0x8000/0x8000->reg0,resubmit:EgressMark",
+			expectedFlows: []string{
+				"cookie=0x1040000000000, table=EgressMark, priority=211,ct_mark=0x100/0xffff00,ip,reg0=0x0/0x8000,reg10=0x64 actions=set_field:192.168.77.101->tun_dst,set_field:0x8000/0x8000->reg0,resubmit:EgressMark",
+				"cookie=0x1040000000000, table=EgressMark, priority=211,ct_mark=0x300/0xffff00,ip,reg0=0x0/0x8000,reg10=0x64 actions=set_field:192.168.77.103->tun_dst,set_field:0x8000/0x8000->reg0,resubmit:EgressMark",
+				"cookie=0x1040000000000, table=EgressMark, priority=210,ip,reg0=0x0/0x8000,reg10=0x64 actions=group:100",
+			},
+		},
+		{
+			name:    "IPv6 Egress IPs",
+			snatIPs: []net.IP{net.ParseIP("fec0:192:168:77::101"), nil, net.ParseIP("fec0:192:168:77::103")},
+			expectedGroup: "group_id=100,type=select," +
+				"bucket=bucket_id:0,weight:100,actions=set_field:0x1/0xffff->reg11,set_field:fec0:192:168:77::101->tun_ipv6_dst,set_field:0x8000/0x8000->reg0,resubmit:EgressMark," +
+				"bucket=bucket_id:1,weight:100,actions=set_field:0x3/0xffff->reg11,set_field:fec0:192:168:77::103->tun_ipv6_dst,set_field:0x8000/0x8000->reg0,resubmit:EgressMark",
+			expectedFlows: []string{
+				"cookie=0x1040000000000, table=EgressMark, priority=211,ct_mark=0x100/0xffff00,ipv6,reg0=0x0/0x8000,reg10=0x64 actions=set_field:fec0:192:168:77::101->tun_ipv6_dst,set_field:0x8000/0x8000->reg0,resubmit:EgressMark",
+				"cookie=0x1040000000000, table=EgressMark, priority=211,ct_mark=0x300/0xffff00,ipv6,reg0=0x0/0x8000,reg10=0x64 actions=set_field:fec0:192:168:77::103->tun_ipv6_dst,set_field:0x8000/0x8000->reg0,resubmit:EgressMark",
+				"cookie=0x1040000000000, table=EgressMark, priority=210,ipv6,reg0=0x0/0x8000,reg10=0x64 actions=group:100",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			m := opstest.NewMockOFEntryOperations(ctrl)
+			fc := newFakeClient(m, true, true, config.K8sNode, config.TrafficEncapModeEncap)
+			defer resetPipelines()
+
+			m.EXPECT().AddOFEntries(gomock.Any()).Return(nil).Times(1)
+			m.EXPECT().ModifyOFEntries(gomock.Any()).Return(nil).Times(1)
+			m.EXPECT().DeleteOFEntries(gomock.Any()).Return(nil).Times(1)
+			m.EXPECT().AddAll(gomock.Any()).Return(nil).Times(1)
+			m.EXPECT().BundleOps(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			m.EXPECT().DeleteAll(gomock.Any()).Return(nil).Times(1)
+			cacheKey := fmt.Sprintf("g%x", groupID)
+
+			assert.NoError(t, fc.InstallEgressGroup(groupID, tc.snatIPs[:1]))
+			assert.NoError(t, fc.InstallEgressGroup(groupID, tc.snatIPs))
+			gCacheI, ok := fc.featureEgress.cachedGroups.Load(groupID)
+			require.True(t, ok)
+			assert.Equal(t, tc.expectedGroup, getGroupFromCache(gCacheI.(binding.Group)))
+			fCacheI, ok := fc.featureEgress.cachedFlows.Load(cacheKey)
+			require.True(t, ok)
+			assert.ElementsMatch(t, tc.expectedFlows, getFlowStrings(fCacheI))
+
+			assert.NoError(t, fc.UninstallEgressGroup(groupID))
+			_, ok = fc.featureEgress.cachedGroups.Load(groupID)
+			require.False(t, ok)
+			_, ok = fc.featureEgress.cachedFlows.Load(cacheKey)
+			require.False(t, ok)
+		})
+	}
+}
+
+func Test_client_InstallPodSNATGroupFlows(t *testing.T) {
+	groupID := binding.GroupIDType(100)
+	ofPort := uint32(100)
+
+	testCases := []struct {
+		name          string
+		ipProtocol    binding.Protocol
+		expectedFlows []string
+	}{
+		{
+			name:       "IPv4",
+			ipProtocol: binding.ProtocolIP,
+			expectedFlows: []string{
+				"cookie=0x1040000000000, table=EgressMark, priority=200,ct_state=+trk,ip,reg0=0x0/0x8000,in_port=100 actions=set_field:0x64->reg10,resubmit:EgressMark",
+			},
+		},
+		{
+			name:       "IPv6",
+			ipProtocol: binding.ProtocolIPv6,
+			expectedFlows: []string{
+				"cookie=0x1040000000000, table=EgressMark, priority=200,ct_state=+trk,ipv6,reg0=0x0/0x8000,in_port=100 actions=set_field:0x64->reg10,resubmit:EgressMark",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			m := opstest.NewMockOFEntryOperations(ctrl)
+			fc := newFakeClient(m, true, true, config.K8sNode, config.TrafficEncapModeEncap)
+			defer resetPipelines()
+
+			m.EXPECT().AddAll(gomock.Any()).Return(nil).Times(1)
+			m.EXPECT().DeleteAll(gomock.Any()).Return(nil).Times(1)
+			cacheKey := fmt.Sprintf("p%x", ofPort)
+
+			assert.NoError(t, fc.InstallPodSNATGroupFlows(ofPort, groupID, tc.ipProtocol))
+			fCacheI, ok := fc.featureEgress.cachedFlows.Load(cacheKey)
+			require.True(t, ok)
+			assert.ElementsMatch(t, tc.expectedFlows, getFlowStrings(fCacheI))
+
+			assert.NoError(t, fc.UninstallPodSNATFlows(ofPort))
+			_, ok = fc.featureEgress.cachedFlows.Load(cacheKey)
+			require.False(t, ok)
+		})
+	}
+}
+
 func Test_client_InstallEgressQoS(t *testing.T) {
 	meterID := uint32(100)
 	meterRate := uint32(100)
@@ -1778,6 +1959,40 @@ func Test_client_InstallEgressQoS(t *testing.T) {
 	require.False(t, ok)
 }
 
+func Test_client_InstallPolicyRuleMeter(t *testing.T) {
+	meterID := uint32(MinPolicyRuleMeterID)
+	meterRate := uint32(1000)
+	meterBurst := uint32(2000)
+
+	ctrl := gomock.NewController(t)
+	m := opstest.NewMockOFEntryOperations(ctrl)
+	bridge := ovsoftest.NewMockBridge(ctrl)
+	fc := newFakeClientWithBridge(m, true, true, config.K8sNode, config.TrafficEncapModeEncap, bridge)
+	defer resetPipelines()
+
+	meter := ovsoftest.NewMockMeter(ctrl)
+	meterBuilder := ovsoftest.NewMockMeterBandBuilder(ctrl)
+	bridge.EXPECT().NewMeter(binding.MeterIDType(meterID), ofctrl.MeterBurst|ofctrl.MeterPktps).Return(meter).Times(2)
+	meter.EXPECT().MeterBand().Return(meterBuilder).Times(2)
+	meterBuilder.EXPECT().MeterType(ofctrl.MeterDrop).Return(meterBuilder).Times(2)
+	meterBuilder.EXPECT().Rate(meterRate).Return(meterBuilder).Times(2)
+	meterBuilder.EXPECT().Burst(meterBurst).Return(meterBuilder).Times(2)
+	meterBuilder.EXPECT().Done().Return(meter).Times(2)
+	meter.EXPECT().Add().Return(nil).Times(1)
+	meter.EXPECT().Modify().Return(nil).Times(1)
+
+	require.NoError(t, fc.InstallPolicyRuleMeter(meterID, meterRate, meterBurst, true))
+	_, ok := fc.featureNetworkPolicy.cachedMeter.Load(meterID)
+	require.True(t, ok)
+	// Installing the meter again modifies the existing one.
+	require.NoError(t, fc.InstallPolicyRuleMeter(meterID, meterRate, meterBurst, true))
+
+	meter.EXPECT().Delete().Return(nil).Times(1)
+	require.NoError(t, fc.UninstallPolicyRuleMeter(meterID))
+	_, ok = fc.featureNetworkPolicy.cachedMeter.Load(meterID)
+	require.False(t, ok)
+}
+
 func Test_client_InstallTraceflowFlows(t *testing.T) {
 	type fields struct {
 	}
@@ -2767,6 +2982,10 @@ func Test_client_ReplayFlows(t *testing.T) {
 		"group_id=4,type=all,bucket=bucket_id:0,actions=resubmit:IngressMetric,bucket=bucket_id:1,actions=set_field:0x400000/0x600000->reg0,resubmit:Output",
 		"group_id=5,type=all,bucket=bucket_id:0,actions=resubmit:MulticastEgressMetric,bucket=bucket_id:1,actions=set_field:0x400000/0x600000->reg0,resubmit:Output",
 		"group_id=6,type=all,bucket=bucket_id:0,actions=resubmit:MulticastIngressMetric,bucket=bucket_id:1,actions=set_field:0x400000/0x600000->reg0,resubmit:Output",
+		"group_id=7,type=all,bucket=bucket_id:0,actions=resubmit:AntreaPolicyEgressRule,bucket=bucket_id:1,actions=set_field:0x400000/0x600000->reg0,resubmit:Output",
+		"group_id=8,type=all,bucket=bucket_id:0,actions=resubmit:EgressDefaultRule,bucket=bucket_id:1,actions=set_field:0x400000/0x600000->reg0,resubmit:Output",
+		"group_id=9,type=all,bucket=bucket_id:0,actions=resubmit:AntreaPolicyIngressRule,bucket=bucket_id:1,actions=set_field:0x400000/0x600000->reg0,resubmit:Output",
+		"group_id=10,type=all,bucket=bucket_id:0,actions=resubmit:IngressDefaultRule,bucket=bucket_id:1,actions=set_field:0x400000/0x600000->reg0,resubmit:Output",
 	}
 	ruleID := uint32(15)
 	priority200 = uint16(200)
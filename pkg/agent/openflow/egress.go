@@ -28,13 +28,17 @@ import (
 type featureEgress struct {
 	cookieAllocator cookie.Allocator
 	ipProtocols     []binding.Protocol
+	bridge          binding.Bridge
 
-	cachedFlows *flowCategoryCache
-	cachedMeter sync.Map
+	cachedFlows  *flowCategoryCache
+	cachedMeter  sync.Map
+	cachedGroups sync.Map
 
-	exceptCIDRs map[binding.Protocol][]net.IPNet
-	nodeIPs     map[binding.Protocol]net.IP
-	gatewayMAC  net.HardwareAddr
+	exceptCIDRs    map[binding.Protocol][]net.IPNet
+	nodeIPs        map[binding.Protocol]net.IP
+	ctZones        map[binding.Protocol]int
+	ctZoneSrcField *binding.RegField
+	gatewayMAC     net.HardwareAddr
 
 	category                   cookie.Category
 	enableEgressTrafficShaping bool
@@ -46,8 +50,10 @@ func (f *featureEgress) getFeatureName() string {
 
 func newFeatureEgress(cookieAllocator cookie.Allocator,
 	ipProtocols []binding.Protocol,
+	bridge binding.Bridge,
 	nodeConfig *config.NodeConfig,
 	egressConfig *config.EgressConfig,
+	connectUplinkToBridge bool,
 	enableEgressTrafficShaping bool) *featureEgress {
 	exceptCIDRs := make(map[binding.Protocol][]net.IPNet)
 	for _, cidr := range egressConfig.ExceptCIDRs {
@@ -59,11 +65,14 @@ func newFeatureEgress(cookieAllocator cookie.Allocator,
 	}
 
 	nodeIPs := make(map[binding.Protocol]net.IP)
+	ctZones := make(map[binding.Protocol]int)
 	for _, ipProtocol := range ipProtocols {
 		if ipProtocol == binding.ProtocolIP {
 			nodeIPs[ipProtocol] = nodeConfig.NodeIPv4Addr.IP
+			ctZones[ipProtocol] = CtZone
 		} else if ipProtocol == binding.ProtocolIPv6 {
 			nodeIPs[ipProtocol] = nodeConfig.NodeIPv6Addr.IP
+			ctZones[ipProtocol] = CtZoneV6
 		}
 	}
 	return &featureEgress{
@@ -72,7 +81,10 @@ func newFeatureEgress(cookieAllocator cookie.Allocator,
 		cookieAllocator:            cookieAllocator,
 		exceptCIDRs:                exceptCIDRs,
 		ipProtocols:                ipProtocols,
+		bridge:                     bridge,
 		nodeIPs:                    nodeIPs,
+		ctZones:                    ctZones,
+		ctZoneSrcField:             getZoneSrcField(connectUplinkToBridge),
 		gatewayMAC:                 nodeConfig.GatewayConfig.MAC,
 		category:                   cookie.Egress,
 		enableEgressTrafficShaping: enableEgressTrafficShaping,
@@ -98,7 +110,14 @@ func (f *featureEgress) initGroups() []binding.OFEntry {
 }
 
 func (f *featureEgress) replayGroups() []binding.OFEntry {
-	return nil
+	var groups []binding.OFEntry
+	f.cachedGroups.Range(func(id, value interface{}) bool {
+		group := value.(binding.Group)
+		group.Reset()
+		groups = append(groups, group)
+		return true
+	})
+	return groups
 }
 
 func (f *featureEgress) replayMeters() []binding.OFEntry {
@@ -30,7 +30,9 @@ func egressInitFlows(isIPv4 bool) []string {
 			"cookie=0x1040000000000, table=EgressMark, priority=210,ip,nw_dst=192.168.78.0/24 actions=set_field:0x20/0xf0->reg0,goto_table:L2ForwardingCalc",
 			"cookie=0x1040000000000, table=EgressMark, priority=210,ip,nw_dst=192.168.77.100 actions=set_field:0x20/0xf0->reg0,goto_table:L2ForwardingCalc",
 			"cookie=0x1040000000000, table=EgressMark, priority=190,ct_state=+new+trk,ip,reg0=0x1/0xf actions=drop",
+			"cookie=0x1040000000000, table=EgressMark, priority=190,ip,reg0=0x8000/0x8000 actions=set_field:0a:00:00:00:00:01->eth_src,set_field:aa:bb:cc:dd:ee:ff->eth_dst,set_field:0x10/0xf0->reg0,set_field:0x80000/0x80000->reg0,goto_table:L2ForwardingCalc",
 			"cookie=0x1040000000000, table=EgressMark, priority=0 actions=set_field:0x20/0xf0->reg0,goto_table:L2ForwardingCalc",
+			"cookie=0x1040000000000, table=ConntrackCommit, priority=210,ct_state=+new+trk-snat,ct_mark=0x0/0x10,ip,reg0=0x8000/0x8000 actions=ct(commit,table=Output,zone=65520,exec(move:NXM_NX_REG0[0..3]->NXM_NX_CT_MARK[0..3],move:NXM_NX_REG11[0..15]->NXM_NX_CT_MARK[8..23]))",
 		}
 	}
 	return []string{
@@ -39,7 +41,9 @@ func egressInitFlows(isIPv4 bool) []string {
 		"cookie=0x1040000000000, table=EgressMark, priority=210,ipv6,ipv6_dst=fec0:192:168:78::/80 actions=set_field:0x20/0xf0->reg0,goto_table:L2ForwardingCalc",
 		"cookie=0x1040000000000, table=EgressMark, priority=210,ipv6,ipv6_dst=fec0:192:168:77::100 actions=set_field:0x20/0xf0->reg0,goto_table:L2ForwardingCalc",
 		"cookie=0x1040000000000, table=EgressMark, priority=190,ct_state=+new+trk,ipv6,reg0=0x1/0xf actions=drop",
+		"cookie=0x1040000000000, table=EgressMark, priority=190,ipv6,reg0=0x8000/0x8000 actions=set_field:0a:00:00:00:00:01->eth_src,set_field:aa:bb:cc:dd:ee:ff->eth_dst,set_field:0x10/0xf0->reg0,set_field:0x80000/0x80000->reg0,goto_table:L2ForwardingCalc",
 		"cookie=0x1040000000000, table=EgressMark, priority=0 actions=set_field:0x20/0xf0->reg0,goto_table:L2ForwardingCalc",
+		"cookie=0x1040000000000, table=ConntrackCommit, priority=210,ct_state=+new+trk-snat,ct_mark=0x0/0x10,ipv6,reg0=0x8000/0x8000 actions=ct(commit,table=Output,zone=65510,exec(move:NXM_NX_REG0[0..3]->NXM_NX_CT_MARK[0..3],move:NXM_NX_REG11[0..15]->NXM_NX_CT_MARK[8..23]))",
 	}
 }
 
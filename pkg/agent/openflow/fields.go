@@ -74,6 +74,15 @@ var (
 	GeneratedRejectPacketOutRegMark = binding.NewOneBitRegMark(0, 13)
 	// reg0[14]: Mark to indicate a Service without any Endpoints (used by Proxy)
 	SvcNoEpRegMark = binding.NewOneBitRegMark(0, 14)
+	// reg0[15]: Mark to indicate the packet has selected an Egress IP of an ActiveActive Egress.
+	EgressIPSelectedRegMark    = binding.NewOneBitRegMark(0, 15)
+	EgressIPNotSelectedRegMark = binding.NewOneBitZeroRegMark(0, 15)
+	// reg0[16]: Mark to indicate the packet has matched an egress rule with the Audit action of Antrea Policy.
+	APEgressAuditedRegMark    = binding.NewOneBitRegMark(0, 16)
+	APEgressNotAuditedRegMark = binding.NewOneBitZeroRegMark(0, 16)
+	// reg0[17]: Mark to indicate the packet has matched an ingress rule with the Audit action of Antrea Policy.
+	APIngressAuditedRegMark    = binding.NewOneBitRegMark(0, 17)
+	APIngressNotAuditedRegMark = binding.NewOneBitZeroRegMark(0, 17)
 	// reg0[19]: Mark to indicate remote SNAT for Egress.
 	RemoteSNATRegMark = binding.NewOneBitRegMark(0, 19)
 	// reg0[20]: Field to indicate redirect action of layer 7 NetworkPolicy.
@@ -177,6 +186,14 @@ var (
 	// reg9(NXM_NX_REG9)
 	// Field to cache the ofPort of the OVS interface to output traffic control packets.
 	TrafficControlTargetOFPortField = binding.NewRegField(9, 0, 31)
+
+	// reg10(NXM_NX_REG10)
+	// Field to cache the ID of the group of the ActiveActive Egress applied to the packet.
+	EgressGroupIDField = binding.NewRegField(10, 0, 31)
+
+	// reg11(NXM_NX_REG11)
+	// Field to cache the index of the Egress IP selected by the group of an ActiveActive Egress, starting from 1.
+	EgressIPIndexField = binding.NewRegField(11, 0, 15)
 )
 
 // Fields using xxreg.
@@ -216,6 +233,12 @@ var (
 	// for L7 NetworkPolicy.
 	// This CT mark is used in CtZone / CtZoneV6.
 	L7NPRedirectCTMark = binding.NewOneBitCTMark(7)
+
+	// CTMark[8..23]: Field to store the index of the Egress IP selected for the connection by the group of an
+	// ActiveActive Egress, so that the following packets of the connection keep using it when the group changes. This
+	// field has the same width as EgressIPIndexField.
+	// This CT mark is only used in CtZone / CtZoneV6.
+	EgressIPIndexCTMarkField = binding.NewCTMarkField(8, 23)
 )
 
 // Fields using CT label.
@@ -1217,8 +1217,11 @@ func (f *featureNetworkPolicy) calculateActionFlowChangesForRule(rule *types.Pol
 			actionFlows = append(actionFlows, f.conjunctionActionDenyFlow(ruleOfID, ruleTable, rule.Priority, DispositionRej, rule.EnableLogging))
 		} else if rule.IsAntreaNetworkPolicyRule() && *rule.Action == crdv1beta1.RuleActionPass {
 			actionFlows = append(actionFlows, f.conjunctionActionPassFlow(ruleOfID, ruleTable, rule.Priority, rule.EnableLogging))
+		} else if rule.IsAntreaNetworkPolicyRule() && *rule.Action == crdv1beta1.RuleActionAudit {
+			metricFlows = append(metricFlows, f.auditRuleMetricFlows(ruleOfID, isIngress)...)
+			actionFlows = append(actionFlows, f.conjunctionActionAuditFlow(ruleOfID, ruleTable, rule.Priority, rule.EnableLogging))
 		} else {
-			metricFlows = append(metricFlows, f.allowRulesMetricFlows(ruleOfID, isIngress, rule.TableID)...)
+			metricFlows = append(metricFlows, f.allowRulesMetricFlows(ruleOfID, isIngress, rule.TableID, rule.RateLimitMeterID)...)
 			actionFlows = append(actionFlows, f.conjunctionActionFlow(ruleOfID, ruleTable, dropTable.GetNext(), rule.Priority, rule.EnableLogging, rule.L7RuleVlanID)...)
 		}
 		conj.actionFlows = GetFlowModMessages(actionFlows, binding.AddMessage)
@@ -1945,6 +1948,18 @@ func parseAllowFlow(flowMap map[string]string) (uint32, types.RuleMetric) {
 	return uint32(id), m
 }
 
+func parseAuditFlow(flowMap map[string]string) (uint32, types.RuleMetric) {
+	m := parseFlowMetric(flowMap)
+	m.Sessions = m.Packets
+	// The rule ID is in reg6 for ingress rules and in reg5 for egress rules.
+	conjID, ok := flowMap["reg6"]
+	if !ok {
+		conjID = flowMap["reg5"]
+	}
+	id, _ := strconv.ParseUint(conjID, 0, 32)
+	return uint32(id), m
+}
+
 func parseFlowToMap(flow string) map[string]string {
 	split := strings.Split(flow, ",")
 	flowMap := make(map[string]string)
@@ -1976,6 +1991,11 @@ func parseMetricFlow(flowMap map[string]string) (uint32, types.RuleMetric) {
 	if _, ok := flowMap[dropIdentifier]; ok {
 		return parseDropFlow(flowMap)
 	}
+	// example audit flow format, which commits the first packet of the connections matching the rule:
+	// table=101, n_packets=3, n_bytes=222, priority=200,ct_state=+new,ip,reg6=0x5 actions=ct(commit,table=102,zone=65520,exec(load:0x5->NXM_NX_CT_LABEL[0..31]))
+	if _, ok := flowMap["ct_label"]; !ok {
+		return parseAuditFlow(flowMap)
+	}
 	return parseAllowFlow(flowMap)
 }
 
@@ -2086,6 +2106,9 @@ type featureNetworkPolicy struct {
 	// OVS pipeline. The key is the next table used in the second bucket, and the value is the Openflow group.
 	loggingGroupCache sync.Map
 	groupAllocator    GroupAllocator
+	// cachedMeter stores the OF meters used to rate-limit the traffic allowed by NetworkPolicy rules. The key is the
+	// meter ID.
+	cachedMeter sync.Map
 
 	ovsMetersAreSupported bool
 	enableDenyTracking    bool
@@ -2274,6 +2297,11 @@ func (f *featureNetworkPolicy) initGroups() []binding.OFEntry {
 	if f.enableMulticast {
 		candidateTables = append(candidateTables, MulticastEgressMetricTable, MulticastIngressMetricTable)
 	}
+	// Packets matching rules with the Audit action are resubmitted to the table of the rules.
+	if f.enableAntreaPolicy {
+		candidateTables = append(candidateTables, GetAntreaPolicyEgressTables()...)
+		candidateTables = append(candidateTables, GetAntreaPolicyIngressTables()...)
+	}
 	for _, nextTable := range candidateTables {
 		groupKey := fmt.Sprintf("%d", nextTable.GetID())
 		obj, ok := f.loggingGroupCache.Load(groupKey)
@@ -2301,7 +2329,14 @@ func (f *featureNetworkPolicy) initGroups() []binding.OFEntry {
 }
 
 func (f *featureNetworkPolicy) replayMeters() []binding.OFEntry {
-	return nil
+	var meters []binding.OFEntry
+	f.cachedMeter.Range(func(id, value interface{}) bool {
+		meter := value.(binding.Meter)
+		meter.Reset()
+		meters = append(meters, meter)
+		return true
+	})
+	return meters
 }
 
 func (f *featureNetworkPolicy) getLoggingAndResubmitGroupID(nextTable uint8) binding.GroupIDType {
@@ -67,6 +67,7 @@ var (
 
 	actionAllow  = crdv1beta1.RuleActionAllow
 	actionDrop   = crdv1beta1.RuleActionDrop
+	actionAudit  = crdv1beta1.RuleActionAudit
 	port8080     = intstr.FromInt(8080)
 	port32800    = int32(32800)
 	protocolICMP = v1beta2.ProtocolICMP
@@ -475,6 +476,51 @@ func TestBatchInstallPolicyRuleFlows(t *testing.T) {
 				"cookie=0x1020000000000, table=IngressMetric, priority=200,reg0=0x400/0x400,reg3=0xe actions=drop",
 			},
 		},
+		{
+			name: "Antrea NetworkPolicy Audit rule above a Drop rule",
+			rules: []*types.PolicyRule{
+				{
+					Direction: v1beta2.DirectionIn,
+					From:      parseAddresses([]string{"192.168.1.40"}),
+					Action:    &actionAudit,
+					Priority:  &priority200,
+					To:        []types.Address{NewOFPortAddress(1)},
+					FlowID:    uint32(20),
+					PolicyRef: &v1beta2.NetworkPolicyReference{
+						Type:      v1beta2.AntreaNetworkPolicy,
+						Namespace: "ns1",
+						Name:      "np1",
+						UID:       "id1",
+					},
+				},
+				{
+					Direction: v1beta2.DirectionIn,
+					From:      parseAddresses([]string{"192.168.1.40"}),
+					Action:    &actionDrop,
+					Priority:  &priority100,
+					To:        []types.Address{NewOFPortAddress(1)},
+					FlowID:    uint32(21),
+					PolicyRef: &v1beta2.NetworkPolicyReference{
+						Type:      v1beta2.AntreaNetworkPolicy,
+						Namespace: "ns1",
+						Name:      "np2",
+						UID:       "id2",
+					},
+				},
+			},
+			expectedFlows: []string{
+				// The packets matching the Audit rule are resubmitted to the same table, in which they still match the Drop rule.
+				"cookie=0x1020000000000, table=AntreaPolicyIngressRule, priority=200,conj_id=20,reg0=0x0/0x20000 actions=set_field:0x14->reg6,set_field:0x20000/0x20000->reg0,resubmit:AntreaPolicyIngressRule",
+				"cookie=0x1020000000000, table=AntreaPolicyIngressRule, priority=100,conj_id=21 actions=set_field:0x15->reg3,set_field:0x400/0x400->reg0,goto_table:IngressMetric",
+				"cookie=0x1020000000000, table=AntreaPolicyIngressRule, priority=200,ip,nw_src=192.168.1.40 actions=conjunction(20,1/2)",
+				"cookie=0x1020000000000, table=AntreaPolicyIngressRule, priority=200,reg1=0x1 actions=conjunction(20,2/2)",
+				"cookie=0x1020000000000, table=AntreaPolicyIngressRule, priority=100,ip,nw_src=192.168.1.40 actions=conjunction(21,1/2)",
+				"cookie=0x1020000000000, table=AntreaPolicyIngressRule, priority=100,reg1=0x1 actions=conjunction(21,2/2)",
+				"cookie=0x1020000000000, table=IngressMetric, priority=200,ct_state=+new,ip,reg6=0x14 actions=ct(commit,table=ConntrackCommit,zone=65520,exec(set_field:0x14/0xffffffff->ct_label))",
+				"cookie=0x1020000000000, table=IngressMetric, priority=200,ct_state=-new,ct_label=0x14/0xffffffff,ip actions=goto_table:ConntrackCommit",
+				"cookie=0x1020000000000, table=IngressMetric, priority=200,reg0=0x400/0x400,reg3=0x15 actions=drop",
+			},
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
@@ -1082,6 +1128,15 @@ func TestParseMetricFlow(t *testing.T) {
 				Sessions: 123,
 			},
 		},
+		"New audit flow": {
+			flow: "table=101, n_packets=3, n_bytes=222, priority=200,ct_state=+new,ip,reg6=0x5 actions=ct(commit,table=102,zone=65520,exec(load:0x5->NXM_NX_CT_LABEL[0..31]))",
+			rule: 5,
+			metric: types.RuleMetric{
+				Bytes:    222,
+				Packets:  3,
+				Sessions: 3,
+			},
+		},
 		"Following allow flow": {
 			flow: "table=101, n_packets=123, n_bytes=456, priority=200,ct_state=-new,ct_label=0x1/0xffffffff,ip actions=goto_table:105",
 			rule: 1,
@@ -84,6 +84,9 @@ const (
 	PacketInMeterIDNP  = 256
 	PacketInMeterIDTF  = 257
 	PacketInMeterIDDNS = 258
+	// Meter IDs starting from MinPolicyRuleMeterID are reserved for rate limiting of the traffic allowed by
+	// NetworkPolicy rules.
+	MinPolicyRuleMeterID = 1024
 )
 
 // RegisterPacketInHandler stores controller handler in a map with category as keys.
@@ -1094,6 +1094,47 @@ func (f *featureService) flowsToTrace(dataplaneTag uint8,
 	return flows
 }
 
+// serviceEndpointTraceflowFlow generates the flow which selects the given Endpoint for the Traceflow packets with the
+// given data plane tag sent to a Service, instead of the group of the Service, so that the path through the Service to
+// a specific Endpoint can be traced. packet is the Traceflow packet sent to the Service.
+func (f *featureService) serviceEndpointTraceflowFlow(dataplaneTag uint8, packet *binding.Packet, endpointIP net.IP, endpointPort uint16, timeout uint16) binding.Flow {
+	var ipProtocol binding.Protocol
+	switch {
+	case packet.IPProto == protocol.Type_UDP && packet.IsIPv6:
+		ipProtocol = binding.ProtocolUDPv6
+	case packet.IPProto == protocol.Type_UDP:
+		ipProtocol = binding.ProtocolUDP
+	case packet.IsIPv6:
+		ipProtocol = binding.ProtocolTCPv6
+	default:
+		ipProtocol = binding.ProtocolTCP
+	}
+	// This flow must have higher priority than the ones installed by serviceLBFlows.
+	flowBuilder := ServiceLBTable.ofTable.BuildFlow(priorityHigh+1).
+		Cookie(f.cookieAllocator.Request(cookie.Traceflow).Raw()).
+		MatchProtocol(ipProtocol).
+		MatchDstIP(packet.DestinationIP).
+		MatchDstPort(packet.DestinationPort, nil).
+		MatchRegMark(EpToSelectRegMark).
+		MatchIPDSCP(dataplaneTag).
+		SetHardTimeout(timeout).
+		Action().LoadRegMark(RewriteMACRegMark, EpSelectedRegMark)
+	if getIPProtocol(endpointIP) == binding.ProtocolIP {
+		flowBuilder = flowBuilder.Action().LoadToRegField(EndpointIPField, binary.BigEndian.Uint32(endpointIP.To4()))
+	} else {
+		// The xxreg is made of 4 consecutive regs, the first one holding the most significant bits.
+		ipVal := endpointIP.To16()
+		for i := 0; i < 4; i++ {
+			field := binding.NewRegField(EndpointIP6Field.GetRegID()*4+i, 0, 31)
+			flowBuilder = flowBuilder.Action().LoadToRegField(field, binary.BigEndian.Uint32(ipVal[i*4:i*4+4]))
+		}
+	}
+	return flowBuilder.Action().LoadToRegField(EndpointPortField, uint32(endpointPort)).
+		// Like the buckets of the Service group, which are bypassed.
+		Action().GotoTable(ServiceLBTable.GetNext()).
+		Done()
+}
+
 // flowsToTrace is used to generate flows for Traceflow from globalConjMatchFlowCache and policyCache.
 func (f *featureNetworkPolicy) flowsToTrace(dataplaneTag uint8,
 	ovsMetersAreSupported,
@@ -1601,7 +1642,9 @@ func (f *featurePodConnectivity) arpNormalFlow() binding.Flow {
 		Done()
 }
 
-func (f *featureNetworkPolicy) allowRulesMetricFlows(conjunctionID uint32, ingress bool, tableID uint8) []binding.Flow {
+// allowRulesMetricFlows generates the flows to track the stats of a rule which allows traffic. If meterID is not nil,
+// the packets of the connections allowed by the rule are also rate-limited by the meter.
+func (f *featureNetworkPolicy) allowRulesMetricFlows(conjunctionID uint32, ingress bool, tableID uint8, meterID *uint32) []binding.Flow {
 	cookieID := f.cookieAllocator.Request(f.category).Raw()
 	metricTable := IngressMetricTable
 	offset := 0
@@ -1620,12 +1663,15 @@ func (f *featureNetworkPolicy) allowRulesMetricFlows(conjunctionID uint32, ingre
 		metricTable = MulticastIngressMetricTable
 	}
 	metricFlow := func(isCTNew bool, protocol binding.Protocol) binding.Flow {
-		return metricTable.ofTable.BuildFlow(priorityNormal).
+		fb := metricTable.ofTable.BuildFlow(priorityNormal).
 			Cookie(cookieID).
 			MatchProtocol(protocol).
 			MatchCTStateNew(isCTNew).
-			MatchCTLabelField(0, uint64(conjunctionID)<<offset, field).
-			Action().NextTable().
+			MatchCTLabelField(0, uint64(conjunctionID)<<offset, field)
+		if meterID != nil {
+			fb = fb.Action().Meter(*meterID)
+		}
+		return fb.Action().NextTable().
 			Done()
 	}
 	var flows []binding.Flow
@@ -1669,6 +1715,49 @@ func (f *featureNetworkPolicy) denyRuleMetricFlow(conjunctionID uint32, ingress
 		Done()
 }
 
+// auditRuleMetricFlows generates the flows to track the connections matching a rule with the Audit action. As such a
+// rule is not terminal, a connection is committed with the rule ID in ct_label only if it is not denied, and not
+// allowed by another rule, afterwards.
+func (f *featureNetworkPolicy) auditRuleMetricFlows(conjunctionID uint32, ingress bool) []binding.Flow {
+	cookieID := f.cookieAllocator.Request(f.category).Raw()
+	metricTable := IngressMetricTable
+	conjReg := TFIngressConjIDField
+	offset := 0
+	field := IngressRuleCTLabel
+	if !ingress {
+		metricTable = EgressMetricTable
+		conjReg = TFEgressConjIDField
+		offset = 32
+		field = EgressRuleCTLabel
+	}
+	var flows []binding.Flow
+	for _, ipProtocol := range f.ipProtocols {
+		ctZone := CtZone
+		if ipProtocol == binding.ProtocolIPv6 {
+			ctZone = CtZoneV6
+		}
+		flows = append(flows,
+			metricTable.ofTable.BuildFlow(priorityNormal).
+				Cookie(cookieID).
+				MatchProtocol(ipProtocol).
+				MatchCTStateNew(true).
+				MatchRegFieldWithValue(conjReg, conjunctionID).
+				Action().CT(true, metricTable.GetNext(), ctZone, f.ctZoneSrcField).
+				LoadToLabelField(uint64(conjunctionID), field).
+				CTDone().
+				Done(),
+			metricTable.ofTable.BuildFlow(priorityNormal).
+				Cookie(cookieID).
+				MatchProtocol(ipProtocol).
+				MatchCTStateNew(false).
+				MatchCTLabelField(0, uint64(conjunctionID)<<offset, field).
+				Action().NextTable().
+				Done(),
+		)
+	}
+	return flows
+}
+
 // ipv6Flows generates the flows to allow IPv6 packets from link-local addresses and handle multicast packets, Neighbor
 // Solicitation and ND Advertisement packets properly.
 func (f *featurePodConnectivity) ipv6Flows() []binding.Flow {
@@ -1885,6 +1974,45 @@ func (f *featureNetworkPolicy) conjunctionActionPassFlow(conjunctionID uint32, t
 		Done()
 }
 
+// conjunctionActionAuditFlow generates the flow for the rules with the Audit action. Such rules are not terminal: the
+// packet is sent to Antrea Agent to be logged, then resubmitted to the same table so that the rules with lower
+// priorities are still enforced. The audited mark prevents the resubmitted packet from matching the rule again.
+func (f *featureNetworkPolicy) conjunctionActionAuditFlow(conjunctionID uint32, table binding.Table, priority *uint16, enableLogging bool) binding.Flow {
+	ofPriority := *priority
+	conjReg := TFIngressConjIDField
+	auditedRegMark, notAuditedRegMark := APIngressAuditedRegMark, APIngressNotAuditedRegMark
+	tableID := table.GetID()
+	if _, ok := f.egressTables[tableID]; ok {
+		conjReg = TFEgressConjIDField
+		auditedRegMark, notAuditedRegMark = APEgressAuditedRegMark, APEgressNotAuditedRegMark
+	}
+	flowBuilder := table.BuildFlow(ofPriority).
+		Cookie(f.cookieAllocator.Request(f.category).Raw()).
+		MatchConjID(conjunctionID).
+		MatchRegMark(notAuditedRegMark).
+		Action().LoadToRegField(conjReg, conjunctionID).
+		Action().LoadRegMark(auditedRegMark)
+
+	var packetInOperations uint8
+	if f.enableDenyTracking {
+		packetInOperations += PacketInNPStoreDenyOperation
+	}
+	if enableLogging {
+		packetInOperations += PacketInNPLoggingOperation
+	}
+	if packetInOperations != 0 {
+		groupID := f.getLoggingAndResubmitGroupID(tableID)
+		return flowBuilder.
+			Action().LoadRegMark(DispositionAllowRegMark).
+			Action().LoadToRegField(PacketInOperationField, uint32(packetInOperations)).
+			Action().LoadToRegField(PacketInTableField, uint32(tableID)).
+			Action().Group(groupID).
+			Done()
+	}
+	return flowBuilder.Action().ResubmitToTables(tableID).
+		Done()
+}
+
 func (c *client) Disconnect() error {
 	return c.bridge.Disconnect()
 }
@@ -2229,16 +2357,38 @@ func (f *featureEgress) snatIPFromTunnelFlow(snatIP net.IP, mark uint32) binding
 // it sets the packet mark with the ID of the SNAT IP, for the traffic from local Pods to external; if the SNAT IP is
 // on a remote Node, it tunnels the packets to the remote Node.
 func (f *featureEgress) snatRuleFlow(ofPort uint32, snatIP net.IP, snatMark uint32, localGatewayMAC net.HardwareAddr) binding.Flow {
+	return f.snatRuleFlowForDestination(ofPort, snatIP, snatMark, localGatewayMAC, nil)
+}
+
+// snatDestinationRuleFlows generates the flows that apply the SNAT rule for a local Pod only to the traffic destined
+// for the given CIDRs. The flows have a higher priority than the flows generated by snatRuleFlow and snatGroupRuleFlow,
+// so that they take precedence over the Egress which applies to all destinations.
+func (f *featureEgress) snatDestinationRuleFlows(ofPort uint32, snatIP net.IP, snatMark uint32, localGatewayMAC net.HardwareAddr, dstCIDRs []*net.IPNet) []binding.Flow {
+	var flows []binding.Flow
+	for _, dstCIDR := range dstCIDRs {
+		flows = append(flows, f.snatRuleFlowForDestination(ofPort, snatIP, snatMark, localGatewayMAC, dstCIDR))
+	}
+	return flows
+}
+
+func (f *featureEgress) snatRuleFlowForDestination(ofPort uint32, snatIP net.IP, snatMark uint32, localGatewayMAC net.HardwareAddr, dstCIDR *net.IPNet) binding.Flow {
 	cookieID := f.cookieAllocator.Request(f.category).Raw()
 	ipProtocol := getIPProtocol(snatIP)
+	priority := priorityNormal
+	if dstCIDR != nil {
+		priority = priorityNormal + 1
+	}
 	if snatMark != 0 {
 		// Local SNAT IP.
-		fb := EgressMarkTable.ofTable.BuildFlow(priorityNormal).
+		fb := EgressMarkTable.ofTable.BuildFlow(priority).
 			Cookie(cookieID).
 			MatchProtocol(ipProtocol).
 			MatchCTStateTrk(true).
-			MatchInPort(ofPort).
-			Action().LoadPktMarkRange(snatMark, snatPktMarkRange).
+			MatchInPort(ofPort)
+		if dstCIDR != nil {
+			fb = fb.MatchDstIPNet(*dstCIDR)
+		}
+		fb = fb.Action().LoadPktMarkRange(snatMark, snatPktMarkRange).
 			Action().LoadRegMark(ToGatewayRegMark)
 		if f.enableEgressTrafficShaping {
 			// To apply rate-limit on all traffic.
@@ -2249,11 +2399,14 @@ func (f *featureEgress) snatRuleFlow(ofPort uint32, snatIP net.IP, snatMark uint
 		return fb.Done()
 	}
 	// SNAT IP should be on a remote Node.
-	return EgressMarkTable.ofTable.BuildFlow(priorityNormal).
+	fb := EgressMarkTable.ofTable.BuildFlow(priority).
 		Cookie(cookieID).
 		MatchProtocol(ipProtocol).
-		MatchInPort(ofPort).
-		Action().SetSrcMAC(localGatewayMAC).
+		MatchInPort(ofPort)
+	if dstCIDR != nil {
+		fb = fb.MatchDstIPNet(*dstCIDR)
+	}
+	return fb.Action().SetSrcMAC(localGatewayMAC).
 		Action().SetDstMAC(GlobalVirtualMAC).
 		Action().SetTunnelDst(snatIP). // Set tunnel destination to the SNAT IP.
 		Action().LoadRegMark(ToTunnelRegMark, RemoteSNATRegMark).
@@ -2261,6 +2414,106 @@ func (f *featureEgress) snatRuleFlow(ofPort uint32, snatIP net.IP, snatMark uint
 		Done()
 }
 
+// snatGroupRuleFlow generates the flow that applies the SNAT rule of an ActiveActive Egress for a local Pod. It loads
+// the ID of the group of the Egress to EgressGroupIDField and resubmits the packets to EgressMarkTable, where the flows
+// generated by egressGroupFlows select an Egress IP for the connection.
+func (f *featureEgress) snatGroupRuleFlow(ofPort uint32, groupID binding.GroupIDType, ipProtocol binding.Protocol) binding.Flow {
+	return EgressMarkTable.ofTable.BuildFlow(priorityNormal).
+		Cookie(f.cookieAllocator.Request(f.category).Raw()).
+		MatchProtocol(ipProtocol).
+		MatchCTStateTrk(true).
+		MatchInPort(ofPort).
+		MatchRegMark(EgressIPNotSelectedRegMark).
+		Action().LoadToRegField(EgressGroupIDField, uint32(groupID)).
+		Action().ResubmitToTables(EgressMarkTable.GetID()).
+		Done()
+}
+
+// egressGroupFlows generates the flows that select an Egress IP for the packets of an ActiveActive Egress. The packets
+// of the connections whose Egress IP index has been committed to EgressIPIndexCTMarkField keep using that Egress IP,
+// while the other packets are sent to the group of the Egress. A nil IP in snatIPs is an unused index.
+func (f *featureEgress) egressGroupFlows(groupID binding.GroupIDType, snatIPs []net.IP) []binding.Flow {
+	var flows []binding.Flow
+	var ipProtocol binding.Protocol
+	for i, snatIP := range snatIPs {
+		if snatIP == nil {
+			continue
+		}
+		ipProtocol = getIPProtocol(snatIP)
+		flows = append(flows, EgressMarkTable.ofTable.BuildFlow(priorityHigh+1).
+			Cookie(f.cookieAllocator.Request(f.category).Raw()).
+			MatchProtocol(ipProtocol).
+			MatchRegFieldWithValue(EgressGroupIDField, uint32(groupID)).
+			MatchRegMark(EgressIPNotSelectedRegMark).
+			MatchCTMark(binding.NewCTMark(EgressIPIndexCTMarkField, uint32(i+1))).
+			Action().SetTunnelDst(snatIP).
+			Action().LoadRegMark(EgressIPSelectedRegMark).
+			Action().ResubmitToTables(EgressMarkTable.GetID()).
+			Done())
+	}
+	flows = append(flows, EgressMarkTable.ofTable.BuildFlow(priorityHigh).
+		Cookie(f.cookieAllocator.Request(f.category).Raw()).
+		MatchProtocol(ipProtocol).
+		MatchRegFieldWithValue(EgressGroupIDField, uint32(groupID)).
+		MatchRegMark(EgressIPNotSelectedRegMark).
+		Action().Group(groupID).
+		Done())
+	return flows
+}
+
+// egressGroup generates the group that distributes the connections of an ActiveActive Egress across its Egress IPs.
+// Each bucket loads the index of an Egress IP in snatIPs to EgressIPIndexField, sets the tunnel destination to the
+// Egress IP and resubmits the packets to EgressMarkTable, where they are marked by the flow generated by
+// snatIPFromTunnelFlow if the Egress IP is on the local Node, or tunnelled to the remote Node by the flow generated by
+// snatRemoteIPFlow otherwise. A nil IP in snatIPs is an unused index.
+func (f *featureEgress) egressGroup(groupID binding.GroupIDType, snatIPs []net.IP) binding.Group {
+	group := f.bridge.NewGroup(groupID)
+	for i, snatIP := range snatIPs {
+		if snatIP == nil {
+			continue
+		}
+		group = group.Bucket().Weight(100).
+			LoadToRegField(EgressIPIndexField, uint32(i+1)).
+			SetTunnelDst(snatIP).
+			LoadRegMark(EgressIPSelectedRegMark).
+			ResubmitToTable(EgressMarkTable.GetID()).
+			Done()
+	}
+	return group
+}
+
+// egressIPIndexCommitFlow generates the flow that commits the first packet of a connection of an ActiveActive Egress,
+// persisting the index of the Egress IP selected by the group from EgressIPIndexField to EgressIPIndexCTMarkField.
+func (f *featureEgress) egressIPIndexCommitFlow(ipProtocol binding.Protocol) binding.Flow {
+	return ConntrackCommitTable.ofTable.BuildFlow(priorityHigh).
+		Cookie(f.cookieAllocator.Request(f.category).Raw()).
+		MatchProtocol(ipProtocol).
+		MatchCTStateNew(true).
+		MatchCTStateTrk(true).
+		MatchCTStateSNAT(false).
+		MatchCTMark(NotServiceCTMark).
+		MatchRegMark(EgressIPSelectedRegMark).
+		Action().CT(true, ConntrackCommitTable.GetNext(), f.ctZones[ipProtocol], f.ctZoneSrcField).
+		MoveToCtMarkField(PktSourceField, ConnSourceCTMarkField).
+		MoveToCtMarkField(EgressIPIndexField, EgressIPIndexCTMarkField).
+		CTDone().
+		Done()
+}
+
+// snatRemoteIPFlow generates the flow that tunnels the packets to the remote Node holding the Egress IP selected by
+// the group of an ActiveActive Egress. The tunnel destination has been set to the Egress IP by the group.
+func (f *featureEgress) snatRemoteIPFlow(ipProtocol binding.Protocol) binding.Flow {
+	return EgressMarkTable.ofTable.BuildFlow(priorityLow).
+		Cookie(f.cookieAllocator.Request(f.category).Raw()).
+		MatchProtocol(ipProtocol).
+		MatchRegMark(EgressIPSelectedRegMark).
+		Action().SetSrcMAC(f.gatewayMAC).
+		Action().SetDstMAC(GlobalVirtualMAC).
+		Action().LoadRegMark(ToTunnelRegMark, RemoteSNATRegMark).
+		Action().GotoStage(stageSwitching).
+		Done()
+}
+
 func (f *featureEgress) egressQoSFlow(mark uint32) binding.Flow {
 	return EgressQoSTable.ofTable.BuildFlow(priorityNormal).
 		Cookie(f.cookieAllocator.Request(f.category).Raw()).
@@ -2656,6 +2909,10 @@ func (f *featureEgress) externalFlows() []binding.Flow {
 				Done(),
 			// This generates the flow to bypass the packets destined for local Node.
 			f.snatSkipNodeFlow(f.nodeIPs[ipProtocol]),
+			// This generates the flow to tunnel the packets to the remote Egress IPs selected by ActiveActive Egresses.
+			f.snatRemoteIPFlow(ipProtocol),
+			// This generates the flow to commit the Egress IPs selected by ActiveActive Egresses to the connections.
+			f.egressIPIndexCommitFlow(ipProtocol),
 		)
 		// This generates the flows to bypass the packets sourced from local Pods and destined for the except CIDRs for Egress.
 		for _, cidr := range f.exceptCIDRs[ipProtocol] {
@@ -268,6 +268,20 @@ func (mr *MockClientMockRecorder) Initialize(roundInfo, config, networkConfig, e
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Initialize", reflect.TypeOf((*MockClient)(nil).Initialize), roundInfo, config, networkConfig, egressConfig, serviceConfig, l7NetworkPolicyConfig)
 }
 
+// InstallEgressGroup mocks base method.
+func (m *MockClient) InstallEgressGroup(groupID openflow0.GroupIDType, snatIPs []net.IP) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstallEgressGroup", groupID, snatIPs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InstallEgressGroup indicates an expected call of InstallEgressGroup.
+func (mr *MockClientMockRecorder) InstallEgressGroup(groupID, snatIPs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallEgressGroup", reflect.TypeOf((*MockClient)(nil).InstallEgressGroup), groupID, snatIPs)
+}
+
 // InstallEgressQoS mocks base method.
 func (m *MockClient) InstallEgressQoS(meterID, rate, burst uint32) error {
 	m.ctrl.T.Helper()
@@ -436,6 +450,20 @@ func (mr *MockClientMockRecorder) InstallPodFlows(interfaceName, podInterfaceIPs
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallPodFlows", reflect.TypeOf((*MockClient)(nil).InstallPodFlows), interfaceName, podInterfaceIPs, podInterfaceMAC, ofPort, vlanID, labelID)
 }
 
+// InstallPodSNATDestinationFlows mocks base method.
+func (m *MockClient) InstallPodSNATDestinationFlows(egressName string, ofPort uint32, snatIP net.IP, snatMark uint32, dstCIDRs []*net.IPNet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstallPodSNATDestinationFlows", egressName, ofPort, snatIP, snatMark, dstCIDRs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InstallPodSNATDestinationFlows indicates an expected call of InstallPodSNATDestinationFlows.
+func (mr *MockClientMockRecorder) InstallPodSNATDestinationFlows(egressName, ofPort, snatIP, snatMark, dstCIDRs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallPodSNATDestinationFlows", reflect.TypeOf((*MockClient)(nil).InstallPodSNATDestinationFlows), egressName, ofPort, snatIP, snatMark, dstCIDRs)
+}
+
 // InstallPodSNATFlows mocks base method.
 func (m *MockClient) InstallPodSNATFlows(ofPort uint32, snatIP net.IP, snatMark uint32) error {
 	m.ctrl.T.Helper()
@@ -450,6 +478,20 @@ func (mr *MockClientMockRecorder) InstallPodSNATFlows(ofPort, snatIP, snatMark a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallPodSNATFlows", reflect.TypeOf((*MockClient)(nil).InstallPodSNATFlows), ofPort, snatIP, snatMark)
 }
 
+// InstallPodSNATGroupFlows mocks base method.
+func (m *MockClient) InstallPodSNATGroupFlows(ofPort uint32, groupID openflow0.GroupIDType, ipProtocol openflow0.Protocol) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstallPodSNATGroupFlows", ofPort, groupID, ipProtocol)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InstallPodSNATGroupFlows indicates an expected call of InstallPodSNATGroupFlows.
+func (mr *MockClientMockRecorder) InstallPodSNATGroupFlows(ofPort, groupID, ipProtocol any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallPodSNATGroupFlows", reflect.TypeOf((*MockClient)(nil).InstallPodSNATGroupFlows), ofPort, groupID, ipProtocol)
+}
+
 // InstallPolicyBypassFlows mocks base method.
 func (m *MockClient) InstallPolicyBypassFlows(protocol openflow0.Protocol, ipNet *net.IPNet, port uint16, isIngress bool) error {
 	m.ctrl.T.Helper()
@@ -478,6 +520,20 @@ func (mr *MockClientMockRecorder) InstallPolicyRuleFlows(ofPolicyRule any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallPolicyRuleFlows", reflect.TypeOf((*MockClient)(nil).InstallPolicyRuleFlows), ofPolicyRule)
 }
 
+// InstallPolicyRuleMeter mocks base method.
+func (m *MockClient) InstallPolicyRuleMeter(meterID, rate, burst uint32, packetsPerSecond bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstallPolicyRuleMeter", meterID, rate, burst, packetsPerSecond)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InstallPolicyRuleMeter indicates an expected call of InstallPolicyRuleMeter.
+func (mr *MockClientMockRecorder) InstallPolicyRuleMeter(meterID, rate, burst, packetsPerSecond any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallPolicyRuleMeter", reflect.TypeOf((*MockClient)(nil).InstallPolicyRuleMeter), meterID, rate, burst, packetsPerSecond)
+}
+
 // InstallSNATBypassServiceFlows mocks base method.
 func (m *MockClient) InstallSNATBypassServiceFlows(serviceCIDRs []*net.IPNet) error {
 	m.ctrl.T.Helper()
@@ -548,6 +604,20 @@ func (mr *MockClientMockRecorder) InstallTraceflowFlows(dataplaneTag, liveTraffi
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallTraceflowFlows", reflect.TypeOf((*MockClient)(nil).InstallTraceflowFlows), dataplaneTag, liveTraffic, droppedOnly, receiverOnly, packet, ofPort, timeoutSeconds)
 }
 
+// InstallTraceflowServiceEndpointFlows mocks base method.
+func (m *MockClient) InstallTraceflowServiceEndpointFlows(dataplaneTag uint8, packet *openflow0.Packet, endpointIP net.IP, endpointPort, timeoutSeconds uint16) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstallTraceflowServiceEndpointFlows", dataplaneTag, packet, endpointIP, endpointPort, timeoutSeconds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InstallTraceflowServiceEndpointFlows indicates an expected call of InstallTraceflowServiceEndpointFlows.
+func (mr *MockClientMockRecorder) InstallTraceflowServiceEndpointFlows(dataplaneTag, packet, endpointIP, endpointPort, timeoutSeconds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallTraceflowServiceEndpointFlows", reflect.TypeOf((*MockClient)(nil).InstallTraceflowServiceEndpointFlows), dataplaneTag, packet, endpointIP, endpointPort, timeoutSeconds)
+}
+
 // InstallTrafficControlMarkFlows mocks base method.
 func (m *MockClient) InstallTrafficControlMarkFlows(name string, sourceOFPorts []uint32, targetOFPort uint32, direction v1alpha2.Direction, action v1alpha2.TrafficControlAction, priority types.TrafficControlFlowPriority) error {
 	m.ctrl.T.Helper()
@@ -876,6 +946,20 @@ func (mr *MockClientMockRecorder) SubscribePacketIn(reason, pktInQueue any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribePacketIn", reflect.TypeOf((*MockClient)(nil).SubscribePacketIn), reason, pktInQueue)
 }
 
+// UninstallEgressGroup mocks base method.
+func (m *MockClient) UninstallEgressGroup(groupID openflow0.GroupIDType) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UninstallEgressGroup", groupID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UninstallEgressGroup indicates an expected call of UninstallEgressGroup.
+func (mr *MockClientMockRecorder) UninstallEgressGroup(groupID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UninstallEgressGroup", reflect.TypeOf((*MockClient)(nil).UninstallEgressGroup), groupID)
+}
+
 // UninstallEgressQoS mocks base method.
 func (m *MockClient) UninstallEgressQoS(meterID uint32) error {
 	m.ctrl.T.Helper()
@@ -988,6 +1072,20 @@ func (mr *MockClientMockRecorder) UninstallPodFlows(interfaceName any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UninstallPodFlows", reflect.TypeOf((*MockClient)(nil).UninstallPodFlows), interfaceName)
 }
 
+// UninstallPodSNATDestinationFlows mocks base method.
+func (m *MockClient) UninstallPodSNATDestinationFlows(egressName string, ofPort uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UninstallPodSNATDestinationFlows", egressName, ofPort)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UninstallPodSNATDestinationFlows indicates an expected call of UninstallPodSNATDestinationFlows.
+func (mr *MockClientMockRecorder) UninstallPodSNATDestinationFlows(egressName, ofPort any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UninstallPodSNATDestinationFlows", reflect.TypeOf((*MockClient)(nil).UninstallPodSNATDestinationFlows), egressName, ofPort)
+}
+
 // UninstallPodSNATFlows mocks base method.
 func (m *MockClient) UninstallPodSNATFlows(ofPort uint32) error {
 	m.ctrl.T.Helper()
@@ -1017,6 +1115,20 @@ func (mr *MockClientMockRecorder) UninstallPolicyRuleFlows(ruleID any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UninstallPolicyRuleFlows", reflect.TypeOf((*MockClient)(nil).UninstallPolicyRuleFlows), ruleID)
 }
 
+// UninstallPolicyRuleMeter mocks base method.
+func (m *MockClient) UninstallPolicyRuleMeter(meterID uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UninstallPolicyRuleMeter", meterID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UninstallPolicyRuleMeter indicates an expected call of UninstallPolicyRuleMeter.
+func (mr *MockClientMockRecorder) UninstallPolicyRuleMeter(meterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UninstallPolicyRuleMeter", reflect.TypeOf((*MockClient)(nil).UninstallPolicyRuleMeter), meterID)
+}
+
 // UninstallSNATMarkFlows mocks base method.
 func (m *MockClient) UninstallSNATMarkFlows(mark uint32) error {
 	m.ctrl.T.Helper()
@@ -16,6 +16,8 @@ package capture
 
 import (
 	"encoding/binary"
+	"fmt"
+	"math"
 	"net"
 	"strings"
 
@@ -72,11 +74,36 @@ func compareProtocol(protocol uint32, skipTrue, skipFalse uint8) bpf.Instruction
 	return bpf.JumpIf{Cond: bpf.JumpEqual, Val: protocol, SkipTrue: skipTrue, SkipFalse: skipFalse}
 }
 
+// addressMatchSize returns the number of instructions needed to match an address against ipNet.
+func addressMatchSize(ipNet *net.IPNet) int {
+	if ones, bits := ipNet.Mask.Size(); ones != bits {
+		// An extra instruction is needed to apply the mask.
+		return 3
+	}
+	return 2
+}
+
 // compilePacketFilter compiles the CRD spec to bpf instructions. For now, we only focus on
-// ipv4 traffic. Compared to the raw BPF filter supported by libpcap, we only need to support
-// limited use cases, so an expression parser is not needed.
-func compilePacketFilter(packetSpec *crdv1alpha1.Packet, srcIP, dstIP net.IP) []bpf.Instruction {
-	size := uint8(calculateInstructionsSize(packetSpec))
+// ipv4 traffic. The structured fields of the spec are compiled directly, while the optional
+// filter expression is compiled by compileFilterExpression and ANDed with them.
+// srcIPNets and dstIPNets are the addresses or networks the source and destination can match,
+// a packet matches a side if its address matches any of them.
+func compilePacketFilter(packetSpec *crdv1alpha1.Packet, srcIPNets, dstIPNets []*net.IPNet) ([]bpf.Instruction, error) {
+	var filterInst []bpf.Instruction
+	totalSize := calculateInstructionsSize(packetSpec, srcIPNets, dstIPNets)
+	if packetSpec != nil && packetSpec.Filter != "" {
+		var err error
+		filterInst, err = compileFilterExpression(packetSpec.Filter)
+		if err != nil {
+			return nil, fmt.Errorf("invalid filter expression: %w", err)
+		}
+		// The filter expression ends with its own return instructions, which replace the default ones.
+		totalSize += len(filterInst) - 2
+	}
+	if totalSize > math.MaxUint8 {
+		return nil, fmt.Errorf("too many instructions (%d) are needed to filter packets, try with fewer addresses", totalSize)
+	}
+	size := uint8(totalSize)
 
 	// ipv4 check
 	inst := []bpf.Instruction{loadEtherKind}
@@ -101,20 +128,31 @@ func compilePacketFilter(packetSpec *crdv1alpha1.Packet, srcIP, dstIP net.IP) []
 		}
 	}
 
-	// source ip
-	if srcIP != nil {
-		inst = append(inst, loadIPv4SourceAddress)
-		addrVal := binary.BigEndian.Uint32(srcIP[len(srcIP)-4:])
-		// from here we need to check the inst length to calculate skipFalse. If no protocol is set, there will be no related bpf instructions.
-		inst = append(inst, bpf.JumpIf{Cond: bpf.JumpEqual, Val: addrVal, SkipTrue: 0, SkipFalse: size - uint8(len(inst)) - 2})
-
+	// from here we need to check the inst length to calculate skipFalse. If no protocol is set, there will be no related bpf instructions.
+	appendAddressMatch := func(load bpf.LoadAbsolute, ipNets []*net.IPNet) {
+		for i, ipNet := range ipNets {
+			inst = append(inst, load)
+			mask := binary.BigEndian.Uint32(ipNet.Mask[len(ipNet.Mask)-4:])
+			if addressMatchSize(ipNet) == 3 {
+				inst = append(inst, bpf.ALUOpConstant{Op: bpf.ALUOpAnd, Val: mask})
+			}
+			addrVal := binary.BigEndian.Uint32(ipNet.IP[len(ipNet.IP)-4:]) & mask
+			if i == len(ipNets)-1 {
+				inst = append(inst, bpf.JumpIf{Cond: bpf.JumpEqual, Val: addrVal, SkipTrue: 0, SkipFalse: size - uint8(len(inst)) - 2})
+				break
+			}
+			// If the address matches, skip the instructions matching the remaining addresses.
+			remaining := 0
+			for _, n := range ipNets[i+1:] {
+				remaining += addressMatchSize(n)
+			}
+			inst = append(inst, bpf.JumpIf{Cond: bpf.JumpEqual, Val: addrVal, SkipTrue: uint8(remaining), SkipFalse: 0})
+		}
 	}
+	// source ip
+	appendAddressMatch(loadIPv4SourceAddress, srcIPNets)
 	// dst ip
-	if dstIP != nil {
-		inst = append(inst, loadIPv4DestinationAddress)
-		addrVal := binary.BigEndian.Uint32(dstIP[len(dstIP)-4:])
-		inst = append(inst, bpf.JumpIf{Cond: bpf.JumpEqual, Val: addrVal, SkipTrue: 0, SkipFalse: size - uint8(len(inst)) - 2})
-	}
+	appendAddressMatch(loadIPv4DestinationAddress, dstIPNets)
 
 	// ports
 	var srcPort, dstPort uint16
@@ -148,12 +186,55 @@ func compilePacketFilter(packetSpec *crdv1alpha1.Packet, srcIP, dstIP net.IP) []
 
 	}
 
+	if filterInst != nil {
+		inst = append(inst, filterInst...)
+		return inst, nil
+	}
+
 	// return
 	inst = append(inst, returnKeep)
 	inst = append(inst, returnDrop)
 
-	return inst
+	return inst, nil
+
+}
+
+// reversePacketSpec returns a copy of packetSpec matching the packets sent in the reverse direction, i.e. with the
+// source and destination ports, and the source and destination qualifiers of the filter expression, swapped.
+func reversePacketSpec(packetSpec *crdv1alpha1.Packet) *crdv1alpha1.Packet {
+	if packetSpec == nil {
+		return nil
+	}
+	reversed := packetSpec.DeepCopy()
+	if tcp := reversed.TransportHeader.TCP; tcp != nil {
+		tcp.SrcPort, tcp.DstPort = tcp.DstPort, tcp.SrcPort
+	}
+	if udp := reversed.TransportHeader.UDP; udp != nil {
+		udp.SrcPort, udp.DstPort = udp.DstPort, udp.SrcPort
+	}
+	if reversed.Filter != "" {
+		reversed.Filter = reverseFilterExpression(reversed.Filter)
+	}
+	return reversed
+}
 
+// compileDirectionalPacketFilter compiles the bpf instructions matching the packets sent in the given direction
+// between the source and the destination. For the Both direction, the programs of the two directions are chained:
+// all the failed checks of the first program jump to its last instruction (ret #0), which is replaced with the
+// first instruction of the second program, so that a packet is kept if it matches either of them.
+func compileDirectionalPacketFilter(packetSpec *crdv1alpha1.Packet, srcIPNets, dstIPNets []*net.IPNet, direction crdv1alpha1.PacketCaptureDirection) ([]bpf.Instruction, error) {
+	if direction == crdv1alpha1.PacketCaptureDirectionDestinationToSource {
+		return compilePacketFilter(reversePacketSpec(packetSpec), dstIPNets, srcIPNets)
+	}
+	forward, err := compilePacketFilter(packetSpec, srcIPNets, dstIPNets)
+	if err != nil || direction != crdv1alpha1.PacketCaptureDirectionBoth {
+		return forward, err
+	}
+	reverse, err := compilePacketFilter(reversePacketSpec(packetSpec), dstIPNets, srcIPNets)
+	if err != nil {
+		return nil, err
+	}
+	return append(forward[:len(forward)-1], reverse...), nil
 }
 
 // We need to figure out how long the instruction list will be first. It will be used in the instructions' jump case.
@@ -178,7 +259,7 @@ func compilePacketFilter(packetSpec *crdv1alpha1.Packet, srcIP, dstIP net.IP) []
 // (015) ret      #262144                                  # MATCH
 // (016) ret      #0                                       # NOMATCH
 
-func calculateInstructionsSize(packet *crdv1alpha1.Packet) int {
+func calculateInstructionsSize(packet *crdv1alpha1.Packet, srcIPNets, dstIPNets []*net.IPNet) int {
 	count := 0
 	// load ethertype
 	count++
@@ -212,7 +293,12 @@ func calculateInstructionsSize(packet *crdv1alpha1.Packet) int {
 		}
 	}
 	// src and dst ip
-	count += 4
+	for _, ipNet := range srcIPNets {
+		count += addressMatchSize(ipNet)
+	}
+	for _, ipNet := range dstIPNets {
+		count += addressMatchSize(ipNet)
+	}
 
 	// ret command
 	count += 2
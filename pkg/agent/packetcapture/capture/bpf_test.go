@@ -18,7 +18,9 @@ import (
 	"net"
 	"testing"
 
+	"github.com/gopacket/gopacket/layers"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"golang.org/x/net/bpf"
 	"k8s.io/apimachinery/pkg/util/intstr"
 
@@ -30,6 +32,9 @@ var (
 	testUDPProtocol       = intstr.FromString("UDP")
 	testSrcPort     int32 = 80
 	testDstPort     int32 = 80
+
+	testSrcIPNets = []*net.IPNet{{IP: net.ParseIP("127.0.0.1"), Mask: net.CIDRMask(32, 32)}}
+	testDstIPNets = []*net.IPNet{{IP: net.ParseIP("127.0.0.2"), Mask: net.CIDRMask(32, 32)}}
 )
 
 func TestCalculateInstructionsSize(t *testing.T) {
@@ -92,23 +97,23 @@ func TestCalculateInstructionsSize(t *testing.T) {
 
 	for _, item := range tt {
 		t.Run(item.name, func(t *testing.T) {
-			assert.Equal(t, item.count, calculateInstructionsSize(item.packet))
+			assert.Equal(t, item.count, calculateInstructionsSize(item.packet, testSrcIPNets, testDstIPNets))
 		})
 	}
 }
 
 func TestPacketCaptureCompileBPF(t *testing.T) {
 	tt := []struct {
-		name  string
-		srcIP net.IP
-		dstIP net.IP
-		spec  *crdv1alpha1.PacketCaptureSpec
-		inst  []bpf.Instruction
+		name      string
+		srcIPNets []*net.IPNet
+		dstIPNets []*net.IPNet
+		spec      *crdv1alpha1.PacketCaptureSpec
+		inst      []bpf.Instruction
 	}{
 		{
-			name:  "with-proto-and-port",
-			srcIP: net.ParseIP("127.0.0.1"),
-			dstIP: net.ParseIP("127.0.0.2"),
+			name:      "with-proto-and-port",
+			srcIPNets: testSrcIPNets,
+			dstIPNets: testDstIPNets,
 			spec: &crdv1alpha1.PacketCaptureSpec{
 				Packet: &crdv1alpha1.Packet{
 					Protocol: &testTCPProtocol,
@@ -140,9 +145,9 @@ func TestPacketCaptureCompileBPF(t *testing.T) {
 			},
 		},
 		{
-			name:  "udp-proto-str",
-			srcIP: net.ParseIP("127.0.0.1"),
-			dstIP: net.ParseIP("127.0.0.2"),
+			name:      "udp-proto-str",
+			srcIPNets: testSrcIPNets,
+			dstIPNets: testDstIPNets,
 			spec: &crdv1alpha1.PacketCaptureSpec{
 				Packet: &crdv1alpha1.Packet{
 					Protocol: &testUDPProtocol,
@@ -173,12 +178,158 @@ func TestPacketCaptureCompileBPF(t *testing.T) {
 				bpf.RetConstant{Val: 0},
 			},
 		},
+		{
+			name:      "multiple-dst-addresses",
+			srcIPNets: testSrcIPNets,
+			dstIPNets: []*net.IPNet{
+				{IP: net.ParseIP("10.0.0.1"), Mask: net.CIDRMask(32, 32)},
+				{IP: net.ParseIP("10.0.0.2"), Mask: net.CIDRMask(32, 32)},
+			},
+			spec: &crdv1alpha1.PacketCaptureSpec{
+				Packet: &crdv1alpha1.Packet{
+					Protocol: &testUDPProtocol,
+				},
+			},
+			inst: []bpf.Instruction{
+				bpf.LoadAbsolute{Off: 12, Size: 2},
+				bpf.JumpIf{Cond: bpf.JumpEqual, Val: 0x800, SkipFalse: 9},
+				bpf.LoadAbsolute{Off: 23, Size: 1},
+				bpf.JumpIf{Cond: bpf.JumpEqual, Val: 0x11, SkipFalse: 7},
+				bpf.LoadAbsolute{Off: 26, Size: 4},
+				bpf.JumpIf{Cond: bpf.JumpEqual, Val: 0x7f000001, SkipFalse: 5},
+				bpf.LoadAbsolute{Off: 30, Size: 4},
+				bpf.JumpIf{Cond: bpf.JumpEqual, Val: 0x0a000001, SkipTrue: 2}, // skip the next address if matched
+				bpf.LoadAbsolute{Off: 30, Size: 4},
+				bpf.JumpIf{Cond: bpf.JumpEqual, Val: 0x0a000002, SkipFalse: 1},
+				bpf.RetConstant{Val: 262144},
+				bpf.RetConstant{Val: 0},
+			},
+		},
+		{
+			name:      "dst-cidr-with-filter-expression",
+			srcIPNets: testSrcIPNets,
+			dstIPNets: []*net.IPNet{{IP: net.ParseIP("10.0.0.0"), Mask: net.CIDRMask(8, 32)}},
+			spec: &crdv1alpha1.PacketCaptureSpec{
+				Packet: &crdv1alpha1.Packet{
+					Filter: "udp port 53",
+				},
+			},
+			inst: []bpf.Instruction{
+				bpf.LoadAbsolute{Off: 12, Size: 2},
+				bpf.JumpIf{Cond: bpf.JumpEqual, Val: 0x800, SkipFalse: 17},
+				bpf.LoadAbsolute{Off: 26, Size: 4},
+				bpf.JumpIf{Cond: bpf.JumpEqual, Val: 0x7f000001, SkipFalse: 15},
+				bpf.LoadAbsolute{Off: 30, Size: 4},
+				bpf.ALUOpConstant{Op: bpf.ALUOpAnd, Val: 0xff000000},
+				bpf.JumpIf{Cond: bpf.JumpEqual, Val: 0x0a000000, SkipFalse: 12},
+				// instructions compiled from the filter expression
+				bpf.LoadAbsolute{Off: 12, Size: 2},
+				bpf.JumpIf{Cond: bpf.JumpEqual, Val: 0x800, SkipFalse: 10},
+				bpf.LoadAbsolute{Off: 23, Size: 1},
+				bpf.JumpIf{Cond: bpf.JumpEqual, Val: 0x11, SkipFalse: 8},
+				bpf.LoadAbsolute{Off: 20, Size: 2},
+				bpf.JumpIf{Cond: bpf.JumpBitsSet, Val: 0x1fff, SkipTrue: 6},
+				bpf.LoadMemShift{Off: 14},
+				bpf.LoadIndirect{Off: 14, Size: 2},
+				bpf.JumpIf{Cond: bpf.JumpEqual, Val: 53, SkipTrue: 2},
+				bpf.LoadIndirect{Off: 16, Size: 2},
+				bpf.JumpIf{Cond: bpf.JumpEqual, Val: 53, SkipFalse: 1},
+				bpf.RetConstant{Val: 262144},
+				bpf.RetConstant{Val: 0},
+			},
+		},
 	}
 
 	for _, item := range tt {
 		t.Run(item.name, func(t *testing.T) {
-			result := compilePacketFilter(item.spec.Packet, item.srcIP, item.dstIP)
+			result, err := compilePacketFilter(item.spec.Packet, item.srcIPNets, item.dstIPNets)
+			require.NoError(t, err)
 			assert.Equal(t, item.inst, result)
 		})
 	}
 }
+
+func TestPacketCaptureCompileBPFErrors(t *testing.T) {
+	_, err := compilePacketFilter(&crdv1alpha1.Packet{Filter: "tcp port"}, testSrcIPNets, testDstIPNets)
+	assert.ErrorContains(t, err, "invalid filter expression")
+
+	var dstIPNets []*net.IPNet
+	for i := 0; i < 200; i++ {
+		dstIPNets = append(dstIPNets, &net.IPNet{IP: net.IPv4(10, 0, 0, byte(i)), Mask: net.CIDRMask(32, 32)})
+	}
+	_, err = compilePacketFilter(&crdv1alpha1.Packet{}, testSrcIPNets, dstIPNets)
+	assert.ErrorContains(t, err, "too many instructions")
+}
+
+func TestCompileDirectionalPacketFilter(t *testing.T) {
+	tcpPacket := func(srcIP, dstIP string, srcPort, dstPort int) []byte {
+		return craftIPv4Packet(t, srcIP, dstIP, &layers.TCP{SrcPort: layers.TCPPort(srcPort), DstPort: layers.TCPPort(dstPort)}, layers.IPProtocolTCP)
+	}
+	packetSpec := &crdv1alpha1.Packet{
+		Protocol: &testTCPProtocol,
+		TransportHeader: crdv1alpha1.TransportHeader{
+			TCP: &crdv1alpha1.TCPHeader{DstPort: &testDstPort},
+		},
+	}
+	request := tcpPacket("127.0.0.1", "127.0.0.2", 12345, 80)
+	reply := tcpPacket("127.0.0.2", "127.0.0.1", 80, 12345)
+	unrelated := tcpPacket("127.0.0.2", "127.0.0.1", 8080, 12345)
+
+	tests := []struct {
+		direction crdv1alpha1.PacketCaptureDirection
+		matched   [][]byte
+		unmatched [][]byte
+	}{
+		{
+			direction: crdv1alpha1.PacketCaptureDirectionSourceToDestination,
+			matched:   [][]byte{request},
+			unmatched: [][]byte{reply, unrelated},
+		},
+		{
+			direction: crdv1alpha1.PacketCaptureDirectionDestinationToSource,
+			matched:   [][]byte{reply},
+			unmatched: [][]byte{request, unrelated},
+		},
+		{
+			direction: crdv1alpha1.PacketCaptureDirectionBoth,
+			matched:   [][]byte{request, reply},
+			unmatched: [][]byte{unrelated},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.direction), func(t *testing.T) {
+			inst, err := compileDirectionalPacketFilter(packetSpec, testSrcIPNets, testDstIPNets, tt.direction)
+			require.NoError(t, err)
+			vm, err := bpf.NewVM(inst)
+			require.NoError(t, err)
+			for _, packet := range tt.matched {
+				n, err := vm.Run(packet)
+				require.NoError(t, err)
+				assert.NotZero(t, n, "packet should be matched")
+			}
+			for _, packet := range tt.unmatched {
+				n, err := vm.Run(packet)
+				require.NoError(t, err)
+				assert.Zero(t, n, "packet should not be matched")
+			}
+		})
+	}
+	// The spec must not be modified when compiling the reverse direction.
+	assert.Nil(t, packetSpec.TransportHeader.TCP.SrcPort)
+
+	// The filter expression is reversed as well for the reply packets.
+	filterSpec := &crdv1alpha1.Packet{Filter: "tcp dst port 80 and src net 10.0.0.0/8"}
+	inst, err := compileDirectionalPacketFilter(filterSpec, nil, nil, crdv1alpha1.PacketCaptureDirectionBoth)
+	require.NoError(t, err)
+	vm, err := bpf.NewVM(inst)
+	require.NoError(t, err)
+	for _, packet := range [][]byte{tcpPacket("10.0.0.1", "192.168.0.1", 12345, 80), tcpPacket("192.168.0.1", "10.0.0.1", 80, 12345)} {
+		n, err := vm.Run(packet)
+		require.NoError(t, err)
+		assert.NotZero(t, n, "packet should be matched")
+	}
+	n, err := vm.Run(tcpPacket("192.168.0.1", "10.0.0.1", 12345, 80))
+	require.NoError(t, err)
+	assert.Zero(t, n, "packet should not be matched")
+	assert.Equal(t, "tcp dst port 80 and src net 10.0.0.0/8", filterSpec.Filter)
+}
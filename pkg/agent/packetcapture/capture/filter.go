@@ -0,0 +1,518 @@
+// Copyright 2024 Antrea Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capture
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/bpf"
+)
+
+// This file implements a compiler for a subset of the pcap-filter expression language (see pcap-filter(7)), so that
+// users can provide filters which cannot be described by the structured fields of the PacketCapture CRD, without
+// depending on libpcap. As for the rest of PacketCapture, only IPv4 traffic is supported. The supported primitives
+// are:
+//
+//	ip | tcp | udp | icmp
+//	[ip] proto <number|tcp|udp|icmp>
+//	[src|dst] host <IPv4 address>
+//	[src|dst] net <IPv4 CIDR>
+//	[tcp|udp] [src|dst] port <number>
+//	[tcp|udp] [src|dst] portrange <number>-<number>
+//
+// Primitives can be combined with "and" ("&&"), "or" ("||"), "not" ("!") and parentheses. Like in pcap-filter,
+// "not" has the highest precedence, and "and" and "or" have the same precedence and are left associative.
+
+type direction int
+
+const (
+	directionAny direction = iota
+	directionSrc
+	directionDst
+)
+
+type primitiveType int
+
+const (
+	primitiveProto primitiveType = iota
+	primitiveIP
+	primitiveHost
+	primitivePort
+)
+
+type filterNode interface{}
+
+type andNode struct {
+	left, right filterNode
+}
+
+type orNode struct {
+	left, right filterNode
+}
+
+type notNode struct {
+	node filterNode
+}
+
+type primitiveNode struct {
+	typ primitiveType
+	dir direction
+	// proto is the IP protocol matched by a primitiveProto, or the transport protocol qualifier of a primitivePort.
+	// 0 means both TCP and UDP for a primitivePort.
+	proto uint32
+	// ipNet is the address or network matched by a primitiveHost.
+	ipNet *net.IPNet
+	// portLow and portHigh is the port range matched by a primitivePort.
+	portLow, portHigh uint16
+}
+
+type filterParser struct {
+	tokens []string
+	pos    int
+}
+
+func tokenizeFilter(expr string) []string {
+	var tokens []string
+	var current strings.Builder
+	flush := func() {
+		if current.Len() > 0 {
+			tokens = append(tokens, current.String())
+			current.Reset()
+		}
+	}
+	for i := 0; i < len(expr); i++ {
+		ch := expr[i]
+		switch {
+		case ch == ' ' || ch == '\t' || ch == '\n':
+			flush()
+		case ch == '(' || ch == ')':
+			flush()
+			tokens = append(tokens, string(ch))
+		case ch == '!':
+			flush()
+			tokens = append(tokens, "not")
+		case (ch == '&' || ch == '|') && i+1 < len(expr) && expr[i+1] == ch:
+			flush()
+			if ch == '&' {
+				tokens = append(tokens, "and")
+			} else {
+				tokens = append(tokens, "or")
+			}
+			i++
+		default:
+			current.WriteByte(ch)
+		}
+	}
+	flush()
+	return tokens
+}
+
+func (p *filterParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *filterParser) next() string {
+	token := p.peek()
+	if token != "" {
+		p.pos++
+	}
+	return token
+}
+
+func (p *filterParser) parseExpression() (filterNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		switch p.peek() {
+		case "and":
+			p.next()
+			right, err := p.parseUnary()
+			if err != nil {
+				return nil, err
+			}
+			left = &andNode{left: left, right: right}
+		case "or":
+			p.next()
+			right, err := p.parseUnary()
+			if err != nil {
+				return nil, err
+			}
+			left = &orNode{left: left, right: right}
+		default:
+			return left, nil
+		}
+	}
+}
+
+func (p *filterParser) parseUnary() (filterNode, error) {
+	switch p.peek() {
+	case "not":
+		p.next()
+		node, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &notNode{node: node}, nil
+	case "(":
+		p.next()
+		node, err := p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		if token := p.next(); token != ")" {
+			return nil, fmt.Errorf("expected \")\", got %q", token)
+		}
+		return node, nil
+	default:
+		return p.parsePrimitive()
+	}
+}
+
+func isPrimitiveEnd(token string) bool {
+	return token == "" || token == "and" || token == "or" || token == ")"
+}
+
+func parseProtocol(token string) (uint32, error) {
+	if proto, ok := ProtocolMap[strings.ToUpper(token)]; ok {
+		return proto, nil
+	}
+	proto, err := strconv.ParseUint(token, 10, 8)
+	if err != nil {
+		return 0, fmt.Errorf("invalid protocol %q", token)
+	}
+	return uint32(proto), nil
+}
+
+func parsePort(token string) (uint16, error) {
+	port, err := strconv.ParseUint(token, 10, 16)
+	if err != nil {
+		return 0, fmt.Errorf("invalid port %q", token)
+	}
+	return uint16(port), nil
+}
+
+func (p *filterParser) parsePrimitive() (filterNode, error) {
+	node := &primitiveNode{}
+	token := p.next()
+	switch token {
+	case "":
+		return nil, fmt.Errorf("unexpected end of filter expression")
+	case "ip6", "arp", "rarp", "ether":
+		return nil, fmt.Errorf("unsupported primitive %q, only IPv4 is supported", token)
+	case "ip", "tcp", "udp", "icmp":
+		if isPrimitiveEnd(p.peek()) {
+			if token == "ip" {
+				return &primitiveNode{typ: primitiveIP}, nil
+			}
+			return &primitiveNode{typ: primitiveProto, proto: ProtocolMap[strings.ToUpper(token)]}, nil
+		}
+		switch token {
+		case "ip":
+			// "ip" is a no-op qualifier for the other primitives as only IPv4 is supported.
+		case "tcp", "udp":
+			node.proto = ProtocolMap[strings.ToUpper(token)]
+		default:
+			return nil, fmt.Errorf("unexpected %q after %q", p.peek(), token)
+		}
+		token = p.next()
+	}
+
+	switch token {
+	case "src":
+		node.dir = directionSrc
+		token = p.next()
+	case "dst":
+		node.dir = directionDst
+		token = p.next()
+	}
+
+	switch token {
+	case "proto":
+		if node.proto != 0 || node.dir != directionAny {
+			return nil, fmt.Errorf("unexpected qualifier for \"proto\"")
+		}
+		proto, err := parseProtocol(p.next())
+		if err != nil {
+			return nil, err
+		}
+		node.typ = primitiveProto
+		node.proto = proto
+	case "host", "net":
+		if node.proto != 0 {
+			return nil, fmt.Errorf("unexpected protocol qualifier for %q", token)
+		}
+		value := p.next()
+		var ipNet *net.IPNet
+		if token == "host" {
+			ip := net.ParseIP(value)
+			if ip == nil || ip.To4() == nil {
+				return nil, fmt.Errorf("invalid IPv4 address %q", value)
+			}
+			ipNet = &net.IPNet{IP: ip.To4(), Mask: net.CIDRMask(32, 32)}
+		} else {
+			var err error
+			_, ipNet, err = net.ParseCIDR(value)
+			if err != nil || ipNet.IP.To4() == nil {
+				return nil, fmt.Errorf("invalid IPv4 CIDR %q", value)
+			}
+		}
+		node.typ = primitiveHost
+		node.ipNet = ipNet
+	case "port":
+		port, err := parsePort(p.next())
+		if err != nil {
+			return nil, err
+		}
+		node.typ = primitivePort
+		node.portLow, node.portHigh = port, port
+	case "portrange":
+		value := p.next()
+		low, high, found := strings.Cut(value, "-")
+		if !found {
+			return nil, fmt.Errorf("invalid port range %q", value)
+		}
+		portLow, err := parsePort(low)
+		if err != nil {
+			return nil, err
+		}
+		portHigh, err := parsePort(high)
+		if err != nil {
+			return nil, err
+		}
+		if portLow > portHigh {
+			return nil, fmt.Errorf("invalid port range %q", value)
+		}
+		node.typ = primitivePort
+		node.portLow, node.portHigh = portLow, portHigh
+	case "":
+		return nil, fmt.Errorf("unexpected end of filter expression")
+	default:
+		return nil, fmt.Errorf("unsupported primitive %q", token)
+	}
+	return node, nil
+}
+
+// label identifies a position in the generated program. labelNext means the instruction following a jump.
+type label int
+
+const labelNext label = -1
+
+type pendingJump struct {
+	cond                bpf.JumpTest
+	val                 uint32
+	jumpTrue, jumpFalse label
+}
+
+type filterCompiler struct {
+	// insts contains either bpf.Instruction or pendingJump, the latter is resolved when all labels are placed.
+	insts  []interface{}
+	labels []int
+}
+
+func (c *filterCompiler) newLabel() label {
+	c.labels = append(c.labels, -1)
+	return label(len(c.labels) - 1)
+}
+
+func (c *filterCompiler) place(l label) {
+	c.labels[l] = len(c.insts)
+}
+
+func (c *filterCompiler) emit(inst bpf.Instruction) {
+	c.insts = append(c.insts, inst)
+}
+
+func (c *filterCompiler) jump(cond bpf.JumpTest, val uint32, jumpTrue, jumpFalse label) {
+	c.insts = append(c.insts, pendingJump{cond: cond, val: val, jumpTrue: jumpTrue, jumpFalse: jumpFalse})
+}
+
+func (c *filterCompiler) compileNode(node filterNode, matched, unmatched label) {
+	switch n := node.(type) {
+	case *andNode:
+		right := c.newLabel()
+		c.compileNode(n.left, right, unmatched)
+		c.place(right)
+		c.compileNode(n.right, matched, unmatched)
+	case *orNode:
+		right := c.newLabel()
+		c.compileNode(n.left, matched, right)
+		c.place(right)
+		c.compileNode(n.right, matched, unmatched)
+	case *notNode:
+		c.compileNode(n.node, unmatched, matched)
+	case *primitiveNode:
+		c.compilePrimitive(n, matched, unmatched)
+	}
+}
+
+func (c *filterCompiler) compilePrimitive(n *primitiveNode, matched, unmatched label) {
+	c.emit(loadEtherKind)
+	if n.typ == primitiveIP {
+		c.jump(bpf.JumpEqual, etherTypeIPv4, matched, unmatched)
+		return
+	}
+	c.jump(bpf.JumpEqual, etherTypeIPv4, labelNext, unmatched)
+
+	switch n.typ {
+	case primitiveProto:
+		c.emit(loadIPv4Protocol)
+		c.jump(bpf.JumpEqual, n.proto, matched, unmatched)
+	case primitiveHost:
+		ones, bits := n.ipNet.Mask.Size()
+		mask := binary.BigEndian.Uint32(n.ipNet.Mask[len(n.ipNet.Mask)-4:])
+		addr := binary.BigEndian.Uint32(n.ipNet.IP.To4()) & mask
+		matchAddress := func(load bpf.Instruction, jumpFalse label) {
+			c.emit(load)
+			if ones != bits {
+				c.emit(bpf.ALUOpConstant{Op: bpf.ALUOpAnd, Val: mask})
+			}
+			c.jump(bpf.JumpEqual, addr, matched, jumpFalse)
+		}
+		switch n.dir {
+		case directionSrc:
+			matchAddress(loadIPv4SourceAddress, unmatched)
+		case directionDst:
+			matchAddress(loadIPv4DestinationAddress, unmatched)
+		default:
+			matchAddress(loadIPv4SourceAddress, labelNext)
+			matchAddress(loadIPv4DestinationAddress, unmatched)
+		}
+	case primitivePort:
+		c.emit(loadIPv4Protocol)
+		if n.proto != 0 {
+			c.jump(bpf.JumpEqual, n.proto, labelNext, unmatched)
+		} else {
+			hasPort := c.newLabel()
+			c.jump(bpf.JumpEqual, ProtocolMap["TCP"], hasPort, labelNext)
+			c.jump(bpf.JumpEqual, ProtocolMap["UDP"], labelNext, unmatched)
+			c.place(hasPort)
+		}
+		c.emit(bpf.LoadAbsolute{Off: ip4HeaderFlags, Size: lengthHalf})
+		c.jump(bpf.JumpBitsSet, jumpMask, unmatched, labelNext)
+		c.emit(bpf.LoadMemShift{Off: ip4HeaderSize})
+		matchPort := func(load bpf.Instruction, jumpFalse label) {
+			c.emit(load)
+			if n.portLow == n.portHigh {
+				c.jump(bpf.JumpEqual, uint32(n.portLow), matched, jumpFalse)
+				return
+			}
+			c.jump(bpf.JumpGreaterOrEqual, uint32(n.portLow), labelNext, jumpFalse)
+			c.jump(bpf.JumpGreaterThan, uint32(n.portHigh), jumpFalse, matched)
+		}
+		switch n.dir {
+		case directionSrc:
+			matchPort(loadIPv4SourcePort, unmatched)
+		case directionDst:
+			matchPort(loadIPv4DestinationPort, unmatched)
+		default:
+			tryDst := c.newLabel()
+			matchPort(loadIPv4SourcePort, tryDst)
+			c.place(tryDst)
+			matchPort(loadIPv4DestinationPort, unmatched)
+		}
+	}
+}
+
+func (c *filterCompiler) resolve() ([]bpf.Instruction, error) {
+	skip := func(pc int, l label) (uint8, error) {
+		if l == labelNext {
+			return 0, nil
+		}
+		offset := c.labels[l] - pc - 1
+		if offset < 0 || offset > math.MaxUint8 {
+			return 0, fmt.Errorf("filter expression is too complex")
+		}
+		return uint8(offset), nil
+	}
+	result := make([]bpf.Instruction, 0, len(c.insts))
+	for pc, inst := range c.insts {
+		jump, ok := inst.(pendingJump)
+		if !ok {
+			result = append(result, inst.(bpf.Instruction))
+			continue
+		}
+		skipTrue, err := skip(pc, jump.jumpTrue)
+		if err != nil {
+			return nil, err
+		}
+		skipFalse, err := skip(pc, jump.jumpFalse)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, bpf.JumpIf{Cond: jump.cond, Val: jump.val, SkipTrue: skipTrue, SkipFalse: skipFalse})
+	}
+	return result, nil
+}
+
+func parseFilterExpression(expr string) (filterNode, error) {
+	p := &filterParser{tokens: tokenizeFilter(expr)}
+	node, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+	if token := p.peek(); token != "" {
+		return nil, fmt.Errorf("unexpected %q in filter expression", token)
+	}
+	return node, nil
+}
+
+// reverseFilterExpression returns the pcap-filter expression matching the packets sent in the reverse direction,
+// i.e. with the "src" and "dst" qualifiers swapped.
+func reverseFilterExpression(expr string) string {
+	tokens := tokenizeFilter(expr)
+	for i, token := range tokens {
+		switch token {
+		case "src":
+			tokens[i] = "dst"
+		case "dst":
+			tokens[i] = "src"
+		}
+	}
+	return strings.Join(tokens, " ")
+}
+
+// compileFilterExpression compiles a pcap-filter expression to bpf instructions. The last two instructions of the
+// result are always returnKeep and returnDrop.
+func compileFilterExpression(expr string) ([]bpf.Instruction, error) {
+	node, err := parseFilterExpression(expr)
+	if err != nil {
+		return nil, err
+	}
+	c := &filterCompiler{}
+	keep := c.newLabel()
+	drop := c.newLabel()
+	c.compileNode(node, keep, drop)
+	c.place(keep)
+	c.emit(returnKeep)
+	c.place(drop)
+	c.emit(returnDrop)
+	return c.resolve()
+}
+
+// ValidateFilterExpression returns an error if the provided pcap-filter expression is not supported.
+func ValidateFilterExpression(expr string) error {
+	_, err := compileFilterExpression(expr)
+	return err
+}
@@ -0,0 +1,169 @@
+// Copyright 2024 Antrea Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capture
+
+import (
+	"net"
+	"testing"
+
+	"github.com/gopacket/gopacket"
+	"github.com/gopacket/gopacket/layers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/bpf"
+)
+
+func TestTokenizeFilter(t *testing.T) {
+	assert.Equal(t,
+		[]string{"not", "(", "tcp", "port", "80", "or", "udp", "portrange", "53-54", ")", "and", "net", "10.0.0.0/8"},
+		tokenizeFilter("!(tcp port 80 || udp portrange 53-54) && net 10.0.0.0/8"),
+	)
+}
+
+func TestCompileFilterExpressionErrors(t *testing.T) {
+	tests := []struct {
+		expr        string
+		expectedErr string
+	}{
+		{expr: "", expectedErr: "unexpected end of filter expression"},
+		{expr: "tcp port", expectedErr: "invalid port \"\""},
+		{expr: "port 70000", expectedErr: "invalid port \"70000\""},
+		{expr: "portrange 100-10", expectedErr: "invalid port range \"100-10\""},
+		{expr: "host 2001:db8::1", expectedErr: "invalid IPv4 address \"2001:db8::1\""},
+		{expr: "net 10.0.0.1", expectedErr: "invalid IPv4 CIDR \"10.0.0.1\""},
+		{expr: "ip6", expectedErr: "unsupported primitive \"ip6\", only IPv4 is supported"},
+		{expr: "icmp port 80", expectedErr: "unexpected \"port\" after \"icmp\""},
+		{expr: "tcp host 10.0.0.1", expectedErr: "unexpected protocol qualifier for \"host\""},
+		{expr: "(tcp", expectedErr: "expected \")\", got \"\""},
+		{expr: "tcp udp", expectedErr: "unsupported primitive \"udp\""},
+		{expr: "tcp and", expectedErr: "unexpected end of filter expression"},
+		{expr: "vlan 100", expectedErr: "unsupported primitive \"vlan\""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			assert.EqualError(t, ValidateFilterExpression(tt.expr), tt.expectedErr)
+		})
+	}
+}
+
+func craftIPv4Packet(t *testing.T, srcIP, dstIP string, transport gopacket.SerializableLayer, proto layers.IPProtocol) []byte {
+	ip := &layers.IPv4{
+		Version:  4,
+		IHL:      5,
+		TTL:      64,
+		Protocol: proto,
+		SrcIP:    net.ParseIP(srcIP),
+		DstIP:    net.ParseIP(dstIP),
+	}
+	serializableLayers := []gopacket.SerializableLayer{
+		&layers.Ethernet{
+			SrcMAC:       net.HardwareAddr{0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0x01},
+			DstMAC:       net.HardwareAddr{0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0x02},
+			EthernetType: layers.EthernetTypeIPv4,
+		},
+		ip,
+	}
+	if transport != nil {
+		if l, ok := transport.(interface {
+			SetNetworkLayerForChecksum(gopacket.NetworkLayer) error
+		}); ok {
+			require.NoError(t, l.SetNetworkLayerForChecksum(ip))
+		}
+		serializableLayers = append(serializableLayers, transport)
+	}
+	buffer := gopacket.NewSerializeBuffer()
+	require.NoError(t, gopacket.SerializeLayers(buffer, gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true}, serializableLayers...))
+	return buffer.Bytes()
+}
+
+func TestCompileFilterExpression(t *testing.T) {
+	tcpPacket := func(srcIP, dstIP string, srcPort, dstPort int) []byte {
+		return craftIPv4Packet(t, srcIP, dstIP, &layers.TCP{SrcPort: layers.TCPPort(srcPort), DstPort: layers.TCPPort(dstPort)}, layers.IPProtocolTCP)
+	}
+	udpPacket := func(srcIP, dstIP string, srcPort, dstPort int) []byte {
+		return craftIPv4Packet(t, srcIP, dstIP, &layers.UDP{SrcPort: layers.UDPPort(srcPort), DstPort: layers.UDPPort(dstPort)}, layers.IPProtocolUDP)
+	}
+	icmpPacket := func(srcIP, dstIP string) []byte {
+		return craftIPv4Packet(t, srcIP, dstIP, &layers.ICMPv4{TypeCode: layers.CreateICMPv4TypeCode(layers.ICMPv4TypeEchoRequest, 0)}, layers.IPProtocolICMPv4)
+	}
+
+	tests := []struct {
+		expr      string
+		matched   [][]byte
+		unmatched [][]byte
+	}{
+		{
+			expr:      "udp port 53 or tcp port 53",
+			matched:   [][]byte{udpPacket("10.0.0.1", "10.0.0.2", 12345, 53), tcpPacket("10.0.0.2", "10.0.0.1", 53, 12345)},
+			unmatched: [][]byte{udpPacket("10.0.0.1", "10.0.0.2", 12345, 54), icmpPacket("10.0.0.1", "10.0.0.2")},
+		},
+		{
+			expr:      "dst net 10.0.0.0/8 and not dst host 10.0.0.2",
+			matched:   [][]byte{icmpPacket("192.168.0.1", "10.1.2.3")},
+			unmatched: [][]byte{icmpPacket("192.168.0.1", "10.0.0.2"), icmpPacket("10.0.0.1", "192.168.0.1")},
+		},
+		{
+			expr:      "src host 10.0.0.1 && (icmp || tcp dst portrange 8000-8080)",
+			matched:   [][]byte{icmpPacket("10.0.0.1", "10.0.0.2"), tcpPacket("10.0.0.1", "10.0.0.2", 1000, 8080)},
+			unmatched: [][]byte{tcpPacket("10.0.0.1", "10.0.0.2", 1000, 8081), tcpPacket("10.0.0.1", "10.0.0.2", 8000, 80), icmpPacket("10.0.0.3", "10.0.0.2")},
+		},
+		{
+			expr:      "portrange 8000-8080",
+			matched:   [][]byte{tcpPacket("10.0.0.1", "10.0.0.2", 8000, 80), udpPacket("10.0.0.1", "10.0.0.2", 80, 8080)},
+			unmatched: [][]byte{tcpPacket("10.0.0.1", "10.0.0.2", 7999, 8081)},
+		},
+		{
+			expr:      "ip proto 1 or proto udp",
+			matched:   [][]byte{icmpPacket("10.0.0.1", "10.0.0.2"), udpPacket("10.0.0.1", "10.0.0.2", 1, 2)},
+			unmatched: [][]byte{tcpPacket("10.0.0.1", "10.0.0.2", 1, 2)},
+		},
+		{
+			expr:      "host 10.0.0.1",
+			matched:   [][]byte{icmpPacket("10.0.0.1", "10.0.0.2"), icmpPacket("10.0.0.2", "10.0.0.1")},
+			unmatched: [][]byte{icmpPacket("10.0.0.3", "10.0.0.2")},
+		},
+		{
+			expr:      "not ip",
+			unmatched: [][]byte{icmpPacket("10.0.0.3", "10.0.0.2")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			inst, err := compileFilterExpression(tt.expr)
+			require.NoError(t, err)
+			assert.Equal(t, returnKeep, inst[len(inst)-2])
+			assert.Equal(t, returnDrop, inst[len(inst)-1])
+			vm, err := bpf.NewVM(inst)
+			require.NoError(t, err)
+			for _, packet := range tt.matched {
+				n, err := vm.Run(packet)
+				require.NoError(t, err)
+				assert.NotZero(t, n, "packet should be matched")
+			}
+			for _, packet := range tt.unmatched {
+				n, err := vm.Run(packet)
+				require.NoError(t, err)
+				assert.Zero(t, n, "packet should not be matched")
+			}
+		})
+	}
+}
+
+func TestReverseFilterExpression(t *testing.T) {
+	assert.Equal(t,
+		"not ( tcp dst port 80 or udp src portrange 53-54 ) and net 10.0.0.0/8 and src host 10.0.0.1",
+		reverseFilterExpression("!(tcp src port 80 || udp dst portrange 53-54) && net 10.0.0.0/8 and dst host 10.0.0.1"),
+	)
+}
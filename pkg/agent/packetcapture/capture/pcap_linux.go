@@ -41,10 +41,13 @@ func zeroFilter() []bpf.Instruction {
 	return []bpf.Instruction{returnDrop}
 }
 
-func (p *pcapCapture) Capture(ctx context.Context, device string, snapLen int, srcIP, dstIP net.IP, packet *crdv1alpha1.Packet) (chan gopacket.Packet, error) {
+func (p *pcapCapture) Capture(ctx context.Context, device string, snapLen int, srcIPNets, dstIPNets []*net.IPNet, packet *crdv1alpha1.Packet, direction crdv1alpha1.PacketCaptureDirection) (chan gopacket.Packet, error) {
 	// Compile the BPF filter in advance to reduce the time window between starting the capture and applying the filter.
-	inst := compilePacketFilter(packet, srcIP, dstIP)
-	klog.V(5).InfoS("Generated bpf instructions for PacketCapture", "device", device, "srcIP", srcIP, "dstIP", dstIP, "packetSpec", packet, "bpf", inst)
+	inst, err := compileDirectionalPacketFilter(packet, srcIPNets, dstIPNets, direction)
+	if err != nil {
+		return nil, err
+	}
+	klog.V(5).InfoS("Generated bpf instructions for PacketCapture", "device", device, "srcIPNets", srcIPNets, "dstIPNets", dstIPNets, "direction", direction, "packetSpec", packet, "bpf", inst)
 	rawInst, err := bpf.Assemble(inst)
 	if err != nil {
 		return nil, err
@@ -24,5 +24,5 @@ import (
 )
 
 type PacketCapturer interface {
-	Capture(ctx context.Context, device string, snapLen int, srcIP, dstIP net.IP, packet *crdv1alpha1.Packet) (chan gopacket.Packet, error)
+	Capture(ctx context.Context, device string, snapLen int, srcIPNets, dstIPNets []*net.IPNet, packet *crdv1alpha1.Packet, direction crdv1alpha1.PacketCaptureDirection) (chan gopacket.Packet, error)
 }
@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"maps"
 	"net"
 	"os"
@@ -31,15 +32,19 @@ import (
 	"github.com/gopacket/gopacket/layers"
 	"github.com/gopacket/gopacket/pcapgo"
 	"github.com/spf13/afero"
+	"golang.org/x/crypto/ssh"
 	"golang.org/x/time/rate"
 	v1 "k8s.io/api/core/v1"
+	discovery "k8s.io/api/discovery/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/conversion"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	clientset "k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/retry"
 	"k8s.io/client-go/util/workqueue"
@@ -54,6 +59,8 @@ import (
 	crdlisters "antrea.io/antrea/pkg/client/listers/crd/v1alpha1"
 	"antrea.io/antrea/pkg/util/auth"
 	"antrea.io/antrea/pkg/util/env"
+	"antrea.io/antrea/pkg/util/k8s"
+	"antrea.io/antrea/pkg/util/pcapng"
 	"antrea.io/antrea/pkg/util/sftp"
 )
 
@@ -82,6 +89,9 @@ const (
 
 	// max packet size we can capture.
 	snapLen = 65536
+
+	// maxTimeout is the maximum timeout in seconds of a capture which is not bounded by time.
+	maxTimeout = 300
 )
 
 type packetCapturePhase string
@@ -95,15 +105,51 @@ const (
 var (
 	packetDirectory = filepath.Join(os.TempDir(), "antrea", "packetcapture", "packets")
 	defaultFS       = afero.NewOsFs()
+
+	// errCaptureFrozen is the cause used to cancel the context of a RingBuffer capture when it is frozen.
+	errCaptureFrozen = errors.New("packet capture frozen")
+
+	// mergeTimeout is how long the merging Node waits for the Nodes which haven't reported any
+	// result, after the capture is complete on the merging Node.
+	mergeTimeout = 60 * time.Second
 )
 
+// captureTarget is a Pod interface on which packets are captured.
+type captureTarget struct {
+	// device is the name of the interface.
+	device string
+	// pod is the Pod of the interface, formatted as <namespace>/<name>.
+	pod string
+}
+
+// capturedPacket is a packet captured on the interface with the given index in the pcapng file.
+type capturedPacket struct {
+	interfaceIndex int
+	packet         gopacket.Packet
+}
+
 type packetCaptureState struct {
+	// pods are the Pods whose interfaces are captured on this Node, formatted as <namespace>/<name>.
+	pods []string
+	// targets are the interfaces captured on this Node.
+	targets []captureTarget
+	// nodes are the Nodes expected to perform the capture, including this Node.
+	nodes sets.Set[string]
+	// mode is the capture mode of the PacketCapture.
+	mode crdv1alpha1.PacketCaptureMode
 	// capturedPacketsNum records how many packets have been captured. Due to the RateLimiter,
-	// this may not be the real-time data.
+	// this may not be the real-time data. For a RingBuffer capture, it is the number of packets
+	// currently kept in the buffer.
 	capturedPacketsNum int32
-	// targetCapturedPacketsNum is the target number limit for a PacketCapture. When numCapturedPackets == targetCapturedPacketsNum, it means
-	// the PacketCapture is done successfully.
+	// capturedBytes records the total size of the packets which have been captured.
+	capturedBytes int64
+	// targetCapturedPacketsNum is the target number limit for a FirstN PacketCapture. When numCapturedPackets == targetCapturedPacketsNum,
+	// it means the PacketCapture is done successfully.
 	targetCapturedPacketsNum int32
+	// targetCapturedBytes is the byte budget for a Bytes PacketCapture.
+	targetCapturedBytes int64
+	// stopReason describes why the capture stopped.
+	stopReason crdv1alpha1.PacketCaptureStopReason
 	// phase is the phase of the PacketCapture.
 	phase packetCapturePhase
 	// filePath is the final path shown in PacketCapture's status.
@@ -114,10 +160,49 @@ type packetCaptureState struct {
 	uploadErr error
 	// cancel is the cancel function for capture context.
 	cancel context.CancelFunc
+	// freeze is the function to freeze a running RingBuffer capture. It is nil for other modes.
+	freeze context.CancelCauseFunc
+	// merged indicates whether the packets captured on multiple Nodes have been merged by this Node.
+	merged bool
+	// subscribers receive the captured packets while the capture is running.
+	subscribers map[*packetSubscriber]struct{}
 }
 
 func (pcs *packetCaptureState) isCaptureSuccessful() bool {
-	return pcs.capturedPacketsNum == pcs.targetCapturedPacketsNum && pcs.targetCapturedPacketsNum > 0
+	return pcs.mode == crdv1alpha1.PacketCaptureModeFirstN && pcs.capturedPacketsNum == pcs.targetCapturedPacketsNum && pcs.targetCapturedPacketsNum > 0
+}
+
+// getCaptureMode returns the capture mode according to the CaptureConfig. The OpenAPI schema for the CRD makes sure
+// exactly one of the configs is set.
+func getCaptureMode(config *crdv1alpha1.CaptureConfig) crdv1alpha1.PacketCaptureMode {
+	switch {
+	case config.Duration != nil:
+		return crdv1alpha1.PacketCaptureModeDuration
+	case config.Bytes != nil:
+		return crdv1alpha1.PacketCaptureModeBytes
+	case config.RingBuffer != nil:
+		return crdv1alpha1.PacketCaptureModeRingBuffer
+	default:
+		return crdv1alpha1.PacketCaptureModeFirstN
+	}
+}
+
+func newPacketCaptureState(pc *crdv1alpha1.PacketCapture) *packetCaptureState {
+	state := &packetCaptureState{
+		phase: packetCapturePhasePending,
+		mode:  getCaptureMode(&pc.Spec.CaptureConfig),
+	}
+	switch state.mode {
+	case crdv1alpha1.PacketCaptureModeFirstN:
+		state.targetCapturedPacketsNum = pc.Spec.CaptureConfig.FirstN.Number
+	case crdv1alpha1.PacketCaptureModeBytes:
+		state.targetCapturedBytes = pc.Spec.CaptureConfig.Bytes.Limit
+	}
+	return state
+}
+
+func isCaptureFrozen(pc *crdv1alpha1.PacketCapture) bool {
+	return pc.Spec.CaptureConfig.RingBuffer != nil && pc.Spec.CaptureConfig.RingBuffer.Freeze
 }
 
 type Controller struct {
@@ -126,11 +211,17 @@ type Controller struct {
 	packetCaptureInformer crdinformers.PacketCaptureInformer
 	packetCaptureLister   crdlisters.PacketCaptureLister
 	packetCaptureSynced   cache.InformerSynced
-	interfaceStore        interfacestore.InterfaceStore
-	queue                 workqueue.TypedRateLimitingInterface[string]
-	sftpUploader          sftp.Uploader
-	captureInterface      PacketCapturer
-	mutex                 sync.Mutex
+	// podLister lists the Pods running on the current Node. The Pods running on other Nodes are retrieved from the
+	// Kubernetes API when a capture is processed.
+	podLister        corelisters.PodLister
+	podListerSynced  cache.InformerSynced
+	interfaceStore   interfacestore.InterfaceStore
+	nodeName         string
+	queue            workqueue.TypedRateLimitingInterface[string]
+	sftpUploader     sftp.Uploader
+	sftpDownloader   sftp.Downloader
+	captureInterface PacketCapturer
+	mutex            sync.Mutex
 	// A name-state mapping for all PacketCapture CRs.
 	captures           map[string]*packetCaptureState
 	numRunningCaptures int
@@ -140,7 +231,9 @@ func NewPacketCaptureController(
 	kubeClient clientset.Interface,
 	crdClient clientsetversioned.Interface,
 	packetCaptureInformer crdinformers.PacketCaptureInformer,
+	podInformer cache.SharedIndexInformer,
 	interfaceStore interfacestore.InterfaceStore,
+	nodeName string,
 ) (*Controller, error) {
 	c := &Controller{
 		kubeClient:            kubeClient,
@@ -148,13 +241,17 @@ func NewPacketCaptureController(
 		packetCaptureInformer: packetCaptureInformer,
 		packetCaptureLister:   packetCaptureInformer.Lister(),
 		packetCaptureSynced:   packetCaptureInformer.Informer().HasSynced,
+		podLister:             corelisters.NewPodLister(podInformer.GetIndexer()),
+		podListerSynced:       podInformer.HasSynced,
 		interfaceStore:        interfaceStore,
+		nodeName:              nodeName,
 		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
 			workqueue.NewTypedItemExponentialFailureRateLimiter[string](minRetryDelay, maxRetryDelay),
 			workqueue.TypedRateLimitingQueueConfig[string]{Name: "packetcapture"},
 		),
-		sftpUploader: sftp.NewUploader(),
-		captures:     make(map[string]*packetCaptureState),
+		sftpUploader:   sftp.NewUploader(),
+		sftpDownloader: sftp.NewDownloader(),
+		captures:       make(map[string]*packetCaptureState),
 	}
 
 	packetCaptureInformer.Informer().AddEventHandlerWithResyncPeriod(cache.ResourceEventHandlerFuncs{
@@ -183,7 +280,7 @@ func (c *Controller) Run(stopCh <-chan struct{}) {
 	klog.InfoS("Starting controller", "name", controllerName)
 	defer klog.InfoS("Shutting down controller", "name", controllerName)
 
-	cacheSynced := []cache.InformerSynced{c.packetCaptureSynced}
+	cacheSynced := []cache.InformerSynced{c.packetCaptureSynced, c.podListerSynced}
 	if !cache.WaitForNamedCacheSync(controllerName, stopCh, cacheSynced...) {
 		return
 	}
@@ -209,7 +306,8 @@ func (c *Controller) addPacketCapture(obj interface{}) {
 func (c *Controller) updatePacketCapture(oldObj, newObj interface{}) {
 	newPC := newObj.(*crdv1alpha1.PacketCapture)
 	oldPC := oldObj.(*crdv1alpha1.PacketCapture)
-	if newPC.Generation != oldPC.Generation {
+	// The results of other Nodes are needed to aggregate the status and to merge the captured packets.
+	if newPC.Generation != oldPC.Generation || !semanticIgnoreLastTransitionTime.DeepEqual(oldPC.Status.NodeResults, newPC.Status.NodeResults) {
 		klog.V(2).InfoS("Processing PacketCapture UPDATE event", "name", newPC.Name)
 		c.enqueuePacketCapture(newPC)
 	}
@@ -265,9 +363,12 @@ func (c *Controller) syncPacketCapture(pcName string) error {
 		return nil
 	}
 
-	// Capture will not occur on this Node if a corresponding Pod interface is not found.
-	device := c.getTargetCaptureDevice(pc)
-	if device == "" {
+	// Capture will not occur on this Node if no corresponding Pod interface is found.
+	targets, nodes, err := c.getCaptureTargets(context.TODO(), pc)
+	if err != nil {
+		return err
+	}
+	if len(targets) == 0 {
 		klog.V(4).InfoS("Skipping unrelated PacketCapture", "name", pcName)
 		return nil
 	}
@@ -277,17 +378,24 @@ func (c *Controller) syncPacketCapture(pcName string) error {
 		defer c.mutex.Unlock()
 		state := c.captures[pcName]
 		if state == nil {
-			state = &packetCaptureState{
-				phase:                    packetCapturePhasePending,
-				targetCapturedPacketsNum: pc.Spec.CaptureConfig.FirstN.Number,
-			}
+			state = newPacketCaptureState(pc)
 			c.captures[pcName] = state
 		}
 
 		klog.V(2).InfoS("Processing PacketCapture", "name", pcName, "phase", state.phase)
 		if state.phase != packetCapturePhasePending {
+			if state.phase == packetCapturePhaseStarted && state.freeze != nil && isCaptureFrozen(pc) {
+				klog.InfoS("Freezing PacketCapture", "name", pcName)
+				state.freeze(errCaptureFrozen)
+			}
 			return *state, nil
 		}
+		state.nodes = nodes
+		state.targets = targets
+		state.pods = make([]string, 0, len(targets))
+		for _, target := range targets {
+			state.pods = append(state.pods, target.pod)
+		}
 		// Do not return the error as it's not a transient error.
 		if err := c.validatePacketCapture(&pc.Spec); err != nil {
 			state.captureErr = err
@@ -303,21 +411,48 @@ func (c *Controller) syncPacketCapture(pcName string) error {
 		timeout := time.Duration(*pc.Spec.Timeout) * time.Second
 		ctx, cancel := context.WithTimeout(context.Background(), timeout)
 		state.cancel = cancel
+		if state.mode == crdv1alpha1.PacketCaptureModeRingBuffer {
+			ctx, state.freeze = context.WithCancelCause(ctx)
+		}
 		state.phase = packetCapturePhaseStarted
 		// Start the capture goroutine in a separate goroutine. The goroutine will decrease numRunningCaptures on exit.
 		c.numRunningCaptures += 1
-		go c.startCapture(ctx, pc, state, device)
+		go c.startCapture(ctx, pc, state, targets)
 		return *state, nil
 	}()
 
 	if updateErr := c.updateStatus(context.Background(), pc, state); updateErr != nil {
 		return fmt.Errorf("error when patching status: %w", updateErr)
 	}
-	return err
+	if err != nil {
+		return err
+	}
+	return c.mergeCapturedPackets(context.TODO(), pc, state)
 }
 
 func (c *Controller) validatePacketCapture(spec *crdv1alpha1.PacketCaptureSpec) error {
+	// Only the captures which are bounded by time can run longer than maxTimeout, up to the maximum allowed by the
+	// CRD schema.
+	if spec.Timeout != nil && *spec.Timeout > maxTimeout && spec.CaptureConfig.Duration == nil && spec.CaptureConfig.RingBuffer == nil {
+		return fmt.Errorf("timeout %ds exceeds the maximum of %ds, only Duration and RingBuffer captures can have a longer timeout", *spec.Timeout, maxTimeout)
+	}
+	if spec.CaptureConfig.Duration != nil && spec.Timeout != nil && spec.CaptureConfig.Duration.Seconds >= *spec.Timeout {
+		return fmt.Errorf("capture duration %ds must be less than timeout %ds", spec.CaptureConfig.Duration.Seconds, *spec.Timeout)
+	}
+	for _, ipBlock := range []*crdv1alpha1.IPBlock{spec.Source.IPBlock, spec.Destination.IPBlock} {
+		if ipBlock == nil {
+			continue
+		}
+		if _, ipNet, err := net.ParseCIDR(ipBlock.CIDR); err != nil || ipNet.IP.To4() == nil {
+			return fmt.Errorf("invalid ipBlock %q, only IPv4 CIDRs are supported", ipBlock.CIDR)
+		}
+	}
 	if spec.Packet != nil {
+		if spec.Packet.Filter != "" {
+			if err := capture.ValidateFilterExpression(spec.Packet.Filter); err != nil {
+				return fmt.Errorf("invalid filter expression: %w", err)
+			}
+		}
 		protocol := spec.Packet.Protocol
 		if protocol != nil {
 			if protocol.Type == intstr.String {
@@ -363,34 +498,139 @@ func getPacketFile(filePath string) (afero.File, error) {
 	return file, nil
 }
 
-// getTargetCaptureDevice is trying to locate the target device for packet capture. If the target
-// Pod does not exist on the current Node, the agent on this Node will not perform the capture.
-// In the PacketCapture spec, at least one of `.Spec.Source.Pod` or `.Spec.Destination.Pod`
-// should be set.
-func (c *Controller) getTargetCaptureDevice(pc *crdv1alpha1.PacketCapture) string {
-	var pod, ns string
-	if pc.Spec.Source.Pod != nil {
-		pod = pc.Spec.Source.Pod.Name
-		ns = pc.Spec.Source.Pod.Namespace
-	} else {
-		pod = pc.Spec.Destination.Pod.Name
-		ns = pc.Spec.Destination.Pod.Namespace
+// getPodCaptureTarget returns the capture target of a Pod, if the Pod runs on the current Node.
+func (c *Controller) getPodCaptureTarget(namespace, name string) (captureTarget, bool) {
+	podInterfaces := c.interfaceStore.GetContainerInterfacesByPod(name, namespace)
+	if len(podInterfaces) == 0 {
+		return captureTarget{}, false
 	}
+	return captureTarget{device: podInterfaces[0].InterfaceName, pod: k8s.NamespacedName(namespace, name)}, true
+}
 
-	podInterfaces := c.interfaceStore.GetContainerInterfacesByPod(pod, ns)
-	if len(podInterfaces) == 0 {
-		return ""
+// getCaptureTargets is trying to locate the target devices for packet capture. Packets are captured
+// on the interfaces of the source Pods running on the current Node if `.Spec.Source.Pod` or
+// `.Spec.Source.Pods` is set, otherwise on the interfaces of the destination Pods running on the
+// current Node, so that packets between Pods on different Nodes are captured on both Nodes. If none
+// of the Pods exists on the current Node, the agent on this Node will not perform the capture.
+// It also returns the Nodes running the source and destination Pods, which are all expected to
+// perform the capture.
+func (c *Controller) getCaptureTargets(ctx context.Context, pc *crdv1alpha1.PacketCapture) ([]captureTarget, sets.Set[string], error) {
+	src, dst := pc.Spec.Source, pc.Spec.Destination
+	targets, err := c.getLocalCaptureTargets(pc, src.Pod, src.Pods)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(targets) == 0 {
+		if targets, err = c.getLocalCaptureTargets(pc, dst.Pod, dst.Pods); err != nil {
+			return nil, nil, err
+		}
+	}
+	if len(targets) == 0 {
+		return nil, nil, nil
+	}
+	nodes := sets.New(c.nodeName)
+	for _, side := range []struct {
+		podRef      *crdv1alpha1.PodReference
+		podSelector *crdv1alpha1.PodSelector
+	}{{src.Pod, src.Pods}, {dst.Pod, dst.Pods}} {
+		sideNodes, err := c.getPodNodes(ctx, side.podRef, side.podSelector)
+		if err != nil {
+			return nil, nil, err
+		}
+		nodes = nodes.Union(sideNodes)
+	}
+	return targets, nodes, nil
+}
+
+// getLocalCaptureTargets returns the capture targets of the Pods running on the current Node among
+// the Pod referenced by podRef or the Pods selected by podSelector.
+func (c *Controller) getLocalCaptureTargets(pc *crdv1alpha1.PacketCapture, podRef *crdv1alpha1.PodReference, podSelector *crdv1alpha1.PodSelector) ([]captureTarget, error) {
+	if podRef != nil {
+		target, ok := c.getPodCaptureTarget(podRef.Namespace, podRef.Name)
+		if !ok {
+			return nil, nil
+		}
+		return []captureTarget{target}, nil
+	}
+	if podSelector == nil {
+		return nil, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(&podSelector.Selector)
+	if err != nil {
+		// An invalid selector doesn't select any Pod.
+		klog.ErrorS(err, "Invalid Pod selector in PacketCapture", "name", pc.Name)
+		return nil, nil
+	}
+	localPods, err := c.podLister.Pods(podSelector.Namespace).List(selector)
+	if err != nil {
+		return nil, err
+	}
+	var targets []captureTarget
+	for _, pod := range localPods {
+		if target, ok := c.getPodCaptureTarget(pod.Namespace, pod.Name); ok {
+			targets = append(targets, target)
+		}
+	}
+	slices.SortFunc(targets, func(a, b captureTarget) int {
+		return strings.Compare(a.pod, b.pod)
+	})
+	return targets, nil
+}
+
+// getPodNodes returns the Nodes running the Pod referenced by podRef or the Pods selected by
+// podSelector. The Pods are retrieved from the Kubernetes API, as they may run on other Nodes.
+func (c *Controller) getPodNodes(ctx context.Context, podRef *crdv1alpha1.PodReference, podSelector *crdv1alpha1.PodSelector) (sets.Set[string], error) {
+	nodes := sets.New[string]()
+	var pods []v1.Pod
+	if podRef != nil {
+		if _, err := c.podLister.Pods(podRef.Namespace).Get(podRef.Name); err == nil {
+			return nodes.Insert(c.nodeName), nil
+		}
+		pod, err := c.kubeClient.CoreV1().Pods(podRef.Namespace).Get(ctx, podRef.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return nodes, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get Pod %s/%s: %w", podRef.Namespace, podRef.Name, err)
+		}
+		pods = append(pods, *pod)
+	} else if podSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(&podSelector.Selector)
+		if err != nil {
+			return nodes, nil
+		}
+		podList, err := c.kubeClient.CoreV1().Pods(podSelector.Namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list Pods in Namespace %s: %w", podSelector.Namespace, err)
+		}
+		pods = podList.Items
 	}
-	return podInterfaces[0].InterfaceName
+	for _, pod := range pods {
+		if pod.Spec.NodeName != "" {
+			nodes.Insert(pod.Spec.NodeName)
+		}
+	}
+	return nodes, nil
 }
 
-func (c *Controller) startCapture(ctx context.Context, pc *crdv1alpha1.PacketCapture, state *packetCaptureState, device string) {
-	klog.InfoS("Starting packet capture on the current Node", "name", pc.Name, "device", device)
-	defer klog.InfoS("Stopped packet capture on the current Node", "name", pc.Name, "device", device)
+// packetsFileName returns the name of the file storing the packets captured on the current Node on
+// the file server. When more than one Node performs the capture, the Node name is added to the file
+// name to avoid conflicts between Nodes.
+func (c *Controller) packetsFileName(pc *crdv1alpha1.PacketCapture, state *packetCaptureState) string {
+	if state.nodes.Len() > 1 {
+		return c.generatePacketsPathForServer(pc.Name + "-" + c.nodeName)
+	}
+	return c.generatePacketsPathForServer(pc.Name)
+}
+
+func (c *Controller) startCapture(ctx context.Context, pc *crdv1alpha1.PacketCapture, state *packetCaptureState, targets []captureTarget) {
+	klog.InfoS("Starting packet capture on the current Node", "name", pc.Name, "pods", state.pods)
+	defer klog.InfoS("Stopped packet capture on the current Node", "name", pc.Name, "pods", state.pods)
 	// Resync the PacketCapture on exit of the capture goroutine.
 	defer c.enqueuePacketCapture(pc)
 
 	var filePath string
+	var stopReason crdv1alpha1.PacketCaptureStopReason
 	var captureErr, uploadErr error
 	func() {
 		localFilePath := nameToPath(pc.Name)
@@ -402,7 +642,7 @@ func (c *Controller) startCapture(ctx context.Context, pc *crdv1alpha1.PacketCap
 		defer file.Close()
 
 		var capturedAny bool
-		capturedAny, captureErr = c.performCapture(ctx, pc, state, file, device)
+		capturedAny, stopReason, captureErr = c.performCapture(ctx, pc, state, file, targets)
 		// If nothing is captured, no need to proceed.
 		if !capturedAny {
 			return
@@ -413,15 +653,21 @@ func (c *Controller) startCapture(ctx context.Context, pc *crdv1alpha1.PacketCap
 		if pc.Spec.FileServer == nil {
 			return
 		}
+		fileName := c.packetsFileName(pc, state)
 		// It can't use the same context as performCapture because it might have timed out.
-		if uploadErr = c.uploadPackets(context.TODO(), pc, file); uploadErr != nil {
+		if uploadErr = c.uploadPackets(context.TODO(), pc, fileName, file); uploadErr != nil {
 			return
 		}
-		filePath = fmt.Sprintf("%s/%s.pcapng", pc.Spec.FileServer.URL, pc.Name)
+		filePath = fmt.Sprintf("%s/%s", pc.Spec.FileServer.URL, fileName)
 	}()
 
 	if captureErr != nil {
 		klog.ErrorS(captureErr, "PacketCapture failed capturing packets", "name", pc.Name)
+		if errors.Is(captureErr, context.DeadlineExceeded) {
+			stopReason = crdv1alpha1.PacketCaptureStopReasonTimeout
+		} else {
+			stopReason = crdv1alpha1.PacketCaptureStopReasonError
+		}
 	}
 	if uploadErr != nil {
 		klog.ErrorS(uploadErr, "PacketCapture failed uploading packets", "name", pc.Name)
@@ -430,79 +676,192 @@ func (c *Controller) startCapture(ctx context.Context, pc *crdv1alpha1.PacketCap
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
 	state.phase = packetCapturePhaseComplete
+	state.stopReason = stopReason
 	state.filePath = filePath
 	state.captureErr = captureErr
 	state.uploadErr = uploadErr
 	c.numRunningCaptures -= 1
+	state.closeSubscribers()
 }
 
-// performCapture blocks until either the target number of packets have been captured, the context is canceled, or the
-// context reaches its deadline.
-// It returns a boolean indicating whether any packet is captured, and an error if the target number of packets are not
-// captured.
+// performCapture blocks until the stop condition of the capture mode is met, the context is canceled, or the context
+// reaches its deadline.
+// It returns a boolean indicating whether any packet is captured, the reason why the capture stopped, and an error if
+// the capture stopped before its stop condition is met.
 func (c *Controller) performCapture(
 	ctx context.Context,
 	pc *crdv1alpha1.PacketCapture,
 	captureState *packetCaptureState,
 	file afero.File,
-	device string,
-) (bool, error) {
-	srcIP, dstIP, err := c.parseIPs(ctx, pc)
+	targets []captureTarget,
+) (bool, crdv1alpha1.PacketCaptureStopReason, error) {
+	srcIPNets, dstIPNets, err := c.parseIPNets(ctx, pc)
 	if err != nil {
-		return false, err
+		return false, "", err
 	}
 
-	// set SnapLength here to make tcpdump on Mac OSX works. By default, its value is
-	// 0 and means unlimited, but tcpdump on Mac OSX will complain:
-	// 'tcpdump: pcap_loop: invalid packet capture length <len>, bigger than snaplen of 524288'
-	ngInterface := pcapgo.DefaultNgInterface
-	ngInterface.SnapLength = snapLen
-	ngInterface.LinkType = layers.LinkTypeEthernet
-	pcapngWriter, err := pcapgo.NewNgWriterInterface(file, ngInterface, pcapgo.DefaultNgWriterOptions)
+	pcapngWriter, err := newPacketsWriter(file, targets)
 	if err != nil {
-		return false, fmt.Errorf("couldn't initialize a pcap writer: %w", err)
+		return false, "", err
 	}
 	defer pcapngWriter.Flush()
 	updateRateLimiter := rate.NewLimiter(rate.Every(captureStatusUpdatePeriod), 1)
-	packets, err := c.captureInterface.Capture(ctx, device, snapLen, srcIP, dstIP, pc.Spec.Packet)
+	// Stop capturing on all the interfaces as soon as the capture stops.
+	captureCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	packets, err := c.capturePackets(captureCtx, pc, targets, srcIPNets, dstIPNets)
 	if err != nil {
-		return false, err
+		return false, "", err
 	}
+
+	// durationCh is only set for Duration captures, receiving from a nil channel blocks forever.
+	var durationCh <-chan time.Time
+	if captureState.mode == crdv1alpha1.PacketCaptureModeDuration {
+		timer := time.NewTimer(time.Duration(pc.Spec.CaptureConfig.Duration.Seconds) * time.Second)
+		defer timer.Stop()
+		durationCh = timer.C
+	}
+	// ring is only set for RingBuffer captures, packets are kept in it and written to the file when the capture stops.
+	var ring *packetRingBuffer
+	if captureState.mode == crdv1alpha1.PacketCaptureModeRingBuffer {
+		ring = newPacketRingBuffer(int(pc.Spec.CaptureConfig.RingBuffer.Number))
+	}
+	writeRing := func() (bool, error) {
+		for _, p := range ring.packets() {
+			if err := pcapngWriter.WritePacket(p.ci, p.data); err != nil {
+				return false, fmt.Errorf("couldn't write packets: %w", err)
+			}
+		}
+		return ring.len() > 0, nil
+	}
+
 	// Track whether any packet is captured.
 	capturedAny := false
 	for {
 		select {
-		case packet := <-packets:
+		case p := <-packets:
+			data := p.packet.Data()
 			ci := gopacket.CaptureInfo{
-				Timestamp:     time.Now(),
-				CaptureLength: len(packet.Data()),
-				Length:        len(packet.Data()),
+				Timestamp:      time.Now(),
+				CaptureLength:  len(data),
+				Length:         len(data),
+				InterfaceIndex: p.interfaceIndex,
 			}
 			klog.V(5).InfoS("Captured packet", "name", pc.Name, "len", ci.Length)
-			if err = pcapngWriter.WritePacket(ci, packet.Data()); err != nil {
-				return capturedAny, fmt.Errorf("couldn't write packets: %w", err)
-			}
-			capturedAny = true
-
-			if success := func() bool {
-				c.mutex.Lock()
-				defer c.mutex.Unlock()
-				captureState.capturedPacketsNum++
-				klog.V(5).InfoS("Captured packets count", "name", pc.Name, "count", captureState.capturedPacketsNum)
-				return captureState.isCaptureSuccessful()
-			}(); success {
-				return true, nil
+			if ring != nil {
+				// The packet data may be reused by the capture source, it must be copied before being buffered.
+				ring.add(ci, slices.Clone(data))
+				c.publishPacket(captureState, ci, data)
+				func() {
+					c.mutex.Lock()
+					defer c.mutex.Unlock()
+					captureState.capturedPacketsNum = int32(ring.len())
+					captureState.capturedBytes = ring.bytes
+				}()
+			} else {
+				if exceeded := func() bool {
+					c.mutex.Lock()
+					defer c.mutex.Unlock()
+					return captureState.targetCapturedBytes > 0 && captureState.capturedBytes+int64(ci.CaptureLength) > captureState.targetCapturedBytes
+				}(); exceeded {
+					return capturedAny, crdv1alpha1.PacketCaptureStopReasonByteLimitReached, nil
+				}
+				if err = pcapngWriter.WritePacket(ci, data); err != nil {
+					return capturedAny, "", fmt.Errorf("couldn't write packets: %w", err)
+				}
+				capturedAny = true
+				c.publishPacket(captureState, ci, data)
+
+				if success := func() bool {
+					c.mutex.Lock()
+					defer c.mutex.Unlock()
+					captureState.capturedPacketsNum++
+					captureState.capturedBytes += int64(ci.CaptureLength)
+					klog.V(5).InfoS("Captured packets count", "name", pc.Name, "count", captureState.capturedPacketsNum, "bytes", captureState.capturedBytes)
+					return captureState.isCaptureSuccessful()
+				}(); success {
+					return true, crdv1alpha1.PacketCaptureStopReasonPacketLimitReached, nil
+				}
 			}
 			// use rate limiter to reduce the times we need to update status.
 			if updateRateLimiter.Allow() {
 				c.enqueuePacketCapture(pc)
 			}
+		case <-durationCh:
+			return capturedAny, crdv1alpha1.PacketCaptureStopReasonDurationElapsed, nil
 		case <-ctx.Done():
-			return capturedAny, ctx.Err()
+			if ring == nil {
+				return capturedAny, "", ctx.Err()
+			}
+			capturedAny, err = writeRing()
+			if err != nil {
+				return capturedAny, "", err
+			}
+			if errors.Is(context.Cause(ctx), errCaptureFrozen) {
+				return capturedAny, crdv1alpha1.PacketCaptureStopReasonFrozen, nil
+			}
+			return capturedAny, "", ctx.Err()
 		}
 	}
 }
 
+// newPacketsWriter creates a pcapng writer in which each target interface is described by an interface block,
+// in the same order as targets.
+func newPacketsWriter(w io.Writer, targets []captureTarget) (*pcapgo.NgWriter, error) {
+	newNgInterface := func(target captureTarget) pcapgo.NgInterface {
+		// set SnapLength here to make tcpdump on Mac OSX works. By default, its value is
+		// 0 and means unlimited, but tcpdump on Mac OSX will complain:
+		// 'tcpdump: pcap_loop: invalid packet capture length <len>, bigger than snaplen of 524288'
+		ngInterface := pcapgo.DefaultNgInterface
+		ngInterface.SnapLength = snapLen
+		ngInterface.LinkType = layers.LinkTypeEthernet
+		// Record the Pod of each interface, so that packets from different Pods can be told apart.
+		ngInterface.Name = target.device
+		ngInterface.Description = target.pod
+		return ngInterface
+	}
+	pcapngWriter, err := pcapgo.NewNgWriterInterface(w, newNgInterface(targets[0]), pcapgo.DefaultNgWriterOptions)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't initialize a pcap writer: %w", err)
+	}
+	for _, target := range targets[1:] {
+		if _, err := pcapngWriter.AddInterface(newNgInterface(target)); err != nil {
+			return nil, fmt.Errorf("couldn't add interface to the pcap writer: %w", err)
+		}
+	}
+	return pcapngWriter, nil
+}
+
+// capturePackets starts capturing packets on all the target interfaces, and merges the captured
+// packets into a single channel.
+func (c *Controller) capturePackets(ctx context.Context, pc *crdv1alpha1.PacketCapture, targets []captureTarget, srcIPNets, dstIPNets []*net.IPNet) (<-chan capturedPacket, error) {
+	merged := make(chan capturedPacket)
+	for i, target := range targets {
+		packets, err := c.captureInterface.Capture(ctx, target.device, snapLen, srcIPNets, dstIPNets, pc.Spec.Packet, pc.Spec.Direction)
+		if err != nil {
+			return nil, fmt.Errorf("failed to capture packets for Pod %s: %w", target.pod, err)
+		}
+		go func() {
+			for {
+				select {
+				case packet, ok := <-packets:
+					if !ok {
+						return
+					}
+					select {
+					case merged <- capturedPacket{interfaceIndex: i, packet: packet}:
+					case <-ctx.Done():
+						return
+					}
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	return merged, nil
+}
+
 func (c *Controller) getPodIP(ctx context.Context, podRef *crdv1alpha1.PodReference) (net.IP, error) {
 	podInterfaces := c.interfaceStore.GetContainerInterfacesByPod(podRef.Name, podRef.Namespace)
 	var podIP net.IP
@@ -525,29 +884,133 @@ func (c *Controller) getPodIP(ctx context.Context, podRef *crdv1alpha1.PodRefere
 	return podIP, nil
 }
 
-func (c *Controller) parseIPs(ctx context.Context, pc *crdv1alpha1.PacketCapture) (srcIP, dstIP net.IP, err error) {
-	if pc.Spec.Source.Pod != nil {
-		srcIP, err = c.getPodIP(ctx, pc.Spec.Source.Pod)
-		if err != nil {
-			return
+// getServiceIPs returns the IPv4 ClusterIPs and Endpoint addresses of a Service.
+func (c *Controller) getServiceIPs(ctx context.Context, svcRef *crdv1alpha1.NamespacedName) ([]net.IP, error) {
+	svc, err := c.kubeClient.CoreV1().Services(svcRef.Namespace).Get(ctx, svcRef.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Service %s/%s: %w", svcRef.Namespace, svcRef.Name, err)
+	}
+	ips := sets.New[string]()
+	for _, clusterIP := range svc.Spec.ClusterIPs {
+		if ip := net.ParseIP(clusterIP); ip != nil && ip.To4() != nil {
+			ips.Insert(ip.String())
 		}
-	} else if pc.Spec.Source.IP != nil {
-		srcIP = net.ParseIP(*pc.Spec.Source.IP)
-		if srcIP == nil {
-			err = fmt.Errorf("invalid source IP address: %s", *pc.Spec.Source.IP)
-			return
+	}
+	endpointSlices, err := c.kubeClient.DiscoveryV1().EndpointSlices(svcRef.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.Set{discovery.LabelServiceName: svcRef.Name}.String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list EndpointSlices for Service %s/%s: %w", svcRef.Namespace, svcRef.Name, err)
+	}
+	for _, endpointSlice := range endpointSlices.Items {
+		if endpointSlice.AddressType != discovery.AddressTypeIPv4 {
+			continue
+		}
+		for _, endpoint := range endpointSlice.Endpoints {
+			ips.Insert(endpoint.Addresses...)
+		}
+	}
+	if ips.Len() == 0 {
+		return nil, fmt.Errorf("cannot find any IPv4 address for Service %s/%s", svcRef.Namespace, svcRef.Name)
+	}
+	return sortedIPs(ips), nil
+}
+
+// getSelectedPodIPs returns the IPv4 addresses of the Pods selected by a PodSelector.
+func (c *Controller) getSelectedPodIPs(ctx context.Context, podSelector *crdv1alpha1.PodSelector) ([]net.IP, error) {
+	selector, err := metav1.LabelSelectorAsSelector(&podSelector.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Pod selector: %w", err)
+	}
+	pods, err := c.kubeClient.CoreV1().Pods(podSelector.Namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Pods in Namespace %s: %w", podSelector.Namespace, err)
+	}
+	ips := sets.New[string]()
+	for _, pod := range pods.Items {
+		podIPs := make([]net.IP, len(pod.Status.PodIPs))
+		for i, ip := range pod.Status.PodIPs {
+			podIPs[i] = net.ParseIP(ip.IP)
+		}
+		if podIP := util.GetIPv4Addr(podIPs); podIP != nil {
+			ips.Insert(podIP.String())
 		}
 	}
-	if pc.Spec.Destination.Pod != nil {
-		dstIP, err = c.getPodIP(ctx, pc.Spec.Destination.Pod)
+	if ips.Len() == 0 {
+		return nil, fmt.Errorf("cannot find any IPv4 address for the Pods selected in Namespace %s", podSelector.Namespace)
+	}
+	return sortedIPs(ips), nil
+}
+
+// sortedIPs sorts the addresses to generate the same filter for the same set of addresses.
+func sortedIPs(ips sets.Set[string]) []net.IP {
+	result := make([]net.IP, 0, ips.Len())
+	for _, ip := range sets.List(ips) {
+		result = append(result, net.ParseIP(ip))
+	}
+	return result
+}
+
+func hostIPNet(ip net.IP) *net.IPNet {
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(32, 32)}
+}
+
+// getIPNets returns the addresses or networks matched by one side of the PacketCapture. At most one of pod, pods,
+// ip, ipBlock and service is set. It returns nil if none is set, meaning any address can be matched.
+func (c *Controller) getIPNets(ctx context.Context, pod *crdv1alpha1.PodReference, pods *crdv1alpha1.PodSelector, ip *string, ipBlock *crdv1alpha1.IPBlock, service *crdv1alpha1.NamespacedName) ([]*net.IPNet, error) {
+	switch {
+	case pod != nil:
+		podIP, err := c.getPodIP(ctx, pod)
 		if err != nil {
-			return
+			return nil, err
+		}
+		return []*net.IPNet{hostIPNet(podIP)}, nil
+	case pods != nil:
+		podIPs, err := c.getSelectedPodIPs(ctx, pods)
+		if err != nil {
+			return nil, err
+		}
+		ipNets := make([]*net.IPNet, 0, len(podIPs))
+		for _, podIP := range podIPs {
+			ipNets = append(ipNets, hostIPNet(podIP))
+		}
+		return ipNets, nil
+	case ip != nil:
+		parsedIP := net.ParseIP(*ip)
+		if parsedIP == nil {
+			return nil, fmt.Errorf("invalid IP address: %s", *ip)
+		}
+		return []*net.IPNet{hostIPNet(parsedIP)}, nil
+	case ipBlock != nil:
+		_, ipNet, err := net.ParseCIDR(ipBlock.CIDR)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CIDR: %s", ipBlock.CIDR)
 		}
-	} else if pc.Spec.Destination.IP != nil {
-		dstIP = net.ParseIP(*pc.Spec.Destination.IP)
-		if dstIP == nil {
-			err = fmt.Errorf("invalid destination IP address: %s", *pc.Spec.Destination.IP)
+		return []*net.IPNet{ipNet}, nil
+	case service != nil:
+		svcIPs, err := c.getServiceIPs(ctx, service)
+		if err != nil {
+			return nil, err
+		}
+		ipNets := make([]*net.IPNet, 0, len(svcIPs))
+		for _, svcIP := range svcIPs {
+			ipNets = append(ipNets, hostIPNet(svcIP))
 		}
+		return ipNets, nil
+	}
+	return nil, nil
+}
+
+func (c *Controller) parseIPNets(ctx context.Context, pc *crdv1alpha1.PacketCapture) (srcIPNets, dstIPNets []*net.IPNet, err error) {
+	src, dst := pc.Spec.Source, pc.Spec.Destination
+	srcIPNets, err = c.getIPNets(ctx, src.Pod, src.Pods, src.IP, src.IPBlock, src.Service)
+	if err != nil {
+		err = fmt.Errorf("invalid source: %w", err)
+		return
+	}
+	dstIPNets, err = c.getIPNets(ctx, dst.Pod, dst.Pods, dst.IP, dst.IPBlock, dst.Service)
+	if err != nil {
+		err = fmt.Errorf("invalid destination: %w", err)
 	}
 	return
 }
@@ -563,7 +1026,7 @@ func (c *Controller) generatePacketsPathForServer(name string) string {
 	return name + ".pcapng"
 }
 
-func (c *Controller) uploadPackets(ctx context.Context, pc *crdv1alpha1.PacketCapture, outputFile afero.File) error {
+func (c *Controller) uploadPackets(ctx context.Context, pc *crdv1alpha1.PacketCapture, fileName string, outputFile afero.File) error {
 	klog.V(2).InfoS("Uploading captured packets for PacketCapture", "name", pc.Name)
 	uploader, err := c.getUploaderByProtocol(sftpProtocol)
 	if err != nil {
@@ -572,6 +1035,14 @@ func (c *Controller) uploadPackets(ctx context.Context, pc *crdv1alpha1.PacketCa
 	if _, err := outputFile.Seek(0, 0); err != nil {
 		return fmt.Errorf("failed to upload to the file server while setting offset: %v", err)
 	}
+	cfg, err := c.getFileServerClientConfig(ctx, pc)
+	if err != nil {
+		return err
+	}
+	return uploader.Upload(pc.Spec.FileServer.URL, fileName, cfg, outputFile)
+}
+
+func (c *Controller) getFileServerClientConfig(ctx context.Context, pc *crdv1alpha1.PacketCapture) (*ssh.ClientConfig, error) {
 	authSecret := v1.SecretReference{
 		Name:      fileServerAuthSecretName,
 		Namespace: env.GetAntreaNamespace(),
@@ -579,10 +1050,10 @@ func (c *Controller) uploadPackets(ctx context.Context, pc *crdv1alpha1.PacketCa
 	serverAuth, err := auth.GetAuthConfigurationFromSecret(ctx, auth.BasicAuthenticationType, &authSecret, c.kubeClient)
 	if err != nil {
 		klog.ErrorS(err, "Failed to get authentication for the file server", "name", pc.Name, "authSecret", authSecret)
-		return err
+		return nil, err
 	}
 	if serverAuth.BasicAuthentication == nil {
-		return fmt.Errorf("failed to get basic authentication info for the file server")
+		return nil, fmt.Errorf("failed to get basic authentication info for the file server")
 	}
 	cfg, err := sftp.GetSSHClientConfig(
 		serverAuth.BasicAuthentication.Username,
@@ -590,9 +1061,214 @@ func (c *Controller) uploadPackets(ctx context.Context, pc *crdv1alpha1.PacketCa
 		pc.Spec.FileServer.HostPublicKey,
 	)
 	if err != nil {
-		return fmt.Errorf("failed to generate SSH client config: %w", err)
+		return nil, fmt.Errorf("failed to generate SSH client config: %w", err)
+	}
+	return cfg, nil
+}
+
+// isMerger returns whether the current Node merges the packets captured on all the given Nodes,
+// which is done by the Node with the smallest name.
+func (c *Controller) isMerger(nodes sets.Set[string]) bool {
+	return nodes.Len() > 0 && sets.List(nodes)[0] == c.nodeName
+}
+
+// mergeCapturedPackets merges the packets captured on multiple Nodes into a single file and uploads
+// it to the file server, once the captures on all Nodes are complete. Each Node uploads its own file
+// first, which is downloaded by the merging Node. A Node expected to perform the capture may never
+// report any result, e.g. when the Pod has no interface on it yet: such Nodes are waited for until
+// mergeTimeout after the capture is complete on the current Node, then they are marked as failed
+// and the packets captured on the other Nodes are merged.
+func (c *Controller) mergeCapturedPackets(ctx context.Context, pc *crdv1alpha1.PacketCapture, state packetCaptureState) error {
+	if state.phase != packetCapturePhaseComplete || state.merged || pc.Spec.FileServer == nil {
+		return nil
+	}
+	nodes := state.nodes.Clone()
+	reportedNodes := sets.New[string]()
+	for _, result := range pc.Status.NodeResults {
+		nodes.Insert(result.NodeName)
+		reportedNodes.Insert(result.NodeName)
+	}
+	if nodes.Len() <= 1 {
+		return nil
+	}
+	var completeTime time.Time
+	for _, result := range pc.Status.NodeResults {
+		i := slices.IndexFunc(result.Conditions, func(condition crdv1alpha1.PacketCaptureCondition) bool {
+			return condition.Type == crdv1alpha1.PacketCaptureComplete && condition.Status == metav1.ConditionTrue
+		})
+		if i < 0 {
+			return nil
+		}
+		if result.NodeName == c.nodeName {
+			completeTime = result.Conditions[i].LastTransitionTime.Time
+		}
+	}
+	if hasTrueCondition(pc.Status.Conditions, crdv1alpha1.PacketCaptureFileMerged) {
+		return nil
+	}
+	missingNodes := nodes.Difference(reportedNodes)
+	if missingNodes.Len() > 0 {
+		// The result of the current Node hasn't been reported yet.
+		if completeTime.IsZero() {
+			return nil
+		}
+		if wait := mergeTimeout - time.Since(completeTime); wait > 0 {
+			c.queue.AddAfter(pc.Name, wait)
+			return nil
+		}
+		// The Node with the smallest name may be missing, the merge is done by the Nodes which
+		// reported their results.
+		nodes = reportedNodes
+	}
+	if !c.isMerger(nodes) {
+		return nil
+	}
+
+	klog.InfoS("Merging packets captured on multiple Nodes", "name", pc.Name, "nodes", sets.List(nodes), "missingNodes", sets.List(missingNodes))
+	filePath, mergeErr := c.mergeAndUploadPackets(ctx, pc)
+	if err := c.updateMergeStatus(ctx, pc, filePath, mergeErr, sets.List(missingNodes)); err != nil {
+		return fmt.Errorf("error when updating merge status: %w", err)
+	}
+	// Return the error so that merging is retried, the failure is reported in the FileMerged condition meanwhile.
+	if mergeErr != nil {
+		return fmt.Errorf("failed to merge packets: %w", mergeErr)
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if s := c.captures[pc.Name]; s != nil {
+		s.merged = true
+	}
+	return nil
+}
+
+func hasTrueCondition(conditions []crdv1alpha1.PacketCaptureCondition, conditionType crdv1alpha1.PacketCaptureConditionType) bool {
+	return slices.ContainsFunc(conditions, func(condition crdv1alpha1.PacketCaptureCondition) bool {
+		return condition.Type == conditionType && condition.Status == metav1.ConditionTrue
+	})
+}
+
+// mergeAndUploadPackets merges the files uploaded by all Nodes and uploads the merged file. Nodes
+// which haven't captured any packet, or have failed to upload their files, are skipped.
+func (c *Controller) mergeAndUploadPackets(ctx context.Context, pc *crdv1alpha1.PacketCapture) (string, error) {
+	cfg, err := c.getFileServerClientConfig(ctx, pc)
+	if err != nil {
+		return "", err
+	}
+	var files []pcapng.File
+	for _, result := range pc.Status.NodeResults {
+		if !hasTrueCondition(result.Conditions, crdv1alpha1.PacketCaptureFileUploaded) {
+			continue
+		}
+		var file afero.File
+		if result.NodeName == c.nodeName {
+			if file, err = defaultFS.Open(nameToPath(pc.Name)); err != nil {
+				return "", fmt.Errorf("failed to open the captured packets file: %w", err)
+			}
+		} else {
+			if file, err = afero.TempFile(defaultFS, packetDirectory, "download-*.pcapng"); err != nil {
+				return "", fmt.Errorf("failed to create the file for downloaded packets: %w", err)
+			}
+			defer defaultFS.Remove(file.Name())
+			fileName := c.generatePacketsPathForServer(pc.Name + "-" + result.NodeName)
+			if err := c.sftpDownloader.Download(pc.Spec.FileServer.URL, fileName, cfg, file); err != nil {
+				file.Close()
+				return "", fmt.Errorf("failed to download packets captured on Node %s: %w", result.NodeName, err)
+			}
+			if _, err := file.Seek(0, 0); err != nil {
+				file.Close()
+				return "", err
+			}
+		}
+		defer file.Close()
+		files = append(files, pcapng.File{NodeName: result.NodeName, Reader: file})
+	}
+
+	mergedFile, err := afero.TempFile(defaultFS, packetDirectory, "merged-*.pcapng")
+	if err != nil {
+		return "", fmt.Errorf("failed to create the file for merged packets: %w", err)
 	}
-	return uploader.Upload(pc.Spec.FileServer.URL, c.generatePacketsPathForServer(pc.Name), cfg, outputFile)
+	defer defaultFS.Remove(mergedFile.Name())
+	defer mergedFile.Close()
+	if err := pcapng.MergeFiles(mergedFile, files, snapLen); err != nil {
+		return "", err
+	}
+	fileName := c.generatePacketsPathForServer(pc.Name)
+	if err := c.uploadPackets(ctx, pc, fileName, mergedFile); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s/%s", pc.Spec.FileServer.URL, fileName), nil
+}
+
+// updateMergeStatus reports the result of merging the packets captured on multiple Nodes.
+// updateMergeStatus reports the result of merging the captured packets in the FileMerged condition.
+// missingNodes are the Nodes which haven't reported any result before mergeTimeout, for which a
+// failed result is added.
+func (c *Controller) updateMergeStatus(ctx context.Context, pc *crdv1alpha1.PacketCapture, filePath string, mergeErr error, missingNodes []string) error {
+	condition := crdv1alpha1.PacketCaptureCondition{
+		Type:               crdv1alpha1.PacketCaptureFileMerged,
+		Status:             metav1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             "Succeed",
+	}
+	if mergeErr != nil {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "Failed"
+		condition.Message = mergeErr.Error()
+	}
+	toUpdate := pc.DeepCopy()
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if addMissingNodeResults(&toUpdate.Status, missingNodes) {
+			nodes := make([]string, 0, len(toUpdate.Status.NodeResults))
+			for _, result := range toUpdate.Status.NodeResults {
+				nodes = append(nodes, result.NodeName)
+			}
+			toUpdate.Status.StopReason = aggregateStopReason(toUpdate.Status.NodeResults)
+			toUpdate.Status.Conditions = aggregateConditions(toUpdate.Status.NodeResults, nodes)
+		}
+		toUpdate.Status.Conditions = mergeConditions(toUpdate.Status.Conditions, []crdv1alpha1.PacketCaptureCondition{condition})
+		if mergeErr == nil {
+			toUpdate.Status.FilePath = filePath
+		}
+		_, updateErr := c.crdClient.CrdV1alpha1().PacketCaptures().UpdateStatus(ctx, toUpdate, metav1.UpdateOptions{})
+		if updateErr != nil && apierrors.IsConflict(updateErr) {
+			var getErr error
+			if toUpdate, getErr = c.crdClient.CrdV1alpha1().PacketCaptures().Get(ctx, pc.Name, metav1.GetOptions{}); getErr != nil {
+				return getErr
+			}
+		}
+		return updateErr
+	})
+}
+
+// addMissingNodeResults adds a failed result for each of the given Nodes which hasn't reported any
+// result in status. It returns whether any result is added.
+func addMissingNodeResults(status *crdv1alpha1.PacketCaptureStatus, missingNodes []string) bool {
+	added := false
+	for _, node := range missingNodes {
+		if slices.ContainsFunc(status.NodeResults, func(result crdv1alpha1.PacketCaptureNodeResult) bool {
+			return result.NodeName == node
+		}) {
+			continue
+		}
+		status.NodeResults = append(status.NodeResults, crdv1alpha1.PacketCaptureNodeResult{
+			NodeName:   node,
+			StopReason: crdv1alpha1.PacketCaptureStopReasonError,
+			Conditions: []crdv1alpha1.PacketCaptureCondition{{
+				Type:               crdv1alpha1.PacketCaptureComplete,
+				Status:             metav1.ConditionTrue,
+				LastTransitionTime: metav1.Now(),
+				Reason:             "Failed",
+				Message:            "the Node didn't report any result before the packets were merged",
+			}},
+		})
+		added = true
+	}
+	if added {
+		slices.SortFunc(status.NodeResults, func(a, b crdv1alpha1.PacketCaptureNodeResult) int {
+			return strings.Compare(a.NodeName, b.NodeName)
+		})
+	}
+	return added
 }
 
 func (c *Controller) updateStatus(ctx context.Context, pc *crdv1alpha1.PacketCapture, state packetCaptureState) error {
@@ -600,8 +1276,12 @@ func (c *Controller) updateStatus(ctx context.Context, pc *crdv1alpha1.PacketCap
 	toUpdate := pc.DeepCopy()
 	var conditions []crdv1alpha1.PacketCaptureCondition
 	t := metav1.Now()
-	desiredStatus := crdv1alpha1.PacketCaptureStatus{
+	nodeResult := crdv1alpha1.PacketCaptureNodeResult{
+		NodeName:       c.nodeName,
+		Pods:           state.pods,
 		NumberCaptured: state.capturedPacketsNum,
+		BytesCaptured:  state.capturedBytes,
+		StopReason:     state.stopReason,
 		FilePath:       state.filePath,
 	}
 
@@ -686,14 +1366,27 @@ func (c *Controller) updateStatus(ctx context.Context, pc *crdv1alpha1.PacketCap
 		}
 	}
 
-	desiredStatus.Conditions = conditions
+	nodeResult.Conditions = conditions
 
 	if retryErr := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		// The results of the other Nodes may have been updated, so the status must be aggregated again on conflict.
+		desiredStatus := aggregateStatus(toUpdate.Status, nodeResult, state.nodes, state.mode)
 		if packetCaptureStatusEqual(toUpdate.Status, desiredStatus) {
 			return nil
 		}
 
 		desiredStatus.Conditions = mergeConditions(toUpdate.Status.Conditions, desiredStatus.Conditions)
+		for i := range desiredStatus.NodeResults {
+			result := &desiredStatus.NodeResults[i]
+			if result.NodeName != c.nodeName {
+				continue
+			}
+			for _, oldResult := range toUpdate.Status.NodeResults {
+				if oldResult.NodeName == c.nodeName {
+					result.Conditions = mergeConditions(oldResult.Conditions, result.Conditions)
+				}
+			}
+		}
 		toUpdate.Status = desiredStatus
 		klog.V(2).InfoS("Updating PacketCapture", "name", pc.Name, "status", toUpdate.Status)
 		_, updateErr := c.crdClient.CrdV1alpha1().PacketCaptures().UpdateStatus(ctx, toUpdate, metav1.UpdateOptions{})
@@ -712,6 +1405,153 @@ func (c *Controller) updateStatus(ctx context.Context, pc *crdv1alpha1.PacketCap
 	return nil
 }
 
+// aggregateStatus generates the status of a PacketCapture from the result of the capture on the
+// current Node and the results reported by the other Nodes in oldStatus. nodes are the Nodes
+// expected to perform the capture. When a single Node performs the capture, the status is the same
+// as its result. Otherwise the result of merging the captured packets is kept from oldStatus.
+func aggregateStatus(
+	oldStatus crdv1alpha1.PacketCaptureStatus,
+	nodeResult crdv1alpha1.PacketCaptureNodeResult,
+	nodes sets.Set[string],
+	mode crdv1alpha1.PacketCaptureMode,
+) crdv1alpha1.PacketCaptureStatus {
+	results := []crdv1alpha1.PacketCaptureNodeResult{nodeResult}
+	allNodes := sets.New(nodeResult.NodeName).Union(nodes)
+	for _, result := range oldStatus.NodeResults {
+		if result.NodeName != nodeResult.NodeName {
+			results = append(results, result)
+			allNodes.Insert(result.NodeName)
+		}
+	}
+	slices.SortFunc(results, func(a, b crdv1alpha1.PacketCaptureNodeResult) int {
+		return strings.Compare(a.NodeName, b.NodeName)
+	})
+
+	status := crdv1alpha1.PacketCaptureStatus{
+		CaptureMode: mode,
+		NodeResults: results,
+	}
+	for _, result := range results {
+		status.NumberCaptured += result.NumberCaptured
+		status.BytesCaptured += result.BytesCaptured
+	}
+	if allNodes.Len() == 1 {
+		status.StopReason = nodeResult.StopReason
+		status.FilePath = nodeResult.FilePath
+		status.Conditions = nodeResult.Conditions
+		return status
+	}
+	if len(results) == allNodes.Len() {
+		status.StopReason = aggregateStopReason(results)
+	}
+	status.FilePath = oldStatus.FilePath
+	status.Conditions = aggregateConditions(results, sets.List(allNodes))
+	for _, condition := range oldStatus.Conditions {
+		if condition.Type == crdv1alpha1.PacketCaptureFileMerged {
+			status.Conditions = append(status.Conditions, condition)
+		}
+	}
+	return status
+}
+
+// aggregateStopReason returns the reason why the captures on all Nodes stopped. It is empty if the
+// capture on any Node is still running. If the capture failed on any Node, the failure is reported.
+func aggregateStopReason(results []crdv1alpha1.PacketCaptureNodeResult) crdv1alpha1.PacketCaptureStopReason {
+	reasons := sets.New[crdv1alpha1.PacketCaptureStopReason]()
+	for _, result := range results {
+		if result.StopReason == "" {
+			return ""
+		}
+		reasons.Insert(result.StopReason)
+	}
+	switch {
+	case reasons.Has(crdv1alpha1.PacketCaptureStopReasonError):
+		return crdv1alpha1.PacketCaptureStopReasonError
+	case reasons.Has(crdv1alpha1.PacketCaptureStopReasonTimeout):
+		return crdv1alpha1.PacketCaptureStopReasonTimeout
+	}
+	return results[0].StopReason
+}
+
+// aggregateConditions merges the conditions of the captures on all Nodes. A Node which hasn't
+// reported its result yet is considered Pending.
+func aggregateConditions(results []crdv1alpha1.PacketCaptureNodeResult, nodes []string) []crdv1alpha1.PacketCaptureCondition {
+	conditionsByNode := make(map[string][]crdv1alpha1.PacketCaptureCondition, len(results))
+	for _, result := range results {
+		conditionsByNode[result.NodeName] = result.Conditions
+	}
+	var conditions []crdv1alpha1.PacketCaptureCondition
+	if started, ok := aggregateCondition(crdv1alpha1.PacketCaptureStarted, nodes, conditionsByNode, "Pending"); ok {
+		conditions = append(conditions, started)
+	}
+	complete, ok := aggregateCondition(crdv1alpha1.PacketCaptureComplete, nodes, conditionsByNode, "Progressing")
+	if !ok {
+		return conditions
+	}
+	conditions = append(conditions, complete)
+	// Nodes which haven't captured any packet don't upload a file, so they are not taken into account.
+	if complete.Status == metav1.ConditionTrue {
+		if uploaded, ok := aggregateCondition(crdv1alpha1.PacketCaptureFileUploaded, nodes, conditionsByNode, ""); ok {
+			conditions = append(conditions, uploaded)
+		}
+	}
+	return conditions
+}
+
+// aggregateCondition merges the conditions of the given type on all Nodes. The merged condition is
+// True only if it is True on all Nodes. Its reason is taken from the first Node on which the
+// condition is not True, or else not successful, and its message lists the messages of all Nodes.
+// A Node missing the condition is considered to have a False condition with missingReason, or is
+// ignored if missingReason is empty. It returns false if no Node has the condition.
+func aggregateCondition(
+	conditionType crdv1alpha1.PacketCaptureConditionType,
+	nodes []string,
+	conditionsByNode map[string][]crdv1alpha1.PacketCaptureCondition,
+	missingReason string,
+) (crdv1alpha1.PacketCaptureCondition, bool) {
+	var conditions []crdv1alpha1.PacketCaptureCondition
+	var messages []string
+	found := false
+	for _, node := range nodes {
+		i := slices.IndexFunc(conditionsByNode[node], func(condition crdv1alpha1.PacketCaptureCondition) bool {
+			return condition.Type == conditionType
+		})
+		var condition crdv1alpha1.PacketCaptureCondition
+		if i >= 0 {
+			condition = conditionsByNode[node][i]
+			found = true
+		} else if missingReason != "" {
+			condition = crdv1alpha1.PacketCaptureCondition{
+				Type:               conditionType,
+				Status:             metav1.ConditionFalse,
+				LastTransitionTime: metav1.Now(),
+				Reason:             missingReason,
+			}
+		} else {
+			continue
+		}
+		if condition.Message != "" {
+			messages = append(messages, fmt.Sprintf("%s: %s", node, condition.Message))
+		}
+		conditions = append(conditions, condition)
+	}
+	if !found {
+		return crdv1alpha1.PacketCaptureCondition{}, false
+	}
+	aggregated := conditions[0]
+	if i := slices.IndexFunc(conditions, func(condition crdv1alpha1.PacketCaptureCondition) bool {
+		return condition.Status != metav1.ConditionTrue
+	}); i >= 0 {
+		aggregated = conditions[i]
+	} else if i := slices.IndexFunc(conditions, func(condition crdv1alpha1.PacketCaptureCondition) bool {
+		return condition.Reason != "Started" && condition.Reason != "Succeed"
+	}); i >= 0 {
+		aggregated = conditions[i]
+	}
+	aggregated.Message = strings.Join(messages, "; ")
+	return aggregated, true
+}
+
 func conditionEqualsIgnoreLastTransitionTime(a, b crdv1alpha1.PacketCaptureCondition) bool {
 	a1 := a
 	a1.LastTransitionTime = metav1.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
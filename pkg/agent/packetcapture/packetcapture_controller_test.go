@@ -15,6 +15,7 @@
 package packetcapture
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -25,19 +26,23 @@ import (
 
 	"github.com/gopacket/gopacket"
 	"github.com/gopacket/gopacket/layers"
+	"github.com/gopacket/gopacket/pcapgo"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 	"golang.org/x/crypto/ssh"
 	v1 "k8s.io/api/core/v1"
+	discovery "k8s.io/api/discovery/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/fake"
+	corelisters "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
 
@@ -66,6 +71,7 @@ var (
 	icmpProto    = intstr.FromString("ICMP")
 	invalidProto = intstr.FromString("INVALID")
 	testFTPUrl   = "sftp://127.0.0.1:22/path"
+	testNodeName = "node-1"
 
 	pod1 = v1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
@@ -168,6 +174,63 @@ func (uploader *testUploader) Upload(url string, fileName string, config *ssh.Cl
 	return nil
 }
 
+type testDownloader struct {
+	url   string
+	files map[string][]byte
+	// failures is the number of the first downloads which fail.
+	failures int
+}
+
+func (downloader *testDownloader) Download(url string, fileName string, config *ssh.ClientConfig, outputFile io.Writer) error {
+	if downloader.failures > 0 {
+		downloader.failures--
+		return fmt.Errorf("connection reset by peer")
+	}
+	if url != downloader.url {
+		return fmt.Errorf("expected url: %s for downloader, got: %s", downloader.url, url)
+	}
+	data, ok := downloader.files[fileName]
+	if !ok {
+		return fmt.Errorf("file %s not found", fileName)
+	}
+	_, err := outputFile.Write(data)
+	return err
+}
+
+type testPacket struct {
+	interfaceIndex int
+	timestamp      time.Time
+	data           []byte
+}
+
+// writeTestPacketFile generates a pcapng file with one interface per Pod.
+func writeTestPacketFile(t *testing.T, pods []string, packets []testPacket) []byte {
+	var buffer bytes.Buffer
+	newNgInterface := func(pod string) pcapgo.NgInterface {
+		ngInterface := pcapgo.DefaultNgInterface
+		ngInterface.LinkType = layers.LinkTypeEthernet
+		ngInterface.Description = pod
+		return ngInterface
+	}
+	writer, err := pcapgo.NewNgWriterInterface(&buffer, newNgInterface(pods[0]), pcapgo.DefaultNgWriterOptions)
+	require.NoError(t, err)
+	for _, pod := range pods[1:] {
+		_, err := writer.AddInterface(newNgInterface(pod))
+		require.NoError(t, err)
+	}
+	for _, packet := range packets {
+		ci := gopacket.CaptureInfo{
+			Timestamp:      packet.timestamp,
+			CaptureLength:  len(packet.data),
+			Length:         len(packet.data),
+			InterfaceIndex: packet.interfaceIndex,
+		}
+		require.NoError(t, writer.WritePacket(ci, packet.data))
+	}
+	require.NoError(t, writer.Flush())
+	return buffer.Bytes()
+}
+
 func craftTestPacket() gopacket.Packet {
 	buffer := gopacket.NewSerializeBuffer()
 	options := gopacket.SerializeOptions{}
@@ -193,7 +256,7 @@ func craftTestPacket() gopacket.Packet {
 type testCapture struct {
 }
 
-func (p *testCapture) Capture(ctx context.Context, device string, snapLen int, srcIP, dstIP net.IP, packet *crdv1alpha1.Packet) (chan gopacket.Packet, error) {
+func (p *testCapture) Capture(ctx context.Context, device string, snapLen int, srcIPNets, dstIPNets []*net.IPNet, packet *crdv1alpha1.Packet, direction crdv1alpha1.PacketCaptureDirection) (chan gopacket.Packet, error) {
 	ch := make(chan gopacket.Packet, testCaptureNum)
 	for i := 0; i < 15; i++ {
 		ch <- craftTestPacket()
@@ -218,13 +281,14 @@ func newFakePacketCaptureController(t *testing.T, runtimeObjects []runtime.Objec
 	crdInformerFactory := crdinformers.NewSharedInformerFactory(crdClient, 0)
 	packetCaptureInformer := crdInformerFactory.Crd().V1alpha1().PacketCaptures()
 	informerFactory := informers.NewSharedInformerFactory(kubeClient, 0)
+	podInformer := informerFactory.Core().V1().Pods().Informer()
 
 	ifaceStore := interfacestore.NewInterfaceStore()
 	addPodInterface(ifaceStore, pod1.Namespace, pod1.Name, []string{pod1IPv4, ipv6}, pod1MAC.String(), int32(ofPortPod1))
 	addPodInterface(ifaceStore, pod2.Namespace, pod2.Name, []string{pod2IPv4}, pod2MAC.String(), int32(ofPortPod2))
 
 	// NewPacketCaptureController dont work on windows
-	pcController, err := NewPacketCaptureController(kubeClient, crdClient, packetCaptureInformer, ifaceStore)
+	pcController, err := NewPacketCaptureController(kubeClient, crdClient, packetCaptureInformer, podInformer, ifaceStore, testNodeName)
 	if err != nil {
 		pcController = &Controller{
 			kubeClient:            kubeClient,
@@ -232,7 +296,10 @@ func newFakePacketCaptureController(t *testing.T, runtimeObjects []runtime.Objec
 			packetCaptureInformer: packetCaptureInformer,
 			packetCaptureLister:   packetCaptureInformer.Lister(),
 			packetCaptureSynced:   packetCaptureInformer.Informer().HasSynced,
+			podLister:             corelisters.NewPodLister(podInformer.GetIndexer()),
+			podListerSynced:       podInformer.HasSynced,
 			interfaceStore:        ifaceStore,
+			nodeName:              testNodeName,
 			captures:              make(map[string]*packetCaptureState),
 		}
 		packetCaptureInformer.Informer().AddEventHandlerWithResyncPeriod(cache.ResourceEventHandlerFuncs{
@@ -243,6 +310,7 @@ func newFakePacketCaptureController(t *testing.T, runtimeObjects []runtime.Objec
 	}
 
 	pcController.sftpUploader = &testUploader{}
+	pcController.sftpDownloader = &testDownloader{}
 	pcController.captureInterface = &testCapture{}
 	pcController.queue = workqueue.NewTypedRateLimitingQueueWithConfig(
 		workqueue.NewTypedItemExponentialFailureRateLimiter[string](time.Millisecond*50, time.Millisecond*200),
@@ -658,7 +726,7 @@ func TestUploadPackets(t *testing.T) {
 			f, err := afero.TempFile(fs, "", "upload-test")
 			require.NoError(t, err)
 			defer f.Close()
-			err = pcc.uploadPackets(ctx, pc, f)
+			err = pcc.uploadPackets(ctx, pc, pcc.generatePacketsPathForServer(pc.Name), f)
 			if tc.expectedErr == "" {
 				assert.NoError(t, err)
 			} else {
@@ -667,3 +735,625 @@ func TestUploadPackets(t *testing.T) {
 		})
 	}
 }
+
+func TestPacketCaptureModes(t *testing.T) {
+	defaultFS = afero.NewMemMapFs()
+	defer func() {
+		defaultFS = afero.NewOsFs()
+	}()
+	packetLen := int64(len(craftTestPacket().Data()))
+	longTimeout := int32(10)
+	durationTimeout := int32(2)
+
+	newCR := func(name string, timeout *int32, config crdv1alpha1.CaptureConfig) *crdv1alpha1.PacketCapture {
+		pc := genTestCR(name, 0)
+		pc.Spec.CaptureConfig = config
+		pc.Spec.FileServer = nil
+		pc.Spec.Timeout = timeout
+		return pc
+	}
+	tests := []struct {
+		name                 string
+		pc                   *crdv1alpha1.PacketCapture
+		freeze               bool
+		expectCompleteReason string
+		expectNum            int32
+		expectBytes          int64
+		expectMode           crdv1alpha1.PacketCaptureMode
+		expectStopReason     crdv1alpha1.PacketCaptureStopReason
+	}{
+		{
+			name:                 "first n",
+			pc:                   newCR("pc-firstn", &testCaptureTimeout, crdv1alpha1.CaptureConfig{FirstN: &crdv1alpha1.PacketCaptureFirstNConfig{Number: 10}}),
+			expectCompleteReason: "Succeed",
+			expectNum:            10,
+			expectBytes:          10 * packetLen,
+			expectMode:           crdv1alpha1.PacketCaptureModeFirstN,
+			expectStopReason:     crdv1alpha1.PacketCaptureStopReasonPacketLimitReached,
+		},
+		{
+			name:                 "duration",
+			pc:                   newCR("pc-duration", &durationTimeout, crdv1alpha1.CaptureConfig{Duration: &crdv1alpha1.PacketCaptureDurationConfig{Seconds: 1}}),
+			expectCompleteReason: "Succeed",
+			expectNum:            testCaptureNum,
+			expectBytes:          int64(testCaptureNum) * packetLen,
+			expectMode:           crdv1alpha1.PacketCaptureModeDuration,
+			expectStopReason:     crdv1alpha1.PacketCaptureStopReasonDurationElapsed,
+		},
+		{
+			name:                 "bytes",
+			pc:                   newCR("pc-bytes", &testCaptureTimeout, crdv1alpha1.CaptureConfig{Bytes: &crdv1alpha1.PacketCaptureBytesConfig{Limit: 5*packetLen + 1}}),
+			expectCompleteReason: "Succeed",
+			expectNum:            5,
+			expectBytes:          5 * packetLen,
+			expectMode:           crdv1alpha1.PacketCaptureModeBytes,
+			expectStopReason:     crdv1alpha1.PacketCaptureStopReasonByteLimitReached,
+		},
+		{
+			name:                 "ring buffer frozen",
+			pc:                   newCR("pc-ring-frozen", &longTimeout, crdv1alpha1.CaptureConfig{RingBuffer: &crdv1alpha1.PacketCaptureRingBufferConfig{Number: 5}}),
+			freeze:               true,
+			expectCompleteReason: "Succeed",
+			expectNum:            5,
+			expectBytes:          5 * packetLen,
+			expectMode:           crdv1alpha1.PacketCaptureModeRingBuffer,
+			expectStopReason:     crdv1alpha1.PacketCaptureStopReasonFrozen,
+		},
+		{
+			name:                 "ring buffer timeout",
+			pc:                   newCR("pc-ring-timeout", &testCaptureTimeout, crdv1alpha1.CaptureConfig{RingBuffer: &crdv1alpha1.PacketCaptureRingBufferConfig{Number: 5}}),
+			expectCompleteReason: "Timeout",
+			expectNum:            5,
+			expectBytes:          5 * packetLen,
+			expectMode:           crdv1alpha1.PacketCaptureModeRingBuffer,
+			expectStopReason:     crdv1alpha1.PacketCaptureStopReasonTimeout,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pcc := newFakePacketCaptureController(t, nil, []runtime.Object{tt.pc})
+			stopCh := make(chan struct{})
+			defer close(stopCh)
+			pcc.crdInformerFactory.Start(stopCh)
+			pcc.crdInformerFactory.WaitForCacheSync(stopCh)
+			pcc.informerFactory.Start(stopCh)
+			pcc.informerFactory.WaitForCacheSync(stopCh)
+			go pcc.Run(stopCh)
+
+			if tt.freeze {
+				assert.Eventually(t, func() bool {
+					pcc.mutex.Lock()
+					defer pcc.mutex.Unlock()
+					state := pcc.captures[tt.pc.Name]
+					return state != nil && state.capturedPacketsNum == tt.expectNum
+				}, 2*time.Second, 20*time.Millisecond)
+				toUpdate, err := pcc.crdClient.CrdV1alpha1().PacketCaptures().Get(context.Background(), tt.pc.Name, metav1.GetOptions{})
+				require.NoError(t, err)
+				toUpdate.Spec.CaptureConfig.RingBuffer.Freeze = true
+				toUpdate.Generation++
+				_, err = pcc.crdClient.CrdV1alpha1().PacketCaptures().Update(context.Background(), toUpdate, metav1.UpdateOptions{})
+				require.NoError(t, err)
+			}
+
+			assert.EventuallyWithT(t, func(c *assert.CollectT) {
+				result, err := pcc.crdClient.CrdV1alpha1().PacketCaptures().Get(context.Background(), tt.pc.Name, metav1.GetOptions{})
+				require.NoError(c, err)
+				var completeCond *crdv1alpha1.PacketCaptureCondition
+				for i := range result.Status.Conditions {
+					if result.Status.Conditions[i].Type == crdv1alpha1.PacketCaptureComplete {
+						completeCond = &result.Status.Conditions[i]
+					}
+				}
+				require.NotNil(c, completeCond)
+				assert.Equal(c, metav1.ConditionTrue, completeCond.Status)
+				assert.Equal(c, tt.expectCompleteReason, completeCond.Reason)
+				assert.Equal(c, tt.expectNum, result.Status.NumberCaptured)
+				assert.Equal(c, tt.expectBytes, result.Status.BytesCaptured)
+				assert.Equal(c, tt.expectMode, result.Status.CaptureMode)
+				assert.Equal(c, tt.expectStopReason, result.Status.StopReason)
+				assert.Equal(c, "antrea-agent:"+nameToPath(tt.pc.Name), result.Status.FilePath)
+			}, 5*time.Second, 20*time.Millisecond)
+		})
+	}
+}
+
+func TestValidatePacketCaptureDuration(t *testing.T) {
+	pcc := newFakePacketCaptureController(t, nil, nil)
+	timeout := int32(60)
+	spec := &crdv1alpha1.PacketCaptureSpec{
+		Timeout: &timeout,
+		CaptureConfig: crdv1alpha1.CaptureConfig{
+			Duration: &crdv1alpha1.PacketCaptureDurationConfig{Seconds: 30},
+		},
+	}
+	assert.NoError(t, pcc.validatePacketCapture(spec))
+	spec.CaptureConfig.Duration.Seconds = 60
+	assert.EqualError(t, pcc.validatePacketCapture(spec), "capture duration 60s must be less than timeout 60s")
+}
+
+func TestValidatePacketCaptureTimeout(t *testing.T) {
+	pcc := newFakePacketCaptureController(t, nil, nil)
+	tests := []struct {
+		name          string
+		timeout       int32
+		captureConfig crdv1alpha1.CaptureConfig
+		expectedErr   string
+	}{
+		{
+			name:          "firstN within maximum",
+			timeout:       300,
+			captureConfig: crdv1alpha1.CaptureConfig{FirstN: &crdv1alpha1.PacketCaptureFirstNConfig{Number: 10}},
+		},
+		{
+			name:          "firstN exceeding maximum",
+			timeout:       301,
+			captureConfig: crdv1alpha1.CaptureConfig{FirstN: &crdv1alpha1.PacketCaptureFirstNConfig{Number: 10}},
+			expectedErr:   "timeout 301s exceeds the maximum of 300s, only Duration and RingBuffer captures can have a longer timeout",
+		},
+		{
+			name:          "bytes exceeding maximum",
+			timeout:       3600,
+			captureConfig: crdv1alpha1.CaptureConfig{Bytes: &crdv1alpha1.PacketCaptureBytesConfig{Limit: 1024}},
+			expectedErr:   "timeout 3600s exceeds the maximum of 300s, only Duration and RingBuffer captures can have a longer timeout",
+		},
+		{
+			name:          "duration",
+			timeout:       3600,
+			captureConfig: crdv1alpha1.CaptureConfig{Duration: &crdv1alpha1.PacketCaptureDurationConfig{Seconds: 1800}},
+		},
+		{
+			name:          "ringBuffer",
+			timeout:       86400,
+			captureConfig: crdv1alpha1.CaptureConfig{RingBuffer: &crdv1alpha1.PacketCaptureRingBufferConfig{Number: 100}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := pcc.validatePacketCapture(&crdv1alpha1.PacketCaptureSpec{Timeout: &tt.timeout, CaptureConfig: tt.captureConfig})
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
+
+func TestParseIPNets(t *testing.T) {
+	svc := &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "svc-1", Namespace: "default"},
+		Spec: v1.ServiceSpec{
+			ClusterIP:  "10.96.0.10",
+			ClusterIPs: []string{"10.96.0.10", "fd00:10:96::a"},
+		},
+	}
+	endpointSlice := &discovery.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "svc-1-abcde",
+			Namespace: "default",
+			Labels:    map[string]string{discovery.LabelServiceName: "svc-1"},
+		},
+		AddressType: discovery.AddressTypeIPv4,
+		Endpoints: []discovery.Endpoint{
+			{Addresses: []string{pod2IPv4}},
+			{Addresses: []string{pod3IPv4}},
+		},
+	}
+	hostNet := func(ip string) *net.IPNet {
+		return &net.IPNet{IP: net.ParseIP(ip), Mask: net.CIDRMask(32, 32)}
+	}
+	tests := []struct {
+		name        string
+		src         crdv1alpha1.Source
+		dst         crdv1alpha1.Destination
+		expectedSrc []*net.IPNet
+		expectedDst []*net.IPNet
+		expectedErr string
+	}{
+		{
+			name:        "pod to ipBlock",
+			src:         crdv1alpha1.Source{Pod: &crdv1alpha1.PodReference{Namespace: pod1.Namespace, Name: pod1.Name}},
+			dst:         crdv1alpha1.Destination{IPBlock: &crdv1alpha1.IPBlock{CIDR: "10.0.0.0/8"}},
+			expectedSrc: []*net.IPNet{hostNet(pod1IPv4)},
+			expectedDst: []*net.IPNet{{IP: net.IP{10, 0, 0, 0}, Mask: net.CIDRMask(8, 32)}},
+		},
+		{
+			name:        "pod to Service",
+			src:         crdv1alpha1.Source{Pod: &crdv1alpha1.PodReference{Namespace: pod1.Namespace, Name: pod1.Name}},
+			dst:         crdv1alpha1.Destination{Service: &crdv1alpha1.NamespacedName{Namespace: "default", Name: "svc-1"}},
+			expectedSrc: []*net.IPNet{hostNet(pod1IPv4)},
+			expectedDst: []*net.IPNet{hostNet("10.96.0.10"), hostNet(pod2IPv4), hostNet(pod3IPv4)},
+		},
+		{
+			name:        "Service to pod",
+			src:         crdv1alpha1.Source{Service: &crdv1alpha1.NamespacedName{Namespace: "default", Name: "svc-1"}},
+			dst:         crdv1alpha1.Destination{Pod: &crdv1alpha1.PodReference{Namespace: pod1.Namespace, Name: pod1.Name}},
+			expectedSrc: []*net.IPNet{hostNet("10.96.0.10"), hostNet(pod2IPv4), hostNet(pod3IPv4)},
+			expectedDst: []*net.IPNet{hostNet(pod1IPv4)},
+		},
+		{
+			name:        "non-existing Service",
+			src:         crdv1alpha1.Source{Pod: &crdv1alpha1.PodReference{Namespace: pod1.Namespace, Name: pod1.Name}},
+			dst:         crdv1alpha1.Destination{Service: &crdv1alpha1.NamespacedName{Namespace: "default", Name: "svc-2"}},
+			expectedErr: "invalid destination: failed to get Service default/svc-2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pcc := newFakePacketCaptureController(t, []runtime.Object{svc, endpointSlice}, nil)
+			pc := genTestCR("pc", testCaptureNum)
+			pc.Spec.Source = tt.src
+			pc.Spec.Destination = tt.dst
+			srcIPNets, dstIPNets, err := pcc.parseIPNets(context.Background(), pc)
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedSrc, srcIPNets)
+			assert.Equal(t, tt.expectedDst, dstIPNets)
+		})
+	}
+}
+
+func TestValidatePacketCaptureFilters(t *testing.T) {
+	pcc := newFakePacketCaptureController(t, nil, nil)
+	tests := []struct {
+		name        string
+		spec        crdv1alpha1.PacketCaptureSpec
+		expectedErr string
+	}{
+		{
+			name: "valid",
+			spec: crdv1alpha1.PacketCaptureSpec{
+				Destination: crdv1alpha1.Destination{IPBlock: &crdv1alpha1.IPBlock{CIDR: "10.0.0.0/8"}},
+				Packet:      &crdv1alpha1.Packet{Filter: "udp port 53 or tcp port 53"},
+			},
+		},
+		{
+			name: "IPv6 ipBlock",
+			spec: crdv1alpha1.PacketCaptureSpec{
+				Source: crdv1alpha1.Source{IPBlock: &crdv1alpha1.IPBlock{CIDR: "fd00::/64"}},
+			},
+			expectedErr: "invalid ipBlock \"fd00::/64\", only IPv4 CIDRs are supported",
+		},
+		{
+			name: "invalid filter",
+			spec: crdv1alpha1.PacketCaptureSpec{
+				Packet: &crdv1alpha1.Packet{Filter: "udp port"},
+			},
+			expectedErr: "invalid filter expression: invalid port \"\"",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := pcc.validatePacketCapture(&tt.spec)
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
+
+func TestPacketCapturePodSelector(t *testing.T) {
+	defaultFS = afero.NewMemMapFs()
+	defer func() {
+		defaultFS = afero.NewOsFs()
+	}()
+	newWebPod := func(name, ip, nodeName string) *v1.Pod {
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: map[string]string{"app": "web"}},
+			Spec:       v1.PodSpec{NodeName: nodeName},
+			Status:     v1.PodStatus{PodIPs: []v1.PodIP{{IP: ip}}},
+		}
+	}
+	webPod1 := newWebPod("web-1", "192.168.20.10", testNodeName)
+	webPod2 := newWebPod("web-2", "192.168.20.11", testNodeName)
+	webPod3 := newWebPod("web-3", "192.168.30.10", "node-2")
+	pc := genTestCR("pc-selector", 20)
+	pc.Spec.Source = crdv1alpha1.Source{
+		Pods: &crdv1alpha1.PodSelector{
+			Namespace: "default",
+			Selector:  metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+		},
+	}
+	pc.Spec.Direction = crdv1alpha1.PacketCaptureDirectionBoth
+	pc.Spec.FileServer = nil
+
+	pcc := newFakePacketCaptureController(t, []runtime.Object{webPod1, webPod2, webPod3}, []runtime.Object{pc})
+	addPodInterface(pcc.interfaceStore, webPod1.Namespace, webPod1.Name, []string{"192.168.20.10"}, "aa:bb:cc:dd:ee:01", 3)
+	addPodInterface(pcc.interfaceStore, webPod2.Namespace, webPod2.Name, []string{"192.168.20.11"}, "aa:bb:cc:dd:ee:02", 4)
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	pcc.crdInformerFactory.Start(stopCh)
+	pcc.crdInformerFactory.WaitForCacheSync(stopCh)
+	pcc.informerFactory.Start(stopCh)
+	pcc.informerFactory.WaitForCacheSync(stopCh)
+	go pcc.Run(stopCh)
+
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		result, err := pcc.crdClient.CrdV1alpha1().PacketCaptures().Get(context.Background(), pc.Name, metav1.GetOptions{})
+		require.NoError(c, err)
+		require.Len(c, result.Status.NodeResults, 1)
+		nodeResult := result.Status.NodeResults[0]
+		assert.Equal(c, testNodeName, nodeResult.NodeName)
+		assert.Equal(c, []string{"default/web-1", "default/web-2"}, nodeResult.Pods)
+		assert.Equal(c, int32(20), nodeResult.NumberCaptured)
+		assert.Equal(c, crdv1alpha1.PacketCaptureStopReasonPacketLimitReached, nodeResult.StopReason)
+		assert.Equal(c, int32(20), result.Status.NumberCaptured)
+		// node-2 runs a selected Pod but hasn't reported its result.
+		assert.Empty(c, result.Status.StopReason)
+		for _, cond := range result.Status.Conditions {
+			if cond.Type == crdv1alpha1.PacketCaptureStarted {
+				assert.Equal(c, metav1.ConditionFalse, cond.Status)
+				assert.Equal(c, "Pending", cond.Reason)
+			}
+		}
+	}, 5*time.Second, 20*time.Millisecond)
+
+	// Each Pod is recorded as an interface in the pcapng file.
+	f, err := defaultFS.Open(nameToPath(pc.Name))
+	require.NoError(t, err)
+	defer f.Close()
+	reader, err := pcapgo.NewNgReader(f, pcapgo.DefaultNgReaderOptions)
+	require.NoError(t, err)
+	interfaceIndexes := sets.New[int]()
+	for {
+		_, ci, err := reader.ReadPacketData()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		interfaceIndexes.Insert(ci.InterfaceIndex)
+	}
+	require.Equal(t, 2, reader.NInterfaces())
+	for i, pod := range []string{"default/web-1", "default/web-2"} {
+		ngInterface, err := reader.Interface(i)
+		require.NoError(t, err)
+		assert.Equal(t, pod, ngInterface.Description)
+	}
+	assert.Equal(t, sets.New(0, 1), interfaceIndexes)
+}
+
+func TestAggregateStatus(t *testing.T) {
+	now := metav1.Now()
+	startedCondition := crdv1alpha1.PacketCaptureCondition{Type: crdv1alpha1.PacketCaptureStarted, Status: metav1.ConditionTrue, LastTransitionTime: now, Reason: "Started"}
+	progressingCondition := crdv1alpha1.PacketCaptureCondition{Type: crdv1alpha1.PacketCaptureComplete, Status: metav1.ConditionFalse, LastTransitionTime: now, Reason: "Progressing"}
+	succeedCondition := crdv1alpha1.PacketCaptureCondition{Type: crdv1alpha1.PacketCaptureComplete, Status: metav1.ConditionTrue, LastTransitionTime: now, Reason: "Succeed"}
+	timeoutCondition := crdv1alpha1.PacketCaptureCondition{Type: crdv1alpha1.PacketCaptureComplete, Status: metav1.ConditionTrue, LastTransitionTime: now, Reason: "Timeout", Message: "context deadline exceeded"}
+	uploadedCondition := crdv1alpha1.PacketCaptureCondition{Type: crdv1alpha1.PacketCaptureFileUploaded, Status: metav1.ConditionTrue, LastTransitionTime: now, Reason: "Succeed"}
+
+	node1Complete := crdv1alpha1.PacketCaptureNodeResult{
+		NodeName:       "node-1",
+		NumberCaptured: 10,
+		BytesCaptured:  1000,
+		StopReason:     crdv1alpha1.PacketCaptureStopReasonPacketLimitReached,
+		FilePath:       "sftp://127.0.0.1:22/path/pc-node-1.pcapng",
+		Conditions:     []crdv1alpha1.PacketCaptureCondition{startedCondition, succeedCondition, uploadedCondition},
+	}
+	node2Running := crdv1alpha1.PacketCaptureNodeResult{
+		NodeName:       "node-2",
+		NumberCaptured: 5,
+		BytesCaptured:  500,
+		Conditions:     []crdv1alpha1.PacketCaptureCondition{startedCondition, progressingCondition},
+	}
+	node2Timeout := crdv1alpha1.PacketCaptureNodeResult{
+		NodeName:       "node-2",
+		NumberCaptured: 5,
+		BytesCaptured:  500,
+		StopReason:     crdv1alpha1.PacketCaptureStopReasonTimeout,
+		Conditions:     []crdv1alpha1.PacketCaptureCondition{startedCondition, timeoutCondition, uploadedCondition},
+	}
+
+	tests := []struct {
+		name        string
+		nodeResults []crdv1alpha1.PacketCaptureNodeResult
+		nodeResult  crdv1alpha1.PacketCaptureNodeResult
+		nodes       sets.Set[string]
+		expected    crdv1alpha1.PacketCaptureStatus
+	}{
+		{
+			name:       "single Node",
+			nodeResult: node1Complete,
+			nodes:      sets.New("node-1"),
+			expected: crdv1alpha1.PacketCaptureStatus{
+				NumberCaptured: 10,
+				BytesCaptured:  1000,
+				CaptureMode:    crdv1alpha1.PacketCaptureModeFirstN,
+				StopReason:     crdv1alpha1.PacketCaptureStopReasonPacketLimitReached,
+				FilePath:       node1Complete.FilePath,
+				Conditions:     node1Complete.Conditions,
+				NodeResults:    []crdv1alpha1.PacketCaptureNodeResult{node1Complete},
+			},
+		},
+		{
+			name:       "other Node not reported",
+			nodeResult: node1Complete,
+			nodes:      sets.New("node-1", "node-2"),
+			expected: crdv1alpha1.PacketCaptureStatus{
+				NumberCaptured: 10,
+				BytesCaptured:  1000,
+				CaptureMode:    crdv1alpha1.PacketCaptureModeFirstN,
+				Conditions: []crdv1alpha1.PacketCaptureCondition{
+					{Type: crdv1alpha1.PacketCaptureStarted, Status: metav1.ConditionFalse, Reason: "Pending"},
+					{Type: crdv1alpha1.PacketCaptureComplete, Status: metav1.ConditionFalse, Reason: "Progressing"},
+				},
+				NodeResults: []crdv1alpha1.PacketCaptureNodeResult{node1Complete},
+			},
+		},
+		{
+			name:        "other Node running",
+			nodeResults: []crdv1alpha1.PacketCaptureNodeResult{node2Running},
+			nodeResult:  node1Complete,
+			nodes:       sets.New("node-1"),
+			expected: crdv1alpha1.PacketCaptureStatus{
+				NumberCaptured: 15,
+				BytesCaptured:  1500,
+				CaptureMode:    crdv1alpha1.PacketCaptureModeFirstN,
+				Conditions:     []crdv1alpha1.PacketCaptureCondition{startedCondition, progressingCondition},
+				NodeResults:    []crdv1alpha1.PacketCaptureNodeResult{node1Complete, node2Running},
+			},
+		},
+		{
+			name:        "other Node timed out",
+			nodeResults: []crdv1alpha1.PacketCaptureNodeResult{node2Timeout, node1Complete},
+			nodeResult:  node1Complete,
+			nodes:       sets.New("node-1", "node-2"),
+			expected: crdv1alpha1.PacketCaptureStatus{
+				NumberCaptured: 15,
+				BytesCaptured:  1500,
+				CaptureMode:    crdv1alpha1.PacketCaptureModeFirstN,
+				StopReason:     crdv1alpha1.PacketCaptureStopReasonTimeout,
+				Conditions: []crdv1alpha1.PacketCaptureCondition{
+					startedCondition,
+					{Type: crdv1alpha1.PacketCaptureComplete, Status: metav1.ConditionTrue, Reason: "Timeout", Message: "node-2: context deadline exceeded"},
+					uploadedCondition,
+				},
+				NodeResults: []crdv1alpha1.PacketCaptureNodeResult{node1Complete, node2Timeout},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := aggregateStatus(crdv1alpha1.PacketCaptureStatus{NodeResults: tt.nodeResults}, tt.nodeResult, tt.nodes, crdv1alpha1.PacketCaptureModeFirstN)
+			assert.True(t, packetCaptureStatusEqual(tt.expected, status), "expected status %v, got %v", tt.expected, status)
+		})
+	}
+}
+
+func TestPacketCaptureCrossNodeMerge(t *testing.T) {
+	defaultFS = afero.NewMemMapFs()
+	defer func() {
+		defaultFS = afero.NewOsFs()
+	}()
+	clientPod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "client", Namespace: "default"},
+		Spec:       v1.PodSpec{NodeName: testNodeName},
+		Status:     v1.PodStatus{PodIPs: []v1.PodIP{{IP: "192.168.20.10"}}},
+	}
+	serverPod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "server", Namespace: "default"},
+		Spec:       v1.PodSpec{NodeName: "node-2"},
+		Status:     v1.PodStatus{PodIPs: []v1.PodIP{{IP: "192.168.30.10"}}},
+	}
+	pc := genTestCR("pc-cross-node", testCaptureNum)
+	pc.Spec.Source.Pod = &crdv1alpha1.PodReference{Namespace: clientPod.Namespace, Name: clientPod.Name}
+	pc.Spec.Destination.Pod = &crdv1alpha1.PodReference{Namespace: serverPod.Namespace, Name: serverPod.Name}
+	// The capture on node-2 has completed and its file has been uploaded.
+	now := metav1.Now()
+	pc.Status.NodeResults = []crdv1alpha1.PacketCaptureNodeResult{{
+		NodeName:       "node-2",
+		Pods:           []string{"default/server"},
+		NumberCaptured: 1,
+		StopReason:     crdv1alpha1.PacketCaptureStopReasonTimeout,
+		FilePath:       testFTPUrl + "/pc-cross-node-node-2.pcapng",
+		Conditions: []crdv1alpha1.PacketCaptureCondition{
+			{Type: crdv1alpha1.PacketCaptureStarted, Status: metav1.ConditionTrue, LastTransitionTime: now, Reason: "Started"},
+			{Type: crdv1alpha1.PacketCaptureComplete, Status: metav1.ConditionTrue, LastTransitionTime: now, Reason: "Timeout"},
+			{Type: crdv1alpha1.PacketCaptureFileUploaded, Status: metav1.ConditionTrue, LastTransitionTime: now, Reason: "Succeed"},
+		},
+	}}
+
+	pcc := newFakePacketCaptureController(t, []runtime.Object{clientPod, serverPod}, []runtime.Object{pc})
+	addPodInterface(pcc.interfaceStore, clientPod.Namespace, clientPod.Name, []string{"192.168.20.10"}, "aa:bb:cc:dd:ee:01", 3)
+	pcc.sftpUploader = &testUploader{url: testFTPUrl}
+	pcc.sftpDownloader = &testDownloader{
+		url: testFTPUrl,
+		files: map[string][]byte{
+			"pc-cross-node-node-2.pcapng": writeTestPacketFile(t, []string{"default/server"}, []testPacket{
+				{timestamp: time.Now(), data: craftTestPacket().Data()},
+			}),
+		},
+		// The first merge fails and must be retried.
+		failures: 1,
+	}
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	pcc.crdInformerFactory.Start(stopCh)
+	pcc.crdInformerFactory.WaitForCacheSync(stopCh)
+	pcc.informerFactory.Start(stopCh)
+	pcc.informerFactory.WaitForCacheSync(stopCh)
+	go pcc.Run(stopCh)
+
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		result, err := pcc.crdClient.CrdV1alpha1().PacketCaptures().Get(context.Background(), pc.Name, metav1.GetOptions{})
+		require.NoError(c, err)
+		require.Len(c, result.Status.NodeResults, 2)
+		assert.Equal(c, testFTPUrl+"/pc-cross-node-node-1.pcapng", result.Status.NodeResults[0].FilePath)
+		assert.Equal(c, testCaptureNum+1, result.Status.NumberCaptured)
+		assert.Equal(c, crdv1alpha1.PacketCaptureStopReasonTimeout, result.Status.StopReason)
+		var mergedCond *crdv1alpha1.PacketCaptureCondition
+		for i := range result.Status.Conditions {
+			if result.Status.Conditions[i].Type == crdv1alpha1.PacketCaptureFileMerged {
+				mergedCond = &result.Status.Conditions[i]
+			}
+		}
+		require.NotNil(c, mergedCond)
+		assert.Equal(c, metav1.ConditionTrue, mergedCond.Status, mergedCond.Message)
+		assert.Equal(c, testFTPUrl+"/pc-cross-node.pcapng", result.Status.FilePath)
+	}, 5*time.Second, 20*time.Millisecond)
+}
+
+func TestPacketCaptureCrossNodeMergeTimeout(t *testing.T) {
+	defaultFS = afero.NewMemMapFs()
+	defer func() {
+		defaultFS = afero.NewOsFs()
+	}()
+	oldMergeTimeout := mergeTimeout
+	mergeTimeout = 500 * time.Millisecond
+	defer func() {
+		mergeTimeout = oldMergeTimeout
+	}()
+	clientPod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "client", Namespace: "default"},
+		Spec:       v1.PodSpec{NodeName: testNodeName},
+		Status:     v1.PodStatus{PodIPs: []v1.PodIP{{IP: "192.168.20.10"}}},
+	}
+	// The server Pod is scheduled on node-2, which never reports any result.
+	serverPod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "server", Namespace: "default"},
+		Spec:       v1.PodSpec{NodeName: "node-2"},
+		Status:     v1.PodStatus{PodIPs: []v1.PodIP{{IP: "192.168.30.10"}}},
+	}
+	pc := genTestCR("pc-cross-node", testCaptureNum)
+	pc.Spec.Source.Pod = &crdv1alpha1.PodReference{Namespace: clientPod.Namespace, Name: clientPod.Name}
+	pc.Spec.Destination.Pod = &crdv1alpha1.PodReference{Namespace: serverPod.Namespace, Name: serverPod.Name}
+
+	pcc := newFakePacketCaptureController(t, []runtime.Object{clientPod, serverPod}, []runtime.Object{pc})
+	addPodInterface(pcc.interfaceStore, clientPod.Namespace, clientPod.Name, []string{"192.168.20.10"}, "aa:bb:cc:dd:ee:01", 3)
+	pcc.sftpUploader = &testUploader{url: testFTPUrl}
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	pcc.crdInformerFactory.Start(stopCh)
+	pcc.crdInformerFactory.WaitForCacheSync(stopCh)
+	pcc.informerFactory.Start(stopCh)
+	pcc.informerFactory.WaitForCacheSync(stopCh)
+	go pcc.Run(stopCh)
+
+	// The packets captured on the current Node are merged after mergeTimeout, and node-2 is marked
+	// as failed.
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		result, err := pcc.crdClient.CrdV1alpha1().PacketCaptures().Get(context.Background(), pc.Name, metav1.GetOptions{})
+		require.NoError(c, err)
+		require.Len(c, result.Status.NodeResults, 2)
+		missingResult := result.Status.NodeResults[1]
+		assert.Equal(c, "node-2", missingResult.NodeName)
+		assert.Equal(c, crdv1alpha1.PacketCaptureStopReasonError, missingResult.StopReason)
+		require.Len(c, missingResult.Conditions, 1)
+		assert.Equal(c, crdv1alpha1.PacketCaptureComplete, missingResult.Conditions[0].Type)
+		assert.Equal(c, "Failed", missingResult.Conditions[0].Reason)
+		assert.Equal(c, crdv1alpha1.PacketCaptureStopReasonError, result.Status.StopReason)
+		var mergedCond *crdv1alpha1.PacketCaptureCondition
+		for i := range result.Status.Conditions {
+			if result.Status.Conditions[i].Type == crdv1alpha1.PacketCaptureFileMerged {
+				mergedCond = &result.Status.Conditions[i]
+			}
+		}
+		require.NotNil(c, mergedCond)
+		assert.Equal(c, metav1.ConditionTrue, mergedCond.Status, mergedCond.Message)
+		assert.Equal(c, testFTPUrl+"/pc-cross-node.pcapng", result.Status.FilePath)
+	}, 5*time.Second, 20*time.Millisecond)
+}
@@ -0,0 +1,72 @@
+// Copyright 2024 Antrea Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packetcapture
+
+import (
+	"github.com/gopacket/gopacket"
+)
+
+type bufferedPacket struct {
+	ci   gopacket.CaptureInfo
+	data []byte
+}
+
+// packetRingBuffer keeps the most recent packets of a RingBuffer capture. When the buffer is full, adding a packet
+// evicts the oldest one. It is not thread-safe.
+type packetRingBuffer struct {
+	buf []bufferedPacket
+	// next is the index in buf where the next packet will be stored.
+	next int
+	// full indicates whether buf has wrapped around at least once.
+	full bool
+	// bytes is the total size of the packets currently kept in the buffer.
+	bytes int64
+}
+
+func newPacketRingBuffer(size int) *packetRingBuffer {
+	return &packetRingBuffer{
+		buf: make([]bufferedPacket, size),
+	}
+}
+
+func (r *packetRingBuffer) add(ci gopacket.CaptureInfo, data []byte) {
+	if r.full {
+		r.bytes -= int64(len(r.buf[r.next].data))
+	}
+	r.buf[r.next] = bufferedPacket{ci: ci, data: data}
+	r.bytes += int64(len(data))
+	r.next++
+	if r.next == len(r.buf) {
+		r.next = 0
+		r.full = true
+	}
+}
+
+func (r *packetRingBuffer) len() int {
+	if r.full {
+		return len(r.buf)
+	}
+	return r.next
+}
+
+// packets returns the packets kept in the buffer, from the oldest to the most recent.
+func (r *packetRingBuffer) packets() []bufferedPacket {
+	if !r.full {
+		return r.buf[:r.next]
+	}
+	result := make([]bufferedPacket, 0, len(r.buf))
+	result = append(result, r.buf[r.next:]...)
+	return append(result, r.buf[:r.next]...)
+}
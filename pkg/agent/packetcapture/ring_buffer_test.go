@@ -0,0 +1,80 @@
+// Copyright 2024 Antrea Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packetcapture
+
+import (
+	"testing"
+
+	"github.com/gopacket/gopacket"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPacketRingBuffer(t *testing.T) {
+	tests := []struct {
+		name          string
+		size          int
+		addNum        int
+		expectedData  [][]byte
+		expectedBytes int64
+	}{
+		{
+			name:          "empty",
+			size:          3,
+			addNum:        0,
+			expectedData:  [][]byte{},
+			expectedBytes: 0,
+		},
+		{
+			name:          "not full",
+			size:          3,
+			addNum:        2,
+			expectedData:  [][]byte{{0}, {1, 1}},
+			expectedBytes: 3,
+		},
+		{
+			name:          "exactly full",
+			size:          3,
+			addNum:        3,
+			expectedData:  [][]byte{{0}, {1, 1}, {2, 2, 2}},
+			expectedBytes: 6,
+		},
+		{
+			name:          "wrapped around",
+			size:          3,
+			addNum:        5,
+			expectedData:  [][]byte{{2, 2, 2}, {3, 3, 3, 3}, {4, 4, 4, 4, 4}},
+			expectedBytes: 12,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newPacketRingBuffer(tt.size)
+			for i := 0; i < tt.addNum; i++ {
+				data := make([]byte, i+1)
+				for j := range data {
+					data[j] = byte(i)
+				}
+				r.add(gopacket.CaptureInfo{CaptureLength: len(data), Length: len(data)}, data)
+			}
+			data := [][]byte{}
+			for _, p := range r.packets() {
+				data = append(data, p.data)
+			}
+			assert.Equal(t, tt.expectedData, data)
+			assert.Equal(t, len(tt.expectedData), r.len())
+			assert.Equal(t, tt.expectedBytes, r.bytes)
+		})
+	}
+}
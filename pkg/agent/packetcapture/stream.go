@@ -0,0 +1,162 @@
+// Copyright 2024 Antrea Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packetcapture
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/gopacket/gopacket"
+	"k8s.io/klog/v2"
+)
+
+// subscriberBufferSize is the number of captured packets buffered for a subscriber. Packets are
+// dropped for a subscriber which doesn't keep up, so that it never slows down the capture.
+const subscriberBufferSize = 1024
+
+var (
+	// ErrPacketCaptureNotFound means the PacketCapture is not performed on the current Node.
+	ErrPacketCaptureNotFound = errors.New("PacketCapture not found on the Node")
+	// ErrPacketCaptureNotRunning means the capture on the current Node is not running, so its packets can't be streamed.
+	ErrPacketCaptureNotRunning = errors.New("PacketCapture is not running on the Node")
+	// ErrPacketsFileNotAvailable means the capture on the current Node is still running, or didn't capture any packet.
+	ErrPacketsFileNotAvailable = errors.New("packets file of the PacketCapture is not available on the Node")
+)
+
+// streamedPacket is a captured packet sent to the subscribers of a capture.
+type streamedPacket struct {
+	ci   gopacket.CaptureInfo
+	data []byte
+}
+
+// packetSubscriber receives the packets of a running capture. Its channel is closed when the
+// capture stops.
+type packetSubscriber struct {
+	packets chan streamedPacket
+	// dropped is the number of packets which couldn't be sent to the subscriber because its buffer was full.
+	dropped int
+}
+
+// publishPacket sends a captured packet to all the subscribers of the capture.
+func (c *Controller) publishPacket(state *packetCaptureState, ci gopacket.CaptureInfo, data []byte) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if len(state.subscribers) == 0 {
+		return
+	}
+	// The packet data may be reused by the capture source, it must be copied before being sent.
+	packet := streamedPacket{ci: ci, data: slices.Clone(data)}
+	for subscriber := range state.subscribers {
+		select {
+		case subscriber.packets <- packet:
+		default:
+			subscriber.dropped++
+		}
+	}
+}
+
+// closeSubscribers notifies the subscribers that no more packets will be captured. It must be called
+// with the mutex of the Controller held.
+func (pcs *packetCaptureState) closeSubscribers() {
+	for subscriber := range pcs.subscribers {
+		close(subscriber.packets)
+	}
+	pcs.subscribers = nil
+}
+
+func (c *Controller) subscribe(name string) (*packetCaptureState, *packetSubscriber, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	state, ok := c.captures[name]
+	if !ok {
+		return nil, nil, ErrPacketCaptureNotFound
+	}
+	if state.phase != packetCapturePhaseStarted {
+		return nil, nil, ErrPacketCaptureNotRunning
+	}
+	subscriber := &packetSubscriber{packets: make(chan streamedPacket, subscriberBufferSize)}
+	if state.subscribers == nil {
+		state.subscribers = make(map[*packetSubscriber]struct{})
+	}
+	state.subscribers[subscriber] = struct{}{}
+	return state, subscriber, nil
+}
+
+func (c *Controller) unsubscribe(state *packetCaptureState, subscriber *packetSubscriber) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	delete(state.subscribers, subscriber)
+}
+
+// StreamPacketCapture writes the packets captured on the current Node for a running PacketCapture
+// to w in the pcapng format, as soon as they are captured. It blocks until the capture stops or ctx
+// is done. Only the packets captured after it is called are written.
+func (c *Controller) StreamPacketCapture(ctx context.Context, name string, w io.Writer) error {
+	state, subscriber, err := c.subscribe(name)
+	if err != nil {
+		return err
+	}
+	defer c.unsubscribe(state, subscriber)
+	klog.V(2).InfoS("Streaming captured packets", "name", name)
+
+	// targets is never updated once the capture is started.
+	pcapngWriter, err := newPacketsWriter(w, state.targets)
+	if err != nil {
+		return err
+	}
+	// Flush the headers right away, so that the receiver knows the interfaces before any packet is captured.
+	if err := pcapngWriter.Flush(); err != nil {
+		return err
+	}
+	for {
+		select {
+		case packet, ok := <-subscriber.packets:
+			if !ok {
+				c.mutex.Lock()
+				dropped := subscriber.dropped
+				c.mutex.Unlock()
+				klog.V(2).InfoS("Stopped streaming captured packets", "name", name, "dropped", dropped)
+				return nil
+			}
+			if err := pcapngWriter.WritePacket(packet.ci, packet.data); err != nil {
+				return fmt.Errorf("couldn't write packets: %w", err)
+			}
+			if err := pcapngWriter.Flush(); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// GetPacketCaptureFile returns the file of the packets captured on the current Node for a
+// PacketCapture which is complete. The caller is responsible for closing the file.
+func (c *Controller) GetPacketCaptureFile(name string) (io.ReadCloser, error) {
+	c.mutex.Lock()
+	state, ok := c.captures[name]
+	available := ok && state.phase == packetCapturePhaseComplete && state.filePath != ""
+	c.mutex.Unlock()
+	if !ok {
+		return nil, ErrPacketCaptureNotFound
+	}
+	if !available {
+		return nil, ErrPacketsFileNotAvailable
+	}
+	return defaultFS.Open(nameToPath(name))
+}
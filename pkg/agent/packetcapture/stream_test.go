@@ -0,0 +1,154 @@
+// Copyright 2024 Antrea Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packetcapture
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/gopacket/gopacket"
+	"github.com/gopacket/gopacket/pcapgo"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (c *Controller) numSubscribers(name string) int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return len(c.captures[name].subscribers)
+}
+
+func TestStreamPacketCapture(t *testing.T) {
+	state := &packetCaptureState{
+		phase:   packetCapturePhaseStarted,
+		targets: []captureTarget{{device: "web-1-eth0", pod: "default/web-1"}, {device: "web-2-eth0", pod: "default/web-2"}},
+	}
+	c := &Controller{captures: map[string]*packetCaptureState{"pc1": state}}
+
+	var buffer bytes.Buffer
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- c.StreamPacketCapture(context.Background(), "pc1", &buffer)
+	}()
+	require.Eventually(t, func() bool {
+		return c.numSubscribers("pc1") == 1
+	}, 2*time.Second, 10*time.Millisecond)
+
+	data := []byte{0x1, 0x2, 0x3}
+	for i := range state.targets {
+		ci := gopacket.CaptureInfo{Timestamp: time.Now(), CaptureLength: len(data), Length: len(data), InterfaceIndex: i}
+		c.publishPacket(state, ci, data)
+	}
+	// The data of a published packet can be reused by the capture source.
+	data[0] = 0xff
+	c.mutex.Lock()
+	state.closeSubscribers()
+	c.mutex.Unlock()
+
+	select {
+	case err := <-errCh:
+		require.NoError(t, err)
+	case <-time.After(2 * time.Second):
+		t.Fatal("StreamPacketCapture didn't return after the capture stopped")
+	}
+	assert.Equal(t, 0, c.numSubscribers("pc1"))
+
+	reader, err := pcapgo.NewNgReader(&buffer, pcapgo.DefaultNgReaderOptions)
+	require.NoError(t, err)
+	var interfaceIndexes []int
+	for {
+		packetData, ci, err := reader.ReadPacketData()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		assert.Equal(t, []byte{0x1, 0x2, 0x3}, packetData)
+		interfaceIndexes = append(interfaceIndexes, ci.InterfaceIndex)
+	}
+	assert.Equal(t, []int{0, 1}, interfaceIndexes)
+	require.Equal(t, 2, reader.NInterfaces())
+	for i, target := range state.targets {
+		ngInterface, err := reader.Interface(i)
+		require.NoError(t, err)
+		assert.Equal(t, target.device, ngInterface.Name)
+		assert.Equal(t, target.pod, ngInterface.Description)
+	}
+}
+
+func TestStreamPacketCaptureCanceled(t *testing.T) {
+	state := &packetCaptureState{
+		phase:   packetCapturePhaseStarted,
+		targets: []captureTarget{{device: "web-1-eth0", pod: "default/web-1"}},
+	}
+	c := &Controller{captures: map[string]*packetCaptureState{"pc1": state}}
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- c.StreamPacketCapture(ctx, "pc1", io.Discard)
+	}()
+	require.Eventually(t, func() bool {
+		return c.numSubscribers("pc1") == 1
+	}, 2*time.Second, 10*time.Millisecond)
+	cancel()
+	select {
+	case err := <-errCh:
+		assert.ErrorIs(t, err, context.Canceled)
+	case <-time.After(2 * time.Second):
+		t.Fatal("StreamPacketCapture didn't return after the context was canceled")
+	}
+	assert.Equal(t, 0, c.numSubscribers("pc1"))
+}
+
+func TestStreamPacketCaptureErrors(t *testing.T) {
+	c := &Controller{captures: map[string]*packetCaptureState{
+		"pending":  {phase: packetCapturePhasePending},
+		"complete": {phase: packetCapturePhaseComplete},
+	}}
+	assert.ErrorIs(t, c.StreamPacketCapture(context.Background(), "unknown", io.Discard), ErrPacketCaptureNotFound)
+	assert.ErrorIs(t, c.StreamPacketCapture(context.Background(), "pending", io.Discard), ErrPacketCaptureNotRunning)
+	assert.ErrorIs(t, c.StreamPacketCapture(context.Background(), "complete", io.Discard), ErrPacketCaptureNotRunning)
+}
+
+func TestGetPacketCaptureFile(t *testing.T) {
+	defaultFS = afero.NewMemMapFs()
+	defer func() {
+		defaultFS = afero.NewOsFs()
+	}()
+	require.NoError(t, afero.WriteFile(defaultFS, nameToPath("complete"), []byte("packets"), 0600))
+
+	c := &Controller{captures: map[string]*packetCaptureState{
+		"started":  {phase: packetCapturePhaseStarted},
+		"empty":    {phase: packetCapturePhaseComplete},
+		"complete": {phase: packetCapturePhaseComplete, filePath: "sftp://127.0.0.1:22/upload/complete.pcapng"},
+	}}
+
+	_, err := c.GetPacketCaptureFile("unknown")
+	assert.ErrorIs(t, err, ErrPacketCaptureNotFound)
+	_, err = c.GetPacketCaptureFile("started")
+	assert.ErrorIs(t, err, ErrPacketsFileNotAvailable)
+	_, err = c.GetPacketCaptureFile("empty")
+	assert.ErrorIs(t, err, ErrPacketsFileNotAvailable)
+
+	file, err := c.GetPacketCaptureFile("complete")
+	require.NoError(t, err)
+	defer file.Close()
+	data, err := io.ReadAll(file)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("packets"), data)
+}
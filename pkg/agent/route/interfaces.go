@@ -121,4 +121,22 @@ type Interface interface {
 
 	// DeleteNodeNetworkPolicyIPTables deletes iptables chains and rules within the chains for NodeNetworkPolicy.
 	DeleteNodeNetworkPolicyIPTables(iptablesChains []string, isIPv6 bool) error
+
+	// AddBGPImportedRoute adds or replaces a route imported from BGP peers, which routes dst via gateway with the
+	// given metric.
+	AddBGPImportedRoute(dst *net.IPNet, gateway net.IP, metric int) error
+
+	// DeleteBGPImportedRoute deletes the route imported from BGP peers for dst with the given metric.
+	DeleteBGPImportedRoute(dst *net.IPNet, metric int) error
+
+	// ListBGPImportedRoutes returns the routes imported from BGP peers which are installed in the routing table, e.g.
+	// by the previous agent.
+	ListBGPImportedRoutes() ([]BGPImportedRoute, error)
+}
+
+// BGPImportedRoute is a route imported from BGP peers, which routes Dst via Gateway with the given Metric.
+type BGPImportedRoute struct {
+	Dst     *net.IPNet
+	Gateway net.IP
+	Metric  int
 }
@@ -52,6 +52,10 @@ const (
 	vxlanPort  = 4789
 	genevePort = 6081
 
+	// bgpImportedRouteProtocol is the protocol of the routes imported from BGP peers. It differs from the protocol used
+	// by other BGP daemons (RTPROT_BGP), so that the imported routes can be identified and cleaned up.
+	bgpImportedRouteProtocol netlink.RouteProtocol = 176
+
 	// Antrea managed ipset.
 	// antreaPodIPSet contains all Per-Node IPAM Pod CIDRs of this cluster.
 	antreaPodIPSet = "ANTREA-POD-IP"
@@ -2097,6 +2101,46 @@ func (c *Client) DeleteRouteForLink(cidr *net.IPNet, linkIndex int) error {
 	return nil
 }
 
+func newBGPImportedRoute(dst *net.IPNet, gateway net.IP, metric int) *netlink.Route {
+	return &netlink.Route{
+		Dst:      dst,
+		Gw:       gateway,
+		Priority: metric,
+		Protocol: bgpImportedRouteProtocol,
+	}
+}
+
+func (c *Client) AddBGPImportedRoute(dst *net.IPNet, gateway net.IP, metric int) error {
+	return c.netlink.RouteReplace(newBGPImportedRoute(dst, gateway, metric))
+}
+
+func (c *Client) DeleteBGPImportedRoute(dst *net.IPNet, metric int) error {
+	route := newBGPImportedRoute(dst, nil, metric)
+	if err := c.netlink.RouteDel(route); err != nil {
+		if err == unix.ESRCH {
+			klog.V(2).InfoS("Failed to delete BGP imported route since the route does not exist", "route", route)
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+func (c *Client) ListBGPImportedRoutes() ([]BGPImportedRoute, error) {
+	routes, err := c.netlink.RouteList(nil, netlink.FAMILY_ALL)
+	if err != nil {
+		return nil, err
+	}
+	var importedRoutes []BGPImportedRoute
+	for _, route := range routes {
+		if route.Protocol != bgpImportedRouteProtocol || route.Dst == nil {
+			continue
+		}
+		importedRoutes = append(importedRoutes, BGPImportedRoute{Dst: route.Dst, Gateway: route.Gw, Metric: route.Priority})
+	}
+	return importedRoutes, nil
+}
+
 func (c *Client) ClearConntrackEntryForService(svcIP net.IP, svcPort uint16, endpointIP net.IP, protocol binding.Protocol) error {
 	var protoVar uint8
 	var ipFamilyVar uint8
@@ -2024,6 +2024,41 @@ func TestEgressRoutes(t *testing.T) {
 	}
 }
 
+func TestBGPImportedRoutes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockNetlink := netlinktest.NewMockInterface(ctrl)
+	c := &Client{
+		netlink:    mockNetlink,
+		nodeConfig: nodeConfig,
+	}
+
+	route1 := &netlink.Route{Dst: ip.MustParseCIDR("10.10.0.0/16"), Gw: net.ParseIP("192.168.77.100"), Priority: 20, Protocol: bgpImportedRouteProtocol}
+	route2 := &netlink.Route{Dst: ip.MustParseCIDR("fec0:10::/64"), Gw: net.ParseIP("fec0::100"), Priority: 100, Protocol: bgpImportedRouteProtocol}
+	mockNetlink.EXPECT().RouteReplace(route1)
+	mockNetlink.EXPECT().RouteReplace(route2)
+	assert.NoError(t, c.AddBGPImportedRoute(route1.Dst, route1.Gw, 20))
+	assert.NoError(t, c.AddBGPImportedRoute(route2.Dst, route2.Gw, 100))
+
+	mockNetlink.EXPECT().RouteDel(&netlink.Route{Dst: route1.Dst, Priority: 20, Protocol: bgpImportedRouteProtocol})
+	mockNetlink.EXPECT().RouteDel(&netlink.Route{Dst: route2.Dst, Priority: 100, Protocol: bgpImportedRouteProtocol}).Return(unix.ESRCH)
+	assert.NoError(t, c.DeleteBGPImportedRoute(route1.Dst, 20))
+	assert.NoError(t, c.DeleteBGPImportedRoute(route2.Dst, 100))
+
+	// Only the routes imported from BGP peers should be listed.
+	route3 := &netlink.Route{Dst: ip.MustParseCIDR("192.168.1.0/24"), Gw: net.ParseIP("1.1.1.1")}
+	mockNetlink.EXPECT().RouteList(nil, netlink.FAMILY_ALL).Return([]netlink.Route{*route1, *route2, *route3}, nil)
+	routes, err := c.ListBGPImportedRoutes()
+	assert.NoError(t, err)
+	assert.Equal(t, []BGPImportedRoute{
+		{Dst: route1.Dst, Gateway: route1.Gw, Metric: 20},
+		{Dst: route2.Dst, Gateway: route2.Gw, Metric: 100},
+	}, routes)
+
+	mockNetlink.EXPECT().RouteList(nil, netlink.FAMILY_ALL).Return(nil, unix.EPERM)
+	_, err = c.ListBGPImportedRoutes()
+	assert.Error(t, err)
+}
+
 func TestEgressRule(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -647,3 +647,15 @@ func (c *Client) AddOrUpdateNodeNetworkPolicyIPTables(iptablesChains []string, i
 func (c *Client) DeleteNodeNetworkPolicyIPTables(iptablesChains []string, isIPv6 bool) error {
 	return errors.New("DeleteNodeNetworkPolicyIPTables is not implemented on Windows")
 }
+
+func (c *Client) AddBGPImportedRoute(dst *net.IPNet, gateway net.IP, metric int) error {
+	return errors.New("AddBGPImportedRoute is not implemented on Windows")
+}
+
+func (c *Client) DeleteBGPImportedRoute(dst *net.IPNet, metric int) error {
+	return errors.New("DeleteBGPImportedRoute is not implemented on Windows")
+}
+
+func (c *Client) ListBGPImportedRoutes() ([]BGPImportedRoute, error) {
+	return nil, errors.New("ListBGPImportedRoutes is not implemented on Windows")
+}
@@ -29,6 +29,7 @@ import (
 	reflect "reflect"
 
 	config "antrea.io/antrea/pkg/agent/config"
+	route "antrea.io/antrea/pkg/agent/route"
 	openflow "antrea.io/antrea/pkg/ovs/openflow"
 	gomock "go.uber.org/mock/gomock"
 	sets "k8s.io/apimachinery/pkg/util/sets"
@@ -58,6 +59,20 @@ func (m *MockInterface) EXPECT() *MockInterfaceMockRecorder {
 	return m.recorder
 }
 
+// AddBGPImportedRoute mocks base method.
+func (m *MockInterface) AddBGPImportedRoute(dst *net.IPNet, gateway net.IP, metric int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddBGPImportedRoute", dst, gateway, metric)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddBGPImportedRoute indicates an expected call of AddBGPImportedRoute.
+func (mr *MockInterfaceMockRecorder) AddBGPImportedRoute(dst, gateway, metric any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBGPImportedRoute", reflect.TypeOf((*MockInterface)(nil).AddBGPImportedRoute), dst, gateway, metric)
+}
+
 // AddEgressRoutes mocks base method.
 func (m *MockInterface) AddEgressRoutes(tableID uint32, dev int, gateway net.IP, prefixLength int) error {
 	m.ctrl.T.Helper()
@@ -212,6 +227,20 @@ func (mr *MockInterfaceMockRecorder) ClearConntrackEntryForService(svcIP, svcPor
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearConntrackEntryForService", reflect.TypeOf((*MockInterface)(nil).ClearConntrackEntryForService), svcIP, svcPort, endpointIP, protocol)
 }
 
+// DeleteBGPImportedRoute mocks base method.
+func (m *MockInterface) DeleteBGPImportedRoute(dst *net.IPNet, metric int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBGPImportedRoute", dst, metric)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBGPImportedRoute indicates an expected call of DeleteBGPImportedRoute.
+func (mr *MockInterfaceMockRecorder) DeleteBGPImportedRoute(dst, metric any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBGPImportedRoute", reflect.TypeOf((*MockInterface)(nil).DeleteBGPImportedRoute), dst, metric)
+}
+
 // DeleteEgressRoutes mocks base method.
 func (m *MockInterface) DeleteEgressRoutes(tableID uint32) error {
 	m.ctrl.T.Helper()
@@ -366,6 +395,21 @@ func (mr *MockInterfaceMockRecorder) Initialize(nodeConfig, done any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Initialize", reflect.TypeOf((*MockInterface)(nil).Initialize), nodeConfig, done)
 }
 
+// ListBGPImportedRoutes mocks base method.
+func (m *MockInterface) ListBGPImportedRoutes() ([]route.BGPImportedRoute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBGPImportedRoutes")
+	ret0, _ := ret[0].([]route.BGPImportedRoute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBGPImportedRoutes indicates an expected call of ListBGPImportedRoutes.
+func (mr *MockInterfaceMockRecorder) ListBGPImportedRoutes() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBGPImportedRoutes", reflect.TypeOf((*MockInterface)(nil).ListBGPImportedRoutes))
+}
+
 // MigrateRoutesToGw mocks base method.
 func (m *MockInterface) MigrateRoutesToGw(linkName string) error {
 	m.ctrl.T.Helper()
@@ -27,6 +27,10 @@ const (
 	// NodeMaxEgressIPsAnnotationKey represents the key of maximum Egress IP number in the Annotations of the Node.
 	NodeMaxEgressIPsAnnotationKey string = "node.antrea.io/max-egress-ips"
 
+	// NodeEgressTrafficLoadAnnotationKey represents the key of the Node's Egress traffic load in the Annotations of the Node.
+	// It is maintained by the user or an external monitoring system, not by Antrea.
+	NodeEgressTrafficLoadAnnotationKey string = "node.antrea.io/egress-traffic-load"
+
 	// NodeBGPRouterIDAnnotationKey represents the key of the Node's BGP router ID in the Annotations of the Node.
 	NodeBGPRouterIDAnnotationKey string = "node.antrea.io/bgp-router-id"
 
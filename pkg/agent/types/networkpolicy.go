@@ -104,6 +104,9 @@ type PolicyRule struct {
 	PolicyRef     *v1beta2.NetworkPolicyReference
 	EnableLogging bool
 	LogLabel      string
+	// RateLimitMeterID is the ID of the OF meter used to rate-limit the traffic allowed by the rule. nil if the
+	// traffic is not rate-limited.
+	RateLimitMeterID *uint32
 }
 
 // IsAntreaNetworkPolicyRule returns if a PolicyRule is created for Antrea NetworkPolicy types.
@@ -19,10 +19,12 @@ import (
 
 	agentapis "antrea.io/antrea/pkg/agent/apis"
 	fallbackversion "antrea.io/antrea/pkg/antctl/fallback/version"
+	bgpraw "antrea.io/antrea/pkg/antctl/raw/bgp"
 	checkcluster "antrea.io/antrea/pkg/antctl/raw/check/cluster"
 	checkinstallation "antrea.io/antrea/pkg/antctl/raw/check/installation"
 	"antrea.io/antrea/pkg/antctl/raw/featuregates"
 	"antrea.io/antrea/pkg/antctl/raw/multicluster"
+	"antrea.io/antrea/pkg/antctl/raw/packetcapture"
 	"antrea.io/antrea/pkg/antctl/raw/proxy"
 	"antrea.io/antrea/pkg/antctl/raw/set"
 	"antrea.io/antrea/pkg/antctl/raw/supportbundle"
@@ -716,8 +718,8 @@ $ antctl get podmulticaststats pod -n namespace`,
 						{
 							name:            "type",
 							shorthand:       "T",
-							usage:           "Get advertised bgp routes of a specific type. Valid types are EgressIP, ServiceLoadBalancerIP, ServiceExternalIP, ServiceClusterIP or NodeIPAMPodCIDR.",
-							supportedValues: []string{"EgressIP", "ServiceLoadBalancerIP", "ServiceExternalIP", "ServiceClusterIP", "NodeIPAMPodCIDR"},
+							usage:           "Get advertised bgp routes of a specific type. Valid types are EgressIP, ServiceLoadBalancerIP, ServiceExternalIP, ServiceClusterIP, NodeIPAMPodCIDR or PodIP.",
+							supportedValues: []string{"EgressIP", "ServiceLoadBalancerIP", "ServiceExternalIP", "ServiceClusterIP", "NodeIPAMPodCIDR", "PodIP"},
 						},
 					},
 					outputType: multiple,
@@ -750,6 +752,12 @@ $ antctl get podmulticaststats pod -n namespace`,
 			supportAgent:      true,
 			supportController: true,
 		},
+		{
+			cobraCommand:      packetcapture.Command,
+			supportAgent:      true,
+			supportController: true,
+			requiresInput:     true,
+		},
 		{
 			cobraCommand:      proxy.Command,
 			supportAgent:      false,
@@ -821,6 +829,20 @@ $ antctl get podmulticaststats pod -n namespace`,
 			supportController: false,
 			commandGroup:      upgrade,
 		},
+		{
+			cobraCommand:      bgpraw.ExplainCmd,
+			supportAgent:      false,
+			supportController: true,
+			commandGroup:      bgp,
+			requiresInput:     true,
+		},
+		{
+			cobraCommand:      bgpraw.DryRunCmd,
+			supportAgent:      true,
+			supportController: false,
+			commandGroup:      bgp,
+			requiresInput:     true,
+		},
 	},
 	codec: scheme.Codecs,
 }
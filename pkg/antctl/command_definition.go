@@ -71,6 +71,7 @@ const (
 	mc
 	upgrade
 	check
+	bgp
 )
 
 var groupCommands = map[commandGroup]*cobra.Command{
@@ -98,6 +99,11 @@ var groupCommands = map[commandGroup]*cobra.Command{
 		Use:   "check",
 		Short: "Performs pre and post installation checks",
 	},
+	bgp: {
+		Use:   "bgp",
+		Short: "Sub-commands of BGPPolicy feature",
+		Long:  "Sub-commands of BGPPolicy feature",
+	},
 }
 
 type endpointResponder interface {
@@ -211,6 +217,9 @@ type rawCommand struct {
 	supportController     bool
 	supportFlowAggregator bool
 	commandGroup          commandGroup
+	// requiresInput indicates that the command cannot run without user-provided arguments or flags, so it is not
+	// included in the debug commands.
+	requiresInput bool
 }
 
 // commandDefinition defines options to create a cobra.Command for an antctl client.
@@ -144,6 +144,9 @@ func (cl *commandList) GetDebugCommands(mode string) [][]string {
 			// cannot be used as is in e2e tests.
 			continue
 		}
+		if cmd.requiresInput {
+			continue
+		}
 		if mode == runtime.ModeController && cmd.supportController ||
 			mode == runtime.ModeAgent && cmd.supportAgent {
 			var currentCommand []string
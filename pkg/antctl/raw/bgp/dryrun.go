@@ -0,0 +1,158 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bgp
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/yaml"
+
+	agentapis "antrea.io/antrea/pkg/agent/apis"
+	"antrea.io/antrea/pkg/antctl/output"
+	"antrea.io/antrea/pkg/antctl/raw"
+	"antrea.io/antrea/pkg/apis/crd/v1alpha1"
+)
+
+const dryRunPath = "/bgppolicy/dryrun"
+
+var (
+	DryRunCmd    *cobra.Command
+	dryRunOption = &struct {
+		filename   string
+		outputType string
+	}{}
+	getRestClient = getAgentRestClient
+)
+
+func init() {
+	DryRunCmd = &cobra.Command{
+		Use:   "dryrun",
+		Short: "Show the routes a BGPPolicy would advertise from the local Node",
+		Long: "Show the routes a BGPPolicy would advertise from the local Node without applying it, compared with the " +
+			"routes currently advertised. The BGPPolicy replaces the existing BGPPolicy with the same name, if any.",
+		Example: `  Show the routes the BGPPolicy in policy.yaml would advertise from the local Node
+  $ antctl bgp dryrun -f policy.yaml
+  Show the routes the BGPPolicy read from stdin would advertise from the local Node
+  $ cat policy.yaml | antctl bgp dryrun -f -
+`,
+		RunE: dryRunRunE,
+		Args: cobra.NoArgs,
+	}
+	DryRunCmd.Flags().StringVarP(&dryRunOption.filename, "filename", "f", "", "file containing the BGPPolicy, or - for stdin")
+	DryRunCmd.Flags().StringVarP(&dryRunOption.outputType, "output", "o", "table", "output type: table (default), json, yaml")
+}
+
+func getAgentRestClient(cmd *cobra.Command) (*rest.RESTClient, error) {
+	kubeconfig, err := raw.ResolveKubeconfig(cmd)
+	if err != nil {
+		return nil, err
+	}
+	cfg := rest.CopyConfig(kubeconfig)
+	cfg.GroupVersion = &schema.GroupVersion{Group: "", Version: ""}
+	raw.SetupLocalKubeconfig(cfg)
+	client, err := rest.RESTClientFor(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create rest client: %w", err)
+	}
+	return client, nil
+}
+
+func readBGPPolicy(filename string, stdin io.Reader) (*v1alpha1.BGPPolicy, error) {
+	if filename == "" {
+		return nil, errors.New("please provide the file containing the BGPPolicy with -f")
+	}
+	var data []byte
+	var err error
+	if filename == "-" {
+		data, err = io.ReadAll(stdin)
+	} else {
+		data, err = os.ReadFile(filename)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error when reading BGPPolicy: %w", err)
+	}
+	var policy v1alpha1.BGPPolicy
+	if err := yaml.Unmarshal(data, &policy); err != nil {
+		return nil, fmt.Errorf("error when decoding BGPPolicy: %w", err)
+	}
+	if policy.Kind != "" && policy.Kind != "BGPPolicy" {
+		return nil, fmt.Errorf("expected a BGPPolicy, got %s", policy.Kind)
+	}
+	if policy.Name == "" {
+		return nil, errors.New("the name of the BGPPolicy is required")
+	}
+	return &policy, nil
+}
+
+func dryRunRunE(cmd *cobra.Command, _ []string) error {
+	if err := validateOutputType(dryRunOption.outputType); err != nil {
+		return err
+	}
+	policy, err := readBGPPolicy(dryRunOption.filename, cmd.InOrStdin())
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(policy)
+	if err != nil {
+		return err
+	}
+	client, err := getRestClient(cmd)
+	if err != nil {
+		return err
+	}
+	rawResp, err := client.Post().AbsPath(dryRunPath).SetHeader("Content-Type", "application/json").Body(body).DoRaw(cmd.Context())
+	if err != nil {
+		return fmt.Errorf("error when running BGPPolicy dry-run: %w", err)
+	}
+	var resp agentapis.BGPPolicyDryRunResponse
+	if err := json.Unmarshal(rawResp, &resp); err != nil {
+		return fmt.Errorf("failed to unmarshal BGPPolicy dry-run result: %w", err)
+	}
+	return outputDryRunResult(policy.Name, &resp, cmd.OutOrStdout())
+}
+
+func outputDryRunResult(name string, resp *agentapis.BGPPolicyDryRunResponse, writer io.Writer) error {
+	switch dryRunOption.outputType {
+	case "json":
+		return output.JsonOutput(resp, writer)
+	case "yaml":
+		return output.YamlOutput(resp, writer)
+	}
+	switch {
+	case !resp.SelectsNode:
+		fmt.Fprintf(writer, "BGPPolicy %s doesn't select this Node, the routes below would be advertised if it did\n", name)
+	case resp.EffectiveBGPPolicy != name:
+		fmt.Fprintf(writer, "BGPPolicy %s selects this Node, but the older BGPPolicy %s would remain effective, the routes below would be advertised if %s were effective\n",
+			name, resp.EffectiveBGPPolicy, name)
+	default:
+		fmt.Fprintf(writer, "BGPPolicy %s would be effective on this Node\n", name)
+	}
+	if len(resp.Routes) == 0 {
+		_, err := fmt.Fprintln(writer, "No route would be advertised or withdrawn")
+		return err
+	}
+	rows := [][]string{{"ROUTE", "TYPE", "K8S-OBJ-REF", "CHANGE"}}
+	for _, route := range resp.Routes {
+		rows = append(rows, []string{route.Route, route.Type, route.K8sObjRef, string(route.Change)})
+	}
+	return output.ConstructFormattedTable(rows, false, writer)
+}
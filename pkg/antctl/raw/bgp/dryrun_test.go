@@ -0,0 +1,195 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bgp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/rest/fake"
+
+	agentapis "antrea.io/antrea/pkg/agent/apis"
+	"antrea.io/antrea/pkg/apis/crd/v1alpha1"
+)
+
+const testBGPPolicy = `apiVersion: crd.antrea.io/v1alpha1
+kind: BGPPolicy
+metadata:
+  name: policy-a
+spec:
+  localASN: 64512
+  listenPort: 179
+`
+
+func TestReadBGPPolicy(t *testing.T) {
+	dir := t.TempDir()
+	policyFile := filepath.Join(dir, "policy.yaml")
+	require.NoError(t, os.WriteFile(policyFile, []byte(testBGPPolicy), 0644))
+
+	tests := []struct {
+		name           string
+		filename       string
+		stdin          string
+		expectedPolicy string
+		expectedErr    string
+	}{
+		{
+			name:           "from file",
+			filename:       policyFile,
+			expectedPolicy: "policy-a",
+		},
+		{
+			name:           "from stdin",
+			filename:       "-",
+			stdin:          testBGPPolicy,
+			expectedPolicy: "policy-a",
+		},
+		{
+			name:        "no file",
+			expectedErr: "please provide the file containing the BGPPolicy with -f",
+		},
+		{
+			name:        "wrong kind",
+			filename:    "-",
+			stdin:       strings.Replace(testBGPPolicy, "kind: BGPPolicy", "kind: Egress", 1),
+			expectedErr: "expected a BGPPolicy, got Egress",
+		},
+		{
+			name:        "no name",
+			filename:    "-",
+			stdin:       strings.Replace(testBGPPolicy, "name: policy-a", "namespace: default", 1),
+			expectedErr: "the name of the BGPPolicy is required",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, err := readBGPPolicy(tt.filename, strings.NewReader(tt.stdin))
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedPolicy, policy.Name)
+			assert.Equal(t, int32(64512), policy.Spec.LocalASN)
+		})
+	}
+}
+
+func TestDryRun(t *testing.T) {
+	tests := []struct {
+		name           string
+		response       agentapis.BGPPolicyDryRunResponse
+		outputType     string
+		expectedOutput string
+	}{
+		{
+			name: "effective BGPPolicy",
+			response: agentapis.BGPPolicyDryRunResponse{
+				SelectsNode:        true,
+				EffectiveBGPPolicy: "policy-a",
+				Routes: []agentapis.BGPRouteChangeResponse{
+					{Route: "10.96.10.10/32", Type: "ClusterIP", K8sObjRef: "default/svc1", Change: agentapis.BGPRouteAdded},
+					{Route: "10.244.0.0/24", Type: "NodeIPAMPodCIDR", Change: agentapis.BGPRouteUnchanged},
+				},
+			},
+			outputType: "table",
+			expectedOutput: `BGPPolicy policy-a would be effective on this Node
+ROUTE          TYPE            K8S-OBJ-REF  CHANGE   
+10.96.10.10/32 ClusterIP       default/svc1 Added    
+10.244.0.0/24  NodeIPAMPodCIDR <NONE>       Unchanged
+`,
+		},
+		{
+			name: "BGPPolicy not selecting the Node",
+			response: agentapis.BGPPolicyDryRunResponse{
+				EffectiveBGPPolicy: "policy-b",
+			},
+			outputType: "table",
+			expectedOutput: `BGPPolicy policy-a doesn't select this Node, the routes below would be advertised if it did
+No route would be advertised or withdrawn
+`,
+		},
+		{
+			name: "older BGPPolicy remains effective",
+			response: agentapis.BGPPolicyDryRunResponse{
+				SelectsNode:        true,
+				EffectiveBGPPolicy: "policy-b",
+			},
+			outputType: "table",
+			expectedOutput: `BGPPolicy policy-a selects this Node, but the older BGPPolicy policy-b would remain effective, the routes below would be advertised if policy-a were effective
+No route would be advertised or withdrawn
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response, err := json.Marshal(tt.response)
+			require.NoError(t, err)
+			var requestPath string
+			var requestPolicy v1alpha1.BGPPolicy
+			getRestClient = getFakeRestClient(func(req *http.Request) (*http.Response, error) {
+				requestPath = req.URL.Path
+				body, _ := io.ReadAll(req.Body)
+				json.Unmarshal(body, &requestPolicy)
+				return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewBuffer(response))}, nil
+			})
+			defer func() {
+				getRestClient = getAgentRestClient
+			}()
+			dryRunOption.filename = "-"
+			dryRunOption.outputType = tt.outputType
+
+			buf := new(bytes.Buffer)
+			DryRunCmd.SetContext(context.Background())
+			DryRunCmd.SetIn(strings.NewReader(testBGPPolicy))
+			DryRunCmd.SetOut(buf)
+			DryRunCmd.SetErr(buf)
+			require.NoError(t, dryRunRunE(DryRunCmd, nil))
+			assert.Equal(t, tt.expectedOutput, buf.String())
+			assert.Equal(t, dryRunPath, requestPath)
+			assert.Equal(t, "policy-a", requestPolicy.Name)
+		})
+	}
+}
+
+func getFakeRestClient(roundTripper func(req *http.Request) (*http.Response, error)) func(cmd *cobra.Command) (*rest.RESTClient, error) {
+	return func(cmd *cobra.Command) (*rest.RESTClient, error) {
+		restClient, err := rest.RESTClientFor(&rest.Config{
+			ContentConfig: rest.ContentConfig{
+				NegotiatedSerializer: scheme.Codecs,
+				GroupVersion:         &schema.GroupVersion{},
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		restClient.Client = fake.CreateHTTPClient(roundTripper)
+		return restClient, nil
+	}
+}
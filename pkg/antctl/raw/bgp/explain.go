@@ -0,0 +1,159 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bgp
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+
+	"antrea.io/antrea/pkg/antctl/output"
+	"antrea.io/antrea/pkg/antctl/raw"
+	antrea "antrea.io/antrea/pkg/client/clientset/versioned"
+)
+
+var (
+	ExplainCmd    *cobra.Command
+	explainOption = &struct {
+		outputType string
+	}{}
+	getClients = getK8sClients
+)
+
+// explainResult describes the BGPPolicies selecting a Node.
+type explainResult struct {
+	Node        string            `json:"node"`
+	BGPPolicies []bgpPolicyResult `json:"bgpPolicies"`
+}
+
+type bgpPolicyResult struct {
+	Name              string      `json:"name"`
+	CreationTimestamp metav1.Time `json:"creationTimestamp"`
+	Effective         bool        `json:"effective"`
+}
+
+func init() {
+	ExplainCmd = &cobra.Command{
+		Use:   "explain NODE",
+		Short: "Explain which BGPPolicy is effective on a Node",
+		Long: "Explain which BGPPolicy is effective on a Node. When multiple BGPPolicies select a Node, only the oldest one " +
+			"is effective on the Node and the others are ignored.",
+		Example: `  Explain which BGPPolicy is effective on Node node-1
+  $ antctl bgp explain node-1
+  Explain which BGPPolicy is effective on Node node-1 in JSON format
+  $ antctl bgp explain node-1 -o json
+`,
+		RunE: explainRunE,
+		Args: cobra.ExactArgs(1),
+	}
+	ExplainCmd.Flags().StringVarP(&explainOption.outputType, "output", "o", "table", "output type: table (default), json, yaml")
+}
+
+func getK8sClients(cmd *cobra.Command) (kubernetes.Interface, antrea.Interface, error) {
+	kubeconfig, err := raw.ResolveKubeconfig(cmd)
+	if err != nil {
+		return nil, nil, err
+	}
+	k8sClientset, antreaClientset, err := raw.SetupClients(kubeconfig)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create clientset: %w", err)
+	}
+	return k8sClientset, antreaClientset, nil
+}
+
+func explainRunE(cmd *cobra.Command, args []string) error {
+	if err := validateOutputType(explainOption.outputType); err != nil {
+		return err
+	}
+	k8sClient, antreaClient, err := getClients(cmd)
+	if err != nil {
+		return err
+	}
+	ctx := cmd.Context()
+	node, err := k8sClient.CoreV1().Nodes().Get(ctx, args[0], metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("error when getting Node %s: %w", args[0], err)
+	}
+	policies, err := antreaClient.CrdV1alpha1().BGPPolicies().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("error when listing BGPPolicies: %w", err)
+	}
+
+	result := &explainResult{Node: node.Name}
+	for i := range policies.Items {
+		policy := &policies.Items[i]
+		nodeSelector, err := metav1.LabelSelectorAsSelector(&policy.Spec.NodeSelector)
+		if err != nil || !nodeSelector.Matches(labels.Set(node.Labels)) {
+			continue
+		}
+		result.BGPPolicies = append(result.BGPPolicies, bgpPolicyResult{
+			Name:              policy.Name,
+			CreationTimestamp: policy.CreationTimestamp,
+		})
+	}
+	// Like antrea-agent, the oldest BGPPolicy selecting the Node is effective.
+	slices.SortFunc(result.BGPPolicies, func(a, b bgpPolicyResult) int {
+		if n := a.CreationTimestamp.Compare(b.CreationTimestamp.Time); n != 0 {
+			return n
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+	if len(result.BGPPolicies) > 0 {
+		result.BGPPolicies[0].Effective = true
+	}
+	return outputExplainResult(result, cmd.OutOrStdout())
+}
+
+func outputExplainResult(result *explainResult, writer io.Writer) error {
+	switch explainOption.outputType {
+	case "json":
+		return output.JsonOutput(result, writer)
+	case "yaml":
+		return output.YamlOutput(result, writer)
+	}
+	if len(result.BGPPolicies) == 0 {
+		_, err := fmt.Fprintf(writer, "No BGPPolicy selects Node %s\n", result.Node)
+		return err
+	}
+	fmt.Fprintf(writer, "Node %s is selected by %d BGPPolicies, the oldest one is effective\n", result.Node, len(result.BGPPolicies))
+	if len(result.BGPPolicies) > 1 && result.BGPPolicies[0].CreationTimestamp.Equal(&result.BGPPolicies[1].CreationTimestamp) {
+		fmt.Fprintf(writer, "Warning: BGPPolicies %s and %s have the same creation timestamp, either of them may be effective\n",
+			result.BGPPolicies[0].Name, result.BGPPolicies[1].Name)
+	}
+	rows := [][]string{{"NAME", "CREATION-TIMESTAMP", "EFFECTIVE"}}
+	for _, policy := range result.BGPPolicies {
+		effective := "No"
+		if policy.Effective {
+			effective = "Yes"
+		}
+		rows = append(rows, []string{policy.Name, policy.CreationTimestamp.UTC().Format(time.RFC3339), effective})
+	}
+	return output.ConstructFormattedTable(rows, false, writer)
+}
+
+func validateOutputType(outputType string) error {
+	switch outputType {
+	case "table", "json", "yaml":
+		return nil
+	}
+	return fmt.Errorf("unsupported output type %q, must be one of table, json or yaml", outputType)
+}
@@ -0,0 +1,149 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bgp
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+
+	"antrea.io/antrea/pkg/apis/crd/v1alpha1"
+	antrea "antrea.io/antrea/pkg/client/clientset/versioned"
+	antreafakeclient "antrea.io/antrea/pkg/client/clientset/versioned/fake"
+)
+
+var (
+	creationTime = metav1.NewTime(time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC))
+	node1        = &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "node-1",
+			Labels: map[string]string{"bgp": "enabled"},
+		},
+	}
+)
+
+func newBGPPolicy(name string, creationTimestamp metav1.Time, nodeLabels map[string]string) *v1alpha1.BGPPolicy {
+	return &v1alpha1.BGPPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			CreationTimestamp: creationTimestamp,
+		},
+		Spec: v1alpha1.BGPPolicySpec{
+			NodeSelector: metav1.LabelSelector{MatchLabels: nodeLabels},
+		},
+	}
+}
+
+func TestExplain(t *testing.T) {
+	tests := []struct {
+		name           string
+		policies       []runtime.Object
+		outputType     string
+		expectedOutput string
+		expectedErr    string
+	}{
+		{
+			name:           "no BGPPolicy",
+			outputType:     "table",
+			expectedOutput: "No BGPPolicy selects Node node-1\n",
+		},
+		{
+			name: "multiple BGPPolicies",
+			policies: []runtime.Object{
+				newBGPPolicy("policy-b", metav1.NewTime(creationTime.Add(time.Minute)), map[string]string{"bgp": "enabled"}),
+				newBGPPolicy("policy-a", creationTime, map[string]string{"bgp": "enabled"}),
+				newBGPPolicy("policy-c", creationTime, map[string]string{"bgp": "disabled"}),
+			},
+			outputType: "table",
+			expectedOutput: `Node node-1 is selected by 2 BGPPolicies, the oldest one is effective
+NAME     CREATION-TIMESTAMP   EFFECTIVE
+policy-a 2024-09-01T00:00:00Z Yes      
+policy-b 2024-09-01T00:01:00Z No       
+`,
+		},
+		{
+			name: "BGPPolicies with the same creation timestamp",
+			policies: []runtime.Object{
+				newBGPPolicy("policy-b", creationTime, map[string]string{"bgp": "enabled"}),
+				newBGPPolicy("policy-a", creationTime, nil),
+			},
+			outputType: "table",
+			expectedOutput: `Node node-1 is selected by 2 BGPPolicies, the oldest one is effective
+Warning: BGPPolicies policy-a and policy-b have the same creation timestamp, either of them may be effective
+NAME     CREATION-TIMESTAMP   EFFECTIVE
+policy-a 2024-09-01T00:00:00Z Yes      
+policy-b 2024-09-01T00:00:00Z No       
+`,
+		},
+		{
+			name: "json output",
+			policies: []runtime.Object{
+				newBGPPolicy("policy-a", creationTime, map[string]string{"bgp": "enabled"}),
+			},
+			outputType: "json",
+			expectedOutput: `{
+  "node": "node-1",
+  "bgpPolicies": [
+    {
+      "name": "policy-a",
+      "creationTimestamp": "2024-09-01T00:00:00Z",
+      "effective": true
+    }
+  ]
+}
+`,
+		},
+		{
+			name:        "unsupported output type",
+			outputType:  "wide",
+			expectedErr: `unsupported output type "wide", must be one of table, json or yaml`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sClient := k8sfake.NewSimpleClientset(node1)
+			antreaClient := antreafakeclient.NewSimpleClientset(tt.policies...)
+			getClients = func(cmd *cobra.Command) (kubernetes.Interface, antrea.Interface, error) {
+				return k8sClient, antreaClient, nil
+			}
+			defer func() {
+				getClients = getK8sClients
+			}()
+			explainOption.outputType = tt.outputType
+
+			buf := new(bytes.Buffer)
+			ExplainCmd.SetContext(context.Background())
+			ExplainCmd.SetOut(buf)
+			ExplainCmd.SetErr(buf)
+			err := explainRunE(ExplainCmd, []string{"node-1"})
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedOutput, buf.String())
+		})
+	}
+}
@@ -0,0 +1,621 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packetcapture
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gopacket/gopacket"
+	"github.com/gopacket/gopacket/pcapgo"
+	"github.com/spf13/cobra"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	"antrea.io/antrea/pkg/antctl/raw"
+	crdv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
+	antrea "antrea.io/antrea/pkg/client/clientset/versioned"
+	"antrea.io/antrea/pkg/util/pcapng"
+)
+
+const (
+	defaultTimeout = time.Second * 60
+	// maxNumberTimeout is the maximum timeout of a capture which is not bounded by time.
+	maxNumberTimeout = time.Minute * 5
+	defaultNumber    = 100
+	// completionGracePeriod is how long to wait for the status of the PacketCapture after its timeout.
+	completionGracePeriod = time.Second * 10
+	// stdoutFile is the output file meaning that the packets are written to stdout.
+	stdoutFile = "-"
+)
+
+var (
+	Command *cobra.Command
+	option  = &struct {
+		source      string
+		destination string
+		flow        string
+		number      int32
+		duration    time.Duration
+		timeout     time.Duration
+		outputFile  string
+		follow      bool
+		nowait      bool
+		insecure    bool
+	}{}
+	getClients       = getK8sClient
+	getPacketsReader = requestPackets
+)
+
+var protocols = map[string]string{
+	"icmp": "ICMP",
+	"tcp":  "TCP",
+	"udp":  "UDP",
+}
+
+func init() {
+	Command = &cobra.Command{
+		Use:     "packetcapture",
+		Short:   "Start a PacketCapture",
+		Long:    "Start a PacketCapture between a Pod and another Pod or IP, wait for it to complete and download the captured packets.",
+		Aliases: []string{"pc", "packetcaptures"},
+		Example: `  Capture the first 100 packets from pod1 to pod2, both Pods are in Namespace default, and save them to a file
+  $antctl packetcapture -S pod1 -D pod2
+  Capture the first 10 TCP packets from pod1 in Namespace ns1 to a destination IP and port, and save them to pc.pcapng
+  $antctl packetcapture -S ns1/pod1 -D 123.123.123.123 -f tcp,tcp_dst=80 -n 10 -w pc.pcapng
+  Capture the UDP packets from pod1 to pod2 for 5 minutes
+  $antctl packetcapture -S pod1 -D pod2 -f udp --duration 5m
+  Stream the packets from pod1 to pod2 to Wireshark while they are captured, for 5 minutes
+  $antctl packetcapture -S pod1 -D pod2 --duration 5m --follow -w - | wireshark -k -i -
+`,
+		RunE: runE,
+		Args: cobra.NoArgs,
+	}
+
+	Command.Flags().StringVarP(&option.source, "source", "S", "", "source of the PacketCapture: Namespace/Pod, Pod, or IP")
+	Command.Flags().StringVarP(&option.destination, "destination", "D", "", "destination of the PacketCapture: Namespace/Pod, Pod, or IP")
+	Command.Flags().StringVarP(&option.flow, "flow", "f", "", "specify the flow (packet headers) of the captured packets, including tcp, udp, icmp, tcp_src, tcp_dst, udp_src, udp_dst")
+	Command.Flags().Int32VarP(&option.number, "number", "n", 0, fmt.Sprintf("number of packets to capture (default %d), mutually exclusive with --duration", defaultNumber))
+	Command.Flags().DurationVarP(&option.duration, "duration", "", 0, "capture all the packets during the given duration, instead of a number of packets")
+	Command.Flags().StringVarP(&option.outputFile, "write", "w", "", "file to write the captured packets to, or - for stdout (default <PacketCapture name>.pcapng)")
+	Command.Flags().BoolVarP(&option.follow, "follow", "F", false, "if set, stream the packets as they are captured instead of downloading them when the capture is complete")
+	Command.Flags().BoolVarP(&option.nowait, "nowait", "", false, "if set, command returns without retrieving results")
+	Command.Flags().BoolVarP(&option.insecure, "insecure", "", false, "Skip TLS verification when connecting to Antrea Agent")
+}
+
+func getK8sClient(cmd *cobra.Command) (kubernetes.Interface, antrea.Interface, error) {
+	kubeconfig, err := raw.ResolveKubeconfig(cmd)
+	if err != nil {
+		return nil, nil, err
+	}
+	k8sClientset, client, err := raw.SetupClients(kubeconfig)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create clientset: %w", err)
+	}
+	return k8sClientset, client, nil
+}
+
+// requestPackets requests the packets captured for a PacketCapture from the antrea-agent running on
+// the given Node. If follow is true, the packets are streamed as they are captured.
+func requestPackets(ctx context.Context, cmd *cobra.Command, k8sClient kubernetes.Interface, antreaClient antrea.Interface, nodeName, name string, follow bool) (io.ReadCloser, error) {
+	kubeconfig, err := raw.ResolveKubeconfig(cmd)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := raw.CreateAgentClientCfg(ctx, k8sClient, antreaClient, kubeconfig, nodeName, option.insecure)
+	if err != nil {
+		return nil, fmt.Errorf("error when creating client config for the antrea-agent on Node %s: %w", nodeName, err)
+	}
+	client, err := rest.UnversionedRESTClientFor(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create rest client: %w", err)
+	}
+	request := client.Get().AbsPath("/packetcaptures").Param("name", name)
+	if follow {
+		request = request.Param("follow", "")
+	}
+	return request.Stream(ctx)
+}
+
+func runE(cmd *cobra.Command, _ []string) error {
+	option.timeout, _ = cmd.Flags().GetDuration("timeout")
+	if option.timeout > time.Hour*24 {
+		return errors.New("timeout cannot be longer than 24 hours")
+	}
+	if option.timeout == 0 {
+		option.timeout = defaultTimeout
+		if option.duration > 0 {
+			option.timeout = option.duration + completionGracePeriod
+		}
+	}
+	if option.source == "" || option.destination == "" {
+		return errors.New("please provide source and destination")
+	}
+	if option.number != 0 && option.duration != 0 {
+		return errors.New("--number and --duration are mutually exclusive")
+	}
+	if option.duration != 0 && option.duration >= option.timeout {
+		return errors.New("duration must be less than timeout")
+	}
+	if option.duration == 0 && option.timeout > maxNumberTimeout {
+		return errors.New("timeout cannot be longer than 5 minutes when capturing a number of packets")
+	}
+	if option.nowait && option.follow {
+		return errors.New("--nowait and --follow are mutually exclusive")
+	}
+
+	k8sClient, client, err := getClients(cmd)
+	if err != nil {
+		return err
+	}
+	pc, err := newPacketCapture()
+	if err != nil {
+		return fmt.Errorf("error when filling up PacketCapture config: %w", err)
+	}
+
+	createCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, err := client.CrdV1alpha1().PacketCaptures().Create(createCtx, pc, metav1.CreateOptions{}); err != nil {
+		return fmt.Errorf("error when creating PacketCapture, is PacketCapture feature gate enabled? %w", err)
+	}
+	// Messages are written to stderr when the packets are written to stdout.
+	messageWriter := cmd.OutOrStdout()
+	if option.outputFile == stdoutFile {
+		messageWriter = cmd.ErrOrStderr()
+	}
+	if option.nowait {
+		fmt.Fprintf(messageWriter, "PacketCapture %s created\n", pc.Name)
+		return nil
+	}
+	// The packets captured locally by the antrea-agents are deleted along with the PacketCapture.
+	defer func() {
+		if err := client.CrdV1alpha1().PacketCaptures().Delete(context.TODO(), pc.Name, metav1.DeleteOptions{}); err != nil {
+			klog.ErrorS(err, "Error when deleting PacketCapture", "name", pc.Name)
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), option.timeout+completionGracePeriod)
+	defer cancel()
+	if option.follow {
+		return followPackets(ctx, cmd, k8sClient, client, pc, messageWriter)
+	}
+
+	var res *crdv1alpha1.PacketCapture
+	err = wait.PollUntilContextCancel(ctx, 1*time.Second, false, func(ctx context.Context) (bool, error) {
+		res, err = client.CrdV1alpha1().PacketCaptures().Get(ctx, pc.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		return isCaptureComplete(res), nil
+	})
+	if wait.Interrupted(err) {
+		return errors.New("timeout waiting for PacketCapture done")
+	} else if err != nil {
+		return fmt.Errorf("error when retrieving PacketCapture: %w", err)
+	}
+	return downloadPackets(ctx, cmd, k8sClient, client, res, messageWriter)
+}
+
+func isCaptureComplete(pc *crdv1alpha1.PacketCapture) bool {
+	for _, condition := range pc.Status.Conditions {
+		if condition.Type == crdv1alpha1.PacketCaptureComplete {
+			return condition.Status == metav1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func getCompleteMessage(pc *crdv1alpha1.PacketCapture) string {
+	for _, condition := range pc.Status.Conditions {
+		if condition.Type == crdv1alpha1.PacketCaptureComplete {
+			if condition.Message == "" {
+				return condition.Reason
+			}
+			return fmt.Sprintf("%s: %s", condition.Reason, condition.Message)
+		}
+	}
+	return ""
+}
+
+// outputFilePath returns the path of the file to write the captured packets to.
+func outputFilePath(pc *crdv1alpha1.PacketCapture) string {
+	if option.outputFile == "" {
+		return pc.Name + ".pcapng"
+	}
+	return option.outputFile
+}
+
+// writeOutput calls write with the file at path, or with stdout if path is stdoutFile.
+func writeOutput(path string, stdout io.Writer, write func(w io.Writer) error) error {
+	if path == stdoutFile {
+		return write(stdout)
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return write(file)
+}
+
+func writePackets(path string, stdout io.Writer, packets io.Reader) error {
+	return writeOutput(path, stdout, func(w io.Writer) error {
+		_, err := io.Copy(w, packets)
+		return err
+	})
+}
+
+// downloadPackets downloads the packets captured on each Node from the antrea-agents. When packets
+// are captured on multiple Nodes, the files of all Nodes are merged into a single file, in which
+// packets are ordered by their timestamps, like the antrea-agents do when a file server is used.
+func downloadPackets(ctx context.Context, cmd *cobra.Command, k8sClient kubernetes.Interface, client antrea.Interface, pc *crdv1alpha1.PacketCapture, messageWriter io.Writer) error {
+	var results []crdv1alpha1.PacketCaptureNodeResult
+	for _, result := range pc.Status.NodeResults {
+		if result.NumberCaptured > 0 {
+			results = append(results, result)
+		}
+	}
+	if len(results) == 0 {
+		return fmt.Errorf("no packets captured by PacketCapture %s (%s)", pc.Name, getCompleteMessage(pc))
+	}
+	path := outputFilePath(pc)
+	if len(results) == 1 {
+		result := results[0]
+		packets, err := getPacketsReader(ctx, cmd, k8sClient, client, result.NodeName, pc.Name, false)
+		if err != nil {
+			return fmt.Errorf("error when downloading the packets captured on Node %s: %w", result.NodeName, err)
+		}
+		defer packets.Close()
+		if err := writePackets(path, cmd.OutOrStdout(), packets); err != nil {
+			return fmt.Errorf("error when downloading the packets captured on Node %s: %w", result.NodeName, err)
+		}
+		if path != stdoutFile {
+			fmt.Fprintf(messageWriter, "%d packets captured on Node %s saved to %s\n", result.NumberCaptured, result.NodeName, path)
+		}
+		return nil
+	}
+
+	// The files are downloaded first as they are read concurrently when merging packets.
+	var files []pcapng.File
+	var nodeNames []string
+	var numberCaptured int32
+	for _, result := range results {
+		file, err := os.CreateTemp("", pc.Name+"-*.pcapng")
+		if err != nil {
+			return fmt.Errorf("failed to create the file for downloaded packets: %w", err)
+		}
+		defer os.Remove(file.Name())
+		defer file.Close()
+		if err := func() error {
+			packets, err := getPacketsReader(ctx, cmd, k8sClient, client, result.NodeName, pc.Name, false)
+			if err != nil {
+				return err
+			}
+			defer packets.Close()
+			if _, err := io.Copy(file, packets); err != nil {
+				return err
+			}
+			_, err = file.Seek(0, io.SeekStart)
+			return err
+		}(); err != nil {
+			return fmt.Errorf("error when downloading the packets captured on Node %s: %w", result.NodeName, err)
+		}
+		files = append(files, pcapng.File{NodeName: result.NodeName, Reader: file})
+		nodeNames = append(nodeNames, result.NodeName)
+		numberCaptured += result.NumberCaptured
+	}
+	if err := writeOutput(path, cmd.OutOrStdout(), func(w io.Writer) error {
+		// The snap length is only used when there is no packet, which is not the case here.
+		return pcapng.MergeFiles(w, files, 0)
+	}); err != nil {
+		return fmt.Errorf("error when merging the packets captured on Nodes %s: %w", strings.Join(nodeNames, ", "), err)
+	}
+	if path != stdoutFile {
+		fmt.Fprintf(messageWriter, "%d packets captured on Nodes %s saved to %s\n", numberCaptured, strings.Join(nodeNames, ", "), path)
+	}
+	return nil
+}
+
+// getCaptureNodes returns the Nodes on which the packets to stream are captured, which are the Nodes
+// of the source Pod and of the destination Pod.
+func getCaptureNodes(ctx context.Context, k8sClient kubernetes.Interface, pc *crdv1alpha1.PacketCapture) ([]string, error) {
+	nodeNames := sets.New[string]()
+	for _, podRef := range []*crdv1alpha1.PodReference{pc.Spec.Source.Pod, pc.Spec.Destination.Pod} {
+		if podRef == nil {
+			continue
+		}
+		pod, err := k8sClient.CoreV1().Pods(podRef.Namespace).Get(ctx, podRef.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get Pod %s/%s: %w", podRef.Namespace, podRef.Name, err)
+		}
+		if pod.Spec.NodeName == "" {
+			return nil, fmt.Errorf("the Pod %s/%s is not scheduled to a Node", podRef.Namespace, podRef.Name)
+		}
+		nodeNames.Insert(pod.Spec.NodeName)
+	}
+	return sets.List(nodeNames), nil
+}
+
+// streamPackets requests the packets captured on a Node to be streamed, waiting for the capture to
+// start on the Node.
+func streamPackets(ctx context.Context, cmd *cobra.Command, k8sClient kubernetes.Interface, client antrea.Interface, pc *crdv1alpha1.PacketCapture, nodeName string) (io.ReadCloser, error) {
+	var packets io.ReadCloser
+	// The antrea-agent may not have started the capture yet.
+	err := wait.PollUntilContextCancel(ctx, 500*time.Millisecond, true, func(ctx context.Context) (bool, error) {
+		var err error
+		packets, err = getPacketsReader(ctx, cmd, k8sClient, client, nodeName, pc.Name, true)
+		if err == nil {
+			return true, nil
+		}
+		if k8serrors.IsNotFound(err) || k8serrors.IsConflict(err) {
+			klog.V(2).InfoS("Waiting for the PacketCapture to start", "name", pc.Name, "node", nodeName, "err", err)
+			return false, nil
+		}
+		return false, err
+	})
+	if wait.Interrupted(err) {
+		return nil, errors.New("timeout waiting for PacketCapture to start")
+	} else if err != nil {
+		return nil, fmt.Errorf("error when streaming the packets captured on Node %s: %w", nodeName, err)
+	}
+	return packets, nil
+}
+
+// streamedPacket is a packet streamed from an antrea-agent, with the interface it was captured on.
+type streamedPacket struct {
+	nodeName    string
+	ngInterface pcapgo.NgInterface
+	ci          gopacket.CaptureInfo
+	data        []byte
+}
+
+// readStreamedPackets reads the packets streamed from the antrea-agent on a Node and sends them to
+// packetsCh, until the capture stops on the Node.
+func readStreamedPackets(ctx context.Context, nodeName string, packets io.Reader, packetsCh chan<- streamedPacket) error {
+	reader, err := pcapgo.NewNgReader(packets, pcapgo.DefaultNgReaderOptions)
+	if err != nil {
+		return fmt.Errorf("failed to read packets captured on Node %s: %w", nodeName, err)
+	}
+	for {
+		data, ci, err := reader.ReadPacketData()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read packets captured on Node %s: %w", nodeName, err)
+		}
+		ngInterface, err := reader.Interface(ci.InterfaceIndex)
+		if err != nil {
+			return fmt.Errorf("invalid interface for packets captured on Node %s: %w", nodeName, err)
+		}
+		select {
+		case packetsCh <- streamedPacket{nodeName: nodeName, ngInterface: ngInterface, ci: ci, data: data}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// followPackets streams the packets captured on the Nodes of the source Pod and of the destination
+// Pod as soon as they are captured, until the capture stops. When the packets are captured on
+// multiple Nodes, they are written to a single pcapng stream in the order they are received.
+func followPackets(ctx context.Context, cmd *cobra.Command, k8sClient kubernetes.Interface, client antrea.Interface, pc *crdv1alpha1.PacketCapture, messageWriter io.Writer) error {
+	nodeNames, err := getCaptureNodes(ctx, k8sClient, pc)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	streams := make(map[string]io.ReadCloser, len(nodeNames))
+	for _, nodeName := range nodeNames {
+		packets, err := streamPackets(ctx, cmd, k8sClient, client, pc, nodeName)
+		if err != nil {
+			return err
+		}
+		defer packets.Close()
+		streams[nodeName] = packets
+	}
+	nodes := strings.Join(nodeNames, ", ")
+	if len(nodeNames) == 1 {
+		fmt.Fprintf(messageWriter, "Streaming the packets captured on Node %s\n", nodes)
+	} else {
+		fmt.Fprintf(messageWriter, "Streaming the packets captured on Nodes %s\n", nodes)
+	}
+	path := outputFilePath(pc)
+	if err := writeOutput(path, cmd.OutOrStdout(), func(w io.Writer) error {
+		if len(nodeNames) == 1 {
+			_, err := io.Copy(w, streams[nodeNames[0]])
+			return err
+		}
+		packetsCh := make(chan streamedPacket)
+		errCh := make(chan error, len(streams))
+		for nodeName, packets := range streams {
+			go func() {
+				errCh <- readStreamedPackets(ctx, nodeName, packets, packetsCh)
+			}()
+		}
+		// The snap length is only used when there is no packet, in which case it doesn't matter.
+		writer := pcapng.NewWriter(w, 0)
+		for running := len(streams); running > 0; {
+			select {
+			case packet := <-packetsCh:
+				if err := writer.WritePacket(packet.nodeName, packet.ngInterface, packet.ci, packet.data); err != nil {
+					return err
+				}
+				// Flush every packet so that the packets are received as soon as they are captured.
+				if err := writer.Flush(); err != nil {
+					return err
+				}
+			case err := <-errCh:
+				if err != nil {
+					return err
+				}
+				running--
+			}
+		}
+		return writer.Flush()
+	}); err != nil {
+		return fmt.Errorf("error when streaming the packets captured on %s: %w", nodes, err)
+	}
+	if path != stdoutFile {
+		fmt.Fprintf(messageWriter, "Packets captured on %s saved to %s\n", nodes, path)
+	}
+	return nil
+}
+
+// parseEndpoint parses a source or destination, which can be a Pod (Namespace/Pod or Pod) or an IP.
+func parseEndpoint(endpoint string) (*crdv1alpha1.PodReference, *string, string, error) {
+	if ip := net.ParseIP(endpoint); ip != nil {
+		return nil, ptr.To(ip.String()), ip.String(), nil
+	}
+	split := strings.Split(endpoint, "/")
+	if len(split) == 1 {
+		return &crdv1alpha1.PodReference{Namespace: "default", Name: split[0]}, nil, split[0], nil
+	} else if len(split) == 2 && len(split[0]) != 0 && len(split[1]) != 0 {
+		return &crdv1alpha1.PodReference{Namespace: split[0], Name: split[1]}, nil, fmt.Sprintf("%s-%s", split[0], split[1]), nil
+	}
+	return nil, nil, "", fmt.Errorf("%s should be in the format of Namespace/Pod or Pod, or an IP address", endpoint)
+}
+
+func newPacketCapture() (*crdv1alpha1.PacketCapture, error) {
+	srcPod, srcIP, srcName, err := parseEndpoint(option.source)
+	if err != nil {
+		return nil, fmt.Errorf("invalid source: %w", err)
+	}
+	dstPod, dstIP, dstName, err := parseEndpoint(option.destination)
+	if err != nil {
+		return nil, fmt.Errorf("invalid destination: %w", err)
+	}
+	if srcPod == nil && dstPod == nil {
+		return nil, errors.New("one of source and destination must be a Pod")
+	}
+
+	pkt, err := parseFlow()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse flow: %w", err)
+	}
+
+	var captureConfig crdv1alpha1.CaptureConfig
+	if option.duration > 0 {
+		captureConfig.Duration = &crdv1alpha1.PacketCaptureDurationConfig{Seconds: int32(option.duration.Seconds())}
+	} else {
+		number := option.number
+		if number == 0 {
+			number = defaultNumber
+		}
+		captureConfig.FirstN = &crdv1alpha1.PacketCaptureFirstNConfig{Number: number}
+	}
+
+	name := getPCName(fmt.Sprintf("%s-to-%s", srcName, dstName))
+	pc := &crdv1alpha1.PacketCapture{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: crdv1alpha1.PacketCaptureSpec{
+			Timeout:       ptr.To(int32(option.timeout.Seconds())),
+			CaptureConfig: captureConfig,
+			Source:        crdv1alpha1.Source{Pod: srcPod, IP: srcIP},
+			Destination:   crdv1alpha1.Destination{Pod: dstPod, IP: dstIP},
+			Packet:        pkt,
+		},
+	}
+	return pc, nil
+}
+
+func parseFlow() (*crdv1alpha1.Packet, error) {
+	cleanFlow := strings.ReplaceAll(option.flow, " ", "")
+	fields, err := getPortFields(cleanFlow)
+	if err != nil {
+		return nil, fmt.Errorf("error when parsing the flow: %w", err)
+	}
+
+	var pkt crdv1alpha1.Packet
+	for k, v := range protocols {
+		if _, ok := fields[k]; ok {
+			protocol := intstr.FromString(v)
+			pkt.Protocol = &protocol
+			break
+		}
+	}
+
+	if r, ok := fields["tcp_src"]; ok {
+		pkt.TransportHeader.TCP = new(crdv1alpha1.TCPHeader)
+		pkt.TransportHeader.TCP.SrcPort = ptr.To(int32(r))
+	}
+	if r, ok := fields["tcp_dst"]; ok {
+		if pkt.TransportHeader.TCP == nil {
+			pkt.TransportHeader.TCP = new(crdv1alpha1.TCPHeader)
+		}
+		pkt.TransportHeader.TCP.DstPort = ptr.To(int32(r))
+	}
+	if r, ok := fields["udp_src"]; ok {
+		pkt.TransportHeader.UDP = new(crdv1alpha1.UDPHeader)
+		pkt.TransportHeader.UDP.SrcPort = ptr.To(int32(r))
+	}
+	if r, ok := fields["udp_dst"]; ok {
+		if pkt.TransportHeader.UDP == nil {
+			pkt.TransportHeader.UDP = new(crdv1alpha1.UDPHeader)
+		}
+		pkt.TransportHeader.UDP.DstPort = ptr.To(int32(r))
+	}
+
+	return &pkt, nil
+}
+
+func getPortFields(cleanFlow string) (map[string]int, error) {
+	fields := map[string]int{}
+	for _, v := range strings.Split(cleanFlow, ",") {
+		kv := strings.Split(v, "=")
+		if len(kv) == 2 && len(kv[0]) != 0 && len(kv[1]) != 0 {
+			r, err := strconv.Atoi(kv[1])
+			if err != nil {
+				return nil, err
+			}
+			fields[kv[0]] = r
+		} else if len(kv) == 1 {
+			if len(kv[0]) != 0 {
+				fields[v] = 0
+			}
+		} else {
+			return nil, fmt.Errorf("%s is not valid in flow", v)
+		}
+	}
+	return fields, nil
+}
+
+func getPCName(prefix string) string {
+	// prefix may contain IPv6 address. Replace "::"  and ":" to make it a valid RFC 1123 subdomain.
+	prefix = strings.ReplaceAll(prefix, "::", "-")
+	prefix = strings.ReplaceAll(prefix, ":", "-")
+	if option.nowait {
+		return prefix
+	}
+	return fmt.Sprintf("%s-%s", prefix, rand.String(8))
+}
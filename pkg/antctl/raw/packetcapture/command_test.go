@@ -0,0 +1,446 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packetcapture
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gopacket/gopacket"
+	"github.com/gopacket/gopacket/layers"
+	"github.com/gopacket/gopacket/pcapgo"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
+
+	crdv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
+	antrea "antrea.io/antrea/pkg/client/clientset/versioned"
+	antreafakeclient "antrea.io/antrea/pkg/client/clientset/versioned/fake"
+)
+
+const (
+	srcPod = "default/pod-1"
+	dstPod = "default/pod-2"
+	ipv4   = "192.168.10.10"
+)
+
+var (
+	pod1 = v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pod-1",
+			Namespace: "default",
+		},
+		Spec: v1.PodSpec{
+			NodeName: "node-1",
+		},
+	}
+	pod2 = v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pod-2",
+			Namespace: "default",
+		},
+		Spec: v1.PodSpec{
+			NodeName: "node-2",
+		},
+	}
+	k8sClient = k8sfake.NewSimpleClientset(&pod1, &pod2)
+)
+
+type optionValues struct {
+	src      string
+	dst      string
+	flow     string
+	number   int32
+	duration time.Duration
+	output   string
+	follow   bool
+	nowait   bool
+}
+
+func setOption(t *testing.T, values optionValues) {
+	oldOption := *option
+	option.source = values.src
+	option.destination = values.dst
+	option.flow = values.flow
+	option.number = values.number
+	option.duration = values.duration
+	option.outputFile = values.output
+	option.follow = values.follow
+	option.nowait = values.nowait
+	t.Cleanup(func() {
+		*option = oldOption
+	})
+}
+
+func TestParseFlow(t *testing.T) {
+	tcpProto := intstr.FromString("TCP")
+	udpProto := intstr.FromString("UDP")
+	tcs := []struct {
+		flow        string
+		expected    *crdv1alpha1.Packet
+		expectedErr string
+	}{
+		{
+			flow: "udp,udp_src=1234,udp_dst=4321",
+			expected: &crdv1alpha1.Packet{
+				Protocol: &udpProto,
+				TransportHeader: crdv1alpha1.TransportHeader{
+					UDP: &crdv1alpha1.UDPHeader{
+						SrcPort: ptr.To[int32](1234),
+						DstPort: ptr.To[int32](4321),
+					},
+				},
+			},
+		},
+		{
+			flow: " tcp, tcp_dst=80",
+			expected: &crdv1alpha1.Packet{
+				Protocol: &tcpProto,
+				TransportHeader: crdv1alpha1.TransportHeader{
+					TCP: &crdv1alpha1.TCPHeader{
+						DstPort: ptr.To[int32](80),
+					},
+				},
+			},
+		},
+		{
+			flow:     "",
+			expected: &crdv1alpha1.Packet{},
+		},
+		{
+			flow:        "tcp,tcp_dst=http",
+			expectedErr: "error when parsing the flow",
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.flow, func(t *testing.T) {
+			setOption(t, optionValues{flow: tc.flow})
+			pkt, err := parseFlow()
+			if tc.expectedErr != "" {
+				assert.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, pkt)
+		})
+	}
+}
+
+func TestNewPacketCapture(t *testing.T) {
+	tcs := []struct {
+		name         string
+		options      optionValues
+		expectedSpec crdv1alpha1.PacketCaptureSpec
+		expectedName string
+		expectedErr  string
+	}{
+		{
+			name:    "pod to pod",
+			options: optionValues{src: "pod-1", dst: dstPod, nowait: true},
+			expectedSpec: crdv1alpha1.PacketCaptureSpec{
+				Timeout:       ptr.To[int32](60),
+				CaptureConfig: crdv1alpha1.CaptureConfig{FirstN: &crdv1alpha1.PacketCaptureFirstNConfig{Number: defaultNumber}},
+				Source:        crdv1alpha1.Source{Pod: &crdv1alpha1.PodReference{Namespace: "default", Name: "pod-1"}},
+				Destination:   crdv1alpha1.Destination{Pod: &crdv1alpha1.PodReference{Namespace: "default", Name: "pod-2"}},
+				Packet:        &crdv1alpha1.Packet{},
+			},
+			expectedName: "pod-1-to-default-pod-2",
+		},
+		{
+			name:    "ip to pod with duration",
+			options: optionValues{src: ipv4, dst: "pod-2", duration: 30 * time.Second, nowait: true},
+			expectedSpec: crdv1alpha1.PacketCaptureSpec{
+				Timeout:       ptr.To[int32](60),
+				CaptureConfig: crdv1alpha1.CaptureConfig{Duration: &crdv1alpha1.PacketCaptureDurationConfig{Seconds: 30}},
+				Source:        crdv1alpha1.Source{IP: ptr.To(ipv4)},
+				Destination:   crdv1alpha1.Destination{Pod: &crdv1alpha1.PodReference{Namespace: "default", Name: "pod-2"}},
+				Packet:        &crdv1alpha1.Packet{},
+			},
+			expectedName: "192.168.10.10-to-pod-2",
+		},
+		{
+			name:        "no pod",
+			options:     optionValues{src: ipv4, dst: "10.0.0.1"},
+			expectedErr: "one of source and destination must be a Pod",
+		},
+		{
+			name:        "invalid source",
+			options:     optionValues{src: "a/b/c", dst: dstPod},
+			expectedErr: "invalid source",
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			setOption(t, tc.options)
+			option.timeout = defaultTimeout
+			pc, err := newPacketCapture()
+			if tc.expectedErr != "" {
+				assert.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedName, pc.Name)
+			assert.Equal(t, tc.expectedSpec, pc.Spec)
+		})
+	}
+}
+
+func TestOutputFilePath(t *testing.T) {
+	pc := &crdv1alpha1.PacketCapture{ObjectMeta: metav1.ObjectMeta{Name: "pc"}}
+	tcs := []struct {
+		outputFile string
+		expected   string
+	}{
+		{outputFile: "", expected: "pc.pcapng"},
+		{outputFile: "/tmp/capture.pcapng", expected: "/tmp/capture.pcapng"},
+		{outputFile: stdoutFile, expected: stdoutFile},
+	}
+	for _, tc := range tcs {
+		setOption(t, optionValues{output: tc.outputFile})
+		assert.Equal(t, tc.expected, outputFilePath(pc))
+	}
+}
+
+// testPacketFile generates a pcapng file with a single interface and a packet per timestamp, the
+// data of each packet being its timestamp in milliseconds.
+func testPacketFile(t *testing.T, timestamps ...int64) []byte {
+	var buffer bytes.Buffer
+	ngInterface := pcapgo.DefaultNgInterface
+	ngInterface.LinkType = layers.LinkTypeEthernet
+	writer, err := pcapgo.NewNgWriterInterface(&buffer, ngInterface, pcapgo.DefaultNgWriterOptions)
+	require.NoError(t, err)
+	for _, ts := range timestamps {
+		data := []byte{byte(ts)}
+		require.NoError(t, writer.WritePacket(gopacket.CaptureInfo{Timestamp: time.UnixMilli(ts), CaptureLength: 1, Length: 1}, data))
+	}
+	require.NoError(t, writer.Flush())
+	return buffer.Bytes()
+}
+
+// readTestPacketFile returns the data of the packets and the Nodes they were captured on.
+func readTestPacketFile(t *testing.T, data []byte) ([]byte, []string) {
+	reader, err := pcapgo.NewNgReader(bytes.NewReader(data), pcapgo.DefaultNgReaderOptions)
+	require.NoError(t, err)
+	var packets []byte
+	var nodeNames []string
+	for {
+		packetData, ci, err := reader.ReadPacketData()
+		if err == io.EOF {
+			return packets, nodeNames
+		}
+		require.NoError(t, err)
+		packets = append(packets, packetData...)
+		ngInterface, err := reader.Interface(ci.InterfaceIndex)
+		require.NoError(t, err)
+		nodeNames = append(nodeNames, ngInterface.Comment)
+	}
+}
+
+func TestRunE(t *testing.T) {
+	tempDir := t.TempDir()
+	completeStatus := func(nodeResults ...crdv1alpha1.PacketCaptureNodeResult) crdv1alpha1.PacketCaptureStatus {
+		return crdv1alpha1.PacketCaptureStatus{
+			Conditions: []crdv1alpha1.PacketCaptureCondition{
+				{Type: crdv1alpha1.PacketCaptureStarted, Status: metav1.ConditionTrue, Reason: "Started"},
+				{Type: crdv1alpha1.PacketCaptureComplete, Status: metav1.ConditionTrue, Reason: "Timeout"},
+			},
+			NodeResults: nodeResults,
+		}
+	}
+	tcs := []struct {
+		name           string
+		options        optionValues
+		status         crdv1alpha1.PacketCaptureStatus
+		notStarted     int
+		expectedOutput string
+		expectedFiles  map[string]string
+		// packetFiles are the pcapng files downloaded or streamed from each Node, instead of the default content.
+		packetFiles map[string][]byte
+		// expectedPcapng is the merged pcapng file expected to be written, with the data and the Node of each packet.
+		expectedPcapng  string
+		expectedPackets []byte
+		expectedNodes   []string
+		// unordered is set when the packets of different Nodes can be written in any order.
+		unordered      bool
+		expectedStdout string
+		expectedErr    string
+		expectedCRs    int
+	}{
+		{
+			name:        "no destination",
+			options:     optionValues{src: srcPod},
+			expectedErr: "please provide source and destination",
+		},
+		{
+			name:        "number and duration",
+			options:     optionValues{src: srcPod, dst: dstPod, number: 10, duration: time.Second},
+			expectedErr: "--number and --duration are mutually exclusive",
+		},
+		{
+			name:           "download from a single Node",
+			options:        optionValues{src: srcPod, dst: ipv4, output: filepath.Join(tempDir, "single.pcapng")},
+			status:         completeStatus(crdv1alpha1.PacketCaptureNodeResult{NodeName: "node-1", NumberCaptured: 10}),
+			expectedOutput: fmt.Sprintf("10 packets captured on Node node-1 saved to %s", filepath.Join(tempDir, "single.pcapng")),
+			expectedFiles:  map[string]string{"single.pcapng": "packets-node-1"},
+		},
+		{
+			name:    "download from multiple Nodes",
+			options: optionValues{src: srcPod, dst: dstPod, output: filepath.Join(tempDir, "multiple.pcapng")},
+			status: completeStatus(
+				crdv1alpha1.PacketCaptureNodeResult{NodeName: "node-1", NumberCaptured: 2},
+				crdv1alpha1.PacketCaptureNodeResult{NodeName: "node-2", NumberCaptured: 1},
+				crdv1alpha1.PacketCaptureNodeResult{NodeName: "node-3"},
+			),
+			packetFiles: map[string][]byte{
+				"node-1": testPacketFile(t, 1, 3),
+				"node-2": testPacketFile(t, 2),
+			},
+			expectedOutput:  fmt.Sprintf("3 packets captured on Nodes node-1, node-2 saved to %s", filepath.Join(tempDir, "multiple.pcapng")),
+			expectedPcapng:  "multiple.pcapng",
+			expectedPackets: []byte{1, 2, 3},
+			expectedNodes:   []string{"node-1", "node-2", "node-1"},
+		},
+		{
+			name:           "download to stdout",
+			options:        optionValues{src: srcPod, dst: ipv4, output: stdoutFile},
+			status:         completeStatus(crdv1alpha1.PacketCaptureNodeResult{NodeName: "node-1", NumberCaptured: 10}),
+			expectedStdout: "packets-node-1",
+		},
+		{
+			name:        "no packets captured",
+			options:     optionValues{src: srcPod, dst: ipv4},
+			status:      completeStatus(crdv1alpha1.PacketCaptureNodeResult{NodeName: "node-1"}),
+			expectedErr: "no packets captured by PacketCapture",
+		},
+		{
+			name:           "follow to stdout",
+			options:        optionValues{src: ipv4, dst: dstPod, output: stdoutFile, follow: true},
+			notStarted:     2,
+			expectedOutput: "Streaming the packets captured on Node node-2",
+			expectedStdout: "streamed-packets-node-2",
+		},
+		{
+			name:    "follow from multiple Nodes",
+			options: optionValues{src: srcPod, dst: dstPod, output: filepath.Join(tempDir, "follow.pcapng"), follow: true},
+			packetFiles: map[string][]byte{
+				"node-1": testPacketFile(t, 1, 3),
+				"node-2": testPacketFile(t, 2),
+			},
+			expectedOutput:  "Streaming the packets captured on Nodes node-1, node-2",
+			expectedPcapng:  "follow.pcapng",
+			expectedPackets: []byte{1, 2, 3},
+			expectedNodes:   []string{"node-1", "node-2", "node-1"},
+			unordered:       true,
+		},
+		{
+			name:           "nowait",
+			options:        optionValues{src: srcPod, dst: dstPod, nowait: true},
+			expectedOutput: "PacketCapture default-pod-1-to-default-pod-2 created",
+			expectedCRs:    1,
+		},
+	}
+
+	for _, tt := range tcs {
+		t.Run(tt.name, func(t *testing.T) {
+			setOption(t, tt.options)
+
+			client := antreafakeclient.NewSimpleClientset()
+			client.PrependReactor("create", "packetcaptures", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				createAction := action.(k8stesting.CreateAction)
+				obj := createAction.GetObject().(*crdv1alpha1.PacketCapture)
+				obj.Status = tt.status
+				return false, obj, nil
+			})
+			getClients = func(cmd *cobra.Command) (kubernetes.Interface, antrea.Interface, error) {
+				return k8sClient, client, nil
+			}
+			defer func() { getClients = getK8sClient }()
+			notStarted := tt.notStarted
+			getPacketsReader = func(ctx context.Context, cmd *cobra.Command, k8sClient kubernetes.Interface, antreaClient antrea.Interface, nodeName, name string, follow bool) (io.ReadCloser, error) {
+				if follow {
+					if notStarted > 0 {
+						notStarted--
+						return nil, k8serrors.NewNotFound(schema.GroupResource{}, name)
+					}
+					if data, ok := tt.packetFiles[nodeName]; ok {
+						return io.NopCloser(bytes.NewReader(data)), nil
+					}
+					return io.NopCloser(strings.NewReader("streamed-packets-" + nodeName)), nil
+				}
+				if data, ok := tt.packetFiles[nodeName]; ok {
+					return io.NopCloser(bytes.NewReader(data)), nil
+				}
+				return io.NopCloser(strings.NewReader("packets-" + nodeName)), nil
+			}
+			defer func() { getPacketsReader = requestPackets }()
+
+			stdout, stderr := new(bytes.Buffer), new(bytes.Buffer)
+			Command.SetOut(stdout)
+			Command.SetErr(stderr)
+			err := runE(Command, nil)
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			output := stdout.String()
+			if tt.options.output == stdoutFile {
+				output = stderr.String()
+				assert.Equal(t, tt.expectedStdout, stdout.String())
+			}
+			assert.Contains(t, output, tt.expectedOutput)
+			for fileName, content := range tt.expectedFiles {
+				data, err := os.ReadFile(filepath.Join(tempDir, fileName))
+				require.NoError(t, err)
+				assert.Equal(t, content, string(data))
+			}
+			if tt.expectedPcapng != "" {
+				data, err := os.ReadFile(filepath.Join(tempDir, tt.expectedPcapng))
+				require.NoError(t, err)
+				packets, nodeNames := readTestPacketFile(t, data)
+				if tt.unordered {
+					assert.ElementsMatch(t, tt.expectedPackets, packets)
+					assert.ElementsMatch(t, tt.expectedNodes, nodeNames)
+				} else {
+					assert.Equal(t, tt.expectedPackets, packets)
+					assert.Equal(t, tt.expectedNodes, nodeNames)
+				}
+			}
+			// The PacketCapture is deleted once the packets are retrieved.
+			pcs, err := client.CrdV1alpha1().PacketCaptures().List(context.TODO(), metav1.ListOptions{})
+			require.NoError(t, err)
+			assert.Len(t, pcs.Items, tt.expectedCRs)
+		})
+	}
+}
@@ -51,6 +51,7 @@ var (
 		flow        string
 		liveTraffic bool
 		droppedOnly bool
+		multiPath   bool
 		timeout     time.Duration
 		nowait      bool
 	}{}
@@ -74,13 +75,14 @@ type CapturedPacket struct {
 
 // Response is the response of antctl Traceflow.
 type Response struct {
-	Name           string                 `json:"name" yaml:"name"`                                         // Traceflow name
-	Phase          v1beta1.TraceflowPhase `json:"phase,omitempty" yaml:"phase,omitempty"`                   // Traceflow phase
-	Reason         string                 `json:"reason,omitempty" yaml:"reason,omitempty"`                 // Traceflow phase reason
-	Source         string                 `json:"source,omitempty" yaml:"source,omitempty"`                 // Traceflow source, e.g. "default/pod0"
-	Destination    string                 `json:"destination,omitempty" yaml:"destination,omitempty"`       // Traceflow destination, e.g. "default/pod1"
-	NodeResults    []v1beta1.NodeResult   `json:"results,omitempty" yaml:"results,omitempty"`               // Traceflow node results
-	CapturedPacket *CapturedPacket        `json:"capturedPacket,omitempty" yaml:"capturedPacket,omitempty"` // Captured packet in live-traffic Traceflow
+	Name            string                   `json:"name" yaml:"name"`                                           // Traceflow name
+	Phase           v1beta1.TraceflowPhase   `json:"phase,omitempty" yaml:"phase,omitempty"`                     // Traceflow phase
+	Reason          string                   `json:"reason,omitempty" yaml:"reason,omitempty"`                   // Traceflow phase reason
+	Source          string                   `json:"source,omitempty" yaml:"source,omitempty"`                   // Traceflow source, e.g. "default/pod0"
+	Destination     string                   `json:"destination,omitempty" yaml:"destination,omitempty"`         // Traceflow destination, e.g. "default/pod1"
+	NodeResults     []v1beta1.NodeResult     `json:"results,omitempty" yaml:"results,omitempty"`                 // Traceflow node results
+	CapturedPacket  *CapturedPacket          `json:"capturedPacket,omitempty" yaml:"capturedPacket,omitempty"`   // Captured packet in live-traffic Traceflow
+	EndpointResults []v1beta1.EndpointResult `json:"endpointResults,omitempty" yaml:"endpointResults,omitempty"` // Results of each Service endpoint in multi-path Traceflow
 }
 
 func init() {
@@ -101,6 +103,8 @@ func init() {
   $antctl traceflow -S pod1 -D svc1 -f tcp --live-traffic -t 1m
   Start a Traceflow to capture the first dropped TCP packet to pod1 on port 80, within 10 minutes
   $antctl traceflow -D pod1 -f tcp,tcp_dst=80 --live-traffic --dropped-only -t 10m
+  Start a Traceflow from pod1 to each endpoint of Service svc1 in Namespace ns1
+  $antctl traceflow -S pod1 -D ns1/svc1 -f tcp,tcp_dst=80 --multi-path
 `,
 		RunE: runE,
 		Args: cobra.NoArgs,
@@ -112,6 +116,7 @@ func init() {
 	Command.Flags().StringVarP(&option.flow, "flow", "f", "", "specify the flow (packet headers) of the Traceflow packet, including tcp_src, tcp_dst, tcp_flags, udp_src, udp_dst, ipv6")
 	Command.Flags().BoolVarP(&option.liveTraffic, "live-traffic", "L", false, "if set, the Traceflow will trace the first packet of the matched live traffic flow")
 	Command.Flags().BoolVarP(&option.droppedOnly, "dropped-only", "", false, "if set, capture only the dropped packet in a live-traffic Traceflow")
+	Command.Flags().BoolVarP(&option.multiPath, "multi-path", "", false, "if set, trace the path to each endpoint of the destination Service")
 	Command.Flags().BoolVarP(&option.nowait, "nowait", "", false, "if set, command returns without retrieving results")
 }
 
@@ -157,6 +162,11 @@ func runE(cmd *cobra.Command, _ []string) error {
 		return nil
 	}
 
+	if option.liveTraffic && option.multiPath {
+		fmt.Fprintf(cmd.OutOrStdout(), "--multi-path doesn't work with live-traffic Traceflow")
+		return nil
+	}
+
 	k8sclient, client, err := getClients(cmd)
 	if err != nil {
 		return err
@@ -279,6 +289,10 @@ func newTraceflow(client kubernetes.Interface) (*v1beta1.Traceflow, error) {
 		return nil, errors.New("one of source and destination must be a Pod")
 	}
 
+	if option.multiPath && dst.Service == "" {
+		return nil, errors.New("destination must be a Service in multi-path Traceflow")
+	}
+
 	pkt, err := parseFlow()
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse flow: %w", err)
@@ -295,6 +309,7 @@ func newTraceflow(client kubernetes.Interface) (*v1beta1.Traceflow, error) {
 			Packet:      *pkt,
 			LiveTraffic: option.liveTraffic,
 			DroppedOnly: option.droppedOnly,
+			MultiPath:   option.multiPath,
 			Timeout:     int32(option.timeout.Seconds()),
 		},
 	}
@@ -395,11 +410,12 @@ func getPortFields(cleanFlow string) (map[string]int, error) {
 
 func output(tf *v1beta1.Traceflow, writer io.Writer) error {
 	r := Response{
-		Name:        tf.Name,
-		Phase:       tf.Status.Phase,
-		Reason:      tf.Status.Reason,
-		Source:      fmt.Sprintf("%s/%s", tf.Spec.Source.Namespace, tf.Spec.Source.Pod),
-		NodeResults: tf.Status.Results,
+		Name:            tf.Name,
+		Phase:           tf.Status.Phase,
+		Reason:          tf.Status.Reason,
+		Source:          fmt.Sprintf("%s/%s", tf.Spec.Source.Namespace, tf.Spec.Source.Pod),
+		NodeResults:     tf.Status.Results,
+		EndpointResults: tf.Status.EndpointResults,
 	}
 	if len(tf.Spec.Destination.IP) > 0 {
 		r.Destination = tf.Spec.Destination.IP
@@ -277,6 +277,39 @@ type NetworkPolicyRule struct {
 	L7Protocols []L7Protocol
 	// LogLabel is a user-defined arbitrary string which will be printed in the NetworkPolicy logs.
 	LogLabel string
+	// Schedule restricts the enforcement of this rule to recurring time windows.
+	// The rule is always enforced if it's nil.
+	Schedule *RuleSchedule
+	// RateLimit limits the rate of the traffic allowed by this rule on each Node.
+	// The traffic is not rate-limited if it's nil.
+	RateLimit *RuleRateLimit
+}
+
+// RuleRateLimit describes the rate limit of the traffic allowed by a rule.
+type RuleRateLimit struct {
+	// Rate is the maximum traffic rate, e.g. 300k, 100M.
+	Rate string
+	// Burst is the maximum burst size when traffic exceeds the rate. An empty value means Rate.
+	Burst string
+	// Unit is the unit of Rate and Burst. An empty value means bps.
+	Unit crdv1beta1.RateLimitUnit
+}
+
+// RuleSchedule describes the recurring time windows during which a rule is enforced.
+type RuleSchedule struct {
+	// Windows is the list of time windows during which the rule is enforced.
+	Windows []ScheduleWindow
+	// TimeZone is the IANA name of the time zone in which the windows are evaluated.
+	// An empty value means UTC.
+	TimeZone string
+}
+
+// ScheduleWindow describes a recurring time window.
+type ScheduleWindow struct {
+	// Start is a cron expression which specifies the times at which the window opens.
+	Start string
+	// Duration is how long the window stays open after each start.
+	Duration string
 }
 
 // Protocol defines network protocols supported for things like container ports.
@@ -328,8 +361,11 @@ type Service struct {
 
 // L7Protocol defines application layer protocol to match.
 type L7Protocol struct {
-	HTTP *HTTPProtocol
-	TLS  *TLSProtocol
+	HTTP  *HTTPProtocol
+	TLS   *TLSProtocol
+	GRPC  *GRPCProtocol
+	Kafka *KafkaProtocol
+	DNS   *DNSProtocol
 }
 
 // HTTPProtocol matches HTTP requests with specific host, method, and path. All
@@ -344,6 +380,38 @@ type HTTPProtocol struct {
 	Method string
 	// Path represents the URI path to match (Ex. "/index.html", "/admin").
 	Path string
+	// PathRegex represents a regular expression in RE2 syntax which the whole URI path must match
+	// (Ex. "/api/v[0-9]+/users/.*"). The query string is not part of the path. It can not be set
+	// together with Path.
+	PathRegex string
+	// Headers represents the HTTP request headers to match. A request must match all of them.
+	Headers []HTTPHeaderMatch
+	// QueryParams represents the URI query parameters to match. A request must match all of them.
+	QueryParams []HTTPQueryParamMatch
+}
+
+// HTTPHeaderMatch matches an HTTP request header. If neither Value nor Regex is provided, this
+// matches any request carrying the header.
+type HTTPHeaderMatch struct {
+	// Name represents the name of the header to match. It is case-insensitive.
+	Name string
+	// Value represents the exact value of the header to match.
+	Value string
+	// Regex represents a regular expression in RE2 syntax which the whole value of the header must
+	// match. It can not be set together with Value.
+	Regex string
+}
+
+// HTTPQueryParamMatch matches a URI query parameter. If neither Value nor Regex is provided, this
+// matches any request carrying the parameter.
+type HTTPQueryParamMatch struct {
+	// Name represents the name of the query parameter to match. It is case-sensitive.
+	Name string
+	// Value represents the exact value of the query parameter to match, as it appears in the URI.
+	Value string
+	// Regex represents a regular expression in RE2 syntax which the whole value of the query
+	// parameter, as it appears in the URI, must match. It can not be set together with Value.
+	Regex string
 }
 
 // TLSProtocol matches TLS handshake packets with specific SNI. If the field is not provided, this
@@ -353,6 +421,31 @@ type TLSProtocol struct {
 	SNI string
 }
 
+// GRPCProtocol matches gRPC requests with specific service and method. All fields could be used alone or together.
+// If all fields are not provided, it matches all gRPC requests.
+type GRPCProtocol struct {
+	// Service represents the fully qualified name of the gRPC service to match (Ex. "helloworld.Greeter").
+	Service string
+	// Method represents the name of the gRPC method to match (Ex. "SayHello").
+	Method string
+}
+
+// KafkaProtocol matches Kafka requests with specific API key and topic. The fields could be used alone or together,
+// but at least one of them must be provided.
+type KafkaProtocol struct {
+	// APIKey represents the type of the Kafka requests to match.
+	APIKey crdv1beta1.KafkaAPIKey
+	// Topic represents the name of the Kafka topic to match. It can only be used when APIKey is produce, fetch or metadata.
+	Topic string
+}
+
+// DNSProtocol matches DNS queries with specific query name. If the field is not provided, this matches all DNS
+// queries.
+type DNSProtocol struct {
+	// QueryName represents the domain name in the DNS query to match.
+	QueryName string
+}
+
 // NetworkPolicyPeer describes a peer of NetworkPolicyRules.
 // It could contain one of the subfields or a combination of them.
 type NetworkPolicyPeer struct {
@@ -328,10 +328,38 @@ func (m *ClusterGroupMembers) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ClusterGroupMembers proto.InternalMessageInfo
 
+func (m *DNSProtocol) Reset()      { *m = DNSProtocol{} }
+func (*DNSProtocol) ProtoMessage() {}
+func (*DNSProtocol) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{10}
+}
+func (m *DNSProtocol) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DNSProtocol) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DNSProtocol) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DNSProtocol.Merge(m, src)
+}
+func (m *DNSProtocol) XXX_Size() int {
+	return m.Size()
+}
+func (m *DNSProtocol) XXX_DiscardUnknown() {
+	xxx_messageInfo_DNSProtocol.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DNSProtocol proto.InternalMessageInfo
+
 func (m *EgressGroup) Reset()      { *m = EgressGroup{} }
 func (*EgressGroup) ProtoMessage() {}
 func (*EgressGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{10}
+	return fileDescriptor_fbaa7d016762fa1d, []int{11}
 }
 func (m *EgressGroup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -359,7 +387,7 @@ var xxx_messageInfo_EgressGroup proto.InternalMessageInfo
 func (m *EgressGroupList) Reset()      { *m = EgressGroupList{} }
 func (*EgressGroupList) ProtoMessage() {}
 func (*EgressGroupList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{11}
+	return fileDescriptor_fbaa7d016762fa1d, []int{12}
 }
 func (m *EgressGroupList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -387,7 +415,7 @@ var xxx_messageInfo_EgressGroupList proto.InternalMessageInfo
 func (m *EgressGroupPatch) Reset()      { *m = EgressGroupPatch{} }
 func (*EgressGroupPatch) ProtoMessage() {}
 func (*EgressGroupPatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{12}
+	return fileDescriptor_fbaa7d016762fa1d, []int{13}
 }
 func (m *EgressGroupPatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -415,7 +443,7 @@ var xxx_messageInfo_EgressGroupPatch proto.InternalMessageInfo
 func (m *Entity) Reset()      { *m = Entity{} }
 func (*Entity) ProtoMessage() {}
 func (*Entity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{13}
+	return fileDescriptor_fbaa7d016762fa1d, []int{14}
 }
 func (m *Entity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -443,7 +471,7 @@ var xxx_messageInfo_Entity proto.InternalMessageInfo
 func (m *ExternalEntityReference) Reset()      { *m = ExternalEntityReference{} }
 func (*ExternalEntityReference) ProtoMessage() {}
 func (*ExternalEntityReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{14}
+	return fileDescriptor_fbaa7d016762fa1d, []int{15}
 }
 func (m *ExternalEntityReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -468,10 +496,38 @@ func (m *ExternalEntityReference) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ExternalEntityReference proto.InternalMessageInfo
 
+func (m *GRPCProtocol) Reset()      { *m = GRPCProtocol{} }
+func (*GRPCProtocol) ProtoMessage() {}
+func (*GRPCProtocol) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{16}
+}
+func (m *GRPCProtocol) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GRPCProtocol) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GRPCProtocol) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GRPCProtocol.Merge(m, src)
+}
+func (m *GRPCProtocol) XXX_Size() int {
+	return m.Size()
+}
+func (m *GRPCProtocol) XXX_DiscardUnknown() {
+	xxx_messageInfo_GRPCProtocol.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GRPCProtocol proto.InternalMessageInfo
+
 func (m *GroupAssociation) Reset()      { *m = GroupAssociation{} }
 func (*GroupAssociation) ProtoMessage() {}
 func (*GroupAssociation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{15}
+	return fileDescriptor_fbaa7d016762fa1d, []int{17}
 }
 func (m *GroupAssociation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -499,7 +555,7 @@ var xxx_messageInfo_GroupAssociation proto.InternalMessageInfo
 func (m *GroupMember) Reset()      { *m = GroupMember{} }
 func (*GroupMember) ProtoMessage() {}
 func (*GroupMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{16}
+	return fileDescriptor_fbaa7d016762fa1d, []int{18}
 }
 func (m *GroupMember) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -527,7 +583,7 @@ var xxx_messageInfo_GroupMember proto.InternalMessageInfo
 func (m *GroupMembers) Reset()      { *m = GroupMembers{} }
 func (*GroupMembers) ProtoMessage() {}
 func (*GroupMembers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{17}
+	return fileDescriptor_fbaa7d016762fa1d, []int{19}
 }
 func (m *GroupMembers) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -555,7 +611,7 @@ var xxx_messageInfo_GroupMembers proto.InternalMessageInfo
 func (m *GroupReference) Reset()      { *m = GroupReference{} }
 func (*GroupReference) ProtoMessage() {}
 func (*GroupReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{18}
+	return fileDescriptor_fbaa7d016762fa1d, []int{20}
 }
 func (m *GroupReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -580,10 +636,38 @@ func (m *GroupReference) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GroupReference proto.InternalMessageInfo
 
+func (m *HTTPHeaderMatch) Reset()      { *m = HTTPHeaderMatch{} }
+func (*HTTPHeaderMatch) ProtoMessage() {}
+func (*HTTPHeaderMatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{21}
+}
+func (m *HTTPHeaderMatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HTTPHeaderMatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HTTPHeaderMatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTPHeaderMatch.Merge(m, src)
+}
+func (m *HTTPHeaderMatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *HTTPHeaderMatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTPHeaderMatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTTPHeaderMatch proto.InternalMessageInfo
+
 func (m *HTTPProtocol) Reset()      { *m = HTTPProtocol{} }
 func (*HTTPProtocol) ProtoMessage() {}
 func (*HTTPProtocol) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{19}
+	return fileDescriptor_fbaa7d016762fa1d, []int{22}
 }
 func (m *HTTPProtocol) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -608,10 +692,38 @@ func (m *HTTPProtocol) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HTTPProtocol proto.InternalMessageInfo
 
+func (m *HTTPQueryParamMatch) Reset()      { *m = HTTPQueryParamMatch{} }
+func (*HTTPQueryParamMatch) ProtoMessage() {}
+func (*HTTPQueryParamMatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{23}
+}
+func (m *HTTPQueryParamMatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HTTPQueryParamMatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HTTPQueryParamMatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTPQueryParamMatch.Merge(m, src)
+}
+func (m *HTTPQueryParamMatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *HTTPQueryParamMatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTPQueryParamMatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTTPQueryParamMatch proto.InternalMessageInfo
+
 func (m *IPBlock) Reset()      { *m = IPBlock{} }
 func (*IPBlock) ProtoMessage() {}
 func (*IPBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{20}
+	return fileDescriptor_fbaa7d016762fa1d, []int{24}
 }
 func (m *IPBlock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -639,7 +751,7 @@ var xxx_messageInfo_IPBlock proto.InternalMessageInfo
 func (m *IPGroupAssociation) Reset()      { *m = IPGroupAssociation{} }
 func (*IPGroupAssociation) ProtoMessage() {}
 func (*IPGroupAssociation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{21}
+	return fileDescriptor_fbaa7d016762fa1d, []int{25}
 }
 func (m *IPGroupAssociation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -667,7 +779,7 @@ var xxx_messageInfo_IPGroupAssociation proto.InternalMessageInfo
 func (m *IPNet) Reset()      { *m = IPNet{} }
 func (*IPNet) ProtoMessage() {}
 func (*IPNet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{22}
+	return fileDescriptor_fbaa7d016762fa1d, []int{26}
 }
 func (m *IPNet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -692,10 +804,38 @@ func (m *IPNet) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_IPNet proto.InternalMessageInfo
 
+func (m *KafkaProtocol) Reset()      { *m = KafkaProtocol{} }
+func (*KafkaProtocol) ProtoMessage() {}
+func (*KafkaProtocol) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{27}
+}
+func (m *KafkaProtocol) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KafkaProtocol) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *KafkaProtocol) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KafkaProtocol.Merge(m, src)
+}
+func (m *KafkaProtocol) XXX_Size() int {
+	return m.Size()
+}
+func (m *KafkaProtocol) XXX_DiscardUnknown() {
+	xxx_messageInfo_KafkaProtocol.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KafkaProtocol proto.InternalMessageInfo
+
 func (m *L7Protocol) Reset()      { *m = L7Protocol{} }
 func (*L7Protocol) ProtoMessage() {}
 func (*L7Protocol) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{23}
+	return fileDescriptor_fbaa7d016762fa1d, []int{28}
 }
 func (m *L7Protocol) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -723,7 +863,7 @@ var xxx_messageInfo_L7Protocol proto.InternalMessageInfo
 func (m *MulticastGroupInfo) Reset()      { *m = MulticastGroupInfo{} }
 func (*MulticastGroupInfo) ProtoMessage() {}
 func (*MulticastGroupInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{24}
+	return fileDescriptor_fbaa7d016762fa1d, []int{29}
 }
 func (m *MulticastGroupInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -751,7 +891,7 @@ var xxx_messageInfo_MulticastGroupInfo proto.InternalMessageInfo
 func (m *NamedPort) Reset()      { *m = NamedPort{} }
 func (*NamedPort) ProtoMessage() {}
 func (*NamedPort) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{25}
+	return fileDescriptor_fbaa7d016762fa1d, []int{30}
 }
 func (m *NamedPort) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -779,7 +919,7 @@ var xxx_messageInfo_NamedPort proto.InternalMessageInfo
 func (m *NetworkPolicy) Reset()      { *m = NetworkPolicy{} }
 func (*NetworkPolicy) ProtoMessage() {}
 func (*NetworkPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{26}
+	return fileDescriptor_fbaa7d016762fa1d, []int{31}
 }
 func (m *NetworkPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -807,7 +947,7 @@ var xxx_messageInfo_NetworkPolicy proto.InternalMessageInfo
 func (m *NetworkPolicyEvaluation) Reset()      { *m = NetworkPolicyEvaluation{} }
 func (*NetworkPolicyEvaluation) ProtoMessage() {}
 func (*NetworkPolicyEvaluation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{27}
+	return fileDescriptor_fbaa7d016762fa1d, []int{32}
 }
 func (m *NetworkPolicyEvaluation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -835,7 +975,7 @@ var xxx_messageInfo_NetworkPolicyEvaluation proto.InternalMessageInfo
 func (m *NetworkPolicyEvaluationRequest) Reset()      { *m = NetworkPolicyEvaluationRequest{} }
 func (*NetworkPolicyEvaluationRequest) ProtoMessage() {}
 func (*NetworkPolicyEvaluationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{28}
+	return fileDescriptor_fbaa7d016762fa1d, []int{33}
 }
 func (m *NetworkPolicyEvaluationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -863,7 +1003,7 @@ var xxx_messageInfo_NetworkPolicyEvaluationRequest proto.InternalMessageInfo
 func (m *NetworkPolicyEvaluationResponse) Reset()      { *m = NetworkPolicyEvaluationResponse{} }
 func (*NetworkPolicyEvaluationResponse) ProtoMessage() {}
 func (*NetworkPolicyEvaluationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{29}
+	return fileDescriptor_fbaa7d016762fa1d, []int{34}
 }
 func (m *NetworkPolicyEvaluationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -891,7 +1031,7 @@ var xxx_messageInfo_NetworkPolicyEvaluationResponse proto.InternalMessageInfo
 func (m *NetworkPolicyList) Reset()      { *m = NetworkPolicyList{} }
 func (*NetworkPolicyList) ProtoMessage() {}
 func (*NetworkPolicyList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{30}
+	return fileDescriptor_fbaa7d016762fa1d, []int{35}
 }
 func (m *NetworkPolicyList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -919,7 +1059,7 @@ var xxx_messageInfo_NetworkPolicyList proto.InternalMessageInfo
 func (m *NetworkPolicyNodeStatus) Reset()      { *m = NetworkPolicyNodeStatus{} }
 func (*NetworkPolicyNodeStatus) ProtoMessage() {}
 func (*NetworkPolicyNodeStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{31}
+	return fileDescriptor_fbaa7d016762fa1d, []int{36}
 }
 func (m *NetworkPolicyNodeStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -947,7 +1087,7 @@ var xxx_messageInfo_NetworkPolicyNodeStatus proto.InternalMessageInfo
 func (m *NetworkPolicyPeer) Reset()      { *m = NetworkPolicyPeer{} }
 func (*NetworkPolicyPeer) ProtoMessage() {}
 func (*NetworkPolicyPeer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{32}
+	return fileDescriptor_fbaa7d016762fa1d, []int{37}
 }
 func (m *NetworkPolicyPeer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -975,7 +1115,7 @@ var xxx_messageInfo_NetworkPolicyPeer proto.InternalMessageInfo
 func (m *NetworkPolicyReference) Reset()      { *m = NetworkPolicyReference{} }
 func (*NetworkPolicyReference) ProtoMessage() {}
 func (*NetworkPolicyReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{33}
+	return fileDescriptor_fbaa7d016762fa1d, []int{38}
 }
 func (m *NetworkPolicyReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1003,7 +1143,7 @@ var xxx_messageInfo_NetworkPolicyReference proto.InternalMessageInfo
 func (m *NetworkPolicyRule) Reset()      { *m = NetworkPolicyRule{} }
 func (*NetworkPolicyRule) ProtoMessage() {}
 func (*NetworkPolicyRule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{34}
+	return fileDescriptor_fbaa7d016762fa1d, []int{39}
 }
 func (m *NetworkPolicyRule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1031,7 +1171,7 @@ var xxx_messageInfo_NetworkPolicyRule proto.InternalMessageInfo
 func (m *NetworkPolicyStats) Reset()      { *m = NetworkPolicyStats{} }
 func (*NetworkPolicyStats) ProtoMessage() {}
 func (*NetworkPolicyStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{35}
+	return fileDescriptor_fbaa7d016762fa1d, []int{40}
 }
 func (m *NetworkPolicyStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1059,7 +1199,7 @@ var xxx_messageInfo_NetworkPolicyStats proto.InternalMessageInfo
 func (m *NetworkPolicyStatus) Reset()      { *m = NetworkPolicyStatus{} }
 func (*NetworkPolicyStatus) ProtoMessage() {}
 func (*NetworkPolicyStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{36}
+	return fileDescriptor_fbaa7d016762fa1d, []int{41}
 }
 func (m *NetworkPolicyStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1087,7 +1227,7 @@ var xxx_messageInfo_NetworkPolicyStatus proto.InternalMessageInfo
 func (m *NodeReference) Reset()      { *m = NodeReference{} }
 func (*NodeReference) ProtoMessage() {}
 func (*NodeReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{37}
+	return fileDescriptor_fbaa7d016762fa1d, []int{42}
 }
 func (m *NodeReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1115,7 +1255,7 @@ var xxx_messageInfo_NodeReference proto.InternalMessageInfo
 func (m *NodeStatsSummary) Reset()      { *m = NodeStatsSummary{} }
 func (*NodeStatsSummary) ProtoMessage() {}
 func (*NodeStatsSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{38}
+	return fileDescriptor_fbaa7d016762fa1d, []int{43}
 }
 func (m *NodeStatsSummary) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1143,7 +1283,7 @@ var xxx_messageInfo_NodeStatsSummary proto.InternalMessageInfo
 func (m *PaginationGetOptions) Reset()      { *m = PaginationGetOptions{} }
 func (*PaginationGetOptions) ProtoMessage() {}
 func (*PaginationGetOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{39}
+	return fileDescriptor_fbaa7d016762fa1d, []int{44}
 }
 func (m *PaginationGetOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1171,7 +1311,7 @@ var xxx_messageInfo_PaginationGetOptions proto.InternalMessageInfo
 func (m *PodReference) Reset()      { *m = PodReference{} }
 func (*PodReference) ProtoMessage() {}
 func (*PodReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{40}
+	return fileDescriptor_fbaa7d016762fa1d, []int{45}
 }
 func (m *PodReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1196,10 +1336,38 @@ func (m *PodReference) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PodReference proto.InternalMessageInfo
 
+func (m *RuleRateLimit) Reset()      { *m = RuleRateLimit{} }
+func (*RuleRateLimit) ProtoMessage() {}
+func (*RuleRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{46}
+}
+func (m *RuleRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RuleRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RuleRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RuleRateLimit.Merge(m, src)
+}
+func (m *RuleRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *RuleRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RuleRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RuleRateLimit proto.InternalMessageInfo
+
 func (m *RuleRef) Reset()      { *m = RuleRef{} }
 func (*RuleRef) ProtoMessage() {}
 func (*RuleRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{41}
+	return fileDescriptor_fbaa7d016762fa1d, []int{47}
 }
 func (m *RuleRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1224,10 +1392,66 @@ func (m *RuleRef) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RuleRef proto.InternalMessageInfo
 
+func (m *RuleSchedule) Reset()      { *m = RuleSchedule{} }
+func (*RuleSchedule) ProtoMessage() {}
+func (*RuleSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{48}
+}
+func (m *RuleSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RuleSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RuleSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RuleSchedule.Merge(m, src)
+}
+func (m *RuleSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *RuleSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_RuleSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RuleSchedule proto.InternalMessageInfo
+
+func (m *ScheduleWindow) Reset()      { *m = ScheduleWindow{} }
+func (*ScheduleWindow) ProtoMessage() {}
+func (*ScheduleWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{49}
+}
+func (m *ScheduleWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ScheduleWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleWindow.Merge(m, src)
+}
+func (m *ScheduleWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleWindow proto.InternalMessageInfo
+
 func (m *Service) Reset()      { *m = Service{} }
 func (*Service) ProtoMessage() {}
 func (*Service) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{42}
+	return fileDescriptor_fbaa7d016762fa1d, []int{50}
 }
 func (m *Service) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1255,7 +1479,7 @@ var xxx_messageInfo_Service proto.InternalMessageInfo
 func (m *ServiceReference) Reset()      { *m = ServiceReference{} }
 func (*ServiceReference) ProtoMessage() {}
 func (*ServiceReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{43}
+	return fileDescriptor_fbaa7d016762fa1d, []int{51}
 }
 func (m *ServiceReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1283,7 +1507,7 @@ var xxx_messageInfo_ServiceReference proto.InternalMessageInfo
 func (m *SupportBundleCollection) Reset()      { *m = SupportBundleCollection{} }
 func (*SupportBundleCollection) ProtoMessage() {}
 func (*SupportBundleCollection) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{44}
+	return fileDescriptor_fbaa7d016762fa1d, []int{52}
 }
 func (m *SupportBundleCollection) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1311,7 +1535,7 @@ var xxx_messageInfo_SupportBundleCollection proto.InternalMessageInfo
 func (m *SupportBundleCollectionList) Reset()      { *m = SupportBundleCollectionList{} }
 func (*SupportBundleCollectionList) ProtoMessage() {}
 func (*SupportBundleCollectionList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{45}
+	return fileDescriptor_fbaa7d016762fa1d, []int{53}
 }
 func (m *SupportBundleCollectionList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1339,7 +1563,7 @@ var xxx_messageInfo_SupportBundleCollectionList proto.InternalMessageInfo
 func (m *SupportBundleCollectionNodeStatus) Reset()      { *m = SupportBundleCollectionNodeStatus{} }
 func (*SupportBundleCollectionNodeStatus) ProtoMessage() {}
 func (*SupportBundleCollectionNodeStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{46}
+	return fileDescriptor_fbaa7d016762fa1d, []int{54}
 }
 func (m *SupportBundleCollectionNodeStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1367,7 +1591,7 @@ var xxx_messageInfo_SupportBundleCollectionNodeStatus proto.InternalMessageInfo
 func (m *SupportBundleCollectionStatus) Reset()      { *m = SupportBundleCollectionStatus{} }
 func (*SupportBundleCollectionStatus) ProtoMessage() {}
 func (*SupportBundleCollectionStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{47}
+	return fileDescriptor_fbaa7d016762fa1d, []int{55}
 }
 func (m *SupportBundleCollectionStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1395,7 +1619,7 @@ var xxx_messageInfo_SupportBundleCollectionStatus proto.InternalMessageInfo
 func (m *TLSProtocol) Reset()      { *m = TLSProtocol{} }
 func (*TLSProtocol) ProtoMessage() {}
 func (*TLSProtocol) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{48}
+	return fileDescriptor_fbaa7d016762fa1d, []int{56}
 }
 func (m *TLSProtocol) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1431,19 +1655,24 @@ func init() {
 	proto.RegisterType((*BundleFileServer)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.BundleFileServer")
 	proto.RegisterType((*BundleServerAuthConfiguration)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.BundleServerAuthConfiguration")
 	proto.RegisterType((*ClusterGroupMembers)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.ClusterGroupMembers")
+	proto.RegisterType((*DNSProtocol)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.DNSProtocol")
 	proto.RegisterType((*EgressGroup)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.EgressGroup")
 	proto.RegisterType((*EgressGroupList)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.EgressGroupList")
 	proto.RegisterType((*EgressGroupPatch)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.EgressGroupPatch")
 	proto.RegisterType((*Entity)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.Entity")
 	proto.RegisterType((*ExternalEntityReference)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.ExternalEntityReference")
+	proto.RegisterType((*GRPCProtocol)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.GRPCProtocol")
 	proto.RegisterType((*GroupAssociation)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.GroupAssociation")
 	proto.RegisterType((*GroupMember)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.GroupMember")
 	proto.RegisterType((*GroupMembers)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.GroupMembers")
 	proto.RegisterType((*GroupReference)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.GroupReference")
+	proto.RegisterType((*HTTPHeaderMatch)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.HTTPHeaderMatch")
 	proto.RegisterType((*HTTPProtocol)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.HTTPProtocol")
+	proto.RegisterType((*HTTPQueryParamMatch)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.HTTPQueryParamMatch")
 	proto.RegisterType((*IPBlock)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.IPBlock")
 	proto.RegisterType((*IPGroupAssociation)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.IPGroupAssociation")
 	proto.RegisterType((*IPNet)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.IPNet")
+	proto.RegisterType((*KafkaProtocol)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.KafkaProtocol")
 	proto.RegisterType((*L7Protocol)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.L7Protocol")
 	proto.RegisterType((*MulticastGroupInfo)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.MulticastGroupInfo")
 	proto.RegisterType((*NamedPort)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.NamedPort")
@@ -1462,7 +1691,10 @@ func init() {
 	proto.RegisterType((*NodeStatsSummary)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.NodeStatsSummary")
 	proto.RegisterType((*PaginationGetOptions)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.PaginationGetOptions")
 	proto.RegisterType((*PodReference)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.PodReference")
+	proto.RegisterType((*RuleRateLimit)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.RuleRateLimit")
 	proto.RegisterType((*RuleRef)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.RuleRef")
+	proto.RegisterType((*RuleSchedule)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.RuleSchedule")
+	proto.RegisterType((*ScheduleWindow)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.ScheduleWindow")
 	proto.RegisterType((*Service)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.Service")
 	proto.RegisterType((*ServiceReference)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.ServiceReference")
 	proto.RegisterType((*SupportBundleCollection)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.SupportBundleCollection")
@@ -1477,200 +1709,228 @@ func init() {
 }
 
 var fileDescriptor_fbaa7d016762fa1d = []byte{
-	// 3077 bytes of a gzipped FileDescriptorProto
+	// 3530 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3b, 0x4b, 0x6c, 0x1c, 0xc7,
-	0xb1, 0x9a, 0xfd, 0x90, 0xdc, 0xda, 0x25, 0x45, 0x35, 0x6d, 0x8b, 0xcf, 0xb6, 0x48, 0x79, 0xfc,
-	0x9e, 0xa1, 0x17, 0x38, 0x4b, 0x8b, 0xb1, 0x2d, 0xc5, 0x3f, 0x84, 0x4b, 0x51, 0xf4, 0x26, 0x14,
-	0xb5, 0x6e, 0xd2, 0x36, 0x62, 0xc7, 0x8e, 0x87, 0x33, 0xbd, 0xcb, 0xb1, 0x66, 0x67, 0x46, 0xdd,
-	0x3d, 0xb4, 0xe4, 0x43, 0xe0, 0x20, 0xc9, 0xc1, 0xf9, 0x39, 0xc8, 0x25, 0xf0, 0x2d, 0xb7, 0x5c,
-	0x72, 0xcb, 0xcd, 0xa7, 0xf8, 0x10, 0xc0, 0x47, 0x07, 0x41, 0x10, 0x9f, 0x88, 0x98, 0x41, 0x1c,
-	0xf8, 0x60, 0x04, 0xc8, 0x2d, 0x0a, 0x02, 0x04, 0xfd, 0x99, 0xdf, 0x2e, 0x57, 0xd4, 0x92, 0x14,
-	0x13, 0xc4, 0x3a, 0x71, 0xa7, 0xaa, 0xba, 0xaa, 0xba, 0xab, 0xba, 0xeb, 0xd3, 0x4d, 0x78, 0xc6,
-	0xf2, 0x39, 0x25, 0x56, 0xdd, 0x0d, 0xe6, 0xd4, 0xaf, 0xb9, 0xf0, 0x4a, 0x67, 0xce, 0x0a, 0x5d,
-	0x36, 0x67, 0x07, 0x3e, 0xa7, 0x81, 0x17, 0x7a, 0x96, 0x4f, 0xe6, 0xb6, 0xce, 0x6e, 0x10, 0x6e,
-	0xcd, 0xcf, 0x75, 0x88, 0x4f, 0xa8, 0xc5, 0x89, 0x53, 0x0f, 0x69, 0xc0, 0x03, 0x54, 0x57, 0xa3,
-	0xbe, 0xe9, 0x06, 0xfa, 0x57, 0x3d, 0xbc, 0xd2, 0xa9, 0x8b, 0xf1, 0xf5, 0xec, 0xf8, 0xba, 0x1e,
-	0x7f, 0xef, 0xf9, 0xc1, 0xf2, 0x18, 0xb7, 0x38, 0x9b, 0xdb, 0x3a, 0x6b, 0x79, 0xe1, 0xa6, 0x75,
-	0xb6, 0x57, 0xd2, 0xbd, 0x5f, 0xec, 0xb8, 0x7c, 0x33, 0xda, 0xa8, 0xdb, 0x41, 0x77, 0xae, 0x13,
-	0x74, 0x82, 0x39, 0x09, 0xde, 0x88, 0xda, 0xf2, 0x4b, 0x7e, 0xc8, 0x5f, 0x9a, 0xfc, 0xd1, 0x2b,
-	0xe7, 0x99, 0x94, 0x12, 0xba, 0x5d, 0xcb, 0xde, 0x74, 0x7d, 0x42, 0xaf, 0xa7, 0xb2, 0xba, 0x84,
-	0x5b, 0x73, 0x5b, 0xfd, 0x42, 0xe6, 0x06, 0x8d, 0xa2, 0x91, 0xcf, 0xdd, 0x2e, 0xe9, 0x1b, 0xf0,
-	0xf8, 0x5e, 0x03, 0x98, 0xbd, 0x49, 0xba, 0x56, 0xdf, 0xb8, 0x2f, 0x0d, 0x1a, 0x17, 0x71, 0xd7,
-	0x9b, 0x73, 0x7d, 0xce, 0x38, 0xed, 0x1d, 0x64, 0xfe, 0xc5, 0x80, 0xda, 0x82, 0xe3, 0x50, 0xc2,
-	0xd8, 0x32, 0x0d, 0xa2, 0x10, 0xbd, 0x06, 0x63, 0x62, 0x26, 0x8e, 0xc5, 0xad, 0x69, 0xe3, 0xb4,
-	0x71, 0xa6, 0x3a, 0xff, 0x48, 0x5d, 0x31, 0xae, 0x67, 0x19, 0xa7, 0x36, 0x11, 0xd4, 0xf5, 0xad,
-	0xb3, 0xf5, 0xcb, 0x1b, 0xaf, 0x13, 0x9b, 0x5f, 0x22, 0xdc, 0x6a, 0xa0, 0x0f, 0xb6, 0x67, 0x8f,
-	0xed, 0x6c, 0xcf, 0x42, 0x0a, 0xc3, 0x09, 0x57, 0x14, 0x41, 0xad, 0x23, 0x44, 0x5d, 0x22, 0xdd,
-	0x0d, 0x42, 0xd9, 0x74, 0xe1, 0x74, 0xf1, 0x4c, 0x75, 0xfe, 0xc9, 0x21, 0xcd, 0x5e, 0x5f, 0x4e,
-	0x79, 0x34, 0xee, 0xd2, 0x02, 0x6b, 0x19, 0x20, 0xc3, 0x39, 0x31, 0xe6, 0xef, 0x0c, 0x98, 0xcc,
-	0xce, 0x74, 0xc5, 0x65, 0x1c, 0x7d, 0xa3, 0x6f, 0xb6, 0xf5, 0x5b, 0x9b, 0xad, 0x18, 0x2d, 0xe7,
-	0x3a, 0xa9, 0x45, 0x8f, 0xc5, 0x90, 0xcc, 0x4c, 0x2d, 0x28, 0xbb, 0x9c, 0x74, 0xe3, 0x29, 0x3e,
-	0x35, 0xec, 0x14, 0xb3, 0xea, 0x36, 0xc6, 0xb5, 0xa0, 0x72, 0x53, 0xb0, 0xc4, 0x8a, 0xb3, 0xf9,
-	0x76, 0x11, 0x4e, 0x64, 0xc9, 0x5a, 0x16, 0xb7, 0x37, 0x8f, 0xc0, 0x88, 0xdf, 0x35, 0xe0, 0x84,
-	0xe5, 0x38, 0xc4, 0x59, 0x3e, 0x64, 0x53, 0xfe, 0x8f, 0x16, 0x2b, 0x66, 0x95, 0xe7, 0x8e, 0xfb,
-	0x05, 0xa2, 0xef, 0x1b, 0x30, 0x45, 0x49, 0x37, 0xd8, 0xea, 0x51, 0xa4, 0x78, 0x70, 0x45, 0xee,
-	0xd3, 0x8a, 0x4c, 0xe1, 0x7e, 0xfe, 0x78, 0x37, 0xa1, 0xe6, 0xa7, 0x06, 0x4c, 0x2c, 0x84, 0xa1,
-	0xe7, 0x12, 0x67, 0x3d, 0xf8, 0x2f, 0xdf, 0x4d, 0x7f, 0x30, 0x00, 0xe5, 0xe7, 0x7a, 0x04, 0xfb,
-	0xc9, 0xce, 0xef, 0xa7, 0x67, 0x86, 0xde, 0x4f, 0x39, 0x85, 0x07, 0xec, 0xa8, 0x1f, 0x14, 0x61,
-	0x2a, 0x4f, 0x78, 0x67, 0x4f, 0xfd, 0xfb, 0xf6, 0xd4, 0x55, 0x98, 0x6a, 0x58, 0xcc, 0xb5, 0x17,
-	0x22, 0xbe, 0x49, 0x7c, 0xee, 0xda, 0x16, 0x77, 0x03, 0x1f, 0x3d, 0x0c, 0x63, 0x11, 0x23, 0xd4,
-	0xb7, 0xba, 0x44, 0x1a, 0xa3, 0x92, 0xfa, 0xcd, 0xf3, 0x1a, 0x8e, 0x13, 0x0a, 0x41, 0x1d, 0x5a,
-	0x8c, 0xbd, 0x11, 0x50, 0x67, 0xba, 0x90, 0xa7, 0x6e, 0x69, 0x38, 0x4e, 0x28, 0xcc, 0xd7, 0x61,
-	0xb2, 0x11, 0xf9, 0x8e, 0x47, 0x2e, 0xba, 0x1e, 0x59, 0x23, 0x74, 0x8b, 0x50, 0x74, 0x0a, 0x8a,
-	0x11, 0xf5, 0xb4, 0xa8, 0xaa, 0x1e, 0x5c, 0x7c, 0x1e, 0xaf, 0x60, 0x01, 0x47, 0xe7, 0x60, 0x7c,
-	0x33, 0x60, 0xbc, 0x15, 0x6d, 0x78, 0xae, 0xfd, 0x35, 0x72, 0x5d, 0x4a, 0xa9, 0x35, 0x4e, 0xec,
-	0x6c, 0xcf, 0x8e, 0x3f, 0x9b, 0x45, 0xe0, 0x3c, 0x9d, 0xf9, 0x4e, 0x01, 0x4e, 0x29, 0x61, 0x4a,
-	0x90, 0x98, 0xe6, 0x62, 0xe0, 0xb7, 0xdd, 0x4e, 0x44, 0xd5, 0x4c, 0x1f, 0x83, 0xea, 0x06, 0xb1,
-	0x28, 0xa1, 0xeb, 0xc1, 0x15, 0xe2, 0x6b, 0x0d, 0xa6, 0xb4, 0x06, 0xd5, 0x46, 0x8a, 0xc2, 0x59,
-	0x3a, 0xf4, 0x10, 0x8c, 0x58, 0xa1, 0x1b, 0xab, 0x52, 0x69, 0x4c, 0xe8, 0x11, 0x23, 0x0b, 0xad,
-	0xa6, 0xd0, 0x43, 0x63, 0xd1, 0x8f, 0x0d, 0x98, 0xda, 0xe8, 0x5f, 0xe0, 0xe9, 0xa2, 0xf4, 0xf0,
-	0xc5, 0x61, 0x8d, 0xbd, 0x8b, 0xad, 0x1a, 0x27, 0x85, 0xc1, 0x77, 0x41, 0xe0, 0xdd, 0x04, 0x9b,
-	0x3f, 0x2f, 0xc1, 0xd4, 0xa2, 0x17, 0x31, 0x4e, 0x68, 0xce, 0x2b, 0x6f, 0xff, 0xf6, 0xfb, 0xb6,
-	0x01, 0x93, 0xa4, 0xdd, 0x26, 0x36, 0x77, 0xb7, 0xc8, 0x21, 0xee, 0xbe, 0x69, 0x2d, 0x75, 0x72,
-	0xa9, 0x87, 0x39, 0xee, 0x13, 0x87, 0xbe, 0x05, 0x27, 0x12, 0x58, 0xb3, 0xd5, 0xf0, 0x02, 0xfb,
-	0x4a, 0xbc, 0xf1, 0x1e, 0x1b, 0x56, 0x87, 0x66, 0x6b, 0x95, 0xf0, 0x74, 0xef, 0x2f, 0xf5, 0xf2,
-	0xc5, 0xfd, 0xa2, 0xd0, 0x79, 0xa8, 0xf1, 0x80, 0x5b, 0x5e, 0x3c, 0xfd, 0xd2, 0x69, 0xe3, 0x4c,
-	0x31, 0x0d, 0x08, 0xeb, 0x19, 0x1c, 0xce, 0x51, 0xa2, 0x79, 0x00, 0xf9, 0xdd, 0xb2, 0x3a, 0x84,
-	0x4d, 0x97, 0xe5, 0xb8, 0x64, 0xbd, 0xd7, 0x13, 0x0c, 0xce, 0x50, 0x09, 0xdf, 0xb6, 0x23, 0x4a,
-	0x89, 0xcf, 0xc5, 0xf7, 0xf4, 0x88, 0x1c, 0x94, 0xf8, 0xf6, 0x62, 0x8a, 0xc2, 0x59, 0x3a, 0xf3,
-	0x13, 0x03, 0xaa, 0x4b, 0x9d, 0xcf, 0x41, 0xca, 0xfa, 0x5b, 0x03, 0x8e, 0x67, 0x26, 0x7a, 0x04,
-	0x11, 0xf6, 0xb5, 0x7c, 0x84, 0x1d, 0x7a, 0x86, 0x19, 0x6d, 0x07, 0x84, 0xd7, 0x1f, 0x16, 0x61,
-	0x32, 0x43, 0xa5, 0x62, 0xab, 0x03, 0x10, 0x24, 0xeb, 0x7e, 0xa8, 0x36, 0xcc, 0xf0, 0xbd, 0x13,
-	0x5f, 0x77, 0x89, 0xaf, 0x16, 0x8c, 0x2c, 0xf9, 0xdc, 0xe5, 0xd7, 0xd1, 0x8b, 0x50, 0x0c, 0x03,
-	0x47, 0x2f, 0xfe, 0xd0, 0xa5, 0x4a, 0x2b, 0x70, 0x30, 0x69, 0x13, 0x4a, 0x7c, 0x9b, 0x34, 0x46,
-	0x45, 0x70, 0x14, 0x10, 0xc1, 0xd1, 0xf4, 0xe0, 0xe4, 0xd2, 0x35, 0x2e, 0x42, 0xb1, 0xa7, 0x44,
-	0x25, 0x84, 0xe8, 0x34, 0x94, 0x32, 0x21, 0xbc, 0xa6, 0xb5, 0x2f, 0xad, 0x8a, 0xf0, 0x2d, 0x31,
-	0x68, 0x0e, 0x2a, 0xe2, 0x2f, 0x0b, 0x2d, 0x9b, 0xe8, 0x50, 0x76, 0x42, 0x93, 0x55, 0x56, 0x63,
-	0x04, 0x4e, 0x69, 0xcc, 0x7f, 0x18, 0x30, 0x29, 0x67, 0xb8, 0xc0, 0x58, 0x60, 0xbb, 0x2a, 0x88,
-	0x1e, 0x49, 0xee, 0x36, 0x69, 0x69, 0x89, 0x7a, 0x89, 0xf7, 0x9d, 0xa6, 0xca, 0xd1, 0xe9, 0x6a,
-	0x26, 0xf1, 0x63, 0xa1, 0x87, 0x3f, 0xee, 0x93, 0x68, 0xbe, 0x57, 0x82, 0x6a, 0xc6, 0xbe, 0xb7,
-	0xcd, 0xa8, 0xe8, 0x3b, 0x06, 0x4c, 0x90, 0x9c, 0x55, 0xa5, 0x75, 0xaa, 0xf3, 0xcb, 0x43, 0x1f,
-	0x19, 0xbb, 0xfb, 0x46, 0x03, 0xed, 0x6c, 0xcf, 0x4e, 0xf4, 0x20, 0x7b, 0x44, 0xa2, 0x87, 0xa0,
-	0xe8, 0x86, 0x6a, 0xe7, 0xd4, 0x1a, 0x77, 0x09, 0x05, 0x9b, 0x2d, 0x76, 0x63, 0x7b, 0xb6, 0xd2,
-	0x6c, 0xe9, 0xa2, 0x18, 0x0b, 0x02, 0xf4, 0x2a, 0x94, 0xc3, 0x80, 0x72, 0x11, 0xcf, 0x84, 0x45,
-	0xbe, 0x3c, 0xac, 0x8e, 0xc2, 0xd3, 0x9c, 0x56, 0x40, 0x79, 0x7a, 0xa8, 0x89, 0x2f, 0x86, 0x15,
-	0x5b, 0xf4, 0x32, 0x94, 0xfc, 0xc0, 0x21, 0x32, 0xec, 0x55, 0xe7, 0x9f, 0x1e, 0x9a, 0x7d, 0xe0,
-	0x90, 0x74, 0xe2, 0x63, 0x72, 0x0b, 0x08, 0x90, 0x64, 0x8a, 0x3a, 0x30, 0xca, 0x08, 0xdd, 0x72,
-	0x6d, 0x15, 0x21, 0xab, 0xf3, 0x5f, 0x19, 0x96, 0xff, 0x9a, 0x1a, 0x9e, 0x8a, 0xa8, 0xee, 0x6c,
-	0xcf, 0x8e, 0xc6, 0xd0, 0x98, 0xbb, 0xf9, 0x6e, 0x09, 0x6a, 0x77, 0x72, 0xae, 0x3b, 0x39, 0xd7,
-	0x6e, 0x39, 0xd7, 0x2f, 0x0c, 0x98, 0xc8, 0x9f, 0x4b, 0xf9, 0xa3, 0xd9, 0xd8, 0xfb, 0x68, 0x4e,
-	0x4e, 0xfb, 0xc2, 0xc0, 0xd3, 0xbe, 0x01, 0xc5, 0xc8, 0x75, 0x64, 0xf1, 0x51, 0x69, 0x3c, 0x92,
-	0x94, 0x59, 0xcd, 0x0b, 0x37, 0xb6, 0x67, 0x1f, 0x18, 0xd4, 0xde, 0xe4, 0xd7, 0x43, 0xc2, 0xea,
-	0xcf, 0x37, 0x2f, 0x60, 0x31, 0xd8, 0x7c, 0x13, 0x6a, 0xcf, 0xae, 0xaf, 0xb7, 0x5a, 0x34, 0xe0,
-	0x81, 0x1d, 0x78, 0x42, 0xaa, 0xa8, 0xb9, 0x7a, 0x63, 0x8c, 0x28, 0xcb, 0xb0, 0xc4, 0x88, 0x5a,
-	0xa9, 0x4b, 0xf8, 0x66, 0xe0, 0xf4, 0xd6, 0x4a, 0x97, 0x24, 0x14, 0x6b, 0xac, 0xe0, 0x14, 0x5a,
-	0x7c, 0x53, 0xab, 0x97, 0x70, 0x6a, 0x59, 0x7c, 0x13, 0x4b, 0x8c, 0xf9, 0xbe, 0x01, 0xa3, 0xda,
-	0xae, 0xe8, 0x45, 0x28, 0xd9, 0xae, 0x43, 0xf5, 0xc6, 0xd9, 0xa7, 0x27, 0x25, 0x42, 0x16, 0x9b,
-	0x17, 0x30, 0x96, 0x0c, 0xd1, 0x2b, 0x30, 0x42, 0xae, 0xd9, 0x24, 0xe4, 0x7a, 0xa3, 0xec, 0x93,
-	0x75, 0x32, 0xcb, 0x25, 0xc9, 0x0c, 0x6b, 0xa6, 0xe6, 0x3f, 0x0d, 0x40, 0xcd, 0xd6, 0xe7, 0x37,
-	0x84, 0xb6, 0xa1, 0x2c, 0x17, 0x08, 0x3d, 0x08, 0x05, 0x37, 0x94, 0x73, 0xad, 0x35, 0xa6, 0x76,
-	0xb6, 0x67, 0x0b, 0xcd, 0x56, 0x3e, 0xb4, 0x14, 0xdc, 0x50, 0x6c, 0xde, 0x90, 0x92, 0xb6, 0x7b,
-	0x6d, 0x85, 0xf8, 0x1d, 0xbe, 0x29, 0x3d, 0xa8, 0x9c, 0x6e, 0xde, 0x56, 0x06, 0x87, 0x73, 0x94,
-	0xe6, 0xaf, 0x0d, 0x80, 0x95, 0x73, 0x89, 0x9b, 0xbe, 0x04, 0xa5, 0x4d, 0xce, 0xc3, 0xfd, 0x86,
-	0xea, 0xac, 0xcb, 0xab, 0x08, 0x22, 0x20, 0x58, 0xf2, 0x44, 0x2f, 0x40, 0x91, 0x7b, 0x4c, 0x07,
-	0xe8, 0xa1, 0xcf, 0xd5, 0xf5, 0x95, 0xb5, 0x84, 0xb3, 0x4c, 0x02, 0xd6, 0x57, 0xd6, 0xb0, 0x60,
-	0x68, 0xbe, 0x6b, 0x00, 0xba, 0x14, 0x79, 0xa2, 0x76, 0x67, 0x5c, 0x2e, 0x5f, 0xd3, 0x6f, 0x07,
-	0xe8, 0x41, 0x28, 0xcb, 0x32, 0x46, 0x6f, 0xb9, 0x24, 0x64, 0x2a, 0xa3, 0x28, 0x1c, 0x7a, 0x15,
-	0x4a, 0x61, 0xe0, 0xec, 0xbb, 0x35, 0x9e, 0x4b, 0x4d, 0xd2, 0xad, 0x18, 0x38, 0x0c, 0x4b, 0xbe,
-	0xe6, 0xdb, 0x06, 0x54, 0x92, 0xb0, 0x2d, 0xb7, 0x6e, 0x40, 0xd5, 0x21, 0x50, 0xce, 0xd2, 0x53,
-	0x8e, 0x25, 0xe6, 0x16, 0x0e, 0xa7, 0xf3, 0x30, 0x16, 0xea, 0x75, 0xd0, 0x47, 0xc0, 0xfd, 0x49,
-	0x17, 0x49, 0xc3, 0x6f, 0x64, 0x7e, 0xe3, 0x84, 0xda, 0xfc, 0xac, 0x08, 0xe3, 0xab, 0x84, 0xbf,
-	0x11, 0xd0, 0x2b, 0xad, 0xc0, 0x73, 0xed, 0xeb, 0x47, 0xb0, 0x9b, 0xda, 0x50, 0xa6, 0x91, 0x47,
-	0xe2, 0x05, 0x5e, 0x18, 0x3a, 0x27, 0xc9, 0xea, 0x8b, 0x23, 0x8f, 0xa4, 0x76, 0x14, 0x5f, 0x0c,
-	0x2b, 0xf6, 0xe8, 0x69, 0x38, 0x6e, 0xe5, 0xba, 0xa5, 0x2a, 0x76, 0x56, 0xe4, 0x96, 0x39, 0x9e,
-	0x6f, 0xa4, 0x32, 0xdc, 0x4b, 0x8b, 0xce, 0x88, 0x45, 0x75, 0x03, 0x2a, 0x12, 0x48, 0x11, 0xf8,
-	0x8c, 0x46, 0x4d, 0x2d, 0xa8, 0x82, 0xe1, 0x04, 0x8b, 0x1e, 0x85, 0x1a, 0x77, 0x09, 0x8d, 0x31,
-	0x32, 0xdc, 0x95, 0x1b, 0x93, 0x32, 0x44, 0x66, 0xe0, 0x38, 0x47, 0x85, 0x18, 0x54, 0x58, 0x10,
-	0x51, 0x99, 0xfc, 0xe8, 0xf4, 0xe9, 0xe2, 0xc1, 0x96, 0x22, 0xf1, 0xba, 0x71, 0x11, 0xe8, 0xd6,
-	0x62, 0xe6, 0x38, 0x95, 0x63, 0x7e, 0x56, 0x80, 0x93, 0xb9, 0x41, 0x4b, 0x5b, 0x96, 0x17, 0xf5,
-	0x9f, 0xa3, 0xc5, 0xdb, 0xd4, 0xac, 0x18, 0xa5, 0xe4, 0x6a, 0x44, 0x74, 0xcc, 0xab, 0xce, 0xaf,
-	0x1e, 0x68, 0xc2, 0xa9, 0xee, 0x58, 0x71, 0x55, 0xd9, 0xa3, 0xfe, 0xc0, 0xb1, 0x2c, 0x74, 0x1d,
-	0xc6, 0x28, 0x61, 0x61, 0xe0, 0x33, 0xa2, 0x4f, 0x9a, 0xcb, 0x87, 0x26, 0x57, 0xb1, 0x55, 0xae,
-	0x11, 0x7f, 0xe1, 0x44, 0x9c, 0xf9, 0x57, 0x03, 0x66, 0x6e, 0xae, 0x33, 0x7a, 0x15, 0x46, 0x94,
-	0x7d, 0xf4, 0x9a, 0x3c, 0x3e, 0x74, 0x99, 0x22, 0x2b, 0x8e, 0x34, 0x6a, 0x6a, 0xc3, 0x6b, 0xae,
-	0xa8, 0x0b, 0x55, 0x87, 0x30, 0xee, 0xfa, 0xaa, 0x7d, 0x5a, 0x38, 0x90, 0x90, 0x24, 0x1d, 0xbb,
-	0x90, 0xb2, 0xc4, 0x59, 0xfe, 0xe6, 0xaf, 0x0a, 0x30, 0xbb, 0xc7, 0x6a, 0x89, 0x12, 0x6d, 0xdc,
-	0xcf, 0xd2, 0xe8, 0xa9, 0x1f, 0x96, 0xff, 0xdf, 0xad, 0xb5, 0xcc, 0x1f, 0x6d, 0x38, 0x2f, 0x53,
-	0x64, 0x89, 0xe2, 0xa0, 0x68, 0xfa, 0x0e, 0xb9, 0xa6, 0xa3, 0x63, 0x92, 0x25, 0xe2, 0x18, 0x81,
-	0x53, 0x1a, 0xf4, 0x75, 0x28, 0x89, 0x0f, 0xbd, 0x39, 0xce, 0x0d, 0xab, 0xac, 0xe0, 0x89, 0x49,
-	0x3b, 0x3d, 0xc1, 0x25, 0x40, 0xb2, 0x34, 0x7f, 0x6f, 0xc0, 0x89, 0x9c, 0xb2, 0x47, 0xd0, 0x51,
-	0xdb, 0xc8, 0x77, 0xd4, 0x9e, 0x3e, 0xd0, 0xe2, 0x0f, 0xe8, 0xa9, 0xfd, 0xcd, 0xe8, 0x39, 0x6f,
-	0x44, 0xf5, 0xb8, 0xc6, 0x2d, 0x1e, 0x31, 0xf4, 0x30, 0x8c, 0x89, 0x2a, 0x72, 0x75, 0x97, 0x9b,
-	0x92, 0x55, 0x0d, 0xc7, 0x09, 0x85, 0xa8, 0x28, 0xf4, 0x0b, 0x81, 0xd8, 0x8b, 0x33, 0x15, 0xc5,
-	0x72, 0x82, 0xc1, 0x19, 0x2a, 0xf4, 0x55, 0x40, 0x94, 0x58, 0x9e, 0xfb, 0xa6, 0xfc, 0xbc, 0x68,
-	0xb9, 0x5e, 0x44, 0x95, 0xf9, 0xc6, 0x1a, 0xf7, 0xea, 0xb1, 0x08, 0xf7, 0x51, 0xe0, 0x5d, 0x46,
-	0xa1, 0xff, 0x87, 0xd1, 0x2e, 0x61, 0x4c, 0x54, 0x26, 0x25, 0xa9, 0xec, 0x71, 0xcd, 0x60, 0xf4,
-	0x92, 0x02, 0xe3, 0x18, 0x2f, 0x6f, 0xbe, 0x73, 0x93, 0x6e, 0x11, 0x42, 0xd1, 0x39, 0x18, 0xb7,
-	0x32, 0xd7, 0xe1, 0x6c, 0xda, 0x90, 0xc1, 0x48, 0xde, 0xc4, 0x64, 0xef, 0xc9, 0x19, 0xce, 0xd3,
-	0x21, 0x02, 0x63, 0x6e, 0xa8, 0x8b, 0x3f, 0x65, 0xaa, 0x73, 0xc3, 0xe7, 0xd5, 0x72, 0x7c, 0xba,
-	0xc0, 0x49, 0xd5, 0x97, 0xb0, 0x46, 0xb3, 0x50, 0x6e, 0x5f, 0x75, 0xfc, 0x38, 0x48, 0x56, 0x84,
-	0x2d, 0x2f, 0x3e, 0x77, 0x61, 0x95, 0x61, 0x05, 0x47, 0x5c, 0xd4, 0x74, 0xba, 0x34, 0x8f, 0xfb,
-	0x15, 0x07, 0x2f, 0xf8, 0x33, 0x55, 0x61, 0xcc, 0x1b, 0x67, 0xe4, 0x88, 0x28, 0xee, 0x59, 0x1b,
-	0xc4, 0x6b, 0x3a, 0x44, 0x1c, 0x41, 0xae, 0x2c, 0x27, 0x8b, 0x67, 0xc6, 0x55, 0x14, 0x5f, 0xc9,
-	0xa3, 0x70, 0x2f, 0xad, 0xf9, 0x89, 0x01, 0xf7, 0xec, 0x7e, 0x4a, 0xa0, 0xc7, 0xa0, 0x24, 0x0a,
-	0x34, 0xed, 0x7b, 0x0f, 0xc4, 0xbb, 0x72, 0xfd, 0x7a, 0x48, 0x6e, 0x6c, 0xcf, 0xe6, 0x2d, 0x28,
-	0x80, 0x58, 0x92, 0x0f, 0xdd, 0xf7, 0x4b, 0xf2, 0xb7, 0xe2, 0x5e, 0xc5, 0x65, 0xe9, 0x20, 0xc5,
-	0xe5, 0xfb, 0x23, 0x3d, 0x4e, 0x27, 0x4e, 0x17, 0xf4, 0x14, 0x54, 0x1c, 0x97, 0x8a, 0xb2, 0x3e,
-	0x88, 0x6f, 0xe8, 0x66, 0x62, 0x65, 0x2f, 0xc4, 0x88, 0x1b, 0xd9, 0x0f, 0x9c, 0x0e, 0x40, 0x36,
-	0x94, 0xda, 0x34, 0xe8, 0xea, 0x98, 0x71, 0xb0, 0x44, 0x4d, 0xec, 0x81, 0x74, 0xf2, 0x17, 0x69,
-	0xd0, 0xc5, 0x92, 0x39, 0x7a, 0x05, 0x0a, 0x3c, 0xd0, 0x67, 0xea, 0x21, 0x88, 0x00, 0x2d, 0xa2,
-	0xb0, 0x1e, 0xe0, 0x02, 0x0f, 0xc4, 0xee, 0x61, 0x79, 0x9f, 0x3d, 0xb7, 0x4f, 0x9f, 0x4d, 0x77,
-	0x4f, 0xe2, 0xa8, 0x09, 0x6b, 0x79, 0x91, 0xdb, 0x93, 0xff, 0xa5, 0x29, 0x78, 0x5f, 0xc6, 0xf8,
-	0x02, 0x8c, 0x58, 0xca, 0x26, 0x23, 0xd2, 0x26, 0xcf, 0xc8, 0xfb, 0xcf, 0xd8, 0x18, 0x8f, 0xdc,
-	0xe4, 0x99, 0x1a, 0x75, 0xf4, 0xeb, 0xb4, 0xb3, 0x32, 0x9e, 0xa8, 0x31, 0x58, 0x73, 0x43, 0x4f,
-	0xc2, 0x38, 0xf1, 0xad, 0x0d, 0x8f, 0xac, 0x04, 0x9d, 0x8e, 0xeb, 0x77, 0xa6, 0x47, 0xe5, 0x59,
-	0x97, 0xc4, 0xc3, 0xa5, 0x2c, 0x12, 0xe7, 0x69, 0x77, 0xcb, 0x97, 0xc7, 0x86, 0xc8, 0x97, 0x63,
-	0x37, 0xaf, 0x0c, 0x74, 0xf3, 0xab, 0x50, 0xf5, 0x92, 0xb2, 0x92, 0x4d, 0x83, 0xb4, 0xc6, 0x13,
-	0xc3, 0x5a, 0x23, 0xad, 0x4c, 0xd3, 0x6c, 0x24, 0x85, 0x31, 0x9c, 0x95, 0x21, 0xcc, 0xe2, 0x05,
-	0x1d, 0x79, 0x4a, 0x4c, 0x57, 0xf3, 0x31, 0x66, 0x45, 0xc3, 0x71, 0x42, 0x61, 0xbe, 0x53, 0x04,
-	0x94, 0xf3, 0x28, 0x11, 0xa9, 0xd8, 0x7f, 0x48, 0xba, 0x12, 0x42, 0x8d, 0x53, 0xab, 0xdd, 0x76,
-	0x6d, 0xa9, 0xd5, 0x2d, 0x24, 0x72, 0xf2, 0x8d, 0x61, 0x3d, 0x7e, 0x63, 0x58, 0x5f, 0xcf, 0x8c,
-	0xce, 0x34, 0xf1, 0x32, 0x50, 0x9c, 0x93, 0x80, 0xde, 0x32, 0x60, 0x52, 0x64, 0x27, 0x59, 0x12,
-	0xdd, 0x7e, 0x7c, 0xe2, 0xd6, 0xc5, 0xe2, 0x1e, 0x0e, 0x69, 0xcb, 0xa3, 0x17, 0x83, 0xfb, 0xa4,
-	0x99, 0x7f, 0x36, 0x60, 0xaa, 0xcf, 0x22, 0xd1, 0x51, 0xf4, 0x7f, 0x3d, 0x28, 0x8b, 0xdc, 0x23,
-	0x0e, 0xb9, 0xcb, 0x07, 0xb2, 0x75, 0x9a, 0xf5, 0xa4, 0x79, 0x92, 0x80, 0x31, 0xac, 0x84, 0x98,
-	0x67, 0x61, 0x3c, 0xd7, 0x6a, 0xdf, 0xfb, 0xfe, 0xc9, 0x7c, 0xaf, 0x0c, 0x93, 0x31, 0x5f, 0xb6,
-	0x16, 0x75, 0xbb, 0x16, 0x3d, 0x8a, 0xea, 0xfd, 0x7b, 0x06, 0x1c, 0xcf, 0x3a, 0xa6, 0x9b, 0x2c,
-	0x51, 0xe3, 0x40, 0x4b, 0xa4, 0x7c, 0xe3, 0xa4, 0x96, 0x7d, 0x7c, 0x35, 0x2f, 0x02, 0xf7, 0xca,
-	0x44, 0xbf, 0x34, 0xe0, 0x7e, 0x25, 0x45, 0xbf, 0xc9, 0xe8, 0x19, 0xa1, 0x1d, 0xf5, 0x30, 0x94,
-	0xfa, 0x5f, 0xad, 0xd4, 0xfd, 0x0b, 0x37, 0x91, 0x87, 0x6f, 0xaa, 0x0d, 0xfa, 0x99, 0x01, 0x77,
-	0x2b, 0x82, 0x5e, 0x3d, 0x4b, 0x87, 0xa6, 0xe7, 0x29, 0xad, 0xe7, 0xdd, 0x0b, 0xbb, 0x09, 0xc2,
-	0xbb, 0xcb, 0x47, 0x0c, 0x2a, 0xdd, 0xb8, 0x53, 0x26, 0x53, 0xab, 0x7d, 0x28, 0xd3, 0xdf, 0x6a,
-	0x4b, 0x73, 0xa2, 0x04, 0x87, 0x53, 0x39, 0xe6, 0x2b, 0x70, 0x57, 0xcb, 0xea, 0xe8, 0x9a, 0x71,
-	0x99, 0xf0, 0xcb, 0xa1, 0xf8, 0xc1, 0x54, 0x23, 0xbb, 0xa3, 0xdc, 0xbe, 0x98, 0x6d, 0x64, 0x77,
-	0x08, 0x96, 0x18, 0xf4, 0x20, 0x94, 0x3d, 0xb7, 0xeb, 0x72, 0x5d, 0x02, 0x24, 0xdb, 0x69, 0x45,
-	0x00, 0xb1, 0xc2, 0x99, 0x16, 0xd4, 0xb2, 0x6d, 0xb8, 0xdb, 0x71, 0x9b, 0xfb, 0xbe, 0x01, 0xa3,
-	0xba, 0xa2, 0x3b, 0x60, 0x96, 0xb5, 0x77, 0x7f, 0x2f, 0x4d, 0x17, 0x8a, 0x87, 0x99, 0x2e, 0x98,
-	0xbf, 0x29, 0x42, 0x7c, 0xd7, 0x86, 0x1e, 0xcd, 0xf4, 0x10, 0xd5, 0x14, 0xa6, 0xf7, 0xee, 0x1f,
-	0xa2, 0x55, 0xdd, 0xbd, 0x2c, 0xec, 0x71, 0xd6, 0x44, 0xdc, 0xf5, 0xea, 0xea, 0xa1, 0x77, 0xbd,
-	0xe9, 0xf3, 0xcb, 0x74, 0x8d, 0x53, 0xd7, 0xef, 0xa8, 0x7e, 0x70, 0xa6, 0xd7, 0xf9, 0x7f, 0x30,
-	0x4a, 0x7c, 0xd9, 0x18, 0x95, 0x53, 0x2d, 0xab, 0x8e, 0xce, 0x92, 0x02, 0xe1, 0x18, 0x87, 0xce,
-	0xc0, 0x98, 0x6b, 0x77, 0x43, 0x91, 0x95, 0xcb, 0xac, 0xb9, 0xac, 0x1a, 0x30, 0xcd, 0xc5, 0x4b,
-	0x2d, 0x99, 0xa9, 0x27, 0xd8, 0x98, 0x72, 0x31, 0xbe, 0x03, 0xcd, 0x50, 0x0a, 0x18, 0x4e, 0xb0,
-	0x92, 0xb2, 0xa3, 0x79, 0x8e, 0x64, 0x28, 0x97, 0x13, 0x9e, 0x1a, 0x8b, 0xce, 0xeb, 0x37, 0x37,
-	0xba, 0x6a, 0x93, 0x49, 0x56, 0xa5, 0xe7, 0xd9, 0x4c, 0xdc, 0x89, 0xcf, 0x51, 0x8a, 0xe9, 0x31,
-	0x6a, 0xcb, 0xe9, 0x8d, 0xa5, 0xd3, 0x5b, 0x53, 0x20, 0x1c, 0xe3, 0x50, 0x1d, 0x80, 0x51, 0x5b,
-	0xcf, 0x5a, 0x26, 0x54, 0xe5, 0xc6, 0x84, 0x38, 0x91, 0xd7, 0x12, 0x28, 0xce, 0x50, 0x98, 0x04,
-	0x26, 0x7b, 0xeb, 0xaa, 0xdb, 0xe1, 0xf2, 0xef, 0x94, 0xe0, 0xe4, 0x5a, 0x14, 0x0a, 0x43, 0xa9,
-	0x97, 0x81, 0x8b, 0x81, 0xe7, 0x69, 0x27, 0xbe, 0xfd, 0x81, 0xe7, 0x65, 0xa8, 0x90, 0x6b, 0xa1,
-	0x4b, 0x89, 0xb3, 0x10, 0xfb, 0xdb, 0x17, 0x6e, 0x4d, 0xc4, 0xba, 0xdb, 0x25, 0xe9, 0xd4, 0x96,
-	0x62, 0x26, 0x38, 0xe5, 0x27, 0xd6, 0x82, 0xb9, 0xbe, 0x4d, 0x04, 0xa9, 0xde, 0x64, 0xc9, 0x80,
-	0xb5, 0x18, 0x81, 0x53, 0x1a, 0x51, 0x0c, 0xb7, 0x93, 0x47, 0x98, 0xd2, 0x07, 0xf7, 0x51, 0x0c,
-	0xf7, 0x3e, 0xe6, 0x4c, 0x57, 0x20, 0x85, 0xe1, 0x8c, 0x1c, 0xf4, 0x23, 0x03, 0x26, 0xac, 0xfc,
-	0x73, 0x48, 0x75, 0xb1, 0x7f, 0x69, 0x7f, 0xa2, 0x07, 0x3c, 0xed, 0x6c, 0xdc, 0xa3, 0xf5, 0x98,
-	0xe8, 0x79, 0x17, 0xd9, 0x23, 0xdc, 0xfc, 0xd4, 0x80, 0xfb, 0x06, 0x78, 0xc4, 0x11, 0x34, 0xb0,
-	0xbc, 0x7c, 0x03, 0x6b, 0xe8, 0x14, 0x6d, 0x80, 0xe6, 0x03, 0x5a, 0x59, 0x3f, 0x2d, 0xc0, 0x03,
-	0x03, 0x46, 0xec, 0xbb, 0xa9, 0xf5, 0x24, 0x8c, 0xc7, 0xbf, 0xb3, 0xdb, 0x30, 0x2d, 0x08, 0xb2,
-	0x48, 0x9c, 0xa7, 0x8d, 0x45, 0xc9, 0x03, 0xab, 0xd8, 0x2f, 0x4a, 0x1d, 0x5a, 0x31, 0x85, 0xf0,
-	0x70, 0x3b, 0xe8, 0x86, 0x1e, 0xe1, 0x44, 0x75, 0x1a, 0xc6, 0x52, 0x0f, 0x5f, 0x8c, 0x11, 0x38,
-	0xa5, 0x11, 0x81, 0x96, 0x50, 0x1a, 0x50, 0xe9, 0x61, 0x99, 0xbb, 0xb2, 0x25, 0x01, 0xc4, 0x0a,
-	0x67, 0xfe, 0xdd, 0x80, 0x53, 0x03, 0x16, 0xe5, 0xc8, 0x32, 0xf5, 0xad, 0x7c, 0xa6, 0xfe, 0xdc,
-	0x21, 0xb9, 0xc1, 0x9e, 0x39, 0xfb, 0xc3, 0x50, 0xcd, 0x5c, 0x40, 0xa2, 0x53, 0x50, 0x64, 0xbe,
-	0xdb, 0xfb, 0x10, 0x7b, 0x6d, 0xb5, 0x89, 0x05, 0xbc, 0xb1, 0xfe, 0xc1, 0xc7, 0x33, 0xc7, 0x3e,
-	0xfc, 0x78, 0xe6, 0xd8, 0x47, 0x1f, 0xcf, 0x1c, 0x7b, 0x6b, 0x67, 0xc6, 0xf8, 0x60, 0x67, 0xc6,
-	0xf8, 0x70, 0x67, 0xc6, 0xf8, 0x68, 0x67, 0xc6, 0xf8, 0xe3, 0xce, 0x8c, 0xf1, 0x93, 0x3f, 0xcd,
-	0x1c, 0x7b, 0xa9, 0x3e, 0xdc, 0x7f, 0xa8, 0xfd, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x53, 0x7e, 0xc9,
-	0xb1, 0xd2, 0x36, 0x00, 0x00,
+	0x95, 0xea, 0xf9, 0xf0, 0xf3, 0x66, 0x48, 0x51, 0x45, 0xdb, 0x9a, 0xb5, 0x2d, 0x52, 0x6e, 0xef,
+	0x1a, 0xda, 0x85, 0x77, 0x68, 0xc9, 0x1f, 0x69, 0xd7, 0xb6, 0x76, 0x39, 0x24, 0x45, 0x4f, 0x4c,
+	0x52, 0xa3, 0x1a, 0xca, 0x46, 0xec, 0xd8, 0x71, 0xb3, 0xbb, 0x66, 0xd8, 0x62, 0x4f, 0x77, 0xab,
+	0xba, 0x86, 0x12, 0x0d, 0x24, 0xb0, 0x91, 0xe4, 0xe0, 0xfc, 0x9c, 0x0f, 0x82, 0xc0, 0xb7, 0xdc,
+	0x72, 0x09, 0x90, 0x43, 0x6e, 0xbe, 0xe5, 0x10, 0xc0, 0x47, 0x07, 0x49, 0x10, 0x9f, 0x88, 0x98,
+	0x41, 0x12, 0xe4, 0x60, 0x04, 0xc8, 0x2d, 0x0a, 0x02, 0x04, 0xf5, 0xe9, 0xef, 0x90, 0xa2, 0x66,
+	0x48, 0x31, 0x41, 0xac, 0xd3, 0x4c, 0xbf, 0xf7, 0xea, 0xbd, 0x57, 0x55, 0xaf, 0xde, 0xaf, 0xba,
+	0xe1, 0xa2, 0xe1, 0x32, 0x4a, 0x8c, 0xaa, 0xed, 0xcd, 0xc8, 0x7f, 0x33, 0xfe, 0x46, 0x7b, 0xc6,
+	0xf0, 0xed, 0x60, 0xc6, 0xf4, 0x5c, 0x46, 0x3d, 0xc7, 0x77, 0x0c, 0x97, 0xcc, 0x6c, 0x9e, 0x5d,
+	0x23, 0xcc, 0x38, 0x37, 0xd3, 0x26, 0x2e, 0xa1, 0x06, 0x23, 0x56, 0xd5, 0xa7, 0x1e, 0xf3, 0x50,
+	0x55, 0x8e, 0xfa, 0xbc, 0xed, 0xa9, 0x7f, 0x55, 0x7f, 0xa3, 0x5d, 0xe5, 0xe3, 0xab, 0xc9, 0xf1,
+	0x55, 0x35, 0xfe, 0xc1, 0x0b, 0x7b, 0xcb, 0x0b, 0x98, 0xc1, 0x82, 0x99, 0xcd, 0xb3, 0x86, 0xe3,
+	0xaf, 0x1b, 0x67, 0xb3, 0x92, 0x1e, 0xfc, 0xef, 0xb6, 0xcd, 0xd6, 0xbb, 0x6b, 0x55, 0xd3, 0xeb,
+	0xcc, 0xb4, 0xbd, 0xb6, 0x37, 0x23, 0xc0, 0x6b, 0xdd, 0x96, 0x78, 0x12, 0x0f, 0xe2, 0x9f, 0x22,
+	0x7f, 0x6a, 0xe3, 0x42, 0x20, 0xa4, 0xf8, 0x76, 0xc7, 0x30, 0xd7, 0x6d, 0x97, 0xd0, 0xad, 0x58,
+	0x56, 0x87, 0x30, 0x63, 0x66, 0xb3, 0x57, 0xc8, 0xcc, 0x5e, 0xa3, 0x68, 0xd7, 0x65, 0x76, 0x87,
+	0xf4, 0x0c, 0x78, 0x66, 0xbf, 0x01, 0x81, 0xb9, 0x4e, 0x3a, 0x46, 0xcf, 0xb8, 0x27, 0xf7, 0x1a,
+	0xd7, 0x65, 0xb6, 0x33, 0x63, 0xbb, 0x2c, 0x60, 0x34, 0x3b, 0x48, 0xff, 0x83, 0x06, 0xe5, 0x59,
+	0xcb, 0xa2, 0x24, 0x08, 0x16, 0xa9, 0xd7, 0xf5, 0xd1, 0x1b, 0x30, 0xc2, 0x67, 0x62, 0x19, 0xcc,
+	0xa8, 0x68, 0xa7, 0xb5, 0x33, 0xa5, 0x73, 0x4f, 0x54, 0x25, 0xe3, 0x6a, 0x92, 0x71, 0xbc, 0x27,
+	0x9c, 0xba, 0xba, 0x79, 0xb6, 0x7a, 0x79, 0xed, 0x1a, 0x31, 0xd9, 0x32, 0x61, 0x46, 0x0d, 0x7d,
+	0xb0, 0x3d, 0x7d, 0x6c, 0x67, 0x7b, 0x1a, 0x62, 0x18, 0x8e, 0xb8, 0xa2, 0x2e, 0x94, 0xdb, 0x5c,
+	0xd4, 0x32, 0xe9, 0xac, 0x11, 0x1a, 0x54, 0x72, 0xa7, 0xf3, 0x67, 0x4a, 0xe7, 0x9e, 0xed, 0x73,
+	0xdb, 0xab, 0x8b, 0x31, 0x8f, 0xda, 0x7d, 0x4a, 0x60, 0x39, 0x01, 0x0c, 0x70, 0x4a, 0x8c, 0xfe,
+	0x0b, 0x0d, 0x26, 0x92, 0x33, 0x5d, 0xb2, 0x03, 0x86, 0x3e, 0xd7, 0x33, 0xdb, 0xea, 0x9d, 0xcd,
+	0x96, 0x8f, 0x16, 0x73, 0x9d, 0x50, 0xa2, 0x47, 0x42, 0x48, 0x62, 0xa6, 0x06, 0x14, 0x6d, 0x46,
+	0x3a, 0xe1, 0x14, 0x9f, 0xeb, 0x77, 0x8a, 0x49, 0x75, 0x6b, 0x63, 0x4a, 0x50, 0xb1, 0xce, 0x59,
+	0x62, 0xc9, 0x59, 0x7f, 0x27, 0x0f, 0x27, 0x92, 0x64, 0x0d, 0x83, 0x99, 0xeb, 0x47, 0xb0, 0x89,
+	0x5f, 0xd6, 0xe0, 0x84, 0x61, 0x59, 0xc4, 0x5a, 0x3c, 0xe4, 0xad, 0xfc, 0x37, 0x25, 0xf6, 0xc4,
+	0x6c, 0x96, 0x3b, 0xee, 0x15, 0x88, 0xbe, 0xaa, 0xc1, 0x24, 0x25, 0x1d, 0x6f, 0x33, 0xa3, 0x48,
+	0xfe, 0xe0, 0x8a, 0x3c, 0xa4, 0x14, 0x99, 0xc4, 0xbd, 0xfc, 0xf1, 0x6e, 0x42, 0xf5, 0x3f, 0x6a,
+	0x30, 0x3e, 0xeb, 0xfb, 0x8e, 0x4d, 0xac, 0x55, 0xef, 0x5f, 0xfc, 0x34, 0xfd, 0x5a, 0x03, 0x94,
+	0x9e, 0xeb, 0x11, 0x9c, 0x27, 0x33, 0x7d, 0x9e, 0x2e, 0xf6, 0x7d, 0x9e, 0x52, 0x0a, 0xef, 0x71,
+	0xa2, 0xbe, 0x96, 0x87, 0xc9, 0x34, 0xe1, 0xbd, 0x33, 0xf5, 0x8f, 0x3b, 0x53, 0xd7, 0x61, 0xb2,
+	0x66, 0x04, 0xb6, 0x39, 0xdb, 0x65, 0xeb, 0xc4, 0x65, 0xb6, 0x69, 0x30, 0xdb, 0x73, 0xd1, 0xe3,
+	0x30, 0xd2, 0x0d, 0x08, 0x75, 0x8d, 0x0e, 0x11, 0x9b, 0x31, 0x1a, 0xdb, 0xcd, 0x55, 0x05, 0xc7,
+	0x11, 0x05, 0xa7, 0xf6, 0x8d, 0x20, 0xb8, 0xe1, 0x51, 0xab, 0x92, 0x4b, 0x53, 0x37, 0x14, 0x1c,
+	0x47, 0x14, 0xfa, 0x35, 0x98, 0xa8, 0x75, 0x5d, 0xcb, 0x21, 0x97, 0x6c, 0x87, 0x34, 0x09, 0xdd,
+	0x24, 0x14, 0x9d, 0x82, 0x7c, 0x97, 0x3a, 0x4a, 0x54, 0x49, 0x0d, 0xce, 0x5f, 0xc5, 0x4b, 0x98,
+	0xc3, 0xd1, 0x79, 0x18, 0x5b, 0xf7, 0x02, 0xd6, 0xe8, 0xae, 0x39, 0xb6, 0xf9, 0x22, 0xd9, 0x12,
+	0x52, 0xca, 0xb5, 0x13, 0x3b, 0xdb, 0xd3, 0x63, 0x2f, 0x24, 0x11, 0x38, 0x4d, 0xa7, 0xbf, 0x9b,
+	0x83, 0x53, 0x52, 0x98, 0x14, 0xc4, 0xa7, 0x39, 0xe7, 0xb9, 0x2d, 0xbb, 0xdd, 0xa5, 0x72, 0xa6,
+	0x4f, 0x43, 0x69, 0x8d, 0x18, 0x94, 0xd0, 0x55, 0x6f, 0x83, 0xb8, 0x4a, 0x83, 0x49, 0xa5, 0x41,
+	0xa9, 0x16, 0xa3, 0x70, 0x92, 0x0e, 0x3d, 0x06, 0x43, 0x86, 0x6f, 0x87, 0xaa, 0x8c, 0xd6, 0xc6,
+	0xd5, 0x88, 0xa1, 0xd9, 0x46, 0x9d, 0xeb, 0xa1, 0xb0, 0xe8, 0x9b, 0x1a, 0x4c, 0xae, 0xf5, 0x2e,
+	0x70, 0x25, 0x2f, 0x2c, 0x7c, 0xae, 0xdf, 0xcd, 0xde, 0x65, 0xaf, 0x6a, 0x27, 0xf9, 0x86, 0xef,
+	0x82, 0xc0, 0xbb, 0x09, 0xd6, 0x7f, 0x50, 0x80, 0xc9, 0x39, 0xa7, 0x1b, 0x30, 0x42, 0x53, 0x56,
+	0x79, 0xf7, 0x8f, 0xdf, 0xdb, 0x1a, 0x4c, 0x90, 0x56, 0x8b, 0x98, 0xcc, 0xde, 0x24, 0x87, 0x78,
+	0xfa, 0x2a, 0x4a, 0xea, 0xc4, 0x42, 0x86, 0x39, 0xee, 0x11, 0x87, 0xbe, 0x08, 0x27, 0x22, 0x58,
+	0xbd, 0x51, 0x73, 0x3c, 0x73, 0x23, 0x3c, 0x78, 0x4f, 0xf7, 0xab, 0x43, 0xbd, 0xb1, 0x42, 0x58,
+	0x7c, 0xf6, 0x17, 0xb2, 0x7c, 0x71, 0xaf, 0x28, 0x74, 0x01, 0xca, 0xcc, 0x63, 0x86, 0x13, 0x4e,
+	0xbf, 0x70, 0x5a, 0x3b, 0x93, 0x8f, 0x03, 0xc2, 0x6a, 0x02, 0x87, 0x53, 0x94, 0xe8, 0x1c, 0x80,
+	0x78, 0x6e, 0x18, 0x6d, 0x12, 0x54, 0x8a, 0x62, 0x5c, 0xb4, 0xde, 0xab, 0x11, 0x06, 0x27, 0xa8,
+	0xb8, 0x6d, 0x9b, 0x5d, 0x4a, 0x89, 0xcb, 0xf8, 0x73, 0x65, 0x48, 0x0c, 0x8a, 0x6c, 0x7b, 0x2e,
+	0x46, 0xe1, 0x24, 0x9d, 0x7e, 0x11, 0x4a, 0xf3, 0x2b, 0xcd, 0x06, 0xf5, 0x98, 0x67, 0x7a, 0x0e,
+	0x9a, 0x81, 0xd1, 0xeb, 0x5d, 0x42, 0xb7, 0x56, 0x62, 0x67, 0x70, 0x42, 0xf1, 0x18, 0xbd, 0x12,
+	0x22, 0x70, 0x4c, 0xa3, 0xff, 0x5e, 0x83, 0xd2, 0x42, 0xfb, 0x53, 0x90, 0xf2, 0xfe, 0x5c, 0x83,
+	0xe3, 0x89, 0x89, 0x1e, 0x41, 0x84, 0x7e, 0x23, 0x1d, 0xa1, 0xfb, 0x9e, 0x61, 0x42, 0xdb, 0x3d,
+	0xc2, 0xf3, 0xd7, 0xf3, 0x30, 0x91, 0xa0, 0x92, 0xb1, 0xd9, 0x02, 0xf0, 0xa2, 0x75, 0x3f, 0xd4,
+	0x3d, 0x4c, 0xf0, 0xbd, 0x17, 0x9f, 0x77, 0x89, 0xcf, 0x06, 0x0c, 0x2d, 0xb8, 0xcc, 0x66, 0x5b,
+	0xe8, 0x65, 0xc8, 0xfb, 0x9e, 0xa5, 0x16, 0xbf, 0xef, 0x52, 0xa7, 0xe1, 0x59, 0x98, 0xb4, 0x08,
+	0x25, 0xae, 0x49, 0x6a, 0xc3, 0x3c, 0xb8, 0x72, 0x08, 0xe7, 0xa8, 0x3b, 0x70, 0x72, 0xe1, 0x26,
+	0xe3, 0xa1, 0xdc, 0x91, 0xa2, 0x22, 0x42, 0x74, 0x1a, 0x0a, 0x89, 0x14, 0xa0, 0xac, 0xb4, 0x2f,
+	0x88, 0x03, 0x2f, 0x30, 0xdc, 0x39, 0xf0, 0xdf, 0xc0, 0x37, 0x4c, 0x52, 0xc9, 0xa5, 0x9d, 0xc3,
+	0x4a, 0x88, 0xc0, 0x31, 0x8d, 0x6e, 0x40, 0x79, 0x11, 0x37, 0xe6, 0x22, 0xef, 0xf2, 0x9f, 0x30,
+	0x1c, 0x10, 0xba, 0x69, 0x9b, 0xa1, 0x94, 0xe3, 0x6a, 0xf8, 0x70, 0x53, 0x82, 0x71, 0x88, 0xe7,
+	0x31, 0xb7, 0x43, 0xd8, 0xba, 0x67, 0x65, 0x63, 0xee, 0xb2, 0x80, 0x62, 0x85, 0xd5, 0xff, 0xaa,
+	0xc1, 0x84, 0x58, 0xc4, 0xd9, 0x20, 0xf0, 0x4c, 0x5b, 0xc6, 0xf9, 0x23, 0x49, 0x2f, 0x27, 0x0c,
+	0x25, 0x51, 0xed, 0xe2, 0xc0, 0x99, 0xb4, 0x18, 0x1d, 0x6f, 0x58, 0x14, 0xe2, 0x66, 0x33, 0xfc,
+	0x71, 0x8f, 0x44, 0xfd, 0xfd, 0x02, 0x94, 0x12, 0x26, 0x74, 0xd7, 0xec, 0x06, 0x7d, 0x49, 0x83,
+	0x71, 0x92, 0x32, 0x1c, 0xb1, 0x2f, 0xa5, 0x73, 0x8b, 0x7d, 0x7b, 0xa5, 0xdd, 0xcd, 0xaf, 0x86,
+	0x76, 0xb6, 0xa7, 0xc7, 0x33, 0xc8, 0x8c, 0x48, 0xf4, 0x18, 0xe4, 0x6d, 0x5f, 0x1e, 0xce, 0x72,
+	0xed, 0x3e, 0xae, 0x60, 0xbd, 0x11, 0xdc, 0xda, 0x9e, 0x1e, 0xad, 0x37, 0x54, 0xdd, 0x8e, 0x39,
+	0x01, 0x7a, 0x1d, 0x8a, 0xbe, 0x47, 0x19, 0x0f, 0xb9, 0x7c, 0x47, 0xfe, 0xa7, 0x5f, 0x1d, 0xb9,
+	0x31, 0x5b, 0x0d, 0x8f, 0xb2, 0xd8, 0x6f, 0xf2, 0xa7, 0x00, 0x4b, 0xb6, 0xe8, 0x55, 0x28, 0xb8,
+	0x9e, 0x45, 0x44, 0x64, 0x2e, 0x9d, 0x7b, 0xbe, 0x6f, 0xf6, 0x9e, 0x45, 0xe2, 0x89, 0x8f, 0x88,
+	0x53, 0xc6, 0x41, 0x82, 0x29, 0x6a, 0xc7, 0x87, 0x64, 0x48, 0xf0, 0xff, 0xff, 0x7e, 0xf9, 0x87,
+	0x87, 0x29, 0x12, 0x51, 0xda, 0xed, 0x88, 0xe9, 0xef, 0x15, 0xa0, 0x7c, 0x2f, 0x2d, 0xbc, 0x97,
+	0x16, 0xee, 0x96, 0x16, 0xfe, 0x50, 0x83, 0xf1, 0xb4, 0x5f, 0x4a, 0x7b, 0x7f, 0x6d, 0x7f, 0xef,
+	0x1f, 0x05, 0x94, 0xdc, 0x9e, 0x01, 0xa5, 0x06, 0xf9, 0xae, 0x6d, 0x89, 0xfa, 0x68, 0xb4, 0xf6,
+	0x44, 0x54, 0x09, 0xd6, 0xe7, 0x6f, 0x6d, 0x4f, 0x3f, 0xb2, 0x57, 0x07, 0x96, 0x6d, 0xf9, 0x24,
+	0xa8, 0x5e, 0xad, 0xcf, 0x63, 0x3e, 0x58, 0xff, 0x02, 0x1c, 0x7f, 0x61, 0x75, 0xb5, 0xf1, 0x02,
+	0x31, 0x2c, 0x42, 0x97, 0x45, 0x06, 0xb3, 0x7f, 0x24, 0x7b, 0x14, 0x8a, 0x9b, 0x86, 0xd3, 0x0d,
+	0x75, 0x8b, 0x4e, 0xf9, 0x4b, 0x1c, 0x88, 0x25, 0x8e, 0x13, 0x51, 0xd2, 0x26, 0x37, 0x2b, 0xf9,
+	0x34, 0x11, 0xe6, 0x40, 0x2c, 0x71, 0xfa, 0x77, 0xf3, 0x50, 0xe6, 0xf2, 0xa3, 0x18, 0x77, 0x1a,
+	0x0a, 0xbc, 0x2c, 0xcd, 0x0a, 0xe7, 0x95, 0x2b, 0x16, 0x98, 0x3b, 0x0d, 0x6d, 0x9c, 0x93, 0x6f,
+	0xb0, 0xf5, 0x4a, 0x3e, 0xcd, 0xa9, 0x61, 0xb0, 0x75, 0x2c, 0x30, 0x7c, 0x4b, 0xf8, 0xaf, 0x50,
+	0xa8, 0x52, 0x48, 0x6f, 0x49, 0x23, 0x44, 0xe0, 0x98, 0x06, 0x5d, 0x83, 0xe1, 0x75, 0xb1, 0x50,
+	0xdc, 0x7c, 0xb8, 0xc5, 0xff, 0x5f, 0xbf, 0x16, 0x9f, 0x59, 0xeb, 0x38, 0x82, 0x4b, 0x60, 0x80,
+	0x43, 0x01, 0xe8, 0x4d, 0x28, 0x89, 0x32, 0xa1, 0x61, 0x50, 0xa3, 0x13, 0x54, 0x86, 0x4e, 0xe7,
+	0x07, 0x29, 0x82, 0xb9, 0xbc, 0x2b, 0x11, 0x1b, 0x29, 0x33, 0x32, 0xdf, 0x18, 0x11, 0xe0, 0xa4,
+	0x30, 0xfd, 0x6d, 0x0d, 0x26, 0x77, 0x19, 0x79, 0xa4, 0x96, 0xf1, 0x53, 0x0d, 0x86, 0xd5, 0xa1,
+	0x47, 0x2f, 0x43, 0xc1, 0xb4, 0x2d, 0xaa, 0xbc, 0xea, 0x80, 0x6e, 0x26, 0x52, 0x77, 0xae, 0x3e,
+	0x8f, 0xb1, 0x60, 0x88, 0x5e, 0x83, 0x21, 0x72, 0xd3, 0x24, 0x3e, 0x53, 0x5e, 0x74, 0x40, 0xd6,
+	0x91, 0x09, 0x2e, 0x08, 0x66, 0x58, 0x31, 0xd5, 0xff, 0xa6, 0x01, 0xaa, 0x37, 0x3e, 0xbd, 0xf9,
+	0x55, 0x0b, 0x8a, 0x62, 0x81, 0xd0, 0xa3, 0x90, 0xb3, 0x7d, 0x31, 0xd7, 0x72, 0x6d, 0x72, 0x67,
+	0x7b, 0x3a, 0x57, 0x6f, 0xa4, 0xf3, 0x8e, 0x9c, 0xed, 0x73, 0xcf, 0xee, 0x53, 0xd2, 0xb2, 0x6f,
+	0x2e, 0x11, 0xb7, 0xcd, 0xd6, 0x85, 0x09, 0x15, 0x63, 0xcf, 0xde, 0x48, 0xe0, 0x70, 0x8a, 0x52,
+	0xff, 0xb6, 0x06, 0x63, 0x2f, 0x1a, 0xad, 0x0d, 0x23, 0x72, 0x23, 0xaf, 0x46, 0x3d, 0x27, 0x69,
+	0xab, 0x73, 0xe9, 0x9e, 0xd3, 0xad, 0xed, 0xe9, 0xb3, 0xb7, 0xb9, 0x1a, 0xa4, 0x96, 0xba, 0x11,
+	0x3c, 0x5b, 0x15, 0x6c, 0x33, 0x8d, 0xaa, 0x47, 0xa1, 0xc8, 0x3c, 0xdf, 0x36, 0xb3, 0x46, 0xbe,
+	0xca, 0x81, 0x58, 0xe2, 0xf4, 0x5f, 0xe6, 0x01, 0x96, 0xce, 0x47, 0x0a, 0xbd, 0x02, 0x85, 0x75,
+	0xc6, 0xfc, 0x41, 0x73, 0xcb, 0xa4, 0x8f, 0x94, 0x29, 0x0f, 0x87, 0x60, 0xc1, 0x13, 0xbd, 0x04,
+	0x79, 0xe6, 0x04, 0x2a, 0xa3, 0xec, 0x3b, 0x11, 0x58, 0x5d, 0x8a, 0xfa, 0x17, 0x32, 0x6b, 0x5d,
+	0x5d, 0x6a, 0x62, 0xce, 0x90, 0xeb, 0xdc, 0xa6, 0xbe, 0x59, 0xc9, 0x0f, 0xa6, 0x73, 0xb2, 0x76,
+	0x91, 0x3a, 0x73, 0x08, 0x16, 0x3c, 0x79, 0x8e, 0xb9, 0xc1, 0x97, 0xb6, 0x52, 0x18, 0x2c, 0x09,
+	0x4c, 0x6d, 0x77, 0x6d, 0x94, 0x2f, 0xbf, 0x00, 0x61, 0xc9, 0x96, 0xaf, 0x89, 0xe5, 0x06, 0x95,
+	0xe2, 0x60, 0x6b, 0x32, 0xbf, 0x92, 0x59, 0x93, 0xf9, 0x95, 0x26, 0xe6, 0x0c, 0xf5, 0xf7, 0x34,
+	0x40, 0xcb, 0x5d, 0x87, 0xd9, 0xa6, 0x11, 0x30, 0x61, 0xe6, 0x75, 0xb7, 0xe5, 0x71, 0x93, 0x10,
+	0xed, 0x8e, 0x8a, 0x96, 0x36, 0x09, 0x79, 0x78, 0x24, 0x0e, 0xbd, 0x0e, 0x05, 0xdf, 0xb3, 0x06,
+	0xbe, 0x82, 0x4b, 0xd5, 0x17, 0x71, 0x3c, 0xf3, 0xac, 0x00, 0x0b, 0xbe, 0xfa, 0x3b, 0x1a, 0x8c,
+	0x46, 0xb9, 0xb7, 0x88, 0x7f, 0x1e, 0x95, 0x91, 0xb4, 0x98, 0xa4, 0xa7, 0x0c, 0x17, 0x7c, 0x45,
+	0xb1, 0x4f, 0x86, 0x71, 0x01, 0x46, 0x7c, 0xb5, 0x0e, 0xca, 0x59, 0x3f, 0x1c, 0x75, 0xab, 0x15,
+	0xfc, 0x56, 0xe2, 0x3f, 0x8e, 0xa8, 0xf5, 0x4f, 0xf2, 0x30, 0xb6, 0x42, 0xd8, 0x0d, 0x8f, 0x6e,
+	0x34, 0x3c, 0xc7, 0x36, 0xb7, 0x8e, 0xc0, 0xeb, 0xb5, 0xa0, 0x48, 0xbb, 0x0e, 0x09, 0x17, 0x78,
+	0xb6, 0xef, 0xc2, 0x22, 0xa9, 0x2f, 0xee, 0x3a, 0x24, 0x11, 0x9a, 0x38, 0x5f, 0x2c, 0xd9, 0xa3,
+	0xe7, 0xe1, 0xb8, 0x91, 0xba, 0x95, 0x91, 0x09, 0xf0, 0xa8, 0x70, 0x6d, 0xc7, 0xd3, 0x17, 0x36,
+	0x01, 0xce, 0xd2, 0xa2, 0x33, 0x7c, 0x51, 0x6d, 0x8f, 0xf2, 0x2a, 0x90, 0x5b, 0xbf, 0x56, 0x2b,
+	0xcb, 0x05, 0x95, 0x30, 0x1c, 0x61, 0xd1, 0x53, 0x50, 0x66, 0x36, 0xa1, 0x21, 0x46, 0x58, 0x73,
+	0xb1, 0x36, 0x21, 0xf2, 0xdc, 0x04, 0x1c, 0xa7, 0xa8, 0x50, 0x00, 0xa3, 0x81, 0xd7, 0xa5, 0xa2,
+	0x82, 0x51, 0x35, 0xd0, 0xa5, 0x83, 0x2d, 0x45, 0x64, 0x75, 0x63, 0x3c, 0x35, 0x6a, 0x86, 0xcc,
+	0x71, 0x2c, 0x47, 0xff, 0x24, 0x07, 0x27, 0x53, 0x83, 0x16, 0x78, 0xac, 0xef, 0x8d, 0x77, 0xf9,
+	0xbb, 0xd4, 0xd4, 0x1c, 0xa6, 0xe4, 0x7a, 0x97, 0xa8, 0xc4, 0xb1, 0x74, 0x6e, 0xe5, 0x40, 0x13,
+	0x8e, 0x75, 0xc7, 0x92, 0xab, 0x2c, 0x01, 0xd5, 0x03, 0x0e, 0x65, 0xa1, 0x2d, 0x18, 0xa1, 0x24,
+	0xf0, 0x3d, 0x37, 0x20, 0xca, 0xfb, 0x5e, 0x3e, 0x34, 0xb9, 0x92, 0xad, 0x34, 0x8d, 0xf0, 0x09,
+	0x47, 0xe2, 0xf4, 0x3f, 0x69, 0x30, 0x75, 0x7b, 0x9d, 0xd1, 0xeb, 0x30, 0x24, 0xf7, 0x47, 0xad,
+	0xc9, 0x33, 0x7d, 0xf7, 0x1a, 0x44, 0xdb, 0x20, 0xce, 0x6e, 0xd4, 0xc6, 0x2b, 0xae, 0xa8, 0x03,
+	0x25, 0x8b, 0x04, 0xcc, 0x76, 0x85, 0xd4, 0x4a, 0xee, 0x40, 0x42, 0xa2, 0xa4, 0x74, 0x3e, 0x66,
+	0x89, 0x93, 0xfc, 0xf5, 0x9f, 0xe4, 0x60, 0x7a, 0x9f, 0xd5, 0xe2, 0x7d, 0x96, 0x31, 0x37, 0x49,
+	0x53, 0xd1, 0x0e, 0xd5, 0xfe, 0xef, 0x57, 0x5a, 0xa6, 0x5d, 0x1b, 0x4e, 0xcb, 0xe4, 0x75, 0x05,
+	0x77, 0x14, 0x75, 0xd7, 0x22, 0x37, 0x55, 0x16, 0x13, 0xd5, 0x15, 0x38, 0x44, 0xe0, 0x98, 0x06,
+	0x7d, 0x16, 0x0a, 0xfc, 0x41, 0x1d, 0x8e, 0xf3, 0xfd, 0x2a, 0xcb, 0x79, 0x62, 0xd2, 0x8a, 0x3d,
+	0xb8, 0x00, 0x08, 0x96, 0xfa, 0xaf, 0x34, 0x38, 0x91, 0x52, 0xf6, 0x08, 0x3a, 0xef, 0x6b, 0xe9,
+	0xce, 0xfb, 0xf3, 0x07, 0x5a, 0xfc, 0x3d, 0x7a, 0xef, 0x7f, 0xd6, 0x32, 0xfe, 0x86, 0xb7, 0x80,
+	0x9a, 0xcc, 0x60, 0xdd, 0x80, 0xdf, 0xb1, 0xf2, 0x56, 0xd0, 0xca, 0x2e, 0x37, 0xb2, 0x2b, 0x0a,
+	0x8e, 0x23, 0x0a, 0xde, 0x16, 0x50, 0x6f, 0x22, 0x85, 0x56, 0x9c, 0x68, 0x0b, 0x2c, 0x46, 0x18,
+	0x9c, 0xa0, 0x42, 0x9f, 0x01, 0x44, 0x89, 0xe1, 0xd8, 0x6f, 0x8a, 0xc7, 0x4b, 0x86, 0xed, 0x74,
+	0xa9, 0xdc, 0xbe, 0x91, 0xda, 0x83, 0x6a, 0x2c, 0xc2, 0x3d, 0x14, 0x78, 0x97, 0x51, 0xbc, 0xab,
+	0xdb, 0x21, 0x41, 0xc0, 0xdb, 0x0b, 0x85, 0x74, 0x57, 0x77, 0x59, 0x82, 0x71, 0x88, 0x17, 0x6f,
+	0xd8, 0xa4, 0x26, 0xdd, 0x20, 0x84, 0xf2, 0x1b, 0x5f, 0x23, 0xf1, 0xda, 0x4d, 0x50, 0xd1, 0x44,
+	0x30, 0x12, 0x37, 0xbe, 0xc9, 0xf7, 0x71, 0x02, 0x9c, 0xa6, 0x43, 0x04, 0x46, 0x6c, 0x5f, 0x75,
+	0x70, 0xe4, 0x56, 0x9d, 0xef, 0xbf, 0xfe, 0x11, 0xe3, 0xe3, 0x05, 0x8e, 0x5a, 0x37, 0x11, 0x6b,
+	0x34, 0x0d, 0xc5, 0xd6, 0x75, 0xcb, 0x0d, 0x83, 0xa4, 0xc8, 0xd5, 0x2e, 0x5d, 0x99, 0x5f, 0x09,
+	0xb0, 0x84, 0x23, 0xc6, 0x1b, 0x33, 0xaa, 0xbf, 0x16, 0x36, 0x1d, 0x0f, 0xde, 0xb5, 0x4b, 0xb4,
+	0x76, 0x42, 0xde, 0x38, 0x21, 0x87, 0x47, 0x71, 0xc7, 0x58, 0x23, 0x4e, 0xdd, 0x22, 0xdc, 0x05,
+	0xd9, 0x44, 0x16, 0xf5, 0x63, 0x32, 0x8a, 0x2f, 0xa5, 0x51, 0x38, 0x4b, 0xcb, 0x6f, 0xee, 0x1e,
+	0xd8, 0xdd, 0x4b, 0xa0, 0xa7, 0xa1, 0xc0, 0xbb, 0x2c, 0xca, 0xf6, 0x1e, 0x09, 0x4f, 0xe5, 0xea,
+	0x96, 0x4f, 0x6e, 0x6d, 0x4f, 0xa7, 0x77, 0x90, 0x03, 0xb1, 0x20, 0xef, 0xfb, 0x7e, 0x20, 0xca,
+	0xdf, 0xf2, 0xfb, 0x75, 0x88, 0x0a, 0x07, 0xe9, 0x10, 0x7d, 0x6f, 0x24, 0x63, 0x74, 0xdc, 0xbb,
+	0xa0, 0xe7, 0x60, 0xd4, 0xb2, 0x29, 0x31, 0xc5, 0xa1, 0x91, 0x13, 0x9d, 0x0a, 0x95, 0x9d, 0x0f,
+	0x11, 0xb7, 0x92, 0x0f, 0x38, 0x1e, 0x80, 0x4c, 0x28, 0xb4, 0xa8, 0xd7, 0x51, 0x31, 0xe3, 0x60,
+	0x89, 0x1a, 0x3f, 0x03, 0xf1, 0xe4, 0x2f, 0x51, 0xaf, 0x83, 0x05, 0x73, 0xf4, 0x1a, 0xe4, 0x98,
+	0x57, 0xc9, 0x1f, 0x96, 0x08, 0x50, 0x22, 0x72, 0xab, 0x1e, 0xce, 0x31, 0x8f, 0x9f, 0x9e, 0x20,
+	0x6d, 0xb3, 0xe7, 0x07, 0xb4, 0xd9, 0xf8, 0xf4, 0x44, 0x86, 0x1a, 0xb1, 0x16, 0x2f, 0x8c, 0x64,
+	0xf2, 0xbf, 0x38, 0x05, 0xef, 0xc9, 0x18, 0x5f, 0x82, 0x21, 0x43, 0xee, 0xc9, 0x90, 0xd8, 0x93,
+	0x8b, 0xa2, 0xe6, 0x0d, 0x37, 0xe3, 0x89, 0x3b, 0xab, 0x79, 0xf9, 0x06, 0xcb, 0x31, 0x58, 0x71,
+	0x43, 0xcf, 0xc2, 0x18, 0x71, 0x8d, 0x35, 0x87, 0x2c, 0x79, 0xed, 0xb6, 0xed, 0xb6, 0x2b, 0xc3,
+	0xc2, 0xd7, 0x45, 0xf1, 0x70, 0x21, 0x89, 0xc4, 0x69, 0xda, 0xdd, 0xf2, 0xe5, 0x91, 0x3e, 0xf2,
+	0xe5, 0xd0, 0xcc, 0x47, 0xf7, 0x34, 0xf3, 0xeb, 0x50, 0x72, 0xa2, 0x52, 0x3b, 0xa8, 0x80, 0xd8,
+	0x8d, 0xff, 0xed, 0x77, 0x37, 0xe2, 0x6a, 0x3d, 0xce, 0x46, 0x62, 0x58, 0x80, 0x93, 0x32, 0xf8,
+	0xb6, 0x38, 0x5e, 0x5b, 0x78, 0x89, 0x4a, 0x29, 0x1d, 0x63, 0x96, 0x14, 0x1c, 0x47, 0x14, 0xa8,
+	0x05, 0x23, 0xfc, 0x4d, 0x59, 0x8b, 0x07, 0xf9, 0xf2, 0x60, 0xd5, 0x34, 0xdf, 0x94, 0xa6, 0xe2,
+	0x21, 0xb3, 0xc2, 0xf0, 0x09, 0x47, 0xbc, 0xd1, 0x35, 0x18, 0xa5, 0x06, 0x23, 0x4b, 0x76, 0xc7,
+	0x66, 0x95, 0xb1, 0xc1, 0x2a, 0x6b, 0x91, 0x3c, 0x84, 0x4c, 0x64, 0xc6, 0x1f, 0x3d, 0xe2, 0x98,
+	0xbd, 0xfe, 0x6e, 0x1e, 0x50, 0xea, 0x94, 0xf0, 0xe8, 0x1b, 0xfc, 0x93, 0xa4, 0x60, 0x3e, 0x94,
+	0x19, 0x35, 0x5a, 0x2d, 0xdb, 0x14, 0x5a, 0xdd, 0x41, 0x72, 0x2a, 0xde, 0xcf, 0xae, 0x86, 0xef,
+	0x67, 0x57, 0x57, 0x13, 0xa3, 0x13, 0xb7, 0x0b, 0x09, 0x28, 0x4e, 0x49, 0x40, 0x6f, 0x69, 0x30,
+	0xc1, 0x33, 0xae, 0x24, 0x49, 0x25, 0xbf, 0xaf, 0x25, 0x66, 0xc4, 0xe2, 0x0c, 0x87, 0xb8, 0xdd,
+	0x96, 0xc5, 0xe0, 0x1e, 0x69, 0xfa, 0xef, 0x34, 0x98, 0xec, 0xd9, 0x91, 0xee, 0x51, 0x5c, 0x4c,
+	0x39, 0x50, 0xe4, 0xf9, 0x54, 0x98, 0x46, 0x2c, 0x1e, 0x68, 0xaf, 0xe3, 0x4c, 0x2e, 0xce, 0xfd,
+	0x38, 0x2c, 0xc0, 0x52, 0x88, 0x7e, 0x16, 0xc6, 0x52, 0x77, 0x80, 0xfb, 0xf7, 0xa5, 0xf5, 0xf7,
+	0x8b, 0x30, 0x11, 0xf2, 0x0d, 0x9a, 0xdd, 0x4e, 0xc7, 0xa0, 0x47, 0xd1, 0x91, 0xf8, 0x8a, 0x06,
+	0xc7, 0x93, 0x86, 0x69, 0x47, 0x4b, 0x54, 0x3b, 0xd0, 0x12, 0x49, 0xdb, 0x38, 0xa9, 0x64, 0x1f,
+	0x5f, 0x49, 0x8b, 0xc0, 0x59, 0x99, 0xe8, 0x47, 0x1a, 0x3c, 0x2c, 0xa5, 0xa8, 0xf7, 0xd9, 0x32,
+	0x23, 0x2a, 0xf9, 0x43, 0x53, 0xea, 0xdf, 0x95, 0x52, 0x0f, 0xcf, 0xde, 0x46, 0x1e, 0xbe, 0xad,
+	0x36, 0xe8, 0xfb, 0x1a, 0xdc, 0x2f, 0x09, 0xb2, 0x7a, 0x16, 0x0e, 0x4d, 0xcf, 0x53, 0x4a, 0xcf,
+	0xfb, 0x67, 0x77, 0x13, 0x84, 0x77, 0x97, 0xcf, 0x7b, 0x2b, 0x9d, 0xb0, 0xfb, 0x57, 0x29, 0x0e,
+	0xa6, 0x4c, 0x6f, 0xfb, 0x30, 0xce, 0xf3, 0x22, 0x1c, 0x8e, 0xe5, 0xe8, 0xaf, 0xc1, 0x7d, 0x0d,
+	0xa3, 0xad, 0xea, 0xe0, 0x45, 0xc2, 0x2e, 0xfb, 0xfc, 0x4f, 0x20, 0x6f, 0xb8, 0xda, 0xd2, 0xec,
+	0xf3, 0xc9, 0x1b, 0xae, 0x36, 0xc1, 0x02, 0xc3, 0xdb, 0x92, 0x8e, 0x88, 0x05, 0xb2, 0xac, 0x89,
+	0x8e, 0x93, 0x74, 0xe6, 0x12, 0xc7, 0x5f, 0x33, 0x49, 0xb6, 0x16, 0xef, 0xc6, 0x9b, 0x2c, 0x3f,
+	0xd6, 0x60, 0x2c, 0x15, 0x57, 0xb8, 0x10, 0x6a, 0xb0, 0x1e, 0x21, 0x9c, 0x00, 0x0b, 0x0c, 0xd7,
+	0x7d, 0xad, 0x4b, 0x03, 0x96, 0xed, 0xb2, 0xd7, 0x38, 0x10, 0x4b, 0x1c, 0xbf, 0x19, 0xea, 0xba,
+	0x36, 0xab, 0xe4, 0x53, 0x5d, 0xfe, 0xc2, 0x55, 0xd7, 0x66, 0xb7, 0xb6, 0xa7, 0x9f, 0xbc, 0xc3,
+	0x7c, 0x27, 0xd4, 0x8a, 0x0f, 0xc3, 0x82, 0xa1, 0xb8, 0x7e, 0x52, 0x75, 0xf5, 0x01, 0x73, 0xdd,
+	0xfd, 0xbb, 0xac, 0x71, 0xd2, 0x96, 0x3f, 0xcc, 0xa4, 0x8d, 0xdf, 0x42, 0x97, 0x93, 0x69, 0x03,
+	0xb2, 0x61, 0xf8, 0x86, 0xed, 0x5a, 0xde, 0x0d, 0x59, 0x23, 0x0e, 0x70, 0x19, 0x14, 0xb2, 0x7a,
+	0x59, 0xb0, 0x89, 0x4b, 0x55, 0xf9, 0x1c, 0xe0, 0x90, 0x3f, 0xcf, 0x8f, 0x98, 0xdd, 0x21, 0xaf,
+	0x78, 0x2e, 0xc9, 0xbe, 0xe7, 0xbc, 0xaa, 0xe0, 0x38, 0xa2, 0xd0, 0x4d, 0x18, 0x4f, 0x73, 0xe6,
+	0xbb, 0x1f, 0x30, 0x83, 0xb2, 0x6c, 0x43, 0xbd, 0xc9, 0x81, 0x58, 0xe2, 0xb8, 0x10, 0xab, 0x9b,
+	0x28, 0xdc, 0x13, 0x42, 0xe6, 0x15, 0x1c, 0x47, 0x14, 0xfa, 0xcf, 0xf2, 0x10, 0xbe, 0xc5, 0x81,
+	0x9e, 0x4a, 0x34, 0xb6, 0xa5, 0x84, 0xca, 0xfe, 0x4d, 0x6d, 0xb4, 0xa2, 0x5a, 0xea, 0xb9, 0x7d,
+	0x82, 0x05, 0xff, 0xca, 0xa9, 0x2a, 0xbf, 0x72, 0xaa, 0xd6, 0x5d, 0x76, 0x99, 0x36, 0x19, 0xb5,
+	0xdd, 0x76, 0x6d, 0x24, 0xd3, 0x80, 0xff, 0x0f, 0x18, 0x26, 0xae, 0xe8, 0xd6, 0x8b, 0x9d, 0x2f,
+	0xca, 0x36, 0xe3, 0x82, 0x04, 0xe1, 0x10, 0xc7, 0x1b, 0xc6, 0xb6, 0xd9, 0xf1, 0x79, 0xa9, 0x28,
+	0x4a, 0xb9, 0xa2, 0xcc, 0xff, 0xea, 0x73, 0xcb, 0x0d, 0x0e, 0xc3, 0x11, 0x36, 0xa4, 0x9c, 0x0b,
+	0xdf, 0xae, 0x49, 0x50, 0x72, 0x18, 0x8e, 0xb0, 0x82, 0xb2, 0xad, 0x78, 0x0e, 0x25, 0x28, 0x17,
+	0x23, 0x9e, 0x0a, 0xcb, 0xaf, 0xe5, 0xc4, 0xf5, 0x85, 0x6a, 0x25, 0x88, 0xcc, 0x7f, 0x34, 0xf3,
+	0xce, 0xa7, 0xc2, 0xe1, 0x14, 0x25, 0x9f, 0x5e, 0x40, 0x4d, 0x31, 0xbd, 0x91, 0x78, 0x7a, 0x4d,
+	0x09, 0xc2, 0x21, 0x0e, 0x55, 0x01, 0x02, 0x6a, 0xaa, 0x59, 0x8b, 0x2c, 0xbf, 0x58, 0x1b, 0xe7,
+	0x21, 0xb5, 0x19, 0x41, 0x71, 0x82, 0x42, 0x27, 0x30, 0x91, 0x2d, 0xf6, 0xef, 0x86, 0xcf, 0x7a,
+	0xb7, 0x00, 0x27, 0x9b, 0x5d, 0x9f, 0x6f, 0x94, 0x7c, 0x2d, 0x7e, 0xce, 0x73, 0x1c, 0x75, 0xa6,
+	0xef, 0x7e, 0xe6, 0xf0, 0x2a, 0x8c, 0x92, 0x9b, 0xbe, 0x4d, 0x89, 0x35, 0x1b, 0xda, 0xdb, 0x7f,
+	0xdd, 0x99, 0x08, 0x7e, 0xbc, 0xe2, 0xa9, 0x2d, 0x84, 0x4c, 0x70, 0xcc, 0x8f, 0xaf, 0x45, 0x60,
+	0xbb, 0x26, 0xe1, 0xa4, 0xca, 0xe7, 0x44, 0x03, 0x9a, 0x21, 0x02, 0xc7, 0x34, 0xbc, 0x43, 0xd3,
+	0x8a, 0xbe, 0x40, 0x50, 0x57, 0x76, 0x7d, 0x77, 0x68, 0xb2, 0x5f, 0x32, 0xc4, 0x2b, 0x10, 0xc3,
+	0x70, 0x42, 0x0e, 0xfa, 0x86, 0x06, 0xe3, 0x46, 0xfa, 0x5b, 0x00, 0x79, 0x9f, 0xb7, 0x3c, 0x98,
+	0xe8, 0x3d, 0xbe, 0x6b, 0xa8, 0x3d, 0xa0, 0xf4, 0x18, 0xcf, 0x7c, 0x14, 0x90, 0x11, 0xce, 0x3f,
+	0xaa, 0x7a, 0x68, 0x0f, 0x8b, 0x38, 0x82, 0xae, 0xaa, 0x93, 0xee, 0xaa, 0xf6, 0x9d, 0x63, 0xef,
+	0xa1, 0xf9, 0x1e, 0xfd, 0xd5, 0xef, 0xe4, 0xe0, 0x91, 0x3d, 0x46, 0x0c, 0xdc, 0x69, 0x7d, 0x16,
+	0xc6, 0xc2, 0xff, 0xc9, 0x63, 0x18, 0x57, 0x74, 0x49, 0x24, 0x4e, 0xd3, 0x86, 0xa2, 0x84, 0xc3,
+	0xca, 0xf7, 0x8a, 0x92, 0x4e, 0x2b, 0xa4, 0xe0, 0x16, 0x6e, 0x7a, 0x1d, 0xdf, 0x21, 0x8c, 0xc8,
+	0xf6, 0xd7, 0x48, 0x6c, 0xe1, 0x73, 0x21, 0x02, 0xc7, 0x34, 0x3c, 0xde, 0x10, 0x4a, 0x3d, 0x5a,
+	0x29, 0xa6, 0xe3, 0xcd, 0x02, 0x07, 0x62, 0x89, 0xd3, 0xff, 0xa2, 0xc1, 0xa9, 0x3d, 0x16, 0xe5,
+	0xc8, 0x4a, 0xad, 0xcd, 0x74, 0xa9, 0x75, 0xe5, 0x90, 0xcc, 0x60, 0xdf, 0xa2, 0xeb, 0x71, 0x28,
+	0x25, 0xde, 0x14, 0xe0, 0x5f, 0x21, 0x05, 0xae, 0x9d, 0xfd, 0x0a, 0xa9, 0xb9, 0x52, 0xc7, 0x1c,
+	0x5e, 0x5b, 0xfd, 0xe0, 0xe3, 0xa9, 0x63, 0x1f, 0x7e, 0x3c, 0x75, 0xec, 0xa3, 0x8f, 0xa7, 0x8e,
+	0xbd, 0xb5, 0x33, 0xa5, 0x7d, 0xb0, 0x33, 0xa5, 0x7d, 0xb8, 0x33, 0xa5, 0x7d, 0xb4, 0x33, 0xa5,
+	0xfd, 0x66, 0x67, 0x4a, 0xfb, 0xd6, 0x6f, 0xa7, 0x8e, 0xbd, 0x52, 0xed, 0xef, 0xf3, 0xec, 0xbf,
+	0x0f, 0x00, 0xde, 0x61, 0x0d, 0x44, 0xcf, 0x3d, 0x00, 0x00,
 }
 
 func (m *AddressGroup) Marshal() (dAtA []byte, err error) {
@@ -2166,6 +2426,34 @@ func (m *ClusterGroupMembers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DNSProtocol) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DNSProtocol) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DNSProtocol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.QueryName)
+	copy(dAtA[i:], m.QueryName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.QueryName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *EgressGroup) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2389,6 +2677,39 @@ func (m *ExternalEntityReference) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *GRPCProtocol) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GRPCProtocol) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GRPCProtocol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Method)
+	copy(dAtA[i:], m.Method)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Method)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Service)
+	copy(dAtA[i:], m.Service)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Service)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *GroupAssociation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2638,7 +2959,45 @@ func (m *GroupReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *HTTPProtocol) Marshal() (dAtA []byte, err error) {
+func (m *HTTPHeaderMatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HTTPHeaderMatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HTTPHeaderMatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Regex)
+	copy(dAtA[i:], m.Regex)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Regex)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *HTTPProtocol) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2658,6 +3017,39 @@ func (m *HTTPProtocol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.QueryParams) > 0 {
+		for iNdEx := len(m.QueryParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueryParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Headers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	i -= len(m.PathRegex)
+	copy(dAtA[i:], m.PathRegex)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PathRegex)))
+	i--
+	dAtA[i] = 0x22
 	i -= len(m.Path)
 	copy(dAtA[i:], m.Path)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
@@ -2676,6 +3068,44 @@ func (m *HTTPProtocol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HTTPQueryParamMatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HTTPQueryParamMatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HTTPQueryParamMatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Regex)
+	copy(dAtA[i:], m.Regex)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Regex)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *IPBlock) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2803,6 +3233,39 @@ func (m *IPNet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *KafkaProtocol) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KafkaProtocol) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KafkaProtocol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Topic)
+	copy(dAtA[i:], m.Topic)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Topic)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.APIKey)
+	copy(dAtA[i:], m.APIKey)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.APIKey)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *L7Protocol) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2823,6 +3286,42 @@ func (m *L7Protocol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DNS != nil {
+		{
+			size, err := m.DNS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Kafka != nil {
+		{
+			size, err := m.Kafka.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.GRPC != nil {
+		{
+			size, err := m.GRPC.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.TLS != nil {
 		{
 			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
@@ -3383,6 +3882,30 @@ func (m *NetworkPolicyRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RateLimit != nil {
+		{
+			size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.Schedule != nil {
+		{
+			size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
 	i -= len(m.LogLabel)
 	copy(dAtA[i:], m.LogLabel)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LogLabel)))
@@ -3759,6 +4282,44 @@ func (m *PodReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RuleRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RuleRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RuleRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Unit)
+	copy(dAtA[i:], m.Unit)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Unit)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Burst)
+	copy(dAtA[i:], m.Burst)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Burst)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Rate)
+	copy(dAtA[i:], m.Rate)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Rate)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *RuleRef) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3799,6 +4360,81 @@ func (m *RuleRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RuleSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RuleSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RuleSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.TimeZone)
+	copy(dAtA[i:], m.TimeZone)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TimeZone)))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Windows) > 0 {
+		for iNdEx := len(m.Windows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Windows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Duration)
+	copy(dAtA[i:], m.Duration)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Duration)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Start)
+	copy(dAtA[i:], m.Start)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Start)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Service) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4346,6 +4982,17 @@ func (m *ClusterGroupMembers) Size() (n int) {
 	return n
 }
 
+func (m *DNSProtocol) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.QueryName)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *EgressGroup) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4429,20 +5076,33 @@ func (m *ExternalEntityReference) Size() (n int) {
 	return n
 }
 
-func (m *GroupAssociation) Size() (n int) {
+func (m *GRPCProtocol) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ObjectMeta.Size()
+	l = len(m.Service)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.AssociatedGroups) > 0 {
-		for _, e := range m.AssociatedGroups {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
+	l = len(m.Method)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *GroupAssociation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.AssociatedGroups) > 0 {
+		for _, e := range m.AssociatedGroups {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -4524,6 +5184,21 @@ func (m *GroupReference) Size() (n int) {
 	return n
 }
 
+func (m *HTTPHeaderMatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Value)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Regex)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *HTTPProtocol) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4536,6 +5211,35 @@ func (m *HTTPProtocol) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Path)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.PathRegex)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.QueryParams) > 0 {
+		for _, e := range m.QueryParams {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HTTPQueryParamMatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Value)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Regex)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -4587,6 +5291,19 @@ func (m *IPNet) Size() (n int) {
 	return n
 }
 
+func (m *KafkaProtocol) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.APIKey)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Topic)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *L7Protocol) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4601,6 +5318,18 @@ func (m *L7Protocol) Size() (n int) {
 		l = m.TLS.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.GRPC != nil {
+		l = m.GRPC.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Kafka != nil {
+		l = m.Kafka.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.DNS != nil {
+		l = m.DNS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -4841,6 +5570,14 @@ func (m *NetworkPolicyRule) Size() (n int) {
 	}
 	l = len(m.LogLabel)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.Schedule != nil {
+		l = m.Schedule.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.RateLimit != nil {
+		l = m.RateLimit.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -4950,6 +5687,21 @@ func (m *PodReference) Size() (n int) {
 	return n
 }
 
+func (m *RuleRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Rate)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Burst)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Unit)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *RuleRef) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4967,6 +5719,36 @@ func (m *RuleRef) Size() (n int) {
 	return n
 }
 
+func (m *RuleSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Windows) > 0 {
+		for _, e := range m.Windows {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.TimeZone)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ScheduleWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Start)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Duration)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *Service) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5272,6 +6054,16 @@ func (this *ClusterGroupMembers) String() string {
 	}, "")
 	return s
 }
+func (this *DNSProtocol) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DNSProtocol{`,
+		`QueryName:` + fmt.Sprintf("%v", this.QueryName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *EgressGroup) String() string {
 	if this == nil {
 		return "nil"
@@ -5347,6 +6139,17 @@ func (this *ExternalEntityReference) String() string {
 	}, "")
 	return s
 }
+func (this *GRPCProtocol) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GRPCProtocol{`,
+		`Service:` + fmt.Sprintf("%v", this.Service) + `,`,
+		`Method:` + fmt.Sprintf("%v", this.Method) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *GroupAssociation) String() string {
 	if this == nil {
 		return "nil"
@@ -5420,14 +6223,51 @@ func (this *GroupReference) String() string {
 	}, "")
 	return s
 }
+func (this *HTTPHeaderMatch) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HTTPHeaderMatch{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`Regex:` + fmt.Sprintf("%v", this.Regex) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *HTTPProtocol) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForHeaders := "[]HTTPHeaderMatch{"
+	for _, f := range this.Headers {
+		repeatedStringForHeaders += strings.Replace(strings.Replace(f.String(), "HTTPHeaderMatch", "HTTPHeaderMatch", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForHeaders += "}"
+	repeatedStringForQueryParams := "[]HTTPQueryParamMatch{"
+	for _, f := range this.QueryParams {
+		repeatedStringForQueryParams += strings.Replace(strings.Replace(f.String(), "HTTPQueryParamMatch", "HTTPQueryParamMatch", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForQueryParams += "}"
 	s := strings.Join([]string{`&HTTPProtocol{`,
 		`Host:` + fmt.Sprintf("%v", this.Host) + `,`,
 		`Method:` + fmt.Sprintf("%v", this.Method) + `,`,
 		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`PathRegex:` + fmt.Sprintf("%v", this.PathRegex) + `,`,
+		`Headers:` + repeatedStringForHeaders + `,`,
+		`QueryParams:` + repeatedStringForQueryParams + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HTTPQueryParamMatch) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HTTPQueryParamMatch{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`Regex:` + fmt.Sprintf("%v", this.Regex) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5475,6 +6315,17 @@ func (this *IPNet) String() string {
 	}, "")
 	return s
 }
+func (this *KafkaProtocol) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KafkaProtocol{`,
+		`APIKey:` + fmt.Sprintf("%v", this.APIKey) + `,`,
+		`Topic:` + fmt.Sprintf("%v", this.Topic) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *L7Protocol) String() string {
 	if this == nil {
 		return "nil"
@@ -5482,6 +6333,9 @@ func (this *L7Protocol) String() string {
 	s := strings.Join([]string{`&L7Protocol{`,
 		`HTTP:` + strings.Replace(this.HTTP.String(), "HTTPProtocol", "HTTPProtocol", 1) + `,`,
 		`TLS:` + strings.Replace(this.TLS.String(), "TLSProtocol", "TLSProtocol", 1) + `,`,
+		`GRPC:` + strings.Replace(this.GRPC.String(), "GRPCProtocol", "GRPCProtocol", 1) + `,`,
+		`Kafka:` + strings.Replace(this.Kafka.String(), "KafkaProtocol", "KafkaProtocol", 1) + `,`,
+		`DNS:` + strings.Replace(this.DNS.String(), "DNSProtocol", "DNSProtocol", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5661,6 +6515,8 @@ func (this *NetworkPolicyRule) String() string {
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`L7Protocols:` + repeatedStringForL7Protocols + `,`,
 		`LogLabel:` + fmt.Sprintf("%v", this.LogLabel) + `,`,
+		`Schedule:` + strings.Replace(this.Schedule.String(), "RuleSchedule", "RuleSchedule", 1) + `,`,
+		`RateLimit:` + strings.Replace(this.RateLimit.String(), "RuleRateLimit", "RuleRateLimit", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5764,6 +6620,18 @@ func (this *PodReference) String() string {
 	}, "")
 	return s
 }
+func (this *RuleRateLimit) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RuleRateLimit{`,
+		`Rate:` + fmt.Sprintf("%v", this.Rate) + `,`,
+		`Burst:` + fmt.Sprintf("%v", this.Burst) + `,`,
+		`Unit:` + fmt.Sprintf("%v", this.Unit) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *RuleRef) String() string {
 	if this == nil {
 		return "nil"
@@ -5776,6 +6644,33 @@ func (this *RuleRef) String() string {
 	}, "")
 	return s
 }
+func (this *RuleSchedule) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForWindows := "[]ScheduleWindow{"
+	for _, f := range this.Windows {
+		repeatedStringForWindows += strings.Replace(strings.Replace(f.String(), "ScheduleWindow", "ScheduleWindow", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForWindows += "}"
+	s := strings.Join([]string{`&RuleSchedule{`,
+		`Windows:` + repeatedStringForWindows + `,`,
+		`TimeZone:` + fmt.Sprintf("%v", this.TimeZone) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScheduleWindow) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScheduleWindow{`,
+		`Start:` + fmt.Sprintf("%v", this.Start) + `,`,
+		`Duration:` + fmt.Sprintf("%v", this.Duration) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Service) String() string {
 	if this == nil {
 		return "nil"
@@ -7241,6 +8136,88 @@ func (m *ClusterGroupMembers) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DNSProtocol) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DNSProtocol: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DNSProtocol: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EgressGroup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -7826,7 +8803,7 @@ func (m *ExternalEntityReference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GroupAssociation) Unmarshal(dAtA []byte) error {
+func (m *GRPCProtocol) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7849,17 +8826,17 @@ func (m *GroupAssociation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GroupAssociation: wiretype end group for non-group")
+			return fmt.Errorf("proto: GRPCProtocol: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GroupAssociation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GRPCProtocol: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7869,7 +8846,121 @@ func (m *GroupAssociation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GroupAssociation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupAssociation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupAssociation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -8557,7 +9648,7 @@ func (m *GroupReference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HTTPProtocol) Unmarshal(dAtA []byte) error {
+func (m *HTTPHeaderMatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8580,15 +9671,15 @@ func (m *HTTPProtocol) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HTTPProtocol: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTPHeaderMatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTPProtocol: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTPHeaderMatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8616,11 +9707,11 @@ func (m *HTTPProtocol) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Host = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8648,11 +9739,11 @@ func (m *HTTPProtocol) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Method = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Regex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8680,7 +9771,7 @@ func (m *HTTPProtocol) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Regex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8703,7 +9794,7 @@ func (m *HTTPProtocol) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IPBlock) Unmarshal(dAtA []byte) error {
+func (m *HTTPProtocol) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8726,17 +9817,17 @@ func (m *IPBlock) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IPBlock: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTPProtocol: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IPBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTPProtocol: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CIDR", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8746,30 +9837,29 @@ func (m *IPBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CIDR.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Host = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Except", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8779,81 +9869,61 @@ func (m *IPBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Except = append(m.Except, IPNet{})
-			if err := m.Except[len(m.Except)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Method = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *IPGroupAssociation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: IPGroupAssociation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IPGroupAssociation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PathRegex", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8863,28 +9933,27 @@ func (m *IPGroupAssociation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PathRegex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssociatedGroups", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8911,16 +9980,50 @@ func (m *IPGroupAssociation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssociatedGroups = append(m.AssociatedGroups, GroupReference{})
-			if err := m.AssociatedGroups[len(m.AssociatedGroups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Headers = append(m.Headers, HTTPHeaderMatch{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryParams = append(m.QueryParams, HTTPQueryParamMatch{})
+			if err := m.QueryParams[len(m.QueryParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
@@ -8937,7 +10040,7 @@ func (m *IPGroupAssociation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IPNet) Unmarshal(dAtA []byte) error {
+func (m *HTTPQueryParamMatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8960,17 +10063,17 @@ func (m *IPNet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IPNet: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTPQueryParamMatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IPNet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTPQueryParamMatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8980,31 +10083,29 @@ func (m *IPNet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IP = append(m.IP[:0], dAtA[iNdEx:postIndex]...)
-			if m.IP == nil {
-				m.IP = []byte{}
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrefixLength", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			m.PrefixLength = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9014,11 +10115,56 @@ func (m *IPNet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PrefixLength |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Regex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Regex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -9040,7 +10186,7 @@ func (m *IPNet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *L7Protocol) Unmarshal(dAtA []byte) error {
+func (m *IPBlock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9063,15 +10209,15 @@ func (m *L7Protocol) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: L7Protocol: wiretype end group for non-group")
+			return fmt.Errorf("proto: IPBlock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: L7Protocol: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IPBlock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CIDR", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9098,16 +10244,13 @@ func (m *L7Protocol) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HTTP == nil {
-				m.HTTP = &HTTPProtocol{}
-			}
-			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CIDR.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Except", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9134,10 +10277,8 @@ func (m *L7Protocol) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TLS == nil {
-				m.TLS = &TLSProtocol{}
-			}
-			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Except = append(m.Except, IPNet{})
+			if err := m.Except[len(m.Except)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9162,7 +10303,7 @@ func (m *L7Protocol) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MulticastGroupInfo) Unmarshal(dAtA []byte) error {
+func (m *IPGroupAssociation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9185,17 +10326,17 @@ func (m *MulticastGroupInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MulticastGroupInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: IPGroupAssociation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MulticastGroupInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IPGroupAssociation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9205,27 +10346,28 @@ func (m *MulticastGroupInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pods", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssociatedGroups", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9252,8 +10394,8 @@ func (m *MulticastGroupInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pods = append(m.Pods, PodReference{})
-			if err := m.Pods[len(m.Pods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AssociatedGroups = append(m.AssociatedGroups, GroupReference{})
+			if err := m.AssociatedGroups[len(m.AssociatedGroups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9278,7 +10420,7 @@ func (m *MulticastGroupInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NamedPort) Unmarshal(dAtA []byte) error {
+func (m *IPNet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9301,36 +10443,17 @@ func (m *NamedPort) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NamedPort: wiretype end group for non-group")
+			return fmt.Errorf("proto: IPNet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NamedPort: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IPNet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
-			}
-			m.Port = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Port |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IP", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9340,29 +10463,31 @@ func (m *NamedPort) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.IP = append(m.IP[:0], dAtA[iNdEx:postIndex]...)
+			if m.IP == nil {
+				m.IP = []byte{}
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrefixLength", wireType)
 			}
-			var stringLen uint64
+			m.PrefixLength = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9372,24 +10497,11 @@ func (m *NamedPort) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PrefixLength |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Protocol = Protocol(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -9411,7 +10523,7 @@ func (m *NamedPort) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NetworkPolicy) Unmarshal(dAtA []byte) error {
+func (m *KafkaProtocol) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9434,17 +10546,17 @@ func (m *NetworkPolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NetworkPolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: KafkaProtocol: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NetworkPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KafkaProtocol: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field APIKey", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9454,30 +10566,29 @@ func (m *NetworkPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.APIKey = antrea_io_antrea_pkg_apis_crd_v1beta1.KafkaAPIKey(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9487,134 +10598,32 @@ func (m *NetworkPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rules = append(m.Rules, NetworkPolicyRule{})
-			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Topic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppliedToGroups", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AppliedToGroups = append(m.AppliedToGroups, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 4:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			v2 := float64(math.Float64frombits(v))
-			m.Priority = &v2
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TierPriority", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.TierPriority = &v
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceRef", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.SourceRef == nil {
-				m.SourceRef = &NetworkPolicyReference{}
-			}
-			if err := m.SourceRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
 			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
@@ -9628,7 +10637,7 @@ func (m *NetworkPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NetworkPolicyEvaluation) Unmarshal(dAtA []byte) error {
+func (m *L7Protocol) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9651,15 +10660,15 @@ func (m *NetworkPolicyEvaluation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NetworkPolicyEvaluation: wiretype end group for non-group")
+			return fmt.Errorf("proto: L7Protocol: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NetworkPolicyEvaluation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: L7Protocol: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9686,16 +10695,16 @@ func (m *NetworkPolicyEvaluation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &NetworkPolicyEvaluationRequest{}
+			if m.HTTP == nil {
+				m.HTTP = &HTTPProtocol{}
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9722,16 +10731,16 @@ func (m *NetworkPolicyEvaluation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Response == nil {
-				m.Response = &NetworkPolicyEvaluationResponse{}
+			if m.TLS == nil {
+				m.TLS = &TLSProtocol{}
 			}
-			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GRPC", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9758,63 +10767,16 @@ func (m *NetworkPolicyEvaluation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.GRPC == nil {
+				m.GRPC = &GRPCProtocol{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.GRPC.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NetworkPolicyEvaluationRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NetworkPolicyEvaluationRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NetworkPolicyEvaluationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kafka", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9841,13 +10803,16 @@ func (m *NetworkPolicyEvaluationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Kafka == nil {
+				m.Kafka = &KafkaProtocol{}
+			}
+			if err := m.Kafka.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DNS", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9874,7 +10839,10 @@ func (m *NetworkPolicyEvaluationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.DNS == nil {
+				m.DNS = &DNSProtocol{}
+			}
+			if err := m.DNS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9899,7 +10867,7 @@ func (m *NetworkPolicyEvaluationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NetworkPolicyEvaluationResponse) Unmarshal(dAtA []byte) error {
+func (m *MulticastGroupInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9922,17 +10890,17 @@ func (m *NetworkPolicyEvaluationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NetworkPolicyEvaluationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MulticastGroupInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NetworkPolicyEvaluationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MulticastGroupInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9942,47 +10910,27 @@ func (m *NetworkPolicyEvaluationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NetworkPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RuleIndex", wireType)
-			}
-			m.RuleIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RuleIndex |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rule", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pods", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10009,7 +10957,8 @@ func (m *NetworkPolicyEvaluationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Pods = append(m.Pods, PodReference{})
+			if err := m.Pods[len(m.Pods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10034,7 +10983,7 @@ func (m *NetworkPolicyEvaluationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NetworkPolicyList) Unmarshal(dAtA []byte) error {
+func (m *NamedPort) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10057,17 +11006,17 @@ func (m *NetworkPolicyList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NetworkPolicyList: wiretype end group for non-group")
+			return fmt.Errorf("proto: NamedPort: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NetworkPolicyList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NamedPort: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
 			}
-			var msglen int
+			m.Port = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10077,30 +11026,48 @@ func (m *NetworkPolicyList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Port |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10110,25 +11077,23 @@ func (m *NetworkPolicyList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, NetworkPolicy{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Protocol = Protocol(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10151,7 +11116,7 @@ func (m *NetworkPolicyList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NetworkPolicyNodeStatus) Unmarshal(dAtA []byte) error {
+func (m *NetworkPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10174,17 +11139,17 @@ func (m *NetworkPolicyNodeStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NetworkPolicyNodeStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: NetworkPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NetworkPolicyNodeStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NetworkPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10194,29 +11159,30 @@ func (m *NetworkPolicyNodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NodeName = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Generation", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
 			}
-			m.Generation = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10226,16 +11192,31 @@ func (m *NetworkPolicyNodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Generation |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rules = append(m.Rules, NetworkPolicyRule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RealizationFailure", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppliedToGroups", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10245,17 +11226,61 @@ func (m *NetworkPolicyNodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RealizationFailure = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppliedToGroups = append(m.AppliedToGroups, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			v2 := float64(math.Float64frombits(v))
+			m.Priority = &v2
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TierPriority", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TierPriority = &v
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceRef", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10265,23 +11290,27 @@ func (m *NetworkPolicyNodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if m.SourceRef == nil {
+				m.SourceRef = &NetworkPolicyReference{}
+			}
+			if err := m.SourceRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10304,7 +11333,7 @@ func (m *NetworkPolicyNodeStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NetworkPolicyPeer) Unmarshal(dAtA []byte) error {
+func (m *NetworkPolicyEvaluation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10327,17 +11356,17 @@ func (m *NetworkPolicyPeer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NetworkPolicyPeer: wiretype end group for non-group")
+			return fmt.Errorf("proto: NetworkPolicyEvaluation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NetworkPolicyPeer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NetworkPolicyEvaluation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddressGroups", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10347,27 +11376,31 @@ func (m *NetworkPolicyPeer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AddressGroups = append(m.AddressGroups, string(dAtA[iNdEx:postIndex]))
+			if m.Request == nil {
+				m.Request = &NetworkPolicyEvaluationRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IPBlocks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10394,46 +11427,16 @@ func (m *NetworkPolicyPeer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IPBlocks = append(m.IPBlocks, IPBlock{})
-			if err := m.IPBlocks[len(m.IPBlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Response == nil {
+				m.Response = &NetworkPolicyEvaluationResponse{}
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FQDNs", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FQDNs = append(m.FQDNs, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToServices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10460,87 +11463,10 @@ func (m *NetworkPolicyPeer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToServices = append(m.ToServices, ServiceReference{})
-			if err := m.ToServices[len(m.ToServices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.LabelIdentities = append(m.LabelIdentities, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthGenerated
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthGenerated
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.LabelIdentities) == 0 {
-					m.LabelIdentities = make([]uint32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.LabelIdentities = append(m.LabelIdentities, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field LabelIdentities", wireType)
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -10562,7 +11488,7 @@ func (m *NetworkPolicyPeer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NetworkPolicyReference) Unmarshal(dAtA []byte) error {
+func (m *NetworkPolicyEvaluationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10585,17 +11511,17 @@ func (m *NetworkPolicyReference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NetworkPolicyReference: wiretype end group for non-group")
+			return fmt.Errorf("proto: NetworkPolicyEvaluationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NetworkPolicyReference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NetworkPolicyEvaluationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10605,61 +11531,30 @@ func (m *NetworkPolicyReference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = NetworkPolicyType(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10669,55 +11564,24 @@ func (m *NetworkPolicyReference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.UID = k8s_io_apimachinery_pkg_types.UID(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10740,7 +11604,7 @@ func (m *NetworkPolicyReference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NetworkPolicyRule) Unmarshal(dAtA []byte) error {
+func (m *NetworkPolicyEvaluationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10763,17 +11627,17 @@ func (m *NetworkPolicyRule) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NetworkPolicyRule: wiretype end group for non-group")
+			return fmt.Errorf("proto: NetworkPolicyEvaluationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NetworkPolicyRule: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NetworkPolicyEvaluationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkPolicy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10783,27 +11647,47 @@ func (m *NetworkPolicyRule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Direction = Direction(dAtA[iNdEx:postIndex])
+			if err := m.NetworkPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuleIndex", wireType)
+			}
+			m.RuleIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RuleIndex |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rule", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10830,13 +11714,63 @@ func (m *NetworkPolicyRule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NetworkPolicyList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetworkPolicyList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetworkPolicyList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10863,13 +11797,13 @@ func (m *NetworkPolicyRule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10896,16 +11830,66 @@ func (m *NetworkPolicyRule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Services = append(m.Services, Service{})
-			if err := m.Services[len(m.Services)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, NetworkPolicy{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Priority = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NetworkPolicyNodeStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetworkPolicyNodeStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetworkPolicyNodeStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10915,14 +11899,66 @@ func (m *NetworkPolicyRule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Priority |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Generation", wireType)
+			}
+			m.Generation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Generation |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RealizationFailure", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RealizationFailure = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10950,14 +11986,63 @@ func (m *NetworkPolicyRule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := antrea_io_antrea_pkg_apis_crd_v1beta1.RuleAction(dAtA[iNdEx:postIndex])
-			m.Action = &s
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableLogging", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NetworkPolicyPeer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetworkPolicyPeer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetworkPolicyPeer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressGroups", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10967,15 +12052,61 @@ func (m *NetworkPolicyRule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableLogging = bool(v != 0)
-		case 8:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressGroups = append(m.AddressGroups, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppliedToGroups", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IPBlocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IPBlocks = append(m.IPBlocks, IPBlock{})
+			if err := m.IPBlocks[len(m.IPBlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FQDNs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11003,13 +12134,957 @@ func (m *NetworkPolicyRule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AppliedToGroups = append(m.AppliedToGroups, string(dAtA[iNdEx:postIndex]))
+			m.FQDNs = append(m.FQDNs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 9:
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToServices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToServices = append(m.ToServices, ServiceReference{})
+			if err := m.ToServices[len(m.ToServices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.LabelIdentities = append(m.LabelIdentities, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenerated
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenerated
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.LabelIdentities) == 0 {
+					m.LabelIdentities = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.LabelIdentities = append(m.LabelIdentities, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelIdentities", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NetworkPolicyReference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetworkPolicyReference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetworkPolicyReference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = NetworkPolicyType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UID = k8s_io_apimachinery_pkg_types.UID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NetworkPolicyRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetworkPolicyRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetworkPolicyRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Direction = Direction(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Services = append(m.Services, Service{})
+			if err := m.Services[len(m.Services)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := antrea_io_antrea_pkg_apis_crd_v1beta1.RuleAction(dAtA[iNdEx:postIndex])
+			m.Action = &s
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableLogging", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableLogging = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppliedToGroups", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppliedToGroups = append(m.AppliedToGroups, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field L7Protocols", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.L7Protocols = append(m.L7Protocols, L7Protocol{})
+			if err := m.L7Protocols[len(m.L7Protocols)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogLabel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogLabel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Schedule == nil {
+				m.Schedule = &RuleSchedule{}
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RateLimit == nil {
+				m.RateLimit = &RuleRateLimit{}
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NetworkPolicyStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetworkPolicyStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetworkPolicyStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetworkPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrafficStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TrafficStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuleTrafficStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuleTrafficStats = append(m.RuleTrafficStats, v1alpha1.RuleTrafficStats{})
+			if err := m.RuleTrafficStats[len(m.RuleTrafficStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NetworkPolicyStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetworkPolicyStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetworkPolicyStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11019,27 +13094,28 @@ func (m *NetworkPolicyRule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field L7Protocols", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11066,14 +13142,64 @@ func (m *NetworkPolicyRule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.L7Protocols = append(m.L7Protocols, L7Protocol{})
-			if err := m.L7Protocols[len(m.L7Protocols)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Nodes = append(m.Nodes, NetworkPolicyNodeStatus{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeReference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeReference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeReference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogLabel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11101,7 +13227,7 @@ func (m *NetworkPolicyRule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LogLabel = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11124,7 +13250,7 @@ func (m *NetworkPolicyRule) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NetworkPolicyStats) Unmarshal(dAtA []byte) error {
+func (m *NodeStatsSummary) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11147,15 +13273,15 @@ func (m *NetworkPolicyStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NetworkPolicyStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: NodeStatsSummary: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NetworkPolicyStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NodeStatsSummary: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11182,13 +13308,13 @@ func (m *NetworkPolicyStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NetworkPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrafficStats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkPolicies", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11215,13 +13341,14 @@ func (m *NetworkPolicyStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TrafficStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.NetworkPolicies = append(m.NetworkPolicies, NetworkPolicyStats{})
+			if err := m.NetworkPolicies[len(m.NetworkPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RuleTrafficStats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AntreaClusterNetworkPolicies", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11248,8 +13375,76 @@ func (m *NetworkPolicyStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RuleTrafficStats = append(m.RuleTrafficStats, v1alpha1.RuleTrafficStats{})
-			if err := m.RuleTrafficStats[len(m.RuleTrafficStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AntreaClusterNetworkPolicies = append(m.AntreaClusterNetworkPolicies, NetworkPolicyStats{})
+			if err := m.AntreaClusterNetworkPolicies[len(m.AntreaClusterNetworkPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AntreaNetworkPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AntreaNetworkPolicies = append(m.AntreaNetworkPolicies, NetworkPolicyStats{})
+			if err := m.AntreaNetworkPolicies[len(m.AntreaNetworkPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multicast", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Multicast = append(m.Multicast, MulticastGroupInfo{})
+			if err := m.Multicast[len(m.Multicast)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11274,7 +13469,7 @@ func (m *NetworkPolicyStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NetworkPolicyStatus) Unmarshal(dAtA []byte) error {
+func (m *PaginationGetOptions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11297,17 +13492,17 @@ func (m *NetworkPolicyStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NetworkPolicyStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: PaginationGetOptions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NetworkPolicyStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PaginationGetOptions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
 			}
-			var msglen int
+			m.Page = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11317,30 +13512,16 @@ func (m *NetworkPolicyStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Page |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var msglen int
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11350,26 +13531,11 @@ func (m *NetworkPolicyStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Limit |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Nodes = append(m.Nodes, NetworkPolicyNodeStatus{})
-			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -11391,7 +13557,7 @@ func (m *NetworkPolicyStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NodeReference) Unmarshal(dAtA []byte) error {
+func (m *PodReference) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11414,15 +13580,47 @@ func (m *NodeReference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NodeReference: wiretype end group for non-group")
+			return fmt.Errorf("proto: PodReference: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeReference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PodReference: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11450,7 +13648,7 @@ func (m *NodeReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11473,7 +13671,7 @@ func (m *NodeReference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NodeStatsSummary) Unmarshal(dAtA []byte) error {
+func (m *RuleRateLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11496,17 +13694,17 @@ func (m *NodeStatsSummary) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NodeStatsSummary: wiretype end group for non-group")
+			return fmt.Errorf("proto: RuleRateLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeStatsSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RuleRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11516,30 +13714,29 @@ func (m *NodeStatsSummary) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Rate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkPolicies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Burst", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11549,99 +13746,29 @@ func (m *NodeStatsSummary) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NetworkPolicies = append(m.NetworkPolicies, NetworkPolicyStats{})
-			if err := m.NetworkPolicies[len(m.NetworkPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Burst = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AntreaClusterNetworkPolicies", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AntreaClusterNetworkPolicies = append(m.AntreaClusterNetworkPolicies, NetworkPolicyStats{})
-			if err := m.AntreaClusterNetworkPolicies[len(m.AntreaClusterNetworkPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AntreaNetworkPolicies", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AntreaNetworkPolicies = append(m.AntreaNetworkPolicies, NetworkPolicyStats{})
-			if err := m.AntreaNetworkPolicies[len(m.AntreaNetworkPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Multicast", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11651,25 +13778,23 @@ func (m *NodeStatsSummary) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Multicast = append(m.Multicast, MulticastGroupInfo{})
-			if err := m.Multicast[len(m.Multicast)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Unit = antrea_io_antrea_pkg_apis_crd_v1beta1.RateLimitUnit(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11692,7 +13817,7 @@ func (m *NodeStatsSummary) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PaginationGetOptions) Unmarshal(dAtA []byte) error {
+func (m *RuleRef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11715,17 +13840,17 @@ func (m *PaginationGetOptions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PaginationGetOptions: wiretype end group for non-group")
+			return fmt.Errorf("proto: RuleRef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PaginationGetOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RuleRef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
 			}
-			m.Page = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11735,16 +13860,29 @@ func (m *PaginationGetOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Page |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Direction = Direction(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.Limit = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11754,11 +13892,57 @@ func (m *PaginationGetOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := antrea_io_antrea_pkg_apis_crd_v1beta1.RuleAction(dAtA[iNdEx:postIndex])
+			m.Action = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -11780,7 +13964,7 @@ func (m *PaginationGetOptions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PodReference) Unmarshal(dAtA []byte) error {
+func (m *RuleSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11803,17 +13987,17 @@ func (m *PodReference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PodReference: wiretype end group for non-group")
+			return fmt.Errorf("proto: RuleSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PodReference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RuleSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11823,27 +14007,29 @@ func (m *PodReference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Windows = append(m.Windows, ScheduleWindow{})
+			if err := m.Windows[len(m.Windows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeZone", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11871,7 +14057,7 @@ func (m *PodReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.TimeZone = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11894,7 +14080,7 @@ func (m *PodReference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RuleRef) Unmarshal(dAtA []byte) error {
+func (m *ScheduleWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11917,15 +14103,15 @@ func (m *RuleRef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RuleRef: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduleWindow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RuleRef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduleWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11953,43 +14139,11 @@ func (m *RuleRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Direction = Direction(dAtA[iNdEx:postIndex])
+			m.Start = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12017,8 +14171,7 @@ func (m *RuleRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := antrea_io_antrea_pkg_apis_crd_v1beta1.RuleAction(dAtA[iNdEx:postIndex])
-			m.Action = &s
+			m.Duration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -277,6 +277,39 @@ type NetworkPolicyRule struct {
 	L7Protocols []L7Protocol `json:"l7Protocols,omitempty" protobuf:"bytes,10,rep,name=l7Protocols"`
 	// LogLabel is a user-defined arbitrary string which will be printed in the NetworkPolicy logs.
 	LogLabel string `json:"logLabel,omitempty" protobuf:"bytes,11,opt,name=logLabel"`
+	// Schedule restricts the enforcement of this rule to recurring time windows.
+	// The rule is always enforced if it's nil.
+	Schedule *RuleSchedule `json:"schedule,omitempty" protobuf:"bytes,12,opt,name=schedule"`
+	// RateLimit limits the rate of the traffic allowed by this rule on each Node.
+	// The traffic is not rate-limited if it's nil.
+	RateLimit *RuleRateLimit `json:"rateLimit,omitempty" protobuf:"bytes,13,opt,name=rateLimit"`
+}
+
+// RuleRateLimit describes the rate limit of the traffic allowed by a rule.
+type RuleRateLimit struct {
+	// Rate is the maximum traffic rate, e.g. 300k, 100M.
+	Rate string `json:"rate,omitempty" protobuf:"bytes,1,opt,name=rate"`
+	// Burst is the maximum burst size when traffic exceeds the rate. An empty value means Rate.
+	Burst string `json:"burst,omitempty" protobuf:"bytes,2,opt,name=burst"`
+	// Unit is the unit of Rate and Burst. An empty value means bps.
+	Unit crdv1beta1.RateLimitUnit `json:"unit,omitempty" protobuf:"bytes,3,opt,name=unit"`
+}
+
+// RuleSchedule describes the recurring time windows during which a rule is enforced.
+type RuleSchedule struct {
+	// Windows is the list of time windows during which the rule is enforced.
+	Windows []ScheduleWindow `json:"windows,omitempty" protobuf:"bytes,1,rep,name=windows"`
+	// TimeZone is the IANA name of the time zone in which the windows are evaluated.
+	// An empty value means UTC.
+	TimeZone string `json:"timeZone,omitempty" protobuf:"bytes,2,opt,name=timeZone"`
+}
+
+// ScheduleWindow describes a recurring time window.
+type ScheduleWindow struct {
+	// Start is a cron expression which specifies the times at which the window opens.
+	Start string `json:"start,omitempty" protobuf:"bytes,1,opt,name=start"`
+	// Duration is how long the window stays open after each start.
+	Duration string `json:"duration,omitempty" protobuf:"bytes,2,opt,name=duration"`
 }
 
 // Protocol defines network protocols supported for things like container ports.
@@ -328,8 +361,11 @@ type Service struct {
 
 // L7Protocol defines application layer protocol to match.
 type L7Protocol struct {
-	HTTP *HTTPProtocol `json:"http,omitempty" protobuf:"bytes,1,opt,name=http"`
-	TLS  *TLSProtocol  `json:"tls,omitempty" protobuf:"bytes,2,opt,name=tls"`
+	HTTP  *HTTPProtocol  `json:"http,omitempty" protobuf:"bytes,1,opt,name=http"`
+	TLS   *TLSProtocol   `json:"tls,omitempty" protobuf:"bytes,2,opt,name=tls"`
+	GRPC  *GRPCProtocol  `json:"grpc,omitempty" protobuf:"bytes,3,opt,name=grpc"`
+	Kafka *KafkaProtocol `json:"kafka,omitempty" protobuf:"bytes,4,opt,name=kafka"`
+	DNS   *DNSProtocol   `json:"dns,omitempty" protobuf:"bytes,5,opt,name=dns"`
 }
 
 // HTTPProtocol matches HTTP requests with specific host, method, and path. All fields could be used alone or together.
@@ -343,6 +379,38 @@ type HTTPProtocol struct {
 	Method string `json:"method,omitempty" protobuf:"bytes,2,opt,name=method"`
 	// Path represents the URI path to match (Ex. "/index.html", "/admin").
 	Path string `json:"path,omitempty" protobuf:"bytes,3,opt,name=path"`
+	// PathRegex represents a regular expression in RE2 syntax which the whole URI path must match
+	// (Ex. "/api/v[0-9]+/users/.*"). The query string is not part of the path. It can not be set
+	// together with Path.
+	PathRegex string `json:"pathRegex,omitempty" protobuf:"bytes,4,opt,name=pathRegex"`
+	// Headers represents the HTTP request headers to match. A request must match all of them.
+	Headers []HTTPHeaderMatch `json:"headers,omitempty" protobuf:"bytes,5,rep,name=headers"`
+	// QueryParams represents the URI query parameters to match. A request must match all of them.
+	QueryParams []HTTPQueryParamMatch `json:"queryParams,omitempty" protobuf:"bytes,6,rep,name=queryParams"`
+}
+
+// HTTPHeaderMatch matches an HTTP request header. If neither Value nor Regex is provided, this
+// matches any request carrying the header.
+type HTTPHeaderMatch struct {
+	// Name represents the name of the header to match. It is case-insensitive.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Value represents the exact value of the header to match.
+	Value string `json:"value,omitempty" protobuf:"bytes,2,opt,name=value"`
+	// Regex represents a regular expression in RE2 syntax which the whole value of the header must
+	// match. It can not be set together with Value.
+	Regex string `json:"regex,omitempty" protobuf:"bytes,3,opt,name=regex"`
+}
+
+// HTTPQueryParamMatch matches a URI query parameter. If neither Value nor Regex is provided, this
+// matches any request carrying the parameter.
+type HTTPQueryParamMatch struct {
+	// Name represents the name of the query parameter to match. It is case-sensitive.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Value represents the exact value of the query parameter to match, as it appears in the URI.
+	Value string `json:"value,omitempty" protobuf:"bytes,2,opt,name=value"`
+	// Regex represents a regular expression in RE2 syntax which the whole value of the query
+	// parameter, as it appears in the URI, must match. It can not be set together with Value.
+	Regex string `json:"regex,omitempty" protobuf:"bytes,3,opt,name=regex"`
 }
 
 // TLSProtocol matches TLS handshake packets with specific SNI. If the field is not provided, this
@@ -352,6 +420,31 @@ type TLSProtocol struct {
 	SNI string `json:"sni,omitempty" protobuf:"bytes,1,opt,name=sni"`
 }
 
+// GRPCProtocol matches gRPC requests with specific service and method. All fields could be used alone or together.
+// If all fields are not provided, it matches all gRPC requests.
+type GRPCProtocol struct {
+	// Service represents the fully qualified name of the gRPC service to match (Ex. "helloworld.Greeter").
+	Service string `json:"service,omitempty" protobuf:"bytes,1,opt,name=service"`
+	// Method represents the name of the gRPC method to match (Ex. "SayHello").
+	Method string `json:"method,omitempty" protobuf:"bytes,2,opt,name=method"`
+}
+
+// KafkaProtocol matches Kafka requests with specific API key and topic. The fields could be used alone or together,
+// but at least one of them must be provided.
+type KafkaProtocol struct {
+	// APIKey represents the type of the Kafka requests to match.
+	APIKey crdv1beta1.KafkaAPIKey `json:"apiKey,omitempty" protobuf:"bytes,1,opt,name=apiKey,casttype=antrea.io/antrea/pkg/apis/crd/v1beta1.KafkaAPIKey"`
+	// Topic represents the name of the Kafka topic to match. It can only be used when APIKey is produce, fetch or metadata.
+	Topic string `json:"topic,omitempty" protobuf:"bytes,2,opt,name=topic"`
+}
+
+// DNSProtocol matches DNS queries with specific query name. If the field is not provided, this matches all DNS
+// queries.
+type DNSProtocol struct {
+	// QueryName represents the domain name in the DNS query to match.
+	QueryName string `json:"queryName,omitempty" protobuf:"bytes,1,opt,name=queryName"`
+}
+
 // NetworkPolicyPeer describes a peer of NetworkPolicyRules.
 // It could be a list of names of AddressGroups and/or a list of IPBlock.
 type NetworkPolicyPeer struct {
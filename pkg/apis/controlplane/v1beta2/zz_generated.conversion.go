@@ -139,6 +139,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DNSProtocol)(nil), (*controlplane.DNSProtocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_DNSProtocol_To_controlplane_DNSProtocol(a.(*DNSProtocol), b.(*controlplane.DNSProtocol), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.DNSProtocol)(nil), (*DNSProtocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_DNSProtocol_To_v1beta2_DNSProtocol(a.(*controlplane.DNSProtocol), b.(*DNSProtocol), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*EgressGroup)(nil), (*controlplane.EgressGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_EgressGroup_To_controlplane_EgressGroup(a.(*EgressGroup), b.(*controlplane.EgressGroup), scope)
 	}); err != nil {
@@ -189,6 +199,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*GRPCProtocol)(nil), (*controlplane.GRPCProtocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_GRPCProtocol_To_controlplane_GRPCProtocol(a.(*GRPCProtocol), b.(*controlplane.GRPCProtocol), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.GRPCProtocol)(nil), (*GRPCProtocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_GRPCProtocol_To_v1beta2_GRPCProtocol(a.(*controlplane.GRPCProtocol), b.(*GRPCProtocol), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*GroupAssociation)(nil), (*controlplane.GroupAssociation)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_GroupAssociation_To_controlplane_GroupAssociation(a.(*GroupAssociation), b.(*controlplane.GroupAssociation), scope)
 	}); err != nil {
@@ -229,6 +249,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HTTPHeaderMatch)(nil), (*controlplane.HTTPHeaderMatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_HTTPHeaderMatch_To_controlplane_HTTPHeaderMatch(a.(*HTTPHeaderMatch), b.(*controlplane.HTTPHeaderMatch), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.HTTPHeaderMatch)(nil), (*HTTPHeaderMatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_HTTPHeaderMatch_To_v1beta2_HTTPHeaderMatch(a.(*controlplane.HTTPHeaderMatch), b.(*HTTPHeaderMatch), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*HTTPProtocol)(nil), (*controlplane.HTTPProtocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_HTTPProtocol_To_controlplane_HTTPProtocol(a.(*HTTPProtocol), b.(*controlplane.HTTPProtocol), scope)
 	}); err != nil {
@@ -239,6 +269,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HTTPQueryParamMatch)(nil), (*controlplane.HTTPQueryParamMatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_HTTPQueryParamMatch_To_controlplane_HTTPQueryParamMatch(a.(*HTTPQueryParamMatch), b.(*controlplane.HTTPQueryParamMatch), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.HTTPQueryParamMatch)(nil), (*HTTPQueryParamMatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_HTTPQueryParamMatch_To_v1beta2_HTTPQueryParamMatch(a.(*controlplane.HTTPQueryParamMatch), b.(*HTTPQueryParamMatch), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*IPBlock)(nil), (*controlplane.IPBlock)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_IPBlock_To_controlplane_IPBlock(a.(*IPBlock), b.(*controlplane.IPBlock), scope)
 	}); err != nil {
@@ -269,6 +309,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*KafkaProtocol)(nil), (*controlplane.KafkaProtocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_KafkaProtocol_To_controlplane_KafkaProtocol(a.(*KafkaProtocol), b.(*controlplane.KafkaProtocol), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.KafkaProtocol)(nil), (*KafkaProtocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_KafkaProtocol_To_v1beta2_KafkaProtocol(a.(*controlplane.KafkaProtocol), b.(*KafkaProtocol), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*L7Protocol)(nil), (*controlplane.L7Protocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_L7Protocol_To_controlplane_L7Protocol(a.(*L7Protocol), b.(*controlplane.L7Protocol), scope)
 	}); err != nil {
@@ -449,6 +499,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*RuleRateLimit)(nil), (*controlplane.RuleRateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_RuleRateLimit_To_controlplane_RuleRateLimit(a.(*RuleRateLimit), b.(*controlplane.RuleRateLimit), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.RuleRateLimit)(nil), (*RuleRateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_RuleRateLimit_To_v1beta2_RuleRateLimit(a.(*controlplane.RuleRateLimit), b.(*RuleRateLimit), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*RuleRef)(nil), (*controlplane.RuleRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_RuleRef_To_controlplane_RuleRef(a.(*RuleRef), b.(*controlplane.RuleRef), scope)
 	}); err != nil {
@@ -459,6 +519,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*RuleSchedule)(nil), (*controlplane.RuleSchedule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_RuleSchedule_To_controlplane_RuleSchedule(a.(*RuleSchedule), b.(*controlplane.RuleSchedule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.RuleSchedule)(nil), (*RuleSchedule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_RuleSchedule_To_v1beta2_RuleSchedule(a.(*controlplane.RuleSchedule), b.(*RuleSchedule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ScheduleWindow)(nil), (*controlplane.ScheduleWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ScheduleWindow_To_controlplane_ScheduleWindow(a.(*ScheduleWindow), b.(*controlplane.ScheduleWindow), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.ScheduleWindow)(nil), (*ScheduleWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_ScheduleWindow_To_v1beta2_ScheduleWindow(a.(*controlplane.ScheduleWindow), b.(*ScheduleWindow), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Service)(nil), (*controlplane.Service)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_Service_To_controlplane_Service(a.(*Service), b.(*controlplane.Service), scope)
 	}); err != nil {
@@ -771,6 +851,26 @@ func Convert_controlplane_ClusterGroupMembers_To_v1beta2_ClusterGroupMembers(in
 	return autoConvert_controlplane_ClusterGroupMembers_To_v1beta2_ClusterGroupMembers(in, out, s)
 }
 
+func autoConvert_v1beta2_DNSProtocol_To_controlplane_DNSProtocol(in *DNSProtocol, out *controlplane.DNSProtocol, s conversion.Scope) error {
+	out.QueryName = in.QueryName
+	return nil
+}
+
+// Convert_v1beta2_DNSProtocol_To_controlplane_DNSProtocol is an autogenerated conversion function.
+func Convert_v1beta2_DNSProtocol_To_controlplane_DNSProtocol(in *DNSProtocol, out *controlplane.DNSProtocol, s conversion.Scope) error {
+	return autoConvert_v1beta2_DNSProtocol_To_controlplane_DNSProtocol(in, out, s)
+}
+
+func autoConvert_controlplane_DNSProtocol_To_v1beta2_DNSProtocol(in *controlplane.DNSProtocol, out *DNSProtocol, s conversion.Scope) error {
+	out.QueryName = in.QueryName
+	return nil
+}
+
+// Convert_controlplane_DNSProtocol_To_v1beta2_DNSProtocol is an autogenerated conversion function.
+func Convert_controlplane_DNSProtocol_To_v1beta2_DNSProtocol(in *controlplane.DNSProtocol, out *DNSProtocol, s conversion.Scope) error {
+	return autoConvert_controlplane_DNSProtocol_To_v1beta2_DNSProtocol(in, out, s)
+}
+
 func autoConvert_v1beta2_EgressGroup_To_controlplane_EgressGroup(in *EgressGroup, out *controlplane.EgressGroup, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.GroupMembers = *(*[]controlplane.GroupMember)(unsafe.Pointer(&in.GroupMembers))
@@ -881,6 +981,28 @@ func Convert_controlplane_ExternalEntityReference_To_v1beta2_ExternalEntityRefer
 	return autoConvert_controlplane_ExternalEntityReference_To_v1beta2_ExternalEntityReference(in, out, s)
 }
 
+func autoConvert_v1beta2_GRPCProtocol_To_controlplane_GRPCProtocol(in *GRPCProtocol, out *controlplane.GRPCProtocol, s conversion.Scope) error {
+	out.Service = in.Service
+	out.Method = in.Method
+	return nil
+}
+
+// Convert_v1beta2_GRPCProtocol_To_controlplane_GRPCProtocol is an autogenerated conversion function.
+func Convert_v1beta2_GRPCProtocol_To_controlplane_GRPCProtocol(in *GRPCProtocol, out *controlplane.GRPCProtocol, s conversion.Scope) error {
+	return autoConvert_v1beta2_GRPCProtocol_To_controlplane_GRPCProtocol(in, out, s)
+}
+
+func autoConvert_controlplane_GRPCProtocol_To_v1beta2_GRPCProtocol(in *controlplane.GRPCProtocol, out *GRPCProtocol, s conversion.Scope) error {
+	out.Service = in.Service
+	out.Method = in.Method
+	return nil
+}
+
+// Convert_controlplane_GRPCProtocol_To_v1beta2_GRPCProtocol is an autogenerated conversion function.
+func Convert_controlplane_GRPCProtocol_To_v1beta2_GRPCProtocol(in *controlplane.GRPCProtocol, out *GRPCProtocol, s conversion.Scope) error {
+	return autoConvert_controlplane_GRPCProtocol_To_v1beta2_GRPCProtocol(in, out, s)
+}
+
 func autoConvert_v1beta2_GroupAssociation_To_controlplane_GroupAssociation(in *GroupAssociation, out *controlplane.GroupAssociation, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.AssociatedGroups = *(*[]controlplane.GroupReference)(unsafe.Pointer(&in.AssociatedGroups))
@@ -987,10 +1109,37 @@ func Convert_controlplane_GroupReference_To_v1beta2_GroupReference(in *controlpl
 	return autoConvert_controlplane_GroupReference_To_v1beta2_GroupReference(in, out, s)
 }
 
+func autoConvert_v1beta2_HTTPHeaderMatch_To_controlplane_HTTPHeaderMatch(in *HTTPHeaderMatch, out *controlplane.HTTPHeaderMatch, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	out.Regex = in.Regex
+	return nil
+}
+
+// Convert_v1beta2_HTTPHeaderMatch_To_controlplane_HTTPHeaderMatch is an autogenerated conversion function.
+func Convert_v1beta2_HTTPHeaderMatch_To_controlplane_HTTPHeaderMatch(in *HTTPHeaderMatch, out *controlplane.HTTPHeaderMatch, s conversion.Scope) error {
+	return autoConvert_v1beta2_HTTPHeaderMatch_To_controlplane_HTTPHeaderMatch(in, out, s)
+}
+
+func autoConvert_controlplane_HTTPHeaderMatch_To_v1beta2_HTTPHeaderMatch(in *controlplane.HTTPHeaderMatch, out *HTTPHeaderMatch, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	out.Regex = in.Regex
+	return nil
+}
+
+// Convert_controlplane_HTTPHeaderMatch_To_v1beta2_HTTPHeaderMatch is an autogenerated conversion function.
+func Convert_controlplane_HTTPHeaderMatch_To_v1beta2_HTTPHeaderMatch(in *controlplane.HTTPHeaderMatch, out *HTTPHeaderMatch, s conversion.Scope) error {
+	return autoConvert_controlplane_HTTPHeaderMatch_To_v1beta2_HTTPHeaderMatch(in, out, s)
+}
+
 func autoConvert_v1beta2_HTTPProtocol_To_controlplane_HTTPProtocol(in *HTTPProtocol, out *controlplane.HTTPProtocol, s conversion.Scope) error {
 	out.Host = in.Host
 	out.Method = in.Method
 	out.Path = in.Path
+	out.PathRegex = in.PathRegex
+	out.Headers = *(*[]controlplane.HTTPHeaderMatch)(unsafe.Pointer(&in.Headers))
+	out.QueryParams = *(*[]controlplane.HTTPQueryParamMatch)(unsafe.Pointer(&in.QueryParams))
 	return nil
 }
 
@@ -1003,6 +1152,9 @@ func autoConvert_controlplane_HTTPProtocol_To_v1beta2_HTTPProtocol(in *controlpl
 	out.Host = in.Host
 	out.Method = in.Method
 	out.Path = in.Path
+	out.PathRegex = in.PathRegex
+	out.Headers = *(*[]HTTPHeaderMatch)(unsafe.Pointer(&in.Headers))
+	out.QueryParams = *(*[]HTTPQueryParamMatch)(unsafe.Pointer(&in.QueryParams))
 	return nil
 }
 
@@ -1011,6 +1163,30 @@ func Convert_controlplane_HTTPProtocol_To_v1beta2_HTTPProtocol(in *controlplane.
 	return autoConvert_controlplane_HTTPProtocol_To_v1beta2_HTTPProtocol(in, out, s)
 }
 
+func autoConvert_v1beta2_HTTPQueryParamMatch_To_controlplane_HTTPQueryParamMatch(in *HTTPQueryParamMatch, out *controlplane.HTTPQueryParamMatch, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	out.Regex = in.Regex
+	return nil
+}
+
+// Convert_v1beta2_HTTPQueryParamMatch_To_controlplane_HTTPQueryParamMatch is an autogenerated conversion function.
+func Convert_v1beta2_HTTPQueryParamMatch_To_controlplane_HTTPQueryParamMatch(in *HTTPQueryParamMatch, out *controlplane.HTTPQueryParamMatch, s conversion.Scope) error {
+	return autoConvert_v1beta2_HTTPQueryParamMatch_To_controlplane_HTTPQueryParamMatch(in, out, s)
+}
+
+func autoConvert_controlplane_HTTPQueryParamMatch_To_v1beta2_HTTPQueryParamMatch(in *controlplane.HTTPQueryParamMatch, out *HTTPQueryParamMatch, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	out.Regex = in.Regex
+	return nil
+}
+
+// Convert_controlplane_HTTPQueryParamMatch_To_v1beta2_HTTPQueryParamMatch is an autogenerated conversion function.
+func Convert_controlplane_HTTPQueryParamMatch_To_v1beta2_HTTPQueryParamMatch(in *controlplane.HTTPQueryParamMatch, out *HTTPQueryParamMatch, s conversion.Scope) error {
+	return autoConvert_controlplane_HTTPQueryParamMatch_To_v1beta2_HTTPQueryParamMatch(in, out, s)
+}
+
 func autoConvert_v1beta2_IPBlock_To_controlplane_IPBlock(in *IPBlock, out *controlplane.IPBlock, s conversion.Scope) error {
 	if err := Convert_v1beta2_IPNet_To_controlplane_IPNet(&in.CIDR, &out.CIDR, s); err != nil {
 		return err
@@ -1081,9 +1257,34 @@ func Convert_controlplane_IPNet_To_v1beta2_IPNet(in *controlplane.IPNet, out *IP
 	return autoConvert_controlplane_IPNet_To_v1beta2_IPNet(in, out, s)
 }
 
+func autoConvert_v1beta2_KafkaProtocol_To_controlplane_KafkaProtocol(in *KafkaProtocol, out *controlplane.KafkaProtocol, s conversion.Scope) error {
+	out.APIKey = v1beta1.KafkaAPIKey(in.APIKey)
+	out.Topic = in.Topic
+	return nil
+}
+
+// Convert_v1beta2_KafkaProtocol_To_controlplane_KafkaProtocol is an autogenerated conversion function.
+func Convert_v1beta2_KafkaProtocol_To_controlplane_KafkaProtocol(in *KafkaProtocol, out *controlplane.KafkaProtocol, s conversion.Scope) error {
+	return autoConvert_v1beta2_KafkaProtocol_To_controlplane_KafkaProtocol(in, out, s)
+}
+
+func autoConvert_controlplane_KafkaProtocol_To_v1beta2_KafkaProtocol(in *controlplane.KafkaProtocol, out *KafkaProtocol, s conversion.Scope) error {
+	out.APIKey = v1beta1.KafkaAPIKey(in.APIKey)
+	out.Topic = in.Topic
+	return nil
+}
+
+// Convert_controlplane_KafkaProtocol_To_v1beta2_KafkaProtocol is an autogenerated conversion function.
+func Convert_controlplane_KafkaProtocol_To_v1beta2_KafkaProtocol(in *controlplane.KafkaProtocol, out *KafkaProtocol, s conversion.Scope) error {
+	return autoConvert_controlplane_KafkaProtocol_To_v1beta2_KafkaProtocol(in, out, s)
+}
+
 func autoConvert_v1beta2_L7Protocol_To_controlplane_L7Protocol(in *L7Protocol, out *controlplane.L7Protocol, s conversion.Scope) error {
 	out.HTTP = (*controlplane.HTTPProtocol)(unsafe.Pointer(in.HTTP))
 	out.TLS = (*controlplane.TLSProtocol)(unsafe.Pointer(in.TLS))
+	out.GRPC = (*controlplane.GRPCProtocol)(unsafe.Pointer(in.GRPC))
+	out.Kafka = (*controlplane.KafkaProtocol)(unsafe.Pointer(in.Kafka))
+	out.DNS = (*controlplane.DNSProtocol)(unsafe.Pointer(in.DNS))
 	return nil
 }
 
@@ -1095,6 +1296,9 @@ func Convert_v1beta2_L7Protocol_To_controlplane_L7Protocol(in *L7Protocol, out *
 func autoConvert_controlplane_L7Protocol_To_v1beta2_L7Protocol(in *controlplane.L7Protocol, out *L7Protocol, s conversion.Scope) error {
 	out.HTTP = (*HTTPProtocol)(unsafe.Pointer(in.HTTP))
 	out.TLS = (*TLSProtocol)(unsafe.Pointer(in.TLS))
+	out.GRPC = (*GRPCProtocol)(unsafe.Pointer(in.GRPC))
+	out.Kafka = (*KafkaProtocol)(unsafe.Pointer(in.Kafka))
+	out.DNS = (*DNSProtocol)(unsafe.Pointer(in.DNS))
 	return nil
 }
 
@@ -1433,6 +1637,8 @@ func autoConvert_v1beta2_NetworkPolicyRule_To_controlplane_NetworkPolicyRule(in
 	out.Name = in.Name
 	out.L7Protocols = *(*[]controlplane.L7Protocol)(unsafe.Pointer(&in.L7Protocols))
 	out.LogLabel = in.LogLabel
+	out.Schedule = (*controlplane.RuleSchedule)(unsafe.Pointer(in.Schedule))
+	out.RateLimit = (*controlplane.RuleRateLimit)(unsafe.Pointer(in.RateLimit))
 	return nil
 }
 
@@ -1467,6 +1673,8 @@ func autoConvert_controlplane_NetworkPolicyRule_To_v1beta2_NetworkPolicyRule(in
 	out.AppliedToGroups = *(*[]string)(unsafe.Pointer(&in.AppliedToGroups))
 	out.L7Protocols = *(*[]L7Protocol)(unsafe.Pointer(&in.L7Protocols))
 	out.LogLabel = in.LogLabel
+	out.Schedule = (*RuleSchedule)(unsafe.Pointer(in.Schedule))
+	out.RateLimit = (*RuleRateLimit)(unsafe.Pointer(in.RateLimit))
 	return nil
 }
 
@@ -1642,6 +1850,30 @@ func Convert_controlplane_PodReference_To_v1beta2_PodReference(in *controlplane.
 	return autoConvert_controlplane_PodReference_To_v1beta2_PodReference(in, out, s)
 }
 
+func autoConvert_v1beta2_RuleRateLimit_To_controlplane_RuleRateLimit(in *RuleRateLimit, out *controlplane.RuleRateLimit, s conversion.Scope) error {
+	out.Rate = in.Rate
+	out.Burst = in.Burst
+	out.Unit = v1beta1.RateLimitUnit(in.Unit)
+	return nil
+}
+
+// Convert_v1beta2_RuleRateLimit_To_controlplane_RuleRateLimit is an autogenerated conversion function.
+func Convert_v1beta2_RuleRateLimit_To_controlplane_RuleRateLimit(in *RuleRateLimit, out *controlplane.RuleRateLimit, s conversion.Scope) error {
+	return autoConvert_v1beta2_RuleRateLimit_To_controlplane_RuleRateLimit(in, out, s)
+}
+
+func autoConvert_controlplane_RuleRateLimit_To_v1beta2_RuleRateLimit(in *controlplane.RuleRateLimit, out *RuleRateLimit, s conversion.Scope) error {
+	out.Rate = in.Rate
+	out.Burst = in.Burst
+	out.Unit = v1beta1.RateLimitUnit(in.Unit)
+	return nil
+}
+
+// Convert_controlplane_RuleRateLimit_To_v1beta2_RuleRateLimit is an autogenerated conversion function.
+func Convert_controlplane_RuleRateLimit_To_v1beta2_RuleRateLimit(in *controlplane.RuleRateLimit, out *RuleRateLimit, s conversion.Scope) error {
+	return autoConvert_controlplane_RuleRateLimit_To_v1beta2_RuleRateLimit(in, out, s)
+}
+
 func autoConvert_v1beta2_RuleRef_To_controlplane_RuleRef(in *RuleRef, out *controlplane.RuleRef, s conversion.Scope) error {
 	out.Direction = controlplane.Direction(in.Direction)
 	out.Name = in.Name
@@ -1666,6 +1898,50 @@ func Convert_controlplane_RuleRef_To_v1beta2_RuleRef(in *controlplane.RuleRef, o
 	return autoConvert_controlplane_RuleRef_To_v1beta2_RuleRef(in, out, s)
 }
 
+func autoConvert_v1beta2_RuleSchedule_To_controlplane_RuleSchedule(in *RuleSchedule, out *controlplane.RuleSchedule, s conversion.Scope) error {
+	out.Windows = *(*[]controlplane.ScheduleWindow)(unsafe.Pointer(&in.Windows))
+	out.TimeZone = in.TimeZone
+	return nil
+}
+
+// Convert_v1beta2_RuleSchedule_To_controlplane_RuleSchedule is an autogenerated conversion function.
+func Convert_v1beta2_RuleSchedule_To_controlplane_RuleSchedule(in *RuleSchedule, out *controlplane.RuleSchedule, s conversion.Scope) error {
+	return autoConvert_v1beta2_RuleSchedule_To_controlplane_RuleSchedule(in, out, s)
+}
+
+func autoConvert_controlplane_RuleSchedule_To_v1beta2_RuleSchedule(in *controlplane.RuleSchedule, out *RuleSchedule, s conversion.Scope) error {
+	out.Windows = *(*[]ScheduleWindow)(unsafe.Pointer(&in.Windows))
+	out.TimeZone = in.TimeZone
+	return nil
+}
+
+// Convert_controlplane_RuleSchedule_To_v1beta2_RuleSchedule is an autogenerated conversion function.
+func Convert_controlplane_RuleSchedule_To_v1beta2_RuleSchedule(in *controlplane.RuleSchedule, out *RuleSchedule, s conversion.Scope) error {
+	return autoConvert_controlplane_RuleSchedule_To_v1beta2_RuleSchedule(in, out, s)
+}
+
+func autoConvert_v1beta2_ScheduleWindow_To_controlplane_ScheduleWindow(in *ScheduleWindow, out *controlplane.ScheduleWindow, s conversion.Scope) error {
+	out.Start = in.Start
+	out.Duration = in.Duration
+	return nil
+}
+
+// Convert_v1beta2_ScheduleWindow_To_controlplane_ScheduleWindow is an autogenerated conversion function.
+func Convert_v1beta2_ScheduleWindow_To_controlplane_ScheduleWindow(in *ScheduleWindow, out *controlplane.ScheduleWindow, s conversion.Scope) error {
+	return autoConvert_v1beta2_ScheduleWindow_To_controlplane_ScheduleWindow(in, out, s)
+}
+
+func autoConvert_controlplane_ScheduleWindow_To_v1beta2_ScheduleWindow(in *controlplane.ScheduleWindow, out *ScheduleWindow, s conversion.Scope) error {
+	out.Start = in.Start
+	out.Duration = in.Duration
+	return nil
+}
+
+// Convert_controlplane_ScheduleWindow_To_v1beta2_ScheduleWindow is an autogenerated conversion function.
+func Convert_controlplane_ScheduleWindow_To_v1beta2_ScheduleWindow(in *controlplane.ScheduleWindow, out *ScheduleWindow, s conversion.Scope) error {
+	return autoConvert_controlplane_ScheduleWindow_To_v1beta2_ScheduleWindow(in, out, s)
+}
+
 func autoConvert_v1beta2_Service_To_controlplane_Service(in *Service, out *controlplane.Service, s conversion.Scope) error {
 	out.Protocol = (*controlplane.Protocol)(unsafe.Pointer(in.Protocol))
 	out.Port = (*intstr.IntOrString)(unsafe.Pointer(in.Port))
@@ -213,10 +213,38 @@ type NodeLatencyMonitor struct {
 	Spec NodeLatencyMonitorSpec `json:"spec"`
 }
 
+type NodeLatencyProbeProtocol string
+
+const (
+	NodeLatencyProbeProtocolICMP NodeLatencyProbeProtocol = "ICMP"
+	NodeLatencyProbeProtocolUDP  NodeLatencyProbeProtocol = "UDP"
+	NodeLatencyProbeProtocolTCP  NodeLatencyProbeProtocol = "TCP"
+)
+
 type NodeLatencyMonitorSpec struct {
 	// PingInterval specifies the interval in seconds between ping requests.
 	// Ping interval should be greater than or equal to 1s.
 	PingIntervalSeconds int32 `json:"pingIntervalSeconds"`
+	// MetricsPeerSampleSize specifies the maximum number of peer Nodes for which
+	// each Node exports latency metrics to Prometheus. Peer Nodes are sampled
+	// when the cluster has more Nodes. 0 means that metrics are exported for all
+	// peer Nodes.
+	MetricsPeerSampleSize int32 `json:"metricsPeerSampleSize,omitempty"`
+	// ProbeProtocol specifies the protocol of the probes: ICMP echo requests,
+	// UDP datagrams echoed by the Antrea Agent of the peer Node, or TCP SYN
+	// packets, for which the RTT is measured until the SYN-ACK or RST is
+	// received. Defaults to ICMP.
+	ProbeProtocol NodeLatencyProbeProtocol `json:"probeProtocol,omitempty"`
+	// ProbePort specifies the destination port of UDP and TCP probes. Defaults
+	// to 10352.
+	ProbePort int32 `json:"probePort,omitempty"`
+	// MTUSizedProbes specifies whether probes should be padded to the MTU of
+	// Pod interfaces. Probes are still sent to the peer Nodes (to their Antrea
+	// gateway IPs, except in networkPolicyOnly mode), not to Pods, but in encap
+	// mode they are encapsulated and encrypted like the largest inter-Node Pod
+	// packets, and are lost if the tunnel MTU does not account for the
+	// overhead. It has no effect on TCP probes, which carry no payload.
+	MTUSizedProbes bool `json:"mtuSizedProbes,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -274,18 +302,22 @@ type BGPPolicySpec struct {
 
 	// BGPPeers is the list of BGP peers.
 	BGPPeers []BGPPeer `json:"bgpPeers,omitempty"`
+
+	// Import configures which routes received from BGP peers are installed in the routing table of the Node. If not
+	// set, received routes are not installed.
+	Import *RouteImport `json:"import,omitempty"`
 }
 
 type Advertisements struct {
 	// Service specifies how to advertise Service IPs.
 	Service *ServiceAdvertisement `json:"service,omitempty"`
 
-	// Pod specifies how to advertise Pod IPs. Currently, if this is set, NodeIPAM Pod CIDR instead of specific Pods IPs
-	// will be advertised since pod selector is not added yet.
+	// Pod specifies how to advertise Pod IPs. If no selector is set, the NodeIPAM Pod CIDRs of the Node will be
+	// advertised. Otherwise, the individual IPs of the selected Pods running on the Node will be advertised.
 	Pod *PodAdvertisement `json:"pod,omitempty"`
 
-	// Egress specifies how to advertise Egress IPs. Currently, if this is set, all Egress IPs will be advertised since
-	// Egress selector is not added yet.
+	// Egress specifies how to advertise Egress IPs. Only the IPs of the selected Egresses assigned to the Node will
+	// be advertised.
 	Egress *EgressAdvertisement `json:"egress,omitempty"`
 }
 
@@ -298,22 +330,64 @@ const (
 )
 
 type ServiceAdvertisement struct {
-	// IPTypes specifies the types of Service IPs from the selected Services to be advertised. Currently, all Services
-	// will be selected since Service selector is not added yet.
+	// IPTypes specifies the types of Service IPs from the selected Services to be advertised.
 	IPTypes []ServiceIPType `json:"ipTypes,omitempty"`
 
-	// Empty now, selectors to be added later, which are used to select specific Services.
-	// Selectors []Selector `json:"selectors,omitempty"`
+	// ServiceSelector selects Services by their labels. If not set, all Services in the selected Namespaces will be
+	// selected.
+	ServiceSelector *metav1.LabelSelector `json:"serviceSelector,omitempty"`
+
+	// NamespaceSelector selects Namespaces by their labels. Only Services in the selected Namespaces will be
+	// selected. If not set, Services in all Namespaces will be selected.
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// Attributes specifies the BGP path attributes attached to the advertised Service IPs.
+	Attributes *RouteAttributes `json:"attributes,omitempty"`
 }
 
 type PodAdvertisement struct {
-	// Empty now, selectors to be added later, which are used to select specific Pods.
-	// Selectors []Selector `json:"selectors,omitempty"`
+	// PodSelector selects Pods by their labels. If set, the individual IPs of the selected Pods running on the
+	// Node will be advertised as host routes (/32 for IPv4 and /128 for IPv6) instead of the NodeIPAM Pod CIDRs.
+	PodSelector *metav1.LabelSelector `json:"podSelector,omitempty"`
+
+	// NamespaceSelector selects Namespaces by their labels. If set, the individual IPs of the Pods in the selected
+	// Namespaces running on the Node will be advertised instead of the NodeIPAM Pod CIDRs. It can be combined with
+	// PodSelector to select Pods in specific Namespaces.
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// Attributes specifies the BGP path attributes attached to the advertised Pod IPs or Pod CIDRs.
+	Attributes *RouteAttributes `json:"attributes,omitempty"`
 }
 
 type EgressAdvertisement struct {
-	// Empty now, selectors to be added later, which are used to select specific Egresses.
-	// Selectors []Selector `json:"selectors,omitempty"`
+	// EgressSelector selects Egresses by their labels. If not set, all Egresses will be selected.
+	EgressSelector *metav1.LabelSelector `json:"egressSelector,omitempty"`
+
+	// Attributes specifies the BGP path attributes attached to the advertised Egress IPs.
+	Attributes *RouteAttributes `json:"attributes,omitempty"`
+}
+
+// RouteAttributes specifies the BGP path attributes attached to advertised routes.
+type RouteAttributes struct {
+	// Communities is the list of standard BGP communities (RFC 1997). Each community is either in the "ASN:value"
+	// format, where both parts are in the range 0-65535, or one of the well-known communities "no-export",
+	// "no-advertise" and "no-export-subconfed".
+	Communities []string `json:"communities,omitempty"`
+
+	// LargeCommunities is the list of large BGP communities (RFC 8092). Each community is in the
+	// "globalAdmin:localData1:localData2" format, where each part is in the range 0-4294967295.
+	LargeCommunities []string `json:"largeCommunities,omitempty"`
+
+	// MED is the Multi-Exit Discriminator, in the range 0-4294967295. BGP peers prefer routes with a lower MED.
+	MED *int64 `json:"med,omitempty"`
+
+	// LocalPreference is the local preference, in the range 0-4294967295. It is only sent to iBGP peers, which prefer
+	// routes with a higher local preference.
+	LocalPreference *int64 `json:"localPreference,omitempty"`
+
+	// ASPathPrepend is the number of times the local AS number is prepended to the AS path, in the range 0-10. BGP
+	// peers prefer routes with a shorter AS path.
+	ASPathPrepend int32 `json:"asPathPrepend,omitempty"`
 }
 
 type BGPPeer struct {
@@ -326,6 +400,11 @@ type BGPPeer struct {
 	// The AS number of the BGP peer.
 	ASN int32 `json:"asn"`
 
+	// AddressFamilies specifies the address families enabled for the BGP session with the BGP peer. Enabling both
+	// IPv4Unicast and IPv6Unicast allows the routes of both IP families to be exchanged over a single BGP session. If
+	// not set, only the address family of the peer address is enabled.
+	AddressFamilies []AddressFamily `json:"addressFamilies,omitempty"`
+
 	// The Time To Live (TTL) value used in BGP packets sent to the BGP peer. The range of the value is from 1 to 255,
 	// and the default value is 1.
 	MultihopTTL *int32 `json:"multihopTTL,omitempty"`
@@ -333,6 +412,66 @@ type BGPPeer struct {
 	// GracefulRestartTimeSeconds specifies how long the BGP peer would wait for the BGP session to re-establish after
 	// a restart before deleting stale routes. The range of the value is from 1 to 3600, and the default value is 120.
 	GracefulRestartTimeSeconds *int32 `json:"gracefulRestartTimeSeconds,omitempty"`
+
+	// HoldTimeSeconds specifies how long to wait for a message from the BGP peer before closing the BGP session. The
+	// range of the value is from 3 to 65535, and the default value is 90.
+	HoldTimeSeconds *int32 `json:"holdTimeSeconds,omitempty"`
+
+	// KeepaliveTimeSeconds specifies the interval at which KEEPALIVE messages are sent to the BGP peer. It must be less
+	// than HoldTimeSeconds. The range of the value is from 1 to 21845, and the default value is one third of the hold
+	// time.
+	KeepaliveTimeSeconds *int32 `json:"keepaliveTimeSeconds,omitempty"`
+
+	// BFD enables Bidirectional Forwarding Detection (BFD) with the BGP peer, to detect the failure of the path to the
+	// BGP peer faster than the BGP hold time. When the BFD session goes down, the BGP session is closed until the BFD
+	// session is up again. BFD is only supported for directly connected BGP peers.
+	BFD *BFDConfig `json:"bfd,omitempty"`
+}
+
+type AddressFamily string
+
+const (
+	AddressFamilyIPv4Unicast AddressFamily = "IPv4Unicast"
+	AddressFamilyIPv6Unicast AddressFamily = "IPv6Unicast"
+)
+
+// BFDConfig specifies the parameters of a BFD session.
+type BFDConfig struct {
+	// MinIntervalMilliseconds is the minimum interval at which BFD control packets are sent to and received from the
+	// BGP peer. The range of the value is from 10 to 60000, and the default value is 300.
+	MinIntervalMilliseconds *int32 `json:"minIntervalMilliseconds,omitempty"`
+
+	// Multiplier is the number of BFD control packets which can be missed before the BFD session is declared down. The
+	// range of the value is from 1 to 255, and the default value is 3.
+	Multiplier *int32 `json:"multiplier,omitempty"`
+}
+
+// RouteImport specifies how routes received from BGP peers are imported.
+type RouteImport struct {
+	// Prefixes is the list of prefix filters. A received route is imported only if its prefix matches any filter.
+	Prefixes []ImportPrefix `json:"prefixes"`
+
+	// MaxPrefixes is the maximum number of routes to import. When more routes are received, no route is imported
+	// until the number of received routes is under the limit again. 0 means no limit.
+	MaxPrefixes int32 `json:"maxPrefixes,omitempty"`
+
+	// Metric is the metric of the imported routes in the routing table of the Node. A route with a lower metric is
+	// preferred over other routes for the same prefix, e.g. static routes. The default value is 20.
+	Metric *int32 `json:"metric,omitempty"`
+}
+
+// ImportPrefix matches the prefixes of received routes.
+type ImportPrefix struct {
+	// CIDR is the CIDR containing the matched prefixes, e.g. "10.0.0.0/8".
+	CIDR string `json:"cidr"`
+
+	// MinLength is the minimum prefix length of the matched prefixes. If not set, it defaults to the prefix length of
+	// CIDR.
+	MinLength int32 `json:"minLength,omitempty"`
+
+	// MaxLength is the maximum prefix length of the matched prefixes. If not set, it defaults to the prefix length of
+	// CIDR, i.e. only the CIDR itself is matched.
+	MaxLength int32 `json:"maxLength,omitempty"`
 }
 
 type PodReference struct {
@@ -340,20 +479,43 @@ type PodReference struct {
 	Name      string `json:"name"`
 }
 
-// Source describes the source spec of the packetcapture.
+// PodSelector selects Pods in a Namespace by their labels.
+type PodSelector struct {
+	// Namespace is the Namespace of the selected Pods.
+	Namespace string `json:"namespace"`
+	// Selector selects Pods by their labels. An empty selector selects all Pods in the Namespace.
+	Selector metav1.LabelSelector `json:"selector"`
+}
+
+// Source describes the source spec of the packetcapture. Pod, Pods, IP, IPBlock and Service are mutually exclusive.
 type Source struct {
 	// Pod is the source Pod, mutually exclusive with IP.
 	Pod *PodReference `json:"pod,omitempty"`
+	// Pods selects multiple source Pods. The selection is resolved when the capture starts.
+	Pods *PodSelector `json:"pods,omitempty"`
 	// IP is the source IPv4 or IPv6 address.
 	IP *string `json:"ip,omitempty"`
+	// IPBlock is the CIDR the source address belongs to.
+	IPBlock *IPBlock `json:"ipBlock,omitempty"`
+	// Service is the source Service. It is expanded to the ClusterIPs and the Endpoint addresses of the Service
+	// when the capture starts.
+	Service *NamespacedName `json:"service,omitempty"`
 }
 
-// Destination describes the destination spec of the PacketCapture.
+// Destination describes the destination spec of the PacketCapture. Pod, Pods, IP, IPBlock and Service are mutually
+// exclusive.
 type Destination struct {
 	// Pod is the destination Pod, exclusive with destination IP.
 	Pod *PodReference `json:"pod,omitempty"`
+	// Pods selects multiple destination Pods. The selection is resolved when the capture starts.
+	Pods *PodSelector `json:"pods,omitempty"`
 	// IP is the source IPv4 or IPv6 address.
 	IP *string `json:"ip,omitempty"`
+	// IPBlock is the CIDR the destination address belongs to.
+	IPBlock *IPBlock `json:"ipBlock,omitempty"`
+	// Service is the destination Service. It is expanded to the ClusterIPs and the Endpoint addresses of the Service
+	// when the capture starts.
+	Service *NamespacedName `json:"service,omitempty"`
 }
 
 // TransportHeader describes the spec of a TransportHeader.
@@ -385,6 +547,11 @@ type Packet struct {
 	// Protocol represents the transport protocol. No protocol based filter when it's empty.
 	Protocol        *intstr.IntOrString `json:"protocol,omitempty"`
 	TransportHeader TransportHeader     `json:"transportHeader"`
+	// Filter is a pcap-filter expression, e.g. "udp port 53 or tcp port 53". Packets must match both the
+	// expression and the other fields to be captured. Only a subset of the pcap-filter syntax is supported: the
+	// "ip", "tcp", "udp", "icmp", "proto", "host", "net", "port" and "portrange" primitives, with the "src" and "dst"
+	// qualifiers, combined with "and", "or", "not" and parentheses.
+	Filter string `json:"filter,omitempty"`
 }
 
 // PacketCaptureFirstNConfig contains the config for the FirstN type capture. The only supported parameter is
@@ -393,6 +560,30 @@ type PacketCaptureFirstNConfig struct {
 	Number int32 `json:"number"`
 }
 
+// PacketCaptureDurationConfig contains the config for the Duration type capture. All matching packets are
+// captured until `Seconds` have elapsed since the capture started.
+type PacketCaptureDurationConfig struct {
+	// Seconds is the capture duration in seconds. It must be less than the timeout of the PacketCapture.
+	Seconds int32 `json:"seconds"`
+}
+
+// PacketCaptureBytesConfig contains the config for the Bytes type capture. Matching packets are captured until
+// their total size reaches `Limit` bytes. A packet which would make the total size exceed the limit is not captured.
+type PacketCaptureBytesConfig struct {
+	Limit int64 `json:"limit"`
+}
+
+// PacketCaptureRingBufferConfig contains the config for the RingBuffer type capture. The most recent `Number`
+// packets are kept in memory, and older packets are discarded as new ones arrive. The packets kept in the buffer are
+// saved when the capture is frozen, or when the capture times out.
+type PacketCaptureRingBufferConfig struct {
+	// Number is the number of most recent packets kept in the buffer.
+	Number int32 `json:"number"`
+	// Freeze stops a running capture and saves the packets currently kept in the buffer. It is expected to be set to
+	// true by updating the PacketCapture when the issue being investigated happens.
+	Freeze bool `json:"freeze,omitempty"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 type PacketCaptureList struct {
@@ -414,12 +605,55 @@ type PacketCapture struct {
 	Status            PacketCaptureStatus `json:"status"`
 }
 
+// CaptureConfig specifies when a capture session stops. Exactly one of the fields must be set.
 type CaptureConfig struct {
 	// FirstN means we only capture first N packets from the target traffic.
-	// At the moment this is the only supported configuration and it is required for every capture.
 	FirstN *PacketCaptureFirstNConfig `json:"firstN,omitempty"`
+	// Duration means we capture all packets from the target traffic for a period of time.
+	Duration *PacketCaptureDurationConfig `json:"duration,omitempty"`
+	// Bytes means we capture packets from the target traffic until a byte budget is used up.
+	Bytes *PacketCaptureBytesConfig `json:"bytes,omitempty"`
+	// RingBuffer means we keep the last N packets from the target traffic until the capture is frozen.
+	RingBuffer *PacketCaptureRingBufferConfig `json:"ringBuffer,omitempty"`
 }
 
+type PacketCaptureDirection string
+
+const (
+	// PacketCaptureDirectionSourceToDestination captures packets sent from the source to the destination.
+	PacketCaptureDirectionSourceToDestination PacketCaptureDirection = "SourceToDestination"
+	// PacketCaptureDirectionDestinationToSource captures packets sent from the destination to the source.
+	PacketCaptureDirectionDestinationToSource PacketCaptureDirection = "DestinationToSource"
+	// PacketCaptureDirectionBoth captures packets sent in both directions.
+	PacketCaptureDirectionBoth PacketCaptureDirection = "Both"
+)
+
+type PacketCaptureMode string
+
+const (
+	PacketCaptureModeFirstN     PacketCaptureMode = "FirstN"
+	PacketCaptureModeDuration   PacketCaptureMode = "Duration"
+	PacketCaptureModeBytes      PacketCaptureMode = "Bytes"
+	PacketCaptureModeRingBuffer PacketCaptureMode = "RingBuffer"
+)
+
+type PacketCaptureStopReason string
+
+const (
+	// PacketCaptureStopReasonPacketLimitReached means the target number of packets of a FirstN capture have been captured.
+	PacketCaptureStopReasonPacketLimitReached PacketCaptureStopReason = "PacketLimitReached"
+	// PacketCaptureStopReasonDurationElapsed means the duration of a Duration capture has elapsed.
+	PacketCaptureStopReasonDurationElapsed PacketCaptureStopReason = "DurationElapsed"
+	// PacketCaptureStopReasonByteLimitReached means the byte budget of a Bytes capture has been used up.
+	PacketCaptureStopReasonByteLimitReached PacketCaptureStopReason = "ByteLimitReached"
+	// PacketCaptureStopReasonFrozen means a RingBuffer capture has been frozen.
+	PacketCaptureStopReasonFrozen PacketCaptureStopReason = "Frozen"
+	// PacketCaptureStopReasonTimeout means the capture reached its timeout before it could stop otherwise.
+	PacketCaptureStopReasonTimeout PacketCaptureStopReason = "Timeout"
+	// PacketCaptureStopReasonError means the capture stopped because of an error.
+	PacketCaptureStopReasonError PacketCaptureStopReason = "Error"
+)
+
 // PacketCaptureFileServer specifies the PacketCapture file server information.
 type PacketCaptureFileServer struct {
 	// The URL of the file server. It is set with format: scheme://host[:port][/path],
@@ -432,13 +666,18 @@ type PacketCaptureFileServer struct {
 }
 
 type PacketCaptureSpec struct {
-	// Timeout is the timeout for this capture session. If not specified, defaults to 60s.
+	// Timeout is the timeout for this capture session. If not specified, defaults to 60s. It can be at most 300s,
+	// except for Duration and RingBuffer captures which can run for up to 86400s. For RingBuffer captures, it is the
+	// maximum time the capture keeps running while waiting to be frozen.
 	Timeout       *int32        `json:"timeout,omitempty"`
 	CaptureConfig CaptureConfig `json:"captureConfig"`
 	// Source is the traffic source we want to perform capture on. Both `Source` and `Destination` is required
 	// for a capture session, and at least one `Pod` should be present either in the source or the destination.
 	Source      Source      `json:"source"`
 	Destination Destination `json:"destination"`
+	// Direction is the direction of the traffic to capture between the source and destination. If not specified,
+	// defaults to SourceToDestination.
+	Direction PacketCaptureDirection `json:"direction,omitempty"`
 	// Packet defines what kind of traffic we want to capture between the source and destination. If not specified,
 	// all kinds of traffic will count.
 	Packet *Packet `json:"packet,omitempty"`
@@ -452,12 +691,41 @@ type PacketCaptureStatus struct {
 	// NumberCaptured records how many packets have been captured. If it reaches the target number, the capture
 	// can be considered as finished.
 	NumberCaptured int32 `json:"numberCaptured"`
+	// BytesCaptured records the total size in bytes of the packets which have been captured.
+	BytesCaptured int64 `json:"bytesCaptured"`
+	// CaptureMode is the mode of the capture session, derived from `.spec.captureConfig`.
+	CaptureMode PacketCaptureMode `json:"captureMode,omitempty"`
+	// StopReason describes why the capture session stopped. It is empty while the capture is still running.
+	StopReason PacketCaptureStopReason `json:"stopReason,omitempty"`
 	// FilePath specifies the location where captured packets are stored. It can either be a URL to download the pcap file (if "Spec.FileServer" is specified)
 	// or a local file path on the antrea-agent Pod where the packet was captured, formatted as : <antrea-agent-pod-name>:<path>.
 	// When using a local file path, the file will be automatically removed after the PacketCapture resource is deleted.
 	FilePath string `json:"filePath"`
 	// Condition represents the latest available observations of the PacketCapture's current state.
 	Conditions []PacketCaptureCondition `json:"conditions"`
+	// NodeResults records the capture on each Node involved in the PacketCapture. When more than one Node is
+	// involved, the other fields of the status aggregate the results of all Nodes, and FilePath is the location
+	// of the file merging the packets captured on all Nodes.
+	NodeResults []PacketCaptureNodeResult `json:"nodeResults,omitempty"`
+}
+
+// PacketCaptureNodeResult describes the capture on one Node.
+type PacketCaptureNodeResult struct {
+	// NodeName is the name of the Node.
+	NodeName string `json:"nodeName"`
+	// Pods are the Pods whose interfaces are captured on the Node, formatted as <namespace>/<name>.
+	Pods []string `json:"pods,omitempty"`
+	// NumberCaptured records how many packets have been captured on the Node.
+	NumberCaptured int32 `json:"numberCaptured"`
+	// BytesCaptured records the total size in bytes of the packets which have been captured on the Node.
+	BytesCaptured int64 `json:"bytesCaptured"`
+	// StopReason describes why the capture on the Node stopped.
+	StopReason PacketCaptureStopReason `json:"stopReason,omitempty"`
+	// FilePath specifies the location where the packets captured on the Node are stored, in the same format as
+	// `.status.filePath`.
+	FilePath string `json:"filePath,omitempty"`
+	// Conditions represent the state of the capture on the Node.
+	Conditions []PacketCaptureCondition `json:"conditions,omitempty"`
 }
 
 type PacketCaptureConditionType string
@@ -470,6 +738,9 @@ const (
 	PacketCaptureComplete PacketCaptureConditionType = "PacketCaptureComplete"
 	// PacketCaptureFileUploaded means the captured packets file has been uploaded to the target file server.
 	PacketCaptureFileUploaded PacketCaptureConditionType = "PacketCaptureFileUploaded"
+	// PacketCaptureFileMerged means the packets captured on multiple Nodes have been merged into one file, which has
+	// been uploaded to the target file server.
+	PacketCaptureFileMerged PacketCaptureConditionType = "PacketCaptureFileMerged"
 )
 
 type PacketCaptureCondition struct {
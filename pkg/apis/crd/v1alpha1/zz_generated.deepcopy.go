@@ -37,12 +37,12 @@ func (in *Advertisements) DeepCopyInto(out *Advertisements) {
 	if in.Pod != nil {
 		in, out := &in.Pod, &out.Pod
 		*out = new(PodAdvertisement)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Egress != nil {
 		in, out := &in.Egress, &out.Egress
 		*out = new(EgressAdvertisement)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -57,6 +57,32 @@ func (in *Advertisements) DeepCopy() *Advertisements {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BFDConfig) DeepCopyInto(out *BFDConfig) {
+	*out = *in
+	if in.MinIntervalMilliseconds != nil {
+		in, out := &in.MinIntervalMilliseconds, &out.MinIntervalMilliseconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Multiplier != nil {
+		in, out := &in.Multiplier, &out.Multiplier
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BFDConfig.
+func (in *BFDConfig) DeepCopy() *BFDConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(BFDConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BGPPeer) DeepCopyInto(out *BGPPeer) {
 	*out = *in
@@ -65,6 +91,11 @@ func (in *BGPPeer) DeepCopyInto(out *BGPPeer) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.AddressFamilies != nil {
+		in, out := &in.AddressFamilies, &out.AddressFamilies
+		*out = make([]AddressFamily, len(*in))
+		copy(*out, *in)
+	}
 	if in.MultihopTTL != nil {
 		in, out := &in.MultihopTTL, &out.MultihopTTL
 		*out = new(int32)
@@ -75,6 +106,21 @@ func (in *BGPPeer) DeepCopyInto(out *BGPPeer) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.HoldTimeSeconds != nil {
+		in, out := &in.HoldTimeSeconds, &out.HoldTimeSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.KeepaliveTimeSeconds != nil {
+		in, out := &in.KeepaliveTimeSeconds, &out.KeepaliveTimeSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BFD != nil {
+		in, out := &in.BFD, &out.BFD
+		*out = new(BFDConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -165,6 +211,11 @@ func (in *BGPPolicySpec) DeepCopyInto(out *BGPPolicySpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Import != nil {
+		in, out := &in.Import, &out.Import
+		*out = new(RouteImport)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -280,6 +331,21 @@ func (in *CaptureConfig) DeepCopyInto(out *CaptureConfig) {
 		*out = new(PacketCaptureFirstNConfig)
 		**out = **in
 	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(PacketCaptureDurationConfig)
+		**out = **in
+	}
+	if in.Bytes != nil {
+		in, out := &in.Bytes, &out.Bytes
+		*out = new(PacketCaptureBytesConfig)
+		**out = **in
+	}
+	if in.RingBuffer != nil {
+		in, out := &in.RingBuffer, &out.RingBuffer
+		*out = new(PacketCaptureRingBufferConfig)
+		**out = **in
+	}
 	return
 }
 
@@ -301,11 +367,26 @@ func (in *Destination) DeepCopyInto(out *Destination) {
 		*out = new(PodReference)
 		**out = **in
 	}
+	if in.Pods != nil {
+		in, out := &in.Pods, &out.Pods
+		*out = new(PodSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.IP != nil {
 		in, out := &in.IP, &out.IP
 		*out = new(string)
 		**out = **in
 	}
+	if in.IPBlock != nil {
+		in, out := &in.IPBlock, &out.IPBlock
+		*out = new(IPBlock)
+		**out = **in
+	}
+	if in.Service != nil {
+		in, out := &in.Service, &out.Service
+		*out = new(NamespacedName)
+		**out = **in
+	}
 	return
 }
 
@@ -322,6 +403,16 @@ func (in *Destination) DeepCopy() *Destination {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EgressAdvertisement) DeepCopyInto(out *EgressAdvertisement) {
 	*out = *in
+	if in.EgressSelector != nil {
+		in, out := &in.EgressSelector, &out.EgressSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Attributes != nil {
+		in, out := &in.Attributes, &out.Attributes
+		*out = new(RouteAttributes)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -434,6 +525,22 @@ func (in *IPBlock) DeepCopy() *IPBlock {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImportPrefix) DeepCopyInto(out *ImportPrefix) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImportPrefix.
+func (in *ImportPrefix) DeepCopy() *ImportPrefix {
+	if in == nil {
+		return nil
+	}
+	out := new(ImportPrefix)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NamespacedName) DeepCopyInto(out *NamespacedName) {
 	*out = *in
@@ -597,6 +704,22 @@ func (in *PacketCapture) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketCaptureBytesConfig) DeepCopyInto(out *PacketCaptureBytesConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketCaptureBytesConfig.
+func (in *PacketCaptureBytesConfig) DeepCopy() *PacketCaptureBytesConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketCaptureBytesConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PacketCaptureCondition) DeepCopyInto(out *PacketCaptureCondition) {
 	*out = *in
@@ -614,6 +737,22 @@ func (in *PacketCaptureCondition) DeepCopy() *PacketCaptureCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketCaptureDurationConfig) DeepCopyInto(out *PacketCaptureDurationConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketCaptureDurationConfig.
+func (in *PacketCaptureDurationConfig) DeepCopy() *PacketCaptureDurationConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketCaptureDurationConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PacketCaptureFileServer) DeepCopyInto(out *PacketCaptureFileServer) {
 	*out = *in
@@ -684,6 +823,50 @@ func (in *PacketCaptureList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketCaptureNodeResult) DeepCopyInto(out *PacketCaptureNodeResult) {
+	*out = *in
+	if in.Pods != nil {
+		in, out := &in.Pods, &out.Pods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]PacketCaptureCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketCaptureNodeResult.
+func (in *PacketCaptureNodeResult) DeepCopy() *PacketCaptureNodeResult {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketCaptureNodeResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketCaptureRingBufferConfig) DeepCopyInto(out *PacketCaptureRingBufferConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketCaptureRingBufferConfig.
+func (in *PacketCaptureRingBufferConfig) DeepCopy() *PacketCaptureRingBufferConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketCaptureRingBufferConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PacketCaptureSpec) DeepCopyInto(out *PacketCaptureSpec) {
 	*out = *in
@@ -728,6 +911,13 @@ func (in *PacketCaptureStatus) DeepCopyInto(out *PacketCaptureStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NodeResults != nil {
+		in, out := &in.NodeResults, &out.NodeResults
+		*out = make([]PacketCaptureNodeResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -744,6 +934,21 @@ func (in *PacketCaptureStatus) DeepCopy() *PacketCaptureStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodAdvertisement) DeepCopyInto(out *PodAdvertisement) {
 	*out = *in
+	if in.PodSelector != nil {
+		in, out := &in.PodSelector, &out.PodSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Attributes != nil {
+		in, out := &in.Attributes, &out.Attributes
+		*out = new(RouteAttributes)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -773,6 +978,85 @@ func (in *PodReference) DeepCopy() *PodReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodSelector) DeepCopyInto(out *PodSelector) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodSelector.
+func (in *PodSelector) DeepCopy() *PodSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(PodSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteAttributes) DeepCopyInto(out *RouteAttributes) {
+	*out = *in
+	if in.Communities != nil {
+		in, out := &in.Communities, &out.Communities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LargeCommunities != nil {
+		in, out := &in.LargeCommunities, &out.LargeCommunities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MED != nil {
+		in, out := &in.MED, &out.MED
+		*out = new(int64)
+		**out = **in
+	}
+	if in.LocalPreference != nil {
+		in, out := &in.LocalPreference, &out.LocalPreference
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteAttributes.
+func (in *RouteAttributes) DeepCopy() *RouteAttributes {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteAttributes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteImport) DeepCopyInto(out *RouteImport) {
+	*out = *in
+	if in.Prefixes != nil {
+		in, out := &in.Prefixes, &out.Prefixes
+		*out = make([]ImportPrefix, len(*in))
+		copy(*out, *in)
+	}
+	if in.Metric != nil {
+		in, out := &in.Metric, &out.Metric
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteImport.
+func (in *RouteImport) DeepCopy() *RouteImport {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteImport)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceAdvertisement) DeepCopyInto(out *ServiceAdvertisement) {
 	*out = *in
@@ -781,6 +1065,21 @@ func (in *ServiceAdvertisement) DeepCopyInto(out *ServiceAdvertisement) {
 		*out = make([]ServiceIPType, len(*in))
 		copy(*out, *in)
 	}
+	if in.ServiceSelector != nil {
+		in, out := &in.ServiceSelector, &out.ServiceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Attributes != nil {
+		in, out := &in.Attributes, &out.Attributes
+		*out = new(RouteAttributes)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -802,11 +1101,26 @@ func (in *Source) DeepCopyInto(out *Source) {
 		*out = new(PodReference)
 		**out = **in
 	}
+	if in.Pods != nil {
+		in, out := &in.Pods, &out.Pods
+		*out = new(PodSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.IP != nil {
 		in, out := &in.IP, &out.IP
 		*out = new(string)
 		**out = **in
 	}
+	if in.IPBlock != nil {
+		in, out := &in.IPBlock, &out.IPBlock
+		*out = new(IPBlock)
+		**out = **in
+	}
+	if in.Service != nil {
+		in, out := &in.Service, &out.Service
+		*out = new(NamespacedName)
+		**out = **in
+	}
 	return
 }
 
@@ -229,6 +229,46 @@ type ExternalIPPoolSpec struct {
 	SubnetInfo *SubnetInfo `json:"subnetInfo,omitempty"`
 	// The Nodes that the external IPs can be assigned to. If empty, it means all Nodes.
 	NodeSelector metav1.LabelSelector `json:"nodeSelector"`
+	// The constraints used to select a Node among the Nodes selected by NodeSelector for an external IP. If not set,
+	// the Node is selected by the consistent hash of the IP only.
+	// Currently, it's only used when an IP is allocated from the pool for Egress, and is ignored otherwise.
+	Scheduling *ExternalIPPoolScheduling `json:"scheduling,omitempty"`
+}
+
+// ExternalIPPoolScheduling specifies the constraints used to select a Node for an external IP. The Nodes satisfying
+// TopologySpread are considered first, then the Nodes with the highest total weight of NodeAffinity, then the Nodes
+// with the lowest Egress traffic load if PreferLowTraffic is true. The consistent hash of the IP decides among the
+// remaining Nodes.
+type ExternalIPPoolScheduling struct {
+	// NodeAffinity specifies the weighted preferences of Nodes. A Node gets the weights of all the terms whose
+	// NodeSelector matches it, and the Nodes with a higher total weight are preferred.
+	NodeAffinity []WeightedNodeSelector `json:"nodeAffinity,omitempty"`
+	// TopologySpread spreads the IPs of this pool across topology domains, e.g. zones or racks.
+	TopologySpread *TopologySpread `json:"topologySpread,omitempty"`
+	// PreferLowTraffic prefers the Nodes with a lower Egress traffic load, which is read from the
+	// "node.antrea.io/egress-traffic-load" annotation of Nodes. Antrea never sets the annotation: it is maintained
+	// by the user or an external monitoring system. A Node without the annotation has a load of 0, and each IP
+	// assigned to a Node adds 1 to its load. Changing the annotation only triggers rescheduling when an
+	// ExternalIPPool sets PreferLowTraffic.
+	PreferLowTraffic bool `json:"preferLowTraffic,omitempty"`
+}
+
+// WeightedNodeSelector is a Node selector associated with a weight.
+type WeightedNodeSelector struct {
+	// Weight associated with matching the NodeSelector, in the range 1-100.
+	Weight int32 `json:"weight"`
+	// The Nodes which the weight applies to.
+	NodeSelector metav1.LabelSelector `json:"nodeSelector"`
+}
+
+// TopologySpread specifies how to spread the IPs of a pool across topology domains.
+type TopologySpread struct {
+	// TopologyKey is the key of the Node label, e.g. "topology.kubernetes.io/zone". Nodes with the same value of the
+	// label are in the same topology domain. Nodes without the label are not selected.
+	TopologyKey string `json:"topologyKey"`
+	// MaxSkew is the maximum difference allowed between the numbers of IPs assigned to any two topology domains which
+	// have Nodes available. Defaults to 1.
+	MaxSkew int32 `json:"maxSkew,omitempty"`
 }
 
 // IPRange is a set of contiguous IP addresses, represented by a CIDR or a pair of start and end IPs.
@@ -498,6 +538,12 @@ type NetworkPolicySpec struct {
 	// field within a Rule.
 	// +optional
 	Egress []Rule `json:"egress,omitempty"`
+	// AuditMode indicates whether the Drop and Reject rules of this policy are
+	// enforced with the Audit action, i.e. the traffic matching them is allowed
+	// and logged instead. It can be used to evaluate the impact of a policy
+	// before enforcing it. Defaults to false.
+	// +optional
+	AuditMode bool `json:"auditMode,omitempty"`
 }
 
 // NetworkPolicyPhase defines the phase in which a NetworkPolicy is.
@@ -555,6 +601,19 @@ type NetworkPolicyStatus struct {
 	DesiredNodesRealized int32 `json:"desiredNodesRealized"`
 	// Represents the latest available observations of a NetworkPolicy current state.
 	Conditions []NetworkPolicyCondition `json:"conditions"`
+	// ScheduledRules reports the activation state of the rules with a schedule.
+	ScheduledRules []ScheduledRuleStatus `json:"scheduledRules,omitempty"`
+}
+
+// ScheduledRuleStatus describes the activation state of a rule with a schedule.
+type ScheduledRuleStatus struct {
+	// Name is the name of the rule.
+	Name string `json:"name"`
+	// Active indicates whether the rule is enforced at present.
+	Active bool `json:"active"`
+	// NextTransitionTime is the time at which the rule will be activated or
+	// deactivated next. It is not set if the state of the rule never changes.
+	NextTransitionTime *metav1.Time `json:"nextTransitionTime,omitempty"`
 }
 
 // Rule describes the traffic allowed to/from the workloads selected by
@@ -611,6 +670,61 @@ type Rule struct {
 	// conjunction with NetworkPolicySpec/ClusterNetworkPolicySpec.AppliedTo.
 	// +optional
 	AppliedTo []AppliedTo `json:"appliedTo,omitempty"`
+	// Schedule restricts the enforcement of this rule to recurring time windows.
+	// The rule is only enforced when any of the windows is open. If this field is
+	// not set, the rule is always enforced.
+	// +optional
+	Schedule *RuleSchedule `json:"schedule,omitempty"`
+	// RateLimit limits the rate of the traffic allowed by this rule on each
+	// Node. It can only be set for rules with the Allow action.
+	// +optional
+	RateLimit *RuleRateLimit `json:"rateLimit,omitempty"`
+}
+
+// RateLimitUnit is the unit of the rate of a RuleRateLimit.
+type RateLimitUnit string
+
+const (
+	// RateLimitUnitBitsPerSecond indicates that the rate is in bits per second
+	// and the burst size is in bits.
+	RateLimitUnitBitsPerSecond RateLimitUnit = "bps"
+	// RateLimitUnitPacketsPerSecond indicates that the rate is in packets per
+	// second and the burst size is in packets.
+	RateLimitUnitPacketsPerSecond RateLimitUnit = "pps"
+)
+
+// RuleRateLimit describes the rate limit of the traffic allowed by a rule.
+type RuleRateLimit struct {
+	// Rate specifies the maximum traffic rate, e.g. 300k, 100M.
+	Rate string `json:"rate"`
+	// Burst specifies the maximum burst size when traffic exceeds the rate,
+	// e.g. 300k, 10M. Defaults to Rate if not set.
+	// +optional
+	Burst string `json:"burst,omitempty"`
+	// Unit is the unit of Rate and Burst, "bps" or "pps". Defaults to "bps".
+	// +optional
+	Unit RateLimitUnit `json:"unit,omitempty"`
+}
+
+// RuleSchedule describes the recurring time windows during which a rule is enforced.
+type RuleSchedule struct {
+	// Windows is the list of time windows during which the rule is enforced.
+	Windows []ScheduleWindow `json:"windows"`
+	// TimeZone is the IANA name of the time zone in which the windows are
+	// evaluated, e.g. "America/New_York". Defaults to UTC if not set.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+// ScheduleWindow describes a recurring time window.
+type ScheduleWindow struct {
+	// Start is a cron expression in the standard 5-field format
+	// ("minute hour day-of-month month day-of-week") which specifies the
+	// times at which the window opens, e.g. "0 22 * * 1-5".
+	Start string `json:"start"`
+	// Duration is how long the window stays open after each start, e.g.
+	// "2h" or "90m". It must be at least 1m.
+	Duration string `json:"duration"`
 }
 
 // NetworkPolicyPeer describes the grouping selector of workloads.
@@ -798,6 +912,9 @@ const (
 	// RuleActionReject indicates that the traffic matching the rule must be rejected and the
 	// client will receive a response.
 	RuleActionReject RuleAction = "Reject"
+	// RuleActionAudit indicates that the traffic matching the rule would be denied, but is
+	// allowed and recorded in the audit log and flow records with the Audit verdict.
+	RuleActionAudit RuleAction = "Audit"
 
 	IGMPQuery    int32 = 0x11
 	IGMPReportV1 int32 = 0x12
@@ -855,6 +972,12 @@ type ClusterNetworkPolicySpec struct {
 	// field within a Rule.
 	// +optional
 	Egress []Rule `json:"egress,omitempty"`
+	// AuditMode indicates whether the Drop and Reject rules of this policy are
+	// enforced with the Audit action, i.e. the traffic matching them is allowed
+	// and logged instead. It can be used to evaluate the impact of a policy
+	// before enforcing it. Defaults to false.
+	// +optional
+	AuditMode bool `json:"auditMode,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -910,8 +1033,11 @@ type IGMPProtocol struct {
 }
 
 type L7Protocol struct {
-	HTTP *HTTPProtocol `json:"http,omitempty"`
-	TLS  *TLSProtocol  `json:"tls,omitempty"`
+	HTTP  *HTTPProtocol  `json:"http,omitempty"`
+	TLS   *TLSProtocol   `json:"tls,omitempty"`
+	GRPC  *GRPCProtocol  `json:"grpc,omitempty"`
+	Kafka *KafkaProtocol `json:"kafka,omitempty"`
+	DNS   *DNSProtocol   `json:"dns,omitempty"`
 }
 
 // HTTPProtocol matches HTTP requests with specific host, method, and path. All fields could be used alone or together.
@@ -925,6 +1051,38 @@ type HTTPProtocol struct {
 	Method string `json:"method,omitempty"`
 	// Path represents the URI path to match (Ex. "/index.html", "/admin").
 	Path string `json:"path,omitempty"`
+	// PathRegex represents a regular expression in RE2 syntax which the whole URI path must match
+	// (Ex. "/api/v[0-9]+/users/.*"). The query string is not part of the path. It can not be set
+	// together with Path.
+	PathRegex string `json:"pathRegex,omitempty"`
+	// Headers represents the HTTP request headers to match. A request must match all of them.
+	Headers []HTTPHeaderMatch `json:"headers,omitempty"`
+	// QueryParams represents the URI query parameters to match. A request must match all of them.
+	QueryParams []HTTPQueryParamMatch `json:"queryParams,omitempty"`
+}
+
+// HTTPHeaderMatch matches an HTTP request header. If neither Value nor Regex is provided, this
+// matches any request carrying the header.
+type HTTPHeaderMatch struct {
+	// Name represents the name of the header to match. It is case-insensitive.
+	Name string `json:"name"`
+	// Value represents the exact value of the header to match.
+	Value string `json:"value,omitempty"`
+	// Regex represents a regular expression in RE2 syntax which the whole value of the header must
+	// match. It can not be set together with Value.
+	Regex string `json:"regex,omitempty"`
+}
+
+// HTTPQueryParamMatch matches a URI query parameter. If neither Value nor Regex is provided, this
+// matches any request carrying the parameter.
+type HTTPQueryParamMatch struct {
+	// Name represents the name of the query parameter to match. It is case-sensitive.
+	Name string `json:"name"`
+	// Value represents the exact value of the query parameter to match, as it appears in the URI.
+	Value string `json:"value,omitempty"`
+	// Regex represents a regular expression in RE2 syntax which the whole value of the query
+	// parameter, as it appears in the URI, must match. It can not be set together with Value.
+	Regex string `json:"regex,omitempty"`
 }
 
 // TLSProtocol matches TLS handshake packets with specific SNI. If the field is not provided, this
@@ -934,6 +1092,50 @@ type TLSProtocol struct {
 	SNI string `json:"sni,omitempty"`
 }
 
+// GRPCProtocol matches gRPC requests with specific service and method. All fields could be used alone or together.
+// If all fields are not provided, it matches all gRPC requests.
+type GRPCProtocol struct {
+	// Service represents the fully qualified name of the gRPC service to match (Ex. "helloworld.Greeter").
+	Service string `json:"service,omitempty"`
+	// Method represents the name of the gRPC method to match (Ex. "SayHello").
+	Method string `json:"method,omitempty"`
+}
+
+type KafkaAPIKey string
+
+const (
+	KafkaAPIKeyProduce         KafkaAPIKey = "produce"
+	KafkaAPIKeyFetch           KafkaAPIKey = "fetch"
+	KafkaAPIKeyListOffsets     KafkaAPIKey = "listOffsets"
+	KafkaAPIKeyMetadata        KafkaAPIKey = "metadata"
+	KafkaAPIKeyOffsetCommit    KafkaAPIKey = "offsetCommit"
+	KafkaAPIKeyOffsetFetch     KafkaAPIKey = "offsetFetch"
+	KafkaAPIKeyFindCoordinator KafkaAPIKey = "findCoordinator"
+	KafkaAPIKeyJoinGroup       KafkaAPIKey = "joinGroup"
+	KafkaAPIKeyHeartbeat       KafkaAPIKey = "heartbeat"
+	KafkaAPIKeyLeaveGroup      KafkaAPIKey = "leaveGroup"
+	KafkaAPIKeySyncGroup       KafkaAPIKey = "syncGroup"
+	KafkaAPIKeyApiVersions     KafkaAPIKey = "apiVersions"
+	KafkaAPIKeyCreateTopics    KafkaAPIKey = "createTopics"
+	KafkaAPIKeyDeleteTopics    KafkaAPIKey = "deleteTopics"
+)
+
+// KafkaProtocol matches Kafka requests with specific API key and topic. The fields could be used alone or together,
+// but at least one of them must be provided.
+type KafkaProtocol struct {
+	// APIKey represents the type of the Kafka requests to match.
+	APIKey KafkaAPIKey `json:"apiKey,omitempty"`
+	// Topic represents the name of the Kafka topic to match. It can only be used when APIKey is produce, fetch or metadata.
+	Topic string `json:"topic,omitempty"`
+}
+
+// DNSProtocol matches DNS queries with specific query name. If the field is not provided, this matches all DNS
+// queries.
+type DNSProtocol struct {
+	// QueryName represents the domain name in the DNS query to match.
+	QueryName string `json:"queryName,omitempty"`
+}
+
 // +genclient
 // +genclient:nonNamespaced
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -959,10 +1161,21 @@ type EgressStatus struct {
 	// EgressIP indicates the effective Egress IP for the selected workloads. It could be empty if the Egress IP in spec
 	// is not assigned to any Node. It's also useful when there are more than one Egress IP specified in spec.
 	EgressIP string `json:"egressIP"`
+	// ActiveEgressIPs indicates the Egress IPs in use and the Nodes holding them when the Egress is in ActiveActive
+	// mode.
+	ActiveEgressIPs []ActiveEgressIP `json:"activeEgressIPs,omitempty"`
 
 	Conditions []EgressCondition `json:"conditions,omitempty"`
 }
 
+// ActiveEgressIP is an Egress IP of an ActiveActive Egress that is assigned to a Node.
+type ActiveEgressIP struct {
+	// EgressIP is the Egress IP.
+	EgressIP string `json:"egressIP"`
+	// EgressNode is the name of the Node holding the Egress IP.
+	EgressNode string `json:"egressNode"`
+}
+
 type EgressConditionType string
 
 const (
@@ -993,7 +1206,16 @@ type EgressSpec struct {
 	EgressIP string `json:"egressIP,omitempty"`
 	// EgressIPs specifies multiple SNAT IP addresses for the selected workloads.
 	// Cannot be set with EgressIP.
+	// In ActiveActive mode, it specifies the Egress IPs across which the connections of the selected workloads are
+	// distributed. If ExternalIPPool is non-empty, the IPs must be in the pool and can be allocated by Antrea
+	// automatically according to EgressIPCount.
 	EgressIPs []string `json:"egressIPs,omitempty"`
+	// Mode specifies how the selected workloads use the Egress IPs. Defaults to ActiveStandby.
+	Mode EgressMode `json:"mode,omitempty"`
+	// EgressIPCount specifies the number of Egress IPs to allocate from ExternalIPPool in ActiveActive mode. If it's
+	// larger than the number of IPs in EgressIPs, Antrea allocates more IPs from the pool; if it's smaller, the extra
+	// IPs at the end of EgressIPs are released. If it's 0, the IPs in EgressIPs are used as they are.
+	EgressIPCount int32 `json:"egressIPCount,omitempty"`
 	// ExternalIPPool specifies the IP Pool that the EgressIP should be allocated from.
 	// If it is empty, the specified EgressIP must be assigned to a Node manually.
 	// If it is non-empty, the EgressIP will be assigned to a Node specified by the pool automatically and will failover
@@ -1005,8 +1227,35 @@ type EgressSpec struct {
 	ExternalIPPools []string `json:"externalIPPools,omitempty"`
 	// Bandwidth specifies the rate limit of north-south egress traffic of this Egress.
 	Bandwidth *Bandwidth `json:"bandwidth,omitempty"`
+	// Destinations restricts the Egress to the traffic destined for the specified destinations. If it's empty, the
+	// Egress applies to all north-south egress traffic. For the traffic of a Pod, an Egress with matching Destinations
+	// takes precedence over an Egress without Destinations.
+	Destinations []EgressDestination `json:"destinations,omitempty"`
+}
+
+// EgressDestination describes a destination of the traffic an Egress applies to. Exactly one of CIDR and FQDN must be
+// specified.
+type EgressDestination struct {
+	// CIDR is an IP block the traffic is destined for, e.g. 10.20.0.0/16.
+	CIDR string `json:"cidr,omitempty"`
+	// FQDN is a fully qualified domain name the traffic is destined for, e.g. api.example.com. antrea-agent learns its
+	// IPs from the DNS responses received by the selected Pods and refreshes them when their TTLs expire, and the
+	// Egress applies to the traffic destined for these IPs. Wildcards are not supported.
+	FQDN string `json:"fqdn,omitempty"`
 }
 
+type EgressMode string
+
+const (
+	// EgressModeActiveStandby means the selected workloads use a single Egress IP at any given time, which is assigned
+	// to one Node and fails over to another Node when the Node becomes unavailable.
+	EgressModeActiveStandby EgressMode = "ActiveStandby"
+	// EgressModeActiveActive means the connections of the selected workloads are distributed across all Egress IPs of
+	// the Egress based on the hash of their 5-tuple, and the Egress IPs can be assigned to different Nodes at the same
+	// time.
+	EgressModeActiveActive EgressMode = "ActiveActive"
+)
+
 type Bandwidth struct {
 	// Rate specifies the maximum traffic rate. e.g. 300k, 10M
 	Rate string `json:"rate"`
@@ -1041,6 +1290,7 @@ const (
 	ComponentNetworkPolicy TraceflowComponent = "NetworkPolicy"
 	ComponentForwarding    TraceflowComponent = "Forwarding"
 	ComponentEgress        TraceflowComponent = "Egress"
+	ComponentL7Engine      TraceflowComponent = "L7Engine"
 )
 
 type TraceflowAction string
@@ -1098,6 +1348,19 @@ var SupportedDestinationTypes = []string{
 // Default timeout in seconds.
 const DefaultTraceflowTimeout int32 = 20
 
+// TraceflowParentLabelKey is the label set on the Traceflows created to probe the endpoints of the
+// destination Service of a multi-path Traceflow, or to run a recurring Traceflow. Its value is the
+// name of the multi-path or recurring Traceflow.
+const TraceflowParentLabelKey = "traceflow.antrea.io/parent"
+
+// TraceflowEndpointAnnotationKey is the annotation set on the Traceflows created to probe the
+// endpoints of the destination Service of a multi-path Traceflow. Its value is the "IP:port"
+// endpoint which the Traceflow packet sent to the Service must be load-balanced to.
+const TraceflowEndpointAnnotationKey = "traceflow.antrea.io/endpoint"
+
+// Default number of runs kept in the history of a recurring Traceflow.
+const DefaultTraceflowHistoryLimit int32 = 10
+
 // +genclient
 // +genclient:nonNamespaced
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -1125,6 +1388,28 @@ type TraceflowSpec struct {
 	// Timeout specifies the timeout of the Traceflow in seconds. Defaults
 	// to 20 seconds if not set.
 	Timeout int32 `json:"timeout,omitempty"`
+	// MultiPath indicates the Traceflow is to trace the paths to all the
+	// endpoints of the destination Service, when set to true. A Traceflow is
+	// created for each ready endpoint, whose packet is sent to the Service and
+	// load-balanced to the endpoint, and their results are reported in the
+	// status of this Traceflow. It can only be set for a non-live-traffic
+	// Traceflow to a Service.
+	MultiPath bool `json:"multiPath,omitempty"`
+	// Schedule makes the Traceflow recurring when set. A Traceflow is
+	// created to run the trace at each interval, and the results of the
+	// last runs are kept in the status of this Traceflow. It can only be set
+	// for a non-live-traffic Traceflow.
+	Schedule *TraceflowSchedule `json:"schedule,omitempty"`
+}
+
+// TraceflowSchedule describes the schedule of a recurring Traceflow.
+type TraceflowSchedule struct {
+	// Interval is the interval between two runs in seconds. It must be
+	// larger than the timeout of the Traceflow.
+	Interval int32 `json:"interval"`
+	// HistoryLimit is the number of runs whose results are kept in the
+	// status. Defaults to 10 if not set.
+	HistoryLimit int32 `json:"historyLimit,omitempty"`
 }
 
 // Source describes the source spec of the traceflow.
@@ -1201,6 +1486,30 @@ type TCPHeader struct {
 	Flags *int32 `json:"flags,omitempty"`
 }
 
+// HTTPRequest describes an HTTP request carried by a Traceflow packet.
+type HTTPRequest struct {
+	// Method is the HTTP method of the request. Defaults to GET if not set.
+	Method string `json:"method,omitempty" yaml:"method,omitempty"`
+	// Host is the HTTP Host header of the request, without the port.
+	Host string `json:"host,omitempty" yaml:"host,omitempty"`
+	// Path is the URI path of the request. Defaults to "/" if not set.
+	Path string `json:"path,omitempty" yaml:"path,omitempty"`
+}
+
+// TLSClientHello describes a TLS ClientHello message carried by a Traceflow packet.
+type TLSClientHello struct {
+	// SNI is the Server Name Indication of the ClientHello message.
+	SNI string `json:"sni,omitempty" yaml:"sni,omitempty"`
+}
+
+// L7Payload describes the application layer payload of a Traceflow packet. HTTP and TLS are
+// mutually exclusive. The payload is evaluated against the L7 NetworkPolicy rules the packet
+// is subject to, in the same way as the L7 engine does.
+type L7Payload struct {
+	HTTP *HTTPRequest    `json:"http,omitempty" yaml:"http,omitempty"`
+	TLS  *TLSClientHello `json:"tls,omitempty" yaml:"tls,omitempty"`
+}
+
 // Packet includes header info.
 type Packet struct {
 	SrcIP string `json:"srcIP,omitempty"`
@@ -1210,6 +1519,9 @@ type Packet struct {
 	IPHeader        *IPHeader       `json:"ipHeader,omitempty"`
 	IPv6Header      *IPv6Header     `json:"ipv6Header,omitempty"`
 	TransportHeader TransportHeader `json:"transportHeader,omitempty"`
+	// L7Payload is the application layer payload of the packet. It can only be set for a TCP
+	// packet in a non-live-traffic Traceflow.
+	L7Payload *L7Payload `json:"l7Payload,omitempty"`
 }
 
 // TraceflowStatus describes current status of the traceflow.
@@ -1231,6 +1543,50 @@ type TraceflowStatus struct {
 	Results []NodeResult `json:"results,omitempty"`
 	// CapturedPacket is the captured packet in live-traffic Traceflow.
 	CapturedPacket *Packet `json:"capturedPacket,omitempty"`
+	// EndpointResults is the collection of the results of the paths to each
+	// endpoint of the destination Service in multi-path Traceflow.
+	EndpointResults []EndpointResult `json:"endpointResults,omitempty"`
+	// History is the collection of the results of the last runs of a
+	// recurring Traceflow, from the oldest to the latest.
+	History []TraceflowRun `json:"history,omitempty"`
+}
+
+// TraceflowRun describes a run of a recurring Traceflow.
+type TraceflowRun struct {
+	// Traceflow is the name of the Traceflow of the run.
+	Traceflow string `json:"traceflow,omitempty"`
+	// StartTime is the time at which the run was started.
+	StartTime metav1.Time `json:"startTime"`
+	// Phase is the phase of the run.
+	Phase TraceflowPhase `json:"phase,omitempty"`
+	// Reason is a message indicating the reason of the phase.
+	Reason string `json:"reason,omitempty"`
+	// Results is the collection of all observations of the run.
+	Results []NodeResult `json:"results,omitempty"`
+	// EndpointResults is the collection of the results of the paths to each
+	// endpoint of the destination Service, if the run is multi-path.
+	EndpointResults []EndpointResult `json:"endpointResults,omitempty"`
+}
+
+// EndpointResult describes the result of the path to an endpoint of the destination Service in
+// multi-path Traceflow.
+type EndpointResult struct {
+	// IP is the IP address of the endpoint.
+	IP string `json:"ip,omitempty" yaml:"ip,omitempty"`
+	// Port is the port of the endpoint.
+	Port int32 `json:"port,omitempty" yaml:"port,omitempty"`
+	// Pod is the Pod of the endpoint, formatted as <namespace>/<name>.
+	Pod string `json:"pod,omitempty" yaml:"pod,omitempty"`
+	// Node is the Node of the endpoint.
+	Node string `json:"node,omitempty" yaml:"node,omitempty"`
+	// Traceflow is the name of the Traceflow tracing the path to the endpoint.
+	Traceflow string `json:"traceflow,omitempty" yaml:"traceflow,omitempty"`
+	// Phase is the phase of the Traceflow tracing the path to the endpoint.
+	Phase TraceflowPhase `json:"phase,omitempty" yaml:"phase,omitempty"`
+	// Reason is a message indicating the reason of the phase.
+	Reason string `json:"reason,omitempty" yaml:"reason,omitempty"`
+	// Results is the collection of all observations on the path to the endpoint.
+	Results []NodeResult `json:"results,omitempty" yaml:"results,omitempty"`
 }
 
 type NodeResult struct {
@@ -1248,7 +1604,10 @@ type NodeResult struct {
 type Observation struct {
 	// Component is the observation component.
 	Component TraceflowComponent `json:"component,omitempty" yaml:"component,omitempty"`
-	// ComponentInfo is the extension of Component field.
+	// ComponentInfo is the extension of Component field. For an L7Engine observation, it is
+	// "PredictedHTTP" or "PredictedTLS": the verdict is predicted by the Antrea Agent, which
+	// evaluates the L7 payload against the L7 protocols of the NetworkPolicy rule, and is not
+	// reported by the L7 engine.
 	ComponentInfo string `json:"componentInfo,omitempty" yaml:"componentInfo,omitempty"`
 	// Action is the action to the observation.
 	Action TraceflowAction `json:"action,omitempty" yaml:"action,omitempty"`
@@ -1275,6 +1634,10 @@ type Observation struct {
 	EgressNode string `json:"egressNode,omitempty" yaml:"egressNode,omitempty"`
 	// SrcPodIP is the IP of source Pod.
 	SrcPodIP string `json:"srcPodIP,omitempty" yaml:"srcPodIP,omitempty"`
+	// L7Rule is the L7 protocol of the NetworkPolicy rule which allowed the L7 payload of the
+	// packet, for an L7Engine observation. When the verdict is unknown, it is the L7 protocols of
+	// the rule which can't be evaluated against the payload.
+	L7Rule string `json:"l7Rule,omitempty" yaml:"l7Rule,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
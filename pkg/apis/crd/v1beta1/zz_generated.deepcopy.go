@@ -26,6 +26,22 @@ import (
 	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActiveEgressIP) DeepCopyInto(out *ActiveEgressIP) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActiveEgressIP.
+func (in *ActiveEgressIP) DeepCopy() *ActiveEgressIP {
+	if in == nil {
+		return nil
+	}
+	out := new(ActiveEgressIP)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AgentCondition) DeepCopyInto(out *AgentCondition) {
 	*out = *in
@@ -431,6 +447,22 @@ func (in *ControllerCondition) DeepCopy() *ControllerCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSProtocol) DeepCopyInto(out *DNSProtocol) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSProtocol.
+func (in *DNSProtocol) DeepCopy() *DNSProtocol {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSProtocol)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Destination) DeepCopyInto(out *Destination) {
 	*out = *in
@@ -492,6 +524,22 @@ func (in *EgressCondition) DeepCopy() *EgressCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressDestination) DeepCopyInto(out *EgressDestination) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressDestination.
+func (in *EgressDestination) DeepCopy() *EgressDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EgressList) DeepCopyInto(out *EgressList) {
 	*out = *in
@@ -544,6 +592,11 @@ func (in *EgressSpec) DeepCopyInto(out *EgressSpec) {
 		*out = new(Bandwidth)
 		**out = **in
 	}
+	if in.Destinations != nil {
+		in, out := &in.Destinations, &out.Destinations
+		*out = make([]EgressDestination, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -560,6 +613,11 @@ func (in *EgressSpec) DeepCopy() *EgressSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EgressStatus) DeepCopyInto(out *EgressStatus) {
 	*out = *in
+	if in.ActiveEgressIPs != nil {
+		in, out := &in.ActiveEgressIPs, &out.ActiveEgressIPs
+		*out = make([]ActiveEgressIP, len(*in))
+		copy(*out, *in)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]EgressCondition, len(*in))
@@ -580,6 +638,29 @@ func (in *EgressStatus) DeepCopy() *EgressStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointResult) DeepCopyInto(out *EndpointResult) {
+	*out = *in
+	if in.Results != nil {
+		in, out := &in.Results, &out.Results
+		*out = make([]NodeResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointResult.
+func (in *EndpointResult) DeepCopy() *EndpointResult {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExternalIPPool) DeepCopyInto(out *ExternalIPPool) {
 	*out = *in
@@ -641,6 +722,34 @@ func (in *ExternalIPPoolList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalIPPoolScheduling) DeepCopyInto(out *ExternalIPPoolScheduling) {
+	*out = *in
+	if in.NodeAffinity != nil {
+		in, out := &in.NodeAffinity, &out.NodeAffinity
+		*out = make([]WeightedNodeSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TopologySpread != nil {
+		in, out := &in.TopologySpread, &out.TopologySpread
+		*out = new(TopologySpread)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalIPPoolScheduling.
+func (in *ExternalIPPoolScheduling) DeepCopy() *ExternalIPPoolScheduling {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalIPPoolScheduling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExternalIPPoolSpec) DeepCopyInto(out *ExternalIPPoolSpec) {
 	*out = *in
@@ -655,6 +764,11 @@ func (in *ExternalIPPoolSpec) DeepCopyInto(out *ExternalIPPoolSpec) {
 		**out = **in
 	}
 	in.NodeSelector.DeepCopyInto(&out.NodeSelector)
+	if in.Scheduling != nil {
+		in, out := &in.Scheduling, &out.Scheduling
+		*out = new(ExternalIPPoolScheduling)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -685,6 +799,22 @@ func (in *ExternalIPPoolStatus) DeepCopy() *ExternalIPPoolStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCProtocol) DeepCopyInto(out *GRPCProtocol) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCProtocol.
+func (in *GRPCProtocol) DeepCopy() *GRPCProtocol {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCProtocol)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Group) DeepCopyInto(out *Group) {
 	*out = *in
@@ -834,9 +964,35 @@ func (in *GroupStatus) DeepCopy() *GroupStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPHeaderMatch) DeepCopyInto(out *HTTPHeaderMatch) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPHeaderMatch.
+func (in *HTTPHeaderMatch) DeepCopy() *HTTPHeaderMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPHeaderMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPProtocol) DeepCopyInto(out *HTTPProtocol) {
 	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]HTTPHeaderMatch, len(*in))
+		copy(*out, *in)
+	}
+	if in.QueryParams != nil {
+		in, out := &in.QueryParams, &out.QueryParams
+		*out = make([]HTTPQueryParamMatch, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -850,6 +1006,38 @@ func (in *HTTPProtocol) DeepCopy() *HTTPProtocol {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPQueryParamMatch) DeepCopyInto(out *HTTPQueryParamMatch) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPQueryParamMatch.
+func (in *HTTPQueryParamMatch) DeepCopy() *HTTPQueryParamMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPQueryParamMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPRequest) DeepCopyInto(out *HTTPRequest) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRequest.
+func (in *HTTPRequest) DeepCopy() *HTTPRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ICMPEchoRequestHeader) DeepCopyInto(out *ICMPEchoRequestHeader) {
 	*out = *in
@@ -1153,19 +1341,76 @@ func (in *IPv6Header) DeepCopy() *IPv6Header {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaProtocol) DeepCopyInto(out *KafkaProtocol) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaProtocol.
+func (in *KafkaProtocol) DeepCopy() *KafkaProtocol {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaProtocol)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *L7Payload) DeepCopyInto(out *L7Payload) {
+	*out = *in
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPRequest)
+		**out = **in
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLSClientHello)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new L7Payload.
+func (in *L7Payload) DeepCopy() *L7Payload {
+	if in == nil {
+		return nil
+	}
+	out := new(L7Payload)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *L7Protocol) DeepCopyInto(out *L7Protocol) {
 	*out = *in
 	if in.HTTP != nil {
 		in, out := &in.HTTP, &out.HTTP
 		*out = new(HTTPProtocol)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.TLS != nil {
 		in, out := &in.TLS, &out.TLS
 		*out = new(TLSProtocol)
 		**out = **in
 	}
+	if in.GRPC != nil {
+		in, out := &in.GRPC, &out.GRPC
+		*out = new(GRPCProtocol)
+		**out = **in
+	}
+	if in.Kafka != nil {
+		in, out := &in.Kafka, &out.Kafka
+		*out = new(KafkaProtocol)
+		**out = **in
+	}
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(DNSProtocol)
+		**out = **in
+	}
 	return
 }
 
@@ -1454,6 +1699,13 @@ func (in *NetworkPolicyStatus) DeepCopyInto(out *NetworkPolicyStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ScheduledRules != nil {
+		in, out := &in.ScheduledRules, &out.ScheduledRules
+		*out = make([]ScheduledRuleStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1541,6 +1793,11 @@ func (in *Packet) DeepCopyInto(out *Packet) {
 		(*in).DeepCopyInto(*out)
 	}
 	in.TransportHeader.DeepCopyInto(&out.TransportHeader)
+	if in.L7Payload != nil {
+		in, out := &in.L7Payload, &out.L7Payload
+		*out = new(L7Payload)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1662,6 +1919,16 @@ func (in *Rule) DeepCopyInto(out *Rule) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(RuleSchedule)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(RuleRateLimit)
+		**out = **in
+	}
 	return
 }
 
@@ -1675,6 +1942,79 @@ func (in *Rule) DeepCopy() *Rule {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleRateLimit) DeepCopyInto(out *RuleRateLimit) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleRateLimit.
+func (in *RuleRateLimit) DeepCopy() *RuleRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleSchedule) DeepCopyInto(out *RuleSchedule) {
+	*out = *in
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]ScheduleWindow, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleSchedule.
+func (in *RuleSchedule) DeepCopy() *RuleSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleWindow) DeepCopyInto(out *ScheduleWindow) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleWindow.
+func (in *ScheduleWindow) DeepCopy() *ScheduleWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduledRuleStatus) DeepCopyInto(out *ScheduledRuleStatus) {
+	*out = *in
+	if in.NextTransitionTime != nil {
+		in, out := &in.NextTransitionTime, &out.NextTransitionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduledRuleStatus.
+func (in *ScheduledRuleStatus) DeepCopy() *ScheduledRuleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduledRuleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Source) DeepCopyInto(out *Source) {
 	*out = *in
@@ -1744,6 +2084,22 @@ func (in *TCPHeader) DeepCopy() *TCPHeader {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSClientHello) DeepCopyInto(out *TLSClientHello) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSClientHello.
+func (in *TLSClientHello) DeepCopy() *TLSClientHello {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSClientHello)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TLSProtocol) DeepCopyInto(out *TLSProtocol) {
 	*out = *in
@@ -1836,6 +2192,22 @@ func (in *TierSpec) DeepCopy() *TierSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopologySpread) DeepCopyInto(out *TopologySpread) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologySpread.
+func (in *TopologySpread) DeepCopy() *TopologySpread {
+	if in == nil {
+		return nil
+	}
+	out := new(TopologySpread)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Traceflow) DeepCopyInto(out *Traceflow) {
 	*out = *in
@@ -1897,12 +2269,64 @@ func (in *TraceflowList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TraceflowRun) DeepCopyInto(out *TraceflowRun) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	if in.Results != nil {
+		in, out := &in.Results, &out.Results
+		*out = make([]NodeResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.EndpointResults != nil {
+		in, out := &in.EndpointResults, &out.EndpointResults
+		*out = make([]EndpointResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TraceflowRun.
+func (in *TraceflowRun) DeepCopy() *TraceflowRun {
+	if in == nil {
+		return nil
+	}
+	out := new(TraceflowRun)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TraceflowSchedule) DeepCopyInto(out *TraceflowSchedule) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TraceflowSchedule.
+func (in *TraceflowSchedule) DeepCopy() *TraceflowSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(TraceflowSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TraceflowSpec) DeepCopyInto(out *TraceflowSpec) {
 	*out = *in
 	out.Source = in.Source
 	out.Destination = in.Destination
 	in.Packet.DeepCopyInto(&out.Packet)
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(TraceflowSchedule)
+		**out = **in
+	}
 	return
 }
 
@@ -1935,6 +2359,20 @@ func (in *TraceflowStatus) DeepCopyInto(out *TraceflowStatus) {
 		*out = new(Packet)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.EndpointResults != nil {
+		in, out := &in.EndpointResults, &out.EndpointResults
+		*out = make([]EndpointResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]TraceflowRun, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1994,3 +2432,20 @@ func (in *UDPHeader) DeepCopy() *UDPHeader {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WeightedNodeSelector) DeepCopyInto(out *WeightedNodeSelector) {
+	*out = *in
+	in.NodeSelector.DeepCopyInto(&out.NodeSelector)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WeightedNodeSelector.
+func (in *WeightedNodeSelector) DeepCopy() *WeightedNodeSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(WeightedNodeSelector)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -39,19 +39,24 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.BundleFileServer":                  schema_pkg_apis_controlplane_v1beta2_BundleFileServer(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.BundleServerAuthConfiguration":     schema_pkg_apis_controlplane_v1beta2_BundleServerAuthConfiguration(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.ClusterGroupMembers":               schema_pkg_apis_controlplane_v1beta2_ClusterGroupMembers(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.DNSProtocol":                       schema_pkg_apis_controlplane_v1beta2_DNSProtocol(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.EgressGroup":                       schema_pkg_apis_controlplane_v1beta2_EgressGroup(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.EgressGroupList":                   schema_pkg_apis_controlplane_v1beta2_EgressGroupList(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.EgressGroupPatch":                  schema_pkg_apis_controlplane_v1beta2_EgressGroupPatch(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.Entity":                            schema_pkg_apis_controlplane_v1beta2_Entity(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.ExternalEntityReference":           schema_pkg_apis_controlplane_v1beta2_ExternalEntityReference(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.GRPCProtocol":                      schema_pkg_apis_controlplane_v1beta2_GRPCProtocol(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.GroupAssociation":                  schema_pkg_apis_controlplane_v1beta2_GroupAssociation(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.GroupMember":                       schema_pkg_apis_controlplane_v1beta2_GroupMember(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.GroupMembers":                      schema_pkg_apis_controlplane_v1beta2_GroupMembers(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.GroupReference":                    schema_pkg_apis_controlplane_v1beta2_GroupReference(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPHeaderMatch":                   schema_pkg_apis_controlplane_v1beta2_HTTPHeaderMatch(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPProtocol":                      schema_pkg_apis_controlplane_v1beta2_HTTPProtocol(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPQueryParamMatch":               schema_pkg_apis_controlplane_v1beta2_HTTPQueryParamMatch(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.IPBlock":                           schema_pkg_apis_controlplane_v1beta2_IPBlock(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.IPGroupAssociation":                schema_pkg_apis_controlplane_v1beta2_IPGroupAssociation(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.IPNet":                             schema_pkg_apis_controlplane_v1beta2_IPNet(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.KafkaProtocol":                     schema_pkg_apis_controlplane_v1beta2_KafkaProtocol(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.L7Protocol":                        schema_pkg_apis_controlplane_v1beta2_L7Protocol(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.MulticastGroupInfo":                schema_pkg_apis_controlplane_v1beta2_MulticastGroupInfo(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.NamedPort":                         schema_pkg_apis_controlplane_v1beta2_NamedPort(ref),
@@ -70,7 +75,10 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.NodeStatsSummary":                  schema_pkg_apis_controlplane_v1beta2_NodeStatsSummary(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.PaginationGetOptions":              schema_pkg_apis_controlplane_v1beta2_PaginationGetOptions(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.PodReference":                      schema_pkg_apis_controlplane_v1beta2_PodReference(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.RuleRateLimit":                     schema_pkg_apis_controlplane_v1beta2_RuleRateLimit(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.RuleRef":                           schema_pkg_apis_controlplane_v1beta2_RuleRef(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.RuleSchedule":                      schema_pkg_apis_controlplane_v1beta2_RuleSchedule(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.ScheduleWindow":                    schema_pkg_apis_controlplane_v1beta2_ScheduleWindow(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.Service":                           schema_pkg_apis_controlplane_v1beta2_Service(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.ServiceReference":                  schema_pkg_apis_controlplane_v1beta2_ServiceReference(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.SupportBundleCollection":           schema_pkg_apis_controlplane_v1beta2_SupportBundleCollection(ref),
@@ -78,6 +86,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.SupportBundleCollectionNodeStatus": schema_pkg_apis_controlplane_v1beta2_SupportBundleCollectionNodeStatus(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.SupportBundleCollectionStatus":     schema_pkg_apis_controlplane_v1beta2_SupportBundleCollectionStatus(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.TLSProtocol":                       schema_pkg_apis_controlplane_v1beta2_TLSProtocol(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.ActiveEgressIP":                             schema_pkg_apis_crd_v1beta1_ActiveEgressIP(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.AgentCondition":                             schema_pkg_apis_crd_v1beta1_AgentCondition(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.AntreaAgentInfo":                            schema_pkg_apis_crd_v1beta1_AntreaAgentInfo(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.AntreaAgentInfoList":                        schema_pkg_apis_crd_v1beta1_AntreaAgentInfoList(ref),
@@ -91,22 +100,30 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ClusterNetworkPolicyList":                   schema_pkg_apis_crd_v1beta1_ClusterNetworkPolicyList(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ClusterNetworkPolicySpec":                   schema_pkg_apis_crd_v1beta1_ClusterNetworkPolicySpec(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ControllerCondition":                        schema_pkg_apis_crd_v1beta1_ControllerCondition(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.DNSProtocol":                                schema_pkg_apis_crd_v1beta1_DNSProtocol(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.Destination":                                schema_pkg_apis_crd_v1beta1_Destination(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.Egress":                                     schema_pkg_apis_crd_v1beta1_Egress(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.EgressCondition":                            schema_pkg_apis_crd_v1beta1_EgressCondition(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.EgressDestination":                          schema_pkg_apis_crd_v1beta1_EgressDestination(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.EgressList":                                 schema_pkg_apis_crd_v1beta1_EgressList(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.EgressSpec":                                 schema_pkg_apis_crd_v1beta1_EgressSpec(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.EgressStatus":                               schema_pkg_apis_crd_v1beta1_EgressStatus(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.EndpointResult":                             schema_pkg_apis_crd_v1beta1_EndpointResult(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ExternalIPPool":                             schema_pkg_apis_crd_v1beta1_ExternalIPPool(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ExternalIPPoolList":                         schema_pkg_apis_crd_v1beta1_ExternalIPPoolList(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.ExternalIPPoolScheduling":                   schema_pkg_apis_crd_v1beta1_ExternalIPPoolScheduling(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ExternalIPPoolSpec":                         schema_pkg_apis_crd_v1beta1_ExternalIPPoolSpec(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ExternalIPPoolStatus":                       schema_pkg_apis_crd_v1beta1_ExternalIPPoolStatus(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.GRPCProtocol":                               schema_pkg_apis_crd_v1beta1_GRPCProtocol(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.Group":                                      schema_pkg_apis_crd_v1beta1_Group(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.GroupCondition":                             schema_pkg_apis_crd_v1beta1_GroupCondition(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.GroupList":                                  schema_pkg_apis_crd_v1beta1_GroupList(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.GroupSpec":                                  schema_pkg_apis_crd_v1beta1_GroupSpec(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.GroupStatus":                                schema_pkg_apis_crd_v1beta1_GroupStatus(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPHeaderMatch":                            schema_pkg_apis_crd_v1beta1_HTTPHeaderMatch(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPProtocol":                               schema_pkg_apis_crd_v1beta1_HTTPProtocol(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPQueryParamMatch":                        schema_pkg_apis_crd_v1beta1_HTTPQueryParamMatch(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPRequest":                                schema_pkg_apis_crd_v1beta1_HTTPRequest(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ICMPEchoRequestHeader":                      schema_pkg_apis_crd_v1beta1_ICMPEchoRequestHeader(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ICMPProtocol":                               schema_pkg_apis_crd_v1beta1_ICMPProtocol(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.IGMPProtocol":                               schema_pkg_apis_crd_v1beta1_IGMPProtocol(ref),
@@ -121,6 +138,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.IPPoolUsage":                                schema_pkg_apis_crd_v1beta1_IPPoolUsage(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.IPRange":                                    schema_pkg_apis_crd_v1beta1_IPRange(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.IPv6Header":                                 schema_pkg_apis_crd_v1beta1_IPv6Header(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.KafkaProtocol":                              schema_pkg_apis_crd_v1beta1_KafkaProtocol(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.L7Payload":                                  schema_pkg_apis_crd_v1beta1_L7Payload(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.L7Protocol":                                 schema_pkg_apis_crd_v1beta1_L7Protocol(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.NamespacedName":                             schema_pkg_apis_crd_v1beta1_NamespacedName(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicy":                              schema_pkg_apis_crd_v1beta1_NetworkPolicy(ref),
@@ -140,20 +159,29 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.PeerService":                                schema_pkg_apis_crd_v1beta1_PeerService(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.PodOwner":                                   schema_pkg_apis_crd_v1beta1_PodOwner(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.Rule":                                       schema_pkg_apis_crd_v1beta1_Rule(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.RuleRateLimit":                              schema_pkg_apis_crd_v1beta1_RuleRateLimit(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.RuleSchedule":                               schema_pkg_apis_crd_v1beta1_RuleSchedule(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.ScheduleWindow":                             schema_pkg_apis_crd_v1beta1_ScheduleWindow(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.ScheduledRuleStatus":                        schema_pkg_apis_crd_v1beta1_ScheduledRuleStatus(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.Source":                                     schema_pkg_apis_crd_v1beta1_Source(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.StatefulSetOwner":                           schema_pkg_apis_crd_v1beta1_StatefulSetOwner(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.SubnetInfo":                                 schema_pkg_apis_crd_v1beta1_SubnetInfo(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.TCPHeader":                                  schema_pkg_apis_crd_v1beta1_TCPHeader(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.TLSClientHello":                             schema_pkg_apis_crd_v1beta1_TLSClientHello(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.TLSProtocol":                                schema_pkg_apis_crd_v1beta1_TLSProtocol(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.Tier":                                       schema_pkg_apis_crd_v1beta1_Tier(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.TierList":                                   schema_pkg_apis_crd_v1beta1_TierList(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.TierSpec":                                   schema_pkg_apis_crd_v1beta1_TierSpec(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.TopologySpread":                             schema_pkg_apis_crd_v1beta1_TopologySpread(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.Traceflow":                                  schema_pkg_apis_crd_v1beta1_Traceflow(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.TraceflowList":                              schema_pkg_apis_crd_v1beta1_TraceflowList(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.TraceflowRun":                               schema_pkg_apis_crd_v1beta1_TraceflowRun(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.TraceflowSchedule":                          schema_pkg_apis_crd_v1beta1_TraceflowSchedule(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.TraceflowSpec":                              schema_pkg_apis_crd_v1beta1_TraceflowSpec(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.TraceflowStatus":                            schema_pkg_apis_crd_v1beta1_TraceflowStatus(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.TransportHeader":                            schema_pkg_apis_crd_v1beta1_TransportHeader(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.UDPHeader":                                  schema_pkg_apis_crd_v1beta1_UDPHeader(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.WeightedNodeSelector":                       schema_pkg_apis_crd_v1beta1_WeightedNodeSelector(ref),
 		"antrea.io/antrea/pkg/apis/stats/v1alpha1.AntreaClusterNetworkPolicyStats":         schema_pkg_apis_stats_v1alpha1_AntreaClusterNetworkPolicyStats(ref),
 		"antrea.io/antrea/pkg/apis/stats/v1alpha1.AntreaClusterNetworkPolicyStatsList":     schema_pkg_apis_stats_v1alpha1_AntreaClusterNetworkPolicyStatsList(ref),
 		"antrea.io/antrea/pkg/apis/stats/v1alpha1.AntreaNetworkPolicyStats":                schema_pkg_apis_stats_v1alpha1_AntreaNetworkPolicyStats(ref),
@@ -940,6 +968,26 @@ func schema_pkg_apis_controlplane_v1beta2_ClusterGroupMembers(ref common.Referen
 	}
 }
 
+func schema_pkg_apis_controlplane_v1beta2_DNSProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DNSProtocol matches DNS queries with specific query name. If the field is not provided, this matches all DNS queries.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"queryName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QueryName represents the domain name in the DNS query to match.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_controlplane_v1beta2_EgressGroup(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1138,6 +1186,33 @@ func schema_pkg_apis_controlplane_v1beta2_ExternalEntityReference(ref common.Ref
 	}
 }
 
+func schema_pkg_apis_controlplane_v1beta2_GRPCProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GRPCProtocol matches gRPC requests with specific service and method. All fields could be used alone or together. If all fields are not provided, it matches all gRPC requests.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"service": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Service represents the fully qualified name of the gRPC service to match (Ex. \"helloworld.Greeter\").",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"method": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Method represents the name of the gRPC method to match (Ex. \"SayHello\").",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_controlplane_v1beta2_GroupAssociation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1371,6 +1446,42 @@ func schema_pkg_apis_controlplane_v1beta2_GroupReference(ref common.ReferenceCal
 	}
 }
 
+func schema_pkg_apis_controlplane_v1beta2_HTTPHeaderMatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPHeaderMatch matches an HTTP request header. If neither Value nor Regex is provided, this matches any request carrying the header.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name represents the name of the header to match. It is case-insensitive.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value represents the exact value of the header to match.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"regex": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Regex represents a regular expression in RE2 syntax which the whole value of the header must match. It can not be set together with Value.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_controlplane_v1beta2_HTTPProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1399,7 +1510,80 @@ func schema_pkg_apis_controlplane_v1beta2_HTTPProtocol(ref common.ReferenceCallb
 							Format:      "",
 						},
 					},
+					"pathRegex": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PathRegex represents a regular expression in RE2 syntax which the whole URI path must match (Ex. \"/api/v[0-9]+/users/.*\"). The query string is not part of the path. It can not be set together with Path.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"headers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Headers represents the HTTP request headers to match. A request must match all of them.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPHeaderMatch"),
+									},
+								},
+							},
+						},
+					},
+					"queryParams": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QueryParams represents the URI query parameters to match. A request must match all of them.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPQueryParamMatch"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPHeaderMatch", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPQueryParamMatch"},
+	}
+}
+
+func schema_pkg_apis_controlplane_v1beta2_HTTPQueryParamMatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPQueryParamMatch matches a URI query parameter. If neither Value nor Regex is provided, this matches any request carrying the parameter.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name represents the name of the query parameter to match. It is case-sensitive.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value represents the exact value of the query parameter to match, as it appears in the URI.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"regex": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Regex represents a regular expression in RE2 syntax which the whole value of the query parameter, as it appears in the URI, must match. It can not be set together with Value.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
+				Required: []string{"name"},
 			},
 		},
 	}
@@ -1516,6 +1700,33 @@ func schema_pkg_apis_controlplane_v1beta2_IPNet(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_pkg_apis_controlplane_v1beta2_KafkaProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KafkaProtocol matches Kafka requests with specific API key and topic. The fields could be used alone or together, but at least one of them must be provided.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"apiKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIKey represents the type of the Kafka requests to match.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"topic": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Topic represents the name of the Kafka topic to match. It can only be used when APIKey is produce, fetch or metadata.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_controlplane_v1beta2_L7Protocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1533,11 +1744,26 @@ func schema_pkg_apis_controlplane_v1beta2_L7Protocol(ref common.ReferenceCallbac
 							Ref: ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.TLSProtocol"),
 						},
 					},
+					"grpc": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.GRPCProtocol"),
+						},
+					},
+					"kafka": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.KafkaProtocol"),
+						},
+					},
+					"dns": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.DNSProtocol"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPProtocol", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.TLSProtocol"},
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.DNSProtocol", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.GRPCProtocol", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPProtocol", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.KafkaProtocol", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.TLSProtocol"},
 	}
 }
 
@@ -2130,12 +2356,24 @@ func schema_pkg_apis_controlplane_v1beta2_NetworkPolicyRule(ref common.Reference
 							Format:      "",
 						},
 					},
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedule restricts the enforcement of this rule to recurring time windows. The rule is always enforced if it's nil.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.RuleSchedule"),
+						},
+					},
+					"rateLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RateLimit limits the rate of the traffic allowed by this rule on each Node. The traffic is not rate-limited if it's nil.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.RuleRateLimit"),
+						},
+					},
 				},
 				Required: []string{"enableLogging"},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.L7Protocol", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyPeer", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.Service"},
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.L7Protocol", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyPeer", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.RuleRateLimit", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.RuleSchedule", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.Service"},
 	}
 }
 
@@ -2410,6 +2648,40 @@ func schema_pkg_apis_controlplane_v1beta2_PodReference(ref common.ReferenceCallb
 	}
 }
 
+func schema_pkg_apis_controlplane_v1beta2_RuleRateLimit(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RuleRateLimit describes the rate limit of the traffic allowed by a rule.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"rate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rate is the maximum traffic rate, e.g. 300k, 100M.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"burst": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Burst is the maximum burst size when traffic exceeds the rate. An empty value means Rate.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"unit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Unit is the unit of Rate and Burst. An empty value means bps.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_controlplane_v1beta2_RuleRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2441,6 +2713,69 @@ func schema_pkg_apis_controlplane_v1beta2_RuleRef(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_controlplane_v1beta2_RuleSchedule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RuleSchedule describes the recurring time windows during which a rule is enforced.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"windows": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Windows is the list of time windows during which the rule is enforced.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.ScheduleWindow"),
+									},
+								},
+							},
+						},
+					},
+					"timeZone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeZone is the IANA name of the time zone in which the windows are evaluated. An empty value means UTC.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.ScheduleWindow"},
+	}
+}
+
+func schema_pkg_apis_controlplane_v1beta2_ScheduleWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ScheduleWindow describes a recurring time window.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"start": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Start is a cron expression which specifies the times at which the window opens.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration is how long the window stays open after each start.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_controlplane_v1beta2_Service(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2765,32 +3100,62 @@ func schema_pkg_apis_controlplane_v1beta2_TLSProtocol(ref common.ReferenceCallba
 	}
 }
 
-func schema_pkg_apis_crd_v1beta1_AgentCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_crd_v1beta1_ActiveEgressIP(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "ActiveEgressIP is an Egress IP of an ActiveActive Egress that is assigned to a Node.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"egressIP": {
 						SchemaProps: spec.SchemaProps{
-							Description: "One of the AgentConditionType listed above",
+							Description: "EgressIP is the Egress IP.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"status": {
+					"egressNode": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Mark certain type status, one of True, False, Unknown",
+							Description: "EgressNode is the name of the Node holding the Egress IP.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"lastHeartbeatTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The timestamp when AntreaAgentInfo is created/updated, ideally heartbeat interval is 60s",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+				},
+				Required: []string{"egressIP", "egressNode"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_AgentCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "One of the AgentConditionType listed above",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mark certain type status, one of True, False, Unknown",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastHeartbeatTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The timestamp when AntreaAgentInfo is created/updated, ideally heartbeat interval is 60s",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 					"reason": {
@@ -3481,6 +3846,13 @@ func schema_pkg_apis_crd_v1beta1_ClusterNetworkPolicySpec(ref common.ReferenceCa
 							},
 						},
 					},
+					"auditMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AuditMode indicates whether the Drop and Reject rules of this policy are enforced with the Audit action, i.e. the traffic matching them is allowed and logged instead. It can be used to evaluate the impact of a policy before enforcing it. Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"priority"},
 			},
@@ -3541,6 +3913,26 @@ func schema_pkg_apis_crd_v1beta1_ControllerCondition(ref common.ReferenceCallbac
 	}
 }
 
+func schema_pkg_apis_crd_v1beta1_DNSProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DNSProtocol matches DNS queries with specific query name. If the field is not provided, this matches all DNS queries.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"queryName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QueryName represents the domain name in the DNS query to match.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_crd_v1beta1_Destination(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3676,6 +4068,33 @@ func schema_pkg_apis_crd_v1beta1_EgressCondition(ref common.ReferenceCallback) c
 	}
 }
 
+func schema_pkg_apis_crd_v1beta1_EgressDestination(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EgressDestination describes a destination of the traffic an Egress applies to. Exactly one of CIDR and FQDN must be specified.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"cidr": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CIDR is an IP block the traffic is destined for, e.g. 10.20.0.0/16.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"fqdn": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FQDN is a fully qualified domain name the traffic is destined for, e.g. api.example.com. antrea-agent learns its IPs from the DNS responses received by the selected Pods and refreshes them when their TTLs expire, and the Egress applies to the traffic destined for these IPs. Wildcards are not supported.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_crd_v1beta1_EgressList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3747,7 +4166,7 @@ func schema_pkg_apis_crd_v1beta1_EgressSpec(ref common.ReferenceCallback) common
 					},
 					"egressIPs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "EgressIPs specifies multiple SNAT IP addresses for the selected workloads. Cannot be set with EgressIP.",
+							Description: "EgressIPs specifies multiple SNAT IP addresses for the selected workloads. Cannot be set with EgressIP. In ActiveActive mode, it specifies the Egress IPs across which the connections of the selected workloads are distributed. If ExternalIPPool is non-empty, the IPs must be in the pool and can be allocated by Antrea automatically according to EgressIPCount.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -3760,6 +4179,20 @@ func schema_pkg_apis_crd_v1beta1_EgressSpec(ref common.ReferenceCallback) common
 							},
 						},
 					},
+					"mode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mode specifies how the selected workloads use the Egress IPs. Defaults to ActiveStandby.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"egressIPCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EgressIPCount specifies the number of Egress IPs to allocate from ExternalIPPool in ActiveActive mode. If it's larger than the number of IPs in EgressIPs, Antrea allocates more IPs from the pool; if it's smaller, the extra IPs at the end of EgressIPs are released. If it's 0, the IPs in EgressIPs are used as they are.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 					"externalIPPool": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ExternalIPPool specifies the IP Pool that the EgressIP should be allocated from. If it is empty, the specified EgressIP must be assigned to a Node manually. If it is non-empty, the EgressIP will be assigned to a Node specified by the pool automatically and will failover to a different Node when the Node becomes unreachable.",
@@ -3788,12 +4221,26 @@ func schema_pkg_apis_crd_v1beta1_EgressSpec(ref common.ReferenceCallback) common
 							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.Bandwidth"),
 						},
 					},
+					"destinations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Destinations restricts the Egress to the traffic destined for the specified destinations. If it's empty, the Egress applies to all north-south egress traffic. For the traffic of a Pod, an Egress with matching Destinations takes precedence over an Egress without Destinations.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/crd/v1beta1.EgressDestination"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"appliedTo"},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.AppliedTo", "antrea.io/antrea/pkg/apis/crd/v1beta1.Bandwidth"},
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.AppliedTo", "antrea.io/antrea/pkg/apis/crd/v1beta1.Bandwidth", "antrea.io/antrea/pkg/apis/crd/v1beta1.EgressDestination"},
 	}
 }
 
@@ -3820,6 +4267,20 @@ func schema_pkg_apis_crd_v1beta1_EgressStatus(ref common.ReferenceCallback) comm
 							Format:      "",
 						},
 					},
+					"activeEgressIPs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ActiveEgressIPs indicates the Egress IPs in use and the Nodes holding them when the Egress is in ActiveActive mode.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/crd/v1beta1.ActiveEgressIP"),
+									},
+								},
+							},
+						},
+					},
 					"conditions": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
@@ -3838,7 +4299,85 @@ func schema_pkg_apis_crd_v1beta1_EgressStatus(ref common.ReferenceCallback) comm
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.EgressCondition"},
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.ActiveEgressIP", "antrea.io/antrea/pkg/apis/crd/v1beta1.EgressCondition"},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_EndpointResult(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EndpointResult describes the result of the path to an endpoint of the destination Service in multi-path Traceflow.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ip": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IP is the IP address of the endpoint.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Port is the port of the endpoint.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"pod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Pod is the Pod of the endpoint, formatted as <namespace>/<name>.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"node": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Node is the Node of the endpoint.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"traceflow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Traceflow is the name of the Traceflow tracing the path to the endpoint.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the phase of the Traceflow tracing the path to the endpoint.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is a message indicating the reason of the phase.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"results": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Results is the collection of all observations on the path to the endpoint.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/crd/v1beta1.NodeResult"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.NodeResult"},
 	}
 }
 
@@ -3941,6 +4480,48 @@ func schema_pkg_apis_crd_v1beta1_ExternalIPPoolList(ref common.ReferenceCallback
 	}
 }
 
+func schema_pkg_apis_crd_v1beta1_ExternalIPPoolScheduling(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ExternalIPPoolScheduling specifies the constraints used to select a Node for an external IP. The Nodes satisfying TopologySpread are considered first, then the Nodes with the highest total weight of NodeAffinity, then the Nodes with the lowest Egress traffic load if PreferLowTraffic is true. The consistent hash of the IP decides among the remaining Nodes.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"nodeAffinity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeAffinity specifies the weighted preferences of Nodes. A Node gets the weights of all the terms whose NodeSelector matches it, and the Nodes with a higher total weight are preferred.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/crd/v1beta1.WeightedNodeSelector"),
+									},
+								},
+							},
+						},
+					},
+					"topologySpread": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TopologySpread spreads the IPs of this pool across topology domains, e.g. zones or racks.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.TopologySpread"),
+						},
+					},
+					"preferLowTraffic": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PreferLowTraffic prefers the Nodes with a lower Egress traffic load, which is read from the \"node.antrea.io/egress-traffic-load\" annotation of Nodes. Antrea never sets the annotation: it is maintained by the user or an external monitoring system. A Node without the annotation has a load of 0, and each IP assigned to a Node adds 1 to its load. Changing the annotation only triggers rescheduling when an ExternalIPPool sets PreferLowTraffic.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.TopologySpread", "antrea.io/antrea/pkg/apis/crd/v1beta1.WeightedNodeSelector"},
+	}
+}
+
 func schema_pkg_apis_crd_v1beta1_ExternalIPPoolSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3974,12 +4555,18 @@ func schema_pkg_apis_crd_v1beta1_ExternalIPPoolSpec(ref common.ReferenceCallback
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
+					"scheduling": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The constraints used to select a Node among the Nodes selected by NodeSelector for an external IP. If not set, the Node is selected by the consistent hash of the IP only. Currently, it's only used when an IP is allocated from the pool for Egress, and is ignored otherwise.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.ExternalIPPoolScheduling"),
+						},
+					},
 				},
 				Required: []string{"ipRanges", "nodeSelector"},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.IPRange", "antrea.io/antrea/pkg/apis/crd/v1beta1.SubnetInfo", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.ExternalIPPoolScheduling", "antrea.io/antrea/pkg/apis/crd/v1beta1.IPRange", "antrea.io/antrea/pkg/apis/crd/v1beta1.SubnetInfo", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
@@ -4003,6 +4590,33 @@ func schema_pkg_apis_crd_v1beta1_ExternalIPPoolStatus(ref common.ReferenceCallba
 	}
 }
 
+func schema_pkg_apis_crd_v1beta1_GRPCProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GRPCProtocol matches gRPC requests with specific service and method. All fields could be used alone or together. If all fields are not provided, it matches all gRPC requests.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"service": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Service represents the fully qualified name of the gRPC service to match (Ex. \"helloworld.Greeter\").",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"method": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Method represents the name of the gRPC method to match (Ex. \"SayHello\").",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_crd_v1beta1_Group(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4230,54 +4844,197 @@ func schema_pkg_apis_crd_v1beta1_GroupStatus(ref common.ReferenceCallback) commo
 	}
 }
 
-func schema_pkg_apis_crd_v1beta1_HTTPProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_crd_v1beta1_HTTPHeaderMatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HTTPProtocol matches HTTP requests with specific host, method, and path. All fields could be used alone or together. If all fields are not provided, it matches all HTTP requests.",
+				Description: "HTTPHeaderMatch matches an HTTP request header. If neither Value nor Regex is provided, this matches any request carrying the header.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"host": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Host represents the hostname present in the URI or the HTTP Host header to match. It does not contain the port associated with the host.",
+							Description: "Name represents the name of the header to match. It is case-insensitive.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"method": {
+					"value": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Method represents the HTTP method to match. It could be GET, POST, PUT, HEAD, DELETE, TRACE, OPTIONS, CONNECT and PATCH.",
+							Description: "Value represents the exact value of the header to match.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"path": {
+					"regex": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Path represents the URI path to match (Ex. \"/index.html\", \"/admin\").",
+							Description: "Regex represents a regular expression in RE2 syntax which the whole value of the header must match. It can not be set together with Value.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
+				Required: []string{"name"},
 			},
 		},
 	}
 }
 
-func schema_pkg_apis_crd_v1beta1_ICMPEchoRequestHeader(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_crd_v1beta1_HTTPProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ICMPEchoRequestHeader describes spec of an ICMP echo request header.",
+				Description: "HTTPProtocol matches HTTP requests with specific host, method, and path. All fields could be used alone or together. If all fields are not provided, it matches all HTTP requests.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"id": {
+					"host": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ID is the ICMPEchoRequestHeader ID.",
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
+							Description: "Host represents the hostname present in the URI or the HTTP Host header to match. It does not contain the port associated with the host.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"method": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Method represents the HTTP method to match. It could be GET, POST, PUT, HEAD, DELETE, TRACE, OPTIONS, CONNECT and PATCH.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path represents the URI path to match (Ex. \"/index.html\", \"/admin\").",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"pathRegex": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PathRegex represents a regular expression in RE2 syntax which the whole URI path must match (Ex. \"/api/v[0-9]+/users/.*\"). The query string is not part of the path. It can not be set together with Path.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"headers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Headers represents the HTTP request headers to match. A request must match all of them.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPHeaderMatch"),
+									},
+								},
+							},
+						},
+					},
+					"queryParams": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QueryParams represents the URI query parameters to match. A request must match all of them.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPQueryParamMatch"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPHeaderMatch", "antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPQueryParamMatch"},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_HTTPQueryParamMatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPQueryParamMatch matches a URI query parameter. If neither Value nor Regex is provided, this matches any request carrying the parameter.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name represents the name of the query parameter to match. It is case-sensitive.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value represents the exact value of the query parameter to match, as it appears in the URI.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"regex": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Regex represents a regular expression in RE2 syntax which the whole value of the query parameter, as it appears in the URI, must match. It can not be set together with Value.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_HTTPRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPRequest describes an HTTP request carried by a Traceflow packet.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"method": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Method is the HTTP method of the request. Defaults to GET if not set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"host": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Host is the HTTP Host header of the request, without the port.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the URI path of the request. Defaults to \"/\" if not set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_ICMPEchoRequestHeader(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ICMPEchoRequestHeader describes spec of an ICMP echo request header.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID is the ICMPEchoRequestHeader ID.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 					"sequence": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Sequence is the ICMPEchoRequestHeader sequence.",
@@ -4732,6 +5489,58 @@ func schema_pkg_apis_crd_v1beta1_IPv6Header(ref common.ReferenceCallback) common
 	}
 }
 
+func schema_pkg_apis_crd_v1beta1_KafkaProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KafkaProtocol matches Kafka requests with specific API key and topic. The fields could be used alone or together, but at least one of them must be provided.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"apiKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIKey represents the type of the Kafka requests to match.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"topic": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Topic represents the name of the Kafka topic to match. It can only be used when APIKey is produce, fetch or metadata.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_L7Payload(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "L7Payload describes the application layer payload of a Traceflow packet. HTTP and TLS are mutually exclusive. The payload is evaluated against the L7 NetworkPolicy rules the packet is subject to, in the same way as the L7 engine does.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"http": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPRequest"),
+						},
+					},
+					"tls": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("antrea.io/antrea/pkg/apis/crd/v1beta1.TLSClientHello"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPRequest", "antrea.io/antrea/pkg/apis/crd/v1beta1.TLSClientHello"},
+	}
+}
+
 func schema_pkg_apis_crd_v1beta1_L7Protocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4748,11 +5557,26 @@ func schema_pkg_apis_crd_v1beta1_L7Protocol(ref common.ReferenceCallback) common
 							Ref: ref("antrea.io/antrea/pkg/apis/crd/v1beta1.TLSProtocol"),
 						},
 					},
+					"grpc": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("antrea.io/antrea/pkg/apis/crd/v1beta1.GRPCProtocol"),
+						},
+					},
+					"kafka": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("antrea.io/antrea/pkg/apis/crd/v1beta1.KafkaProtocol"),
+						},
+					},
+					"dns": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("antrea.io/antrea/pkg/apis/crd/v1beta1.DNSProtocol"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPProtocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.TLSProtocol"},
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.DNSProtocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.GRPCProtocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPProtocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.KafkaProtocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.TLSProtocol"},
 	}
 }
 
@@ -5178,6 +6002,13 @@ func schema_pkg_apis_crd_v1beta1_NetworkPolicySpec(ref common.ReferenceCallback)
 							},
 						},
 					},
+					"auditMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AuditMode indicates whether the Drop and Reject rules of this policy are enforced with the Audit action, i.e. the traffic matching them is allowed and logged instead. It can be used to evaluate the impact of a policy before enforcing it. Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"priority"},
 			},
@@ -5240,12 +6071,26 @@ func schema_pkg_apis_crd_v1beta1_NetworkPolicyStatus(ref common.ReferenceCallbac
 							},
 						},
 					},
+					"scheduledRules": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ScheduledRules reports the activation state of the rules with a schedule.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/crd/v1beta1.ScheduledRuleStatus"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"phase", "observedGeneration", "currentNodesRealized", "desiredNodesRealized", "conditions"},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyCondition"},
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyCondition", "antrea.io/antrea/pkg/apis/crd/v1beta1.ScheduledRuleStatus"},
 	}
 }
 
@@ -5354,7 +6199,7 @@ func schema_pkg_apis_crd_v1beta1_Observation(ref common.ReferenceCallback) commo
 					},
 					"componentInfo": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ComponentInfo is the extension of Component field.",
+							Description: "ComponentInfo is the extension of Component field. For an L7Engine observation, it is \"PredictedHTTP\" or \"PredictedTLS\": the verdict is predicted by the Antrea Agent, which evaluates the L7 payload against the L7 protocols of the NetworkPolicy rule, and is not reported by the L7 engine.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -5449,6 +6294,13 @@ func schema_pkg_apis_crd_v1beta1_Observation(ref common.ReferenceCallback) commo
 							Format:      "",
 						},
 					},
+					"l7Rule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "L7Rule is the L7 protocol of the NetworkPolicy rule which allowed the L7 payload of the packet, for an L7Engine observation. When the verdict is unknown, it is the L7 protocols of the rule which can't be evaluated against the payload.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -5497,11 +6349,17 @@ func schema_pkg_apis_crd_v1beta1_Packet(ref common.ReferenceCallback) common.Ope
 							Ref:     ref("antrea.io/antrea/pkg/apis/crd/v1beta1.TransportHeader"),
 						},
 					},
+					"l7Payload": {
+						SchemaProps: spec.SchemaProps{
+							Description: "L7Payload is the application layer payload of the packet. It can only be set for a TCP packet in a non-live-traffic Traceflow.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.L7Payload"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.IPHeader", "antrea.io/antrea/pkg/apis/crd/v1beta1.IPv6Header", "antrea.io/antrea/pkg/apis/crd/v1beta1.TransportHeader"},
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.IPHeader", "antrea.io/antrea/pkg/apis/crd/v1beta1.IPv6Header", "antrea.io/antrea/pkg/apis/crd/v1beta1.L7Payload", "antrea.io/antrea/pkg/apis/crd/v1beta1.TransportHeader"},
 	}
 }
 
@@ -5747,12 +6605,165 @@ func schema_pkg_apis_crd_v1beta1_Rule(ref common.ReferenceCallback) common.OpenA
 							},
 						},
 					},
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedule restricts the enforcement of this rule to recurring time windows. The rule is only enforced when any of the windows is open. If this field is not set, the rule is always enforced.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.RuleSchedule"),
+						},
+					},
+					"rateLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RateLimit limits the rate of the traffic allowed by this rule on each Node. It can only be set for rules with the Allow action.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.RuleRateLimit"),
+						},
+					},
 				},
 				Required: []string{"action"},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.AppliedTo", "antrea.io/antrea/pkg/apis/crd/v1beta1.L7Protocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyPeer", "antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyPort", "antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyProtocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.PeerService"},
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.AppliedTo", "antrea.io/antrea/pkg/apis/crd/v1beta1.L7Protocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyPeer", "antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyPort", "antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyProtocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.PeerService", "antrea.io/antrea/pkg/apis/crd/v1beta1.RuleRateLimit", "antrea.io/antrea/pkg/apis/crd/v1beta1.RuleSchedule"},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_RuleRateLimit(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RuleRateLimit describes the rate limit of the traffic allowed by a rule.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"rate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rate specifies the maximum traffic rate, e.g. 300k, 100M.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"burst": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Burst specifies the maximum burst size when traffic exceeds the rate, e.g. 300k, 10M. Defaults to Rate if not set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"unit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Unit is the unit of Rate and Burst, \"bps\" or \"pps\". Defaults to \"bps\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"rate"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_RuleSchedule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RuleSchedule describes the recurring time windows during which a rule is enforced.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"windows": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Windows is the list of time windows during which the rule is enforced.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/crd/v1beta1.ScheduleWindow"),
+									},
+								},
+							},
+						},
+					},
+					"timeZone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeZone is the IANA name of the time zone in which the windows are evaluated, e.g. \"America/New_York\". Defaults to UTC if not set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"windows"},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.ScheduleWindow"},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_ScheduleWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ScheduleWindow describes a recurring time window.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"start": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Start is a cron expression in the standard 5-field format (\"minute hour day-of-month month day-of-week\") which specifies the times at which the window opens, e.g. \"0 22 * * 1-5\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration is how long the window stays open after each start, e.g. \"2h\" or \"90m\". It must be at least 1m.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"start", "duration"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_ScheduledRuleStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ScheduledRuleStatus describes the activation state of a rule with a schedule.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the rule.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"active": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Active indicates whether the rule is enforced at present.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"nextTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NextTransitionTime is the time at which the rule will be activated or deactivated next. It is not set if the state of the rule never changes.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"name", "active"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -5896,6 +6907,26 @@ func schema_pkg_apis_crd_v1beta1_TCPHeader(ref common.ReferenceCallback) common.
 	}
 }
 
+func schema_pkg_apis_crd_v1beta1_TLSClientHello(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TLSClientHello describes a TLS ClientHello message carried by a Traceflow packet.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"sni": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SNI is the Server Name Indication of the ClientHello message.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_crd_v1beta1_TLSProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -6036,6 +7067,35 @@ func schema_pkg_apis_crd_v1beta1_TierSpec(ref common.ReferenceCallback) common.O
 	}
 }
 
+func schema_pkg_apis_crd_v1beta1_TopologySpread(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TopologySpread specifies how to spread the IPs of a pool across topology domains.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"topologyKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TopologyKey is the key of the Node label, e.g. \"topology.kubernetes.io/zone\". Nodes with the same value of the label are in the same topology domain. Nodes without the label are not selected.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxSkew": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxSkew is the maximum difference allowed between the numbers of IPs assigned to any two topology domains which have Nodes available. Defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"topologyKey"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_crd_v1beta1_Traceflow(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -6130,6 +7190,105 @@ func schema_pkg_apis_crd_v1beta1_TraceflowList(ref common.ReferenceCallback) com
 	}
 }
 
+func schema_pkg_apis_crd_v1beta1_TraceflowRun(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TraceflowRun describes a run of a recurring Traceflow.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"traceflow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Traceflow is the name of the Traceflow of the run.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"startTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartTime is the time at which the run was started.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the phase of the run.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is a message indicating the reason of the phase.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"results": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Results is the collection of all observations of the run.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/crd/v1beta1.NodeResult"),
+									},
+								},
+							},
+						},
+					},
+					"endpointResults": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EndpointResults is the collection of the results of the paths to each endpoint of the destination Service, if the run is multi-path.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/crd/v1beta1.EndpointResult"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"startTime"},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.EndpointResult", "antrea.io/antrea/pkg/apis/crd/v1beta1.NodeResult", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_TraceflowSchedule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TraceflowSchedule describes the schedule of a recurring Traceflow.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"interval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Interval is the interval between two runs in seconds. It must be larger than the timeout of the Traceflow.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"historyLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HistoryLimit is the number of runs whose results are kept in the status. Defaults to 10 if not set.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"interval"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_crd_v1beta1_TraceflowSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -6176,11 +7335,24 @@ func schema_pkg_apis_crd_v1beta1_TraceflowSpec(ref common.ReferenceCallback) com
 							Format:      "int32",
 						},
 					},
+					"multiPath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MultiPath indicates the Traceflow is to trace the paths to all the endpoints of the destination Service, when set to true. A Traceflow is created for each ready endpoint, whose packet is sent to the Service and load-balanced to the endpoint, and their results are reported in the status of this Traceflow. It can only be set for a non-live-traffic Traceflow to a Service.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedule makes the Traceflow recurring when set. A Traceflow is created to run the trace at each interval, and the results of the last runs are kept in the status of this Traceflow. It can only be set for a non-live-traffic Traceflow.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.TraceflowSchedule"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.Destination", "antrea.io/antrea/pkg/apis/crd/v1beta1.Packet", "antrea.io/antrea/pkg/apis/crd/v1beta1.Source"},
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.Destination", "antrea.io/antrea/pkg/apis/crd/v1beta1.Packet", "antrea.io/antrea/pkg/apis/crd/v1beta1.Source", "antrea.io/antrea/pkg/apis/crd/v1beta1.TraceflowSchedule"},
 	}
 }
 
@@ -6238,11 +7410,39 @@ func schema_pkg_apis_crd_v1beta1_TraceflowStatus(ref common.ReferenceCallback) c
 							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.Packet"),
 						},
 					},
+					"endpointResults": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EndpointResults is the collection of the results of the paths to each endpoint of the destination Service in multi-path Traceflow.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/crd/v1beta1.EndpointResult"),
+									},
+								},
+							},
+						},
+					},
+					"history": {
+						SchemaProps: spec.SchemaProps{
+							Description: "History is the collection of the results of the last runs of a recurring Traceflow, from the oldest to the latest.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/crd/v1beta1.TraceflowRun"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.NodeResult", "antrea.io/antrea/pkg/apis/crd/v1beta1.Packet", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.EndpointResult", "antrea.io/antrea/pkg/apis/crd/v1beta1.NodeResult", "antrea.io/antrea/pkg/apis/crd/v1beta1.Packet", "antrea.io/antrea/pkg/apis/crd/v1beta1.TraceflowRun", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -6303,6 +7503,37 @@ func schema_pkg_apis_crd_v1beta1_UDPHeader(ref common.ReferenceCallback) common.
 	}
 }
 
+func schema_pkg_apis_crd_v1beta1_WeightedNodeSelector(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WeightedNodeSelector is a Node selector associated with a weight.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"weight": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Weight associated with matching the NodeSelector, in the range 1-100.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The Nodes which the weight applies to.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+				},
+				Required: []string{"weight", "nodeSelector"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
 func schema_pkg_apis_stats_v1alpha1_AntreaClusterNetworkPolicyStats(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -191,6 +191,7 @@ const (
 	NetworkPolicyRuleActionAllow  NetworkPolicyRuleAction = "Allow"
 	NetworkPolicyRuleActionDrop   NetworkPolicyRuleAction = "Drop"
 	NetworkPolicyRuleActionReject NetworkPolicyRuleAction = "Reject"
+	NetworkPolicyRuleActionAudit  NetworkPolicyRuleAction = "Audit"
 )
 
 // FlowFilter will match a flow if all individual conditions are fulfilled.
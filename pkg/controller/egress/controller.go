@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"net"
 	"reflect"
+	"slices"
 	"sync"
 	"time"
 
@@ -68,6 +69,12 @@ type ipAllocation struct {
 	ipPool string
 }
 
+// ipsAllocation contains the IPs allocated to an ActiveActive Egress and the IP Pool which allocates them.
+type ipsAllocation struct {
+	ips    []net.IP
+	ipPool string
+}
+
 // EgressController is responsible for synchronizing the EgressGroups selected by Egresses.
 type EgressController struct {
 	crdClient clientset.Interface
@@ -76,7 +83,10 @@ type EgressController struct {
 
 	// ipAllocationMap is a map from Egress name to ipAllocation, which is used to check whether the Egress's IP has
 	// changed and to release the IP after the Egress is removed.
-	ipAllocationMap   map[string]*ipAllocation
+	ipAllocationMap map[string]*ipAllocation
+	// ipsAllocationMap is a map from the name of an ActiveActive Egress to ipsAllocation, which is used to check
+	// whether the Egress's IPs have changed and to release the IPs after the Egress is removed.
+	ipsAllocationMap  map[string]*ipsAllocation
 	ipAllocationMutex sync.RWMutex
 
 	egressInformer egressinformers.EgressInformer
@@ -116,6 +126,7 @@ func NewEgressController(crdClient clientset.Interface,
 		groupingInterface:       groupingInterface,
 		groupingInterfaceSynced: groupingInterface.HasSynced,
 		ipAllocationMap:         map[string]*ipAllocation{},
+		ipsAllocationMap:        map[string]*ipsAllocation{},
 		externalIPAllocator:     externalIPAllocator,
 	}
 	// Add handlers for Group events and Egress events.
@@ -173,7 +184,25 @@ func (c *EgressController) Run(stopCh <-chan struct{}) {
 // restoreIPAllocations restores the existing EgressIPs of Egresses and records the successful ones in ipAllocationMap.
 func (c *EgressController) restoreIPAllocations(egresses []*egressv1beta1.Egress) {
 	var previousIPAllocations []externalippool.IPAllocation
+	activeActiveEgresses := sets.New[string]()
 	for _, egress := range egresses {
+		if isEgressActiveActive(egress) {
+			if egress.Spec.ExternalIPPool == "" {
+				continue
+			}
+			activeActiveEgresses.Insert(egress.Name)
+			for _, egressIP := range egress.Spec.EgressIPs {
+				previousIPAllocations = append(previousIPAllocations, externalippool.IPAllocation{
+					ObjectReference: v1.ObjectReference{
+						Name: egress.Name,
+						Kind: egress.Kind,
+					},
+					IPPoolName: egress.Spec.ExternalIPPool,
+					IP:         net.ParseIP(egressIP),
+				})
+			}
+			continue
+		}
 		// Ignore Egress that is not associated to ExternalIPPool or doesn't have EgressIP assigned.
 		if egress.Spec.ExternalIPPool == "" || egress.Spec.EgressIP == "" {
 			continue
@@ -191,6 +220,12 @@ func (c *EgressController) restoreIPAllocations(egresses []*egressv1beta1.Egress
 	}
 	succeededAllocations := c.externalIPAllocator.RestoreIPAllocations(previousIPAllocations)
 	for _, alloc := range succeededAllocations {
+		if activeActiveEgresses.Has(alloc.ObjectReference.Name) {
+			prevIPs, _, _ := c.getIPsAllocation(alloc.ObjectReference.Name)
+			c.setIPsAllocation(alloc.ObjectReference.Name, append(prevIPs, alloc.IP), alloc.IPPoolName)
+			klog.InfoS("Restored EgressIP", "egress", alloc.ObjectReference.Name, "ip", alloc.IP, "pool", alloc.IPPoolName)
+			continue
+		}
 		c.setIPAllocation(alloc.ObjectReference.Name, alloc.IP, alloc.IPPoolName)
 		klog.InfoS("Restored EgressIP", "egress", alloc.ObjectReference.Name, "ip", alloc.IP, "pool", alloc.IPPoolName)
 	}
@@ -245,8 +280,36 @@ func (c *EgressController) setIPAllocation(egressName string, ip net.IP, poolNam
 	}
 }
 
+func (c *EgressController) getIPsAllocation(egressName string) ([]net.IP, string, bool) {
+	c.ipAllocationMutex.RLock()
+	defer c.ipAllocationMutex.RUnlock()
+	allocation, exists := c.ipsAllocationMap[egressName]
+	if !exists {
+		return nil, "", false
+	}
+	return allocation.ips, allocation.ipPool, true
+}
+
+func (c *EgressController) deleteIPsAllocation(egressName string) {
+	c.ipAllocationMutex.Lock()
+	defer c.ipAllocationMutex.Unlock()
+	delete(c.ipsAllocationMap, egressName)
+}
+
+func (c *EgressController) setIPsAllocation(egressName string, ips []net.IP, poolName string) {
+	c.ipAllocationMutex.Lock()
+	defer c.ipAllocationMutex.Unlock()
+	c.ipsAllocationMap[egressName] = &ipsAllocation{
+		ips:    ips,
+		ipPool: poolName,
+	}
+}
+
 // syncEgressIP is responsible for releasing stale EgressIP and allocating new EgressIP for an Egress if applicable.
 func (c *EgressController) syncEgressIP(egress *egressv1beta1.Egress) (net.IP, *egressv1beta1.Egress, error) {
+	// The Egress may be switched from ActiveActive mode, release the IPs allocated to it.
+	c.releaseEgressIPs(egress.Name)
+
 	prevIP, prevIPPool, exists := c.getIPAllocation(egress.Name)
 	if exists {
 		// The EgressIP and the ExternalIPPool haven't changed.
@@ -334,8 +397,156 @@ func (c *EgressController) updateEgressIP(egress *egressv1beta1.Egress, ip strin
 	}
 }
 
+// syncEgressIPs is responsible for releasing stale EgressIPs and allocating new EgressIPs for an ActiveActive Egress
+// if applicable. When EgressIPCount is set, it allocates more IPs from the ExternalIPPool or releases the extra IPs
+// at the end of EgressIPs to make the Egress have EgressIPCount IPs.
+func (c *EgressController) syncEgressIPs(egress *egressv1beta1.Egress) ([]net.IP, *egressv1beta1.Egress, error) {
+	// The Egress may be switched from ActiveStandby mode, release the IP allocated to it.
+	if prevIP, prevIPPool, exists := c.getIPAllocation(egress.Name); exists {
+		c.releaseEgressIP(egress.Name, prevIP, prevIPPool)
+	}
+
+	poolName := egress.Spec.ExternalIPPool
+	prevIPs, prevIPPool, _ := c.getIPsAllocation(egress.Name)
+	specIPs := sets.New[string](egress.Spec.EgressIPs...)
+	// allocatedIPs are the IPs that are still valid among the previously allocated ones.
+	allocatedIPs := sets.New[string]()
+	for _, ip := range prevIPs {
+		if prevIPPool == poolName && specIPs.Has(ip.String()) && c.externalIPAllocator.IPPoolHasIP(poolName, ip) {
+			allocatedIPs.Insert(ip.String())
+			continue
+		}
+		c.releaseIP(egress.Name, ip, prevIPPool)
+	}
+
+	// Skip allocating EgressIPs if ExternalIPPool is not specified and return whatever user specifies.
+	if poolName == "" {
+		c.deleteIPsAllocation(egress.Name)
+		var ips []net.IP
+		for _, egressIP := range egress.Spec.EgressIPs {
+			ips = append(ips, net.ParseIP(egressIP))
+		}
+		return ips, egress, nil
+	}
+
+	if !c.externalIPAllocator.IPPoolExists(poolName) {
+		c.deleteIPsAllocation(egress.Name)
+		// The IP pool has been deleted, reclaim the IPs from the Egress API.
+		if len(egress.Spec.EgressIPs) > 0 {
+			if updatedEgress, err := c.updateEgressIPs(egress, nil); err != nil {
+				return nil, egress, err
+			} else {
+				egress = updatedEgress
+			}
+		}
+		return nil, egress, fmt.Errorf("ExternalIPPool %s does not exist", poolName)
+	}
+
+	var allocErr error
+	var desiredIPs []string
+	var ips, newIPs []net.IP
+	for _, egressIP := range egress.Spec.EgressIPs {
+		ip := net.ParseIP(egressIP)
+		if allocatedIPs.Has(ip.String()) {
+			ips = append(ips, ip)
+			desiredIPs = append(desiredIPs, egressIP)
+			continue
+		}
+		// The ExternalIPPool may no longer contain the IP, reclaim the IP from the Egress API.
+		if !c.externalIPAllocator.IPPoolHasIP(poolName, ip) {
+			klog.InfoS("EgressIP is no longer part of ExternalIPPool, releasing it", "egress", klog.KObj(egress), "ip", egressIP, "pool", poolName)
+			continue
+		}
+		// User specifies the Egress IP, try to allocate it. If it fails, the datapath may still work, we just don't
+		// track the IP allocation so deleting this Egress won't release the IP to the Pool.
+		if err := c.externalIPAllocator.UpdateIPAllocation(poolName, ip); err != nil {
+			allocErr = fmt.Errorf("error when allocating IP %v for Egress %s from ExternalIPPool %s: %v", ip, egress.Name, poolName, err)
+		} else {
+			ips = append(ips, ip)
+		}
+		desiredIPs = append(desiredIPs, egressIP)
+	}
+	if count := int(egress.Spec.EgressIPCount); count > 0 {
+		// Release the extra IPs at the end of EgressIPs.
+		for len(desiredIPs) > count {
+			extraIP := net.ParseIP(desiredIPs[len(desiredIPs)-1])
+			desiredIPs = desiredIPs[:len(desiredIPs)-1]
+			if i := slices.IndexFunc(ips, extraIP.Equal); i >= 0 {
+				ips = slices.Delete(ips, i, i+1)
+				c.releaseIP(egress.Name, extraIP, poolName)
+			}
+		}
+		// Allocate more IPs from the ExternalIPPool.
+		for len(desiredIPs) < count {
+			ip, err := c.externalIPAllocator.AllocateIPFromPool(poolName)
+			if err != nil {
+				allocErr = err
+				break
+			}
+			newIPs = append(newIPs, ip)
+			desiredIPs = append(desiredIPs, ip.String())
+		}
+	}
+	if !slices.Equal(desiredIPs, egress.Spec.EgressIPs) {
+		if updatedEgress, err := c.updateEgressIPs(egress, desiredIPs); err != nil {
+			for _, ip := range newIPs {
+				if rerr := c.externalIPAllocator.ReleaseIP(poolName, ip); rerr != nil &&
+					rerr != externalippool.ErrExternalIPPoolNotFound {
+					klog.ErrorS(rerr, "Failed to release IP", "ip", ip, "pool", poolName)
+				}
+			}
+			c.setIPsAllocation(egress.Name, ips, poolName)
+			return nil, egress, err
+		} else {
+			egress = updatedEgress
+		}
+	}
+	ips = append(ips, newIPs...)
+	c.setIPsAllocation(egress.Name, ips, poolName)
+	if len(newIPs) > 0 {
+		klog.InfoS("Allocated EgressIPs", "egress", egress.Name, "ips", newIPs, "pool", poolName)
+	}
+	if allocErr != nil {
+		return nil, egress, allocErr
+	}
+	return ips, egress, nil
+}
+
+// updateEgressIPs updates the Egress's EgressIPs in Kubernetes API.
+func (c *EgressController) updateEgressIPs(egress *egressv1beta1.Egress, ips []string) (*egressv1beta1.Egress, error) {
+	patch := map[string]interface{}{
+		"spec": map[string][]string{
+			"egressIPs": ips,
+		},
+	}
+	patchBytes, _ := json.Marshal(patch)
+	if updatedEgress, err := c.crdClient.CrdV1beta1().Egresses().Patch(context.TODO(), egress.Name, types.MergePatchType, patchBytes, metav1.PatchOptions{}); err != nil {
+		return nil, fmt.Errorf("error when updating EgressIPs for Egress %s: %v", egress.Name, err)
+	} else {
+		return updatedEgress, nil
+	}
+}
+
+// releaseEgressIPs removes the ActiveActive Egress's ipsAllocation in the cache and releases the IPs to the pool.
+func (c *EgressController) releaseEgressIPs(egressName string) {
+	prevIPs, prevIPPool, exists := c.getIPsAllocation(egressName)
+	if !exists {
+		return
+	}
+	for _, ip := range prevIPs {
+		c.releaseIP(egressName, ip, prevIPPool)
+	}
+	c.deleteIPsAllocation(egressName)
+}
+
 // releaseEgressIP removes the Egress's ipAllocation in the cache and releases the IP to the pool.
 func (c *EgressController) releaseEgressIP(egressName string, egressIP net.IP, poolName string) {
+	c.releaseIP(egressName, egressIP, poolName)
+	c.deleteIPAllocation(egressName)
+}
+
+// releaseIP releases the IP allocated to the Egress to the pool.
+func (c *EgressController) releaseIP(egressName string, egressIP net.IP, poolName string) {
 	if err := c.externalIPAllocator.ReleaseIP(poolName, egressIP); err != nil {
 		if err == externalippool.ErrExternalIPPoolNotFound {
 			// Ignore the error since the external IP Pool could be deleted.
@@ -344,14 +555,12 @@ func (c *EgressController) releaseEgressIP(egressName string, egressIP net.IP, p
 			// It is possible for the external IP Pool to have been deleted and
 			// recreated immediately with a different range, which would trigger this
 			// case. Transient errors in ReleaseIP are not possible, so there is no
-			// point in retrying. We should still delete our own state by calling
-			// deleteIPAllocation.
+			// point in retrying. The caller should still delete its own state.
 			klog.ErrorS(err, "Failed to release IP", "ip", egressIP, "pool", poolName)
 		}
 	} else {
 		klog.InfoS("Released EgressIP", "egress", egressName, "ip", egressIP, "pool", poolName)
 	}
-	c.deleteIPAllocation(egressName)
 }
 
 func (c *EgressController) syncEgress(key string) error {
@@ -363,14 +572,19 @@ func (c *EgressController) syncEgress(key string) error {
 
 	egress, err := c.egressLister.Get(key)
 	if err != nil {
-		// The Egress has been deleted, release its EgressIP if there was one.
+		// The Egress has been deleted, release its EgressIPs if there were any.
 		if prevIP, prevIPPool, exists := c.getIPAllocation(key); exists {
 			c.releaseEgressIP(key, prevIP, prevIPPool)
 		}
+		c.releaseEgressIPs(key)
 		return nil
 	}
 
-	_, egress, err = c.syncEgressIP(egress)
+	if isEgressActiveActive(egress) {
+		_, egress, err = c.syncEgressIPs(egress)
+	} else {
+		_, egress, err = c.syncEgressIP(egress)
+	}
 	c.updateEgressAllocatedCondition(egress, err)
 	if err != nil {
 		return err
@@ -527,6 +741,11 @@ func (c *EgressController) updateEgressAllocatedCondition(egress *egressv1beta1.
 	}
 }
 
+// isEgressActiveActive returns whether the connections of the Egress are distributed across multiple Egress IPs.
+func isEgressActiveActive(egress *egressv1beta1.Egress) bool {
+	return egress.Spec.Mode == egressv1beta1.EgressModeActiveActive
+}
+
 // compareConditionIgnoringTimestamp compares two conditions ignoring the timestamp
 func compareConditionIgnoringTimestamp(condition1, condition2 *egressv1beta1.EgressCondition) bool {
 	if condition1 == nil && condition2 == nil {
@@ -818,6 +818,127 @@ func TestSyncEgressIP(t *testing.T) {
 	}
 }
 
+func TestSyncEgressIPs(t *testing.T) {
+	tests := []struct {
+		name                       string
+		existingEgresses           []*v1beta1.Egress
+		existingExternalIPPool     *v1beta1.ExternalIPPool
+		inputEgress                *v1beta1.Egress
+		expectedEgressIPs          []string
+		expectedExternalIPPoolUsed int
+		expectErr                  bool
+	}{
+		{
+			name:                       "Egress with EgressIPCount and empty EgressIPs",
+			existingExternalIPPool:     newExternalIPPool("ipPoolA", "1.1.1.0/24", "", ""),
+			inputEgress:                newActiveActiveEgress("egressA", "ipPoolA", 3),
+			expectedEgressIPs:          []string{"1.1.1.1", "1.1.1.2", "1.1.1.3"},
+			expectedExternalIPPoolUsed: 3,
+		},
+		{
+			name:                       "Egress with specified EgressIPs",
+			existingExternalIPPool:     newExternalIPPool("ipPoolA", "1.1.1.0/24", "", ""),
+			inputEgress:                newActiveActiveEgress("egressA", "ipPoolA", 0, "1.1.1.10", "1.1.1.11"),
+			expectedEgressIPs:          []string{"1.1.1.10", "1.1.1.11"},
+			expectedExternalIPPoolUsed: 2,
+		},
+		{
+			name: "Egress with increased EgressIPCount",
+			existingEgresses: []*v1beta1.Egress{
+				newActiveActiveEgress("egressA", "ipPoolA", 1, "1.1.1.10"),
+			},
+			existingExternalIPPool:     newExternalIPPool("ipPoolA", "1.1.1.0/24", "", ""),
+			inputEgress:                newActiveActiveEgress("egressA", "ipPoolA", 2, "1.1.1.10"),
+			expectedEgressIPs:          []string{"1.1.1.10", "1.1.1.1"},
+			expectedExternalIPPoolUsed: 2,
+		},
+		{
+			name: "Egress with decreased EgressIPCount",
+			existingEgresses: []*v1beta1.Egress{
+				newActiveActiveEgress("egressA", "ipPoolA", 3, "1.1.1.1", "1.1.1.2", "1.1.1.3"),
+			},
+			existingExternalIPPool:     newExternalIPPool("ipPoolA", "1.1.1.0/24", "", ""),
+			inputEgress:                newActiveActiveEgress("egressA", "ipPoolA", 1, "1.1.1.1", "1.1.1.2", "1.1.1.3"),
+			expectedEgressIPs:          []string{"1.1.1.1"},
+			expectedExternalIPPoolUsed: 1,
+		},
+		{
+			name: "Egress switched from ActiveStandby mode",
+			existingEgresses: []*v1beta1.Egress{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "egressA"},
+					Spec: v1beta1.EgressSpec{
+						EgressIP:       "1.1.1.5",
+						ExternalIPPool: "ipPoolA",
+					},
+				},
+			},
+			existingExternalIPPool:     newExternalIPPool("ipPoolA", "1.1.1.0/24", "", ""),
+			inputEgress:                newActiveActiveEgress("egressA", "ipPoolA", 0, "1.1.1.10"),
+			expectedEgressIPs:          []string{"1.1.1.10"},
+			expectedExternalIPPoolUsed: 1,
+		},
+		{
+			name:                       "Egress with EgressIPCount exceeding the pool size",
+			existingExternalIPPool:     newExternalIPPool("ipPoolA", "1.1.1.0/30", "", ""),
+			inputEgress:                newActiveActiveEgress("egressA", "ipPoolA", 3),
+			expectedEgressIPs:          []string{"1.1.1.1", "1.1.1.2"},
+			expectedExternalIPPoolUsed: 2,
+			expectErr:                  true,
+		},
+		{
+			name: "Egress with conflicting EgressIPs",
+			existingEgresses: []*v1beta1.Egress{
+				newActiveActiveEgress("egressA", "ipPoolA", 0, "1.1.1.10"),
+			},
+			existingExternalIPPool:     newExternalIPPool("ipPoolA", "1.1.1.0/24", "", ""),
+			inputEgress:                newActiveActiveEgress("egressB", "ipPoolA", 0, "1.1.1.10", "1.1.1.11"),
+			expectedEgressIPs:          []string{"1.1.1.10", "1.1.1.11"},
+			expectedExternalIPPoolUsed: 2,
+			expectErr:                  true,
+		},
+		{
+			name:                       "Egress with non-existing ExternalIPPool",
+			existingExternalIPPool:     newExternalIPPool("ipPoolA", "1.1.1.0/24", "", ""),
+			inputEgress:                newActiveActiveEgress("egressA", "ipPoolB", 0, "1.1.1.10"),
+			expectedEgressIPs:          nil,
+			expectedExternalIPPoolUsed: 0,
+			expectErr:                  true,
+		},
+		{
+			name:                       "Egress with empty ExternalIPPool",
+			existingExternalIPPool:     newExternalIPPool("ipPoolA", "1.1.1.0/24", "", ""),
+			inputEgress:                newActiveActiveEgress("egressA", "", 0, "10.10.10.10", "10.10.10.11"),
+			expectedEgressIPs:          []string{"10.10.10.10", "10.10.10.11"},
+			expectedExternalIPPoolUsed: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stopCh := make(chan struct{})
+			defer close(stopCh)
+			var fakeObjects []runtime.Object
+			fakeObjects = append(fakeObjects, tt.inputEgress, tt.existingExternalIPPool)
+			controller := newController(nil, fakeObjects)
+			controller.informerFactory.Start(stopCh)
+			controller.crdInformerFactory.Start(stopCh)
+			controller.informerFactory.WaitForCacheSync(stopCh)
+			controller.crdInformerFactory.WaitForCacheSync(stopCh)
+			go controller.externalIPAllocator.Run(stopCh)
+			require.True(t, cache.WaitForCacheSync(stopCh, controller.externalIPAllocator.HasSynced))
+			controller.restoreIPAllocations(tt.existingEgresses)
+			_, egress, err := controller.syncEgressIPs(tt.inputEgress)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedEgressIPs, egress.Spec.EgressIPs)
+			checkExternalIPPoolUsed(t, controller, tt.existingExternalIPPool.Name, tt.expectedExternalIPPoolUsed)
+		})
+	}
+}
+
 func checkExternalIPPoolUsed(t *testing.T, controller *egressController, poolName string, used int) {
 	exists := controller.externalIPAllocator.IPPoolExists(poolName)
 	require.True(t, exists)
@@ -18,11 +18,16 @@ import (
 	"encoding/json"
 	"fmt"
 	"net"
+	"slices"
+	"strings"
 
 	admv1 "k8s.io/api/admission/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/klog/v2"
+	utilnet "k8s.io/utils/net"
 
 	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
 )
@@ -48,12 +53,25 @@ func (c *EgressController) ValidateEgress(review *admv1.AdmissionReview) *admv1.
 	}
 
 	shouldAllow := func(oldEgress, newEgress *crdv1beta1.Egress) (bool, string) {
-		if len(newEgress.Spec.EgressIPs) > 0 {
-			return false, "spec.egressIPs is not supported yet"
-		}
 		if len(newEgress.Spec.ExternalIPPools) > 0 {
 			return false, "spec.externalIPPools is not supported yet"
 		}
+		if allowed, msg := validateEgressDestinations(newEgress); !allowed {
+			return false, msg
+		}
+		switch newEgress.Spec.Mode {
+		case "", crdv1beta1.EgressModeActiveStandby:
+			if len(newEgress.Spec.EgressIPs) > 0 {
+				return false, "spec.egressIPs is only supported in ActiveActive mode"
+			}
+			if newEgress.Spec.EgressIPCount != 0 {
+				return false, "spec.egressIPCount is only supported in ActiveActive mode"
+			}
+		case crdv1beta1.EgressModeActiveActive:
+			return c.validateActiveActiveEgress(oldEgress, newEgress)
+		default:
+			return false, fmt.Sprintf("Mode %s is not supported", newEgress.Spec.Mode)
+		}
 		// Validate Egress trafficShaping
 		if newEgress.Spec.Bandwidth != nil {
 			_, err := resource.ParseQuantity(newEgress.Spec.Bandwidth.Rate)
@@ -110,6 +128,80 @@ func (c *EgressController) ValidateEgress(review *admv1.AdmissionReview) *admv1.
 	}
 }
 
+// validateActiveActiveEgress validates the Egress IPs of an ActiveActive Egress. They must be of the same IP family
+// as the connections of a Pod are distributed across all of them.
+func (c *EgressController) validateActiveActiveEgress(oldEgress, newEgress *crdv1beta1.Egress) (bool, string) {
+	if newEgress.Spec.EgressIP != "" {
+		return false, "spec.egressIP cannot be set in ActiveActive mode, use spec.egressIPs instead"
+	}
+	if newEgress.Spec.Bandwidth != nil {
+		return false, "spec.bandwidth is not supported in ActiveActive mode"
+	}
+	if len(newEgress.Spec.Destinations) > 0 {
+		return false, "spec.destinations is not supported in ActiveActive mode"
+	}
+	if newEgress.Spec.EgressIPCount < 0 {
+		return false, fmt.Sprintf("EgressIPCount %d is invalid, it must be non-negative", newEgress.Spec.EgressIPCount)
+	}
+	if newEgress.Spec.EgressIPCount > 0 && newEgress.Spec.ExternalIPPool == "" {
+		return false, "spec.egressIPCount requires spec.externalIPPool"
+	}
+	ips := sets.New[string]()
+	var isIPv6 bool
+	for i, egressIP := range newEgress.Spec.EgressIPs {
+		ip := net.ParseIP(egressIP)
+		if ip == nil {
+			return false, fmt.Sprintf("IP %s is not valid", egressIP)
+		}
+		if ips.Has(ip.String()) {
+			return false, fmt.Sprintf("IP %s is duplicate", egressIP)
+		}
+		ips.Insert(ip.String())
+		if i == 0 {
+			isIPv6 = utilnet.IsIPv6(ip)
+		} else if utilnet.IsIPv6(ip) != isIPv6 {
+			return false, "spec.egressIPs must be of the same IP family"
+		}
+	}
+	// Only validate whether the specified Egress IPs are in the Pool when they change.
+	if newEgress.Spec.ExternalIPPool == "" || len(newEgress.Spec.EgressIPs) == 0 ||
+		(newEgress.Spec.ExternalIPPool == oldEgress.Spec.ExternalIPPool && slices.Equal(newEgress.Spec.EgressIPs, oldEgress.Spec.EgressIPs)) {
+		return true, ""
+	}
+	if !c.externalIPAllocator.IPPoolExists(newEgress.Spec.ExternalIPPool) {
+		return false, fmt.Sprintf("ExternalIPPool %s does not exist", newEgress.Spec.ExternalIPPool)
+	}
+	for _, egressIP := range newEgress.Spec.EgressIPs {
+		if !c.externalIPAllocator.IPPoolHasIP(newEgress.Spec.ExternalIPPool, net.ParseIP(egressIP)) {
+			return false, fmt.Sprintf("IP %s is not within the IP range", egressIP)
+		}
+	}
+	return true, ""
+}
+
+// validateEgressDestinations validates the destinations of an Egress. Each destination must have exactly one of a
+// valid CIDR and a valid FQDN.
+func validateEgressDestinations(egress *crdv1beta1.Egress) (bool, string) {
+	for _, destination := range egress.Spec.Destinations {
+		if (destination.CIDR == "") == (destination.FQDN == "") {
+			return false, "exactly one of cidr and fqdn must be specified in a destination"
+		}
+		if destination.CIDR != "" {
+			if _, _, err := net.ParseCIDR(destination.CIDR); err != nil {
+				return false, fmt.Sprintf("CIDR %s is not valid: %v", destination.CIDR, err)
+			}
+			continue
+		}
+		if strings.Contains(destination.FQDN, "*") {
+			return false, fmt.Sprintf("FQDN %s is not valid: wildcard is not supported", destination.FQDN)
+		}
+		if errs := validation.IsDNS1123Subdomain(destination.FQDN); len(errs) > 0 {
+			return false, fmt.Sprintf("FQDN %s is not valid: %s", destination.FQDN, strings.Join(errs, ", "))
+		}
+	}
+	return true, ""
+}
+
 func newAdmissionResponseForErr(err error) *admv1.AdmissionResponse {
 	return &admv1.AdmissionResponse{
 		Result: &metav1.Status{
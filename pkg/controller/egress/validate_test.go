@@ -190,6 +190,204 @@ func TestEgressControllerValidateEgress(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:                   "Creating an ActiveActive Egress with valid EgressIPs should be allowed",
+			existingExternalIPPool: newExternalIPPool("bar", "10.10.10.0/24", "", ""),
+			request: &admv1.AdmissionRequest{
+				Name:      "foo",
+				Operation: "CREATE",
+				Object:    runtime.RawExtension{Raw: marshal(newActiveActiveEgress("foo", "bar", 0, "10.10.10.1", "10.10.10.2"))},
+			},
+			expectedResponse: &admv1.AdmissionResponse{Allowed: true},
+		},
+		{
+			name:                   "Creating an ActiveActive Egress with EgressIPCount should be allowed",
+			existingExternalIPPool: newExternalIPPool("bar", "10.10.10.0/24", "", ""),
+			request: &admv1.AdmissionRequest{
+				Name:      "foo",
+				Operation: "CREATE",
+				Object:    runtime.RawExtension{Raw: marshal(newActiveActiveEgress("foo", "bar", 3))},
+			},
+			expectedResponse: &admv1.AdmissionResponse{Allowed: true},
+		},
+		{
+			name:                   "Creating an ActiveActive Egress with EgressIP out of range should not be allowed",
+			existingExternalIPPool: newExternalIPPool("bar", "10.10.10.0/24", "", ""),
+			request: &admv1.AdmissionRequest{
+				Name:      "foo",
+				Operation: "CREATE",
+				Object:    runtime.RawExtension{Raw: marshal(newActiveActiveEgress("foo", "bar", 0, "10.10.10.1", "10.10.11.1"))},
+			},
+			expectedResponse: &admv1.AdmissionResponse{
+				Allowed: false,
+				Result: &metav1.Status{
+					Message: "IP 10.10.11.1 is not within the IP range",
+				},
+			},
+		},
+		{
+			name: "Creating an ActiveActive Egress with EgressIPs of different IP families should not be allowed",
+			request: &admv1.AdmissionRequest{
+				Name:      "foo",
+				Operation: "CREATE",
+				Object:    runtime.RawExtension{Raw: marshal(newActiveActiveEgress("foo", "", 0, "10.10.10.1", "2021:2::aaa1"))},
+			},
+			expectedResponse: &admv1.AdmissionResponse{
+				Allowed: false,
+				Result: &metav1.Status{
+					Message: "spec.egressIPs must be of the same IP family",
+				},
+			},
+		},
+		{
+			name: "Creating an ActiveActive Egress with duplicate EgressIPs should not be allowed",
+			request: &admv1.AdmissionRequest{
+				Name:      "foo",
+				Operation: "CREATE",
+				Object:    runtime.RawExtension{Raw: marshal(newActiveActiveEgress("foo", "", 0, "10.10.10.1", "10.10.10.1"))},
+			},
+			expectedResponse: &admv1.AdmissionResponse{
+				Allowed: false,
+				Result: &metav1.Status{
+					Message: "IP 10.10.10.1 is duplicate",
+				},
+			},
+		},
+		{
+			name: "Creating an ActiveActive Egress with EgressIPCount but no ExternalIPPool should not be allowed",
+			request: &admv1.AdmissionRequest{
+				Name:      "foo",
+				Operation: "CREATE",
+				Object:    runtime.RawExtension{Raw: marshal(newActiveActiveEgress("foo", "", 2))},
+			},
+			expectedResponse: &admv1.AdmissionResponse{
+				Allowed: false,
+				Result: &metav1.Status{
+					Message: "spec.egressIPCount requires spec.externalIPPool",
+				},
+			},
+		},
+		{
+			name: "Creating an ActiveActive Egress with bandwidth should not be allowed",
+			request: &admv1.AdmissionRequest{
+				Name:      "foo",
+				Operation: "CREATE",
+				Object: runtime.RawExtension{Raw: marshal(func() *crdv1beta1.Egress {
+					egress := newActiveActiveEgress("foo", "", 0, "10.10.10.1")
+					egress.Spec.Bandwidth = &bandwidth
+					return egress
+				}())},
+			},
+			expectedResponse: &admv1.AdmissionResponse{
+				Allowed: false,
+				Result: &metav1.Status{
+					Message: "spec.bandwidth is not supported in ActiveActive mode",
+				},
+			},
+		},
+		{
+			name: "Creating an Egress with valid destinations should be allowed",
+			request: &admv1.AdmissionRequest{
+				Name:      "foo",
+				Operation: "CREATE",
+				Object: runtime.RawExtension{Raw: marshal(func() *crdv1beta1.Egress {
+					egress := newEgress("foo", "10.10.10.1", "", nil, nil, nil)
+					egress.Spec.Destinations = []crdv1beta1.EgressDestination{{CIDR: "10.20.0.0/16"}, {FQDN: "www.example.com"}}
+					return egress
+				}())},
+			},
+			expectedResponse: &admv1.AdmissionResponse{Allowed: true},
+		},
+		{
+			name: "Creating an Egress with invalid destination CIDR should not be allowed",
+			request: &admv1.AdmissionRequest{
+				Name:      "foo",
+				Operation: "CREATE",
+				Object: runtime.RawExtension{Raw: marshal(func() *crdv1beta1.Egress {
+					egress := newEgress("foo", "10.10.10.1", "", nil, nil, nil)
+					egress.Spec.Destinations = []crdv1beta1.EgressDestination{{CIDR: "10.20.0.0/33"}}
+					return egress
+				}())},
+			},
+			expectedResponse: &admv1.AdmissionResponse{
+				Allowed: false,
+				Result: &metav1.Status{
+					Message: "CIDR 10.20.0.0/33 is not valid: invalid CIDR address: 10.20.0.0/33",
+				},
+			},
+		},
+		{
+			name: "Creating an Egress with wildcard destination FQDN should not be allowed",
+			request: &admv1.AdmissionRequest{
+				Name:      "foo",
+				Operation: "CREATE",
+				Object: runtime.RawExtension{Raw: marshal(func() *crdv1beta1.Egress {
+					egress := newEgress("foo", "10.10.10.1", "", nil, nil, nil)
+					egress.Spec.Destinations = []crdv1beta1.EgressDestination{{FQDN: "*.example.com"}}
+					return egress
+				}())},
+			},
+			expectedResponse: &admv1.AdmissionResponse{
+				Allowed: false,
+				Result: &metav1.Status{
+					Message: "FQDN *.example.com is not valid: wildcard is not supported",
+				},
+			},
+		},
+		{
+			name: "Creating an Egress with both CIDR and FQDN in a destination should not be allowed",
+			request: &admv1.AdmissionRequest{
+				Name:      "foo",
+				Operation: "CREATE",
+				Object: runtime.RawExtension{Raw: marshal(func() *crdv1beta1.Egress {
+					egress := newEgress("foo", "10.10.10.1", "", nil, nil, nil)
+					egress.Spec.Destinations = []crdv1beta1.EgressDestination{{CIDR: "10.20.0.0/16", FQDN: "www.example.com"}}
+					return egress
+				}())},
+			},
+			expectedResponse: &admv1.AdmissionResponse{
+				Allowed: false,
+				Result: &metav1.Status{
+					Message: "exactly one of cidr and fqdn must be specified in a destination",
+				},
+			},
+		},
+		{
+			name: "Creating an ActiveActive Egress with destinations should not be allowed",
+			request: &admv1.AdmissionRequest{
+				Name:      "foo",
+				Operation: "CREATE",
+				Object: runtime.RawExtension{Raw: marshal(func() *crdv1beta1.Egress {
+					egress := newActiveActiveEgress("foo", "", 0, "10.10.10.1")
+					egress.Spec.Destinations = []crdv1beta1.EgressDestination{{CIDR: "10.20.0.0/16"}}
+					return egress
+				}())},
+			},
+			expectedResponse: &admv1.AdmissionResponse{
+				Allowed: false,
+				Result: &metav1.Status{
+					Message: "spec.destinations is not supported in ActiveActive mode",
+				},
+			},
+		},
+		{
+			name: "Creating an ActiveStandby Egress with EgressIPs should not be allowed",
+			request: &admv1.AdmissionRequest{
+				Name:      "foo",
+				Operation: "CREATE",
+				Object: runtime.RawExtension{Raw: marshal(func() *crdv1beta1.Egress {
+					egress := newActiveActiveEgress("foo", "", 0, "10.10.10.1")
+					egress.Spec.Mode = ""
+					return egress
+				}())},
+			},
+			expectedResponse: &admv1.AdmissionResponse{
+				Allowed: false,
+				Result: &metav1.Status{
+					Message: "spec.egressIPs is only supported in ActiveActive mode",
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -215,3 +413,11 @@ func TestEgressControllerValidateEgress(t *testing.T) {
 		})
 	}
 }
+
+func newActiveActiveEgress(name, externalIPPool string, egressIPCount int32, egressIPs ...string) *crdv1beta1.Egress {
+	egress := newEgress(name, "", externalIPPool, nil, nil, nil)
+	egress.Spec.Mode = crdv1beta1.EgressModeActiveActive
+	egress.Spec.EgressIPs = egressIPs
+	egress.Spec.EgressIPCount = egressIPCount
+	return egress
+}
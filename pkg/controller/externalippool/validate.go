@@ -18,11 +18,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/netip"
+	"strings"
 
 	admv1 "k8s.io/api/admission/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/klog/v2"
 
 	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
@@ -61,11 +63,17 @@ func (c *ExternalIPPoolController) ValidateExternalIPPool(review *admv1.Admissio
 		if msg, allowed = validateIPRangesAndSubnetInfo(newObj, externalIPPools); !allowed {
 			break
 		}
+		if msg, allowed = validateScheduling(newObj.Spec.Scheduling); !allowed {
+			break
+		}
 	case admv1.Update:
 		klog.V(2).Info("Validating UPDATE request for ExternalIPPool")
 		if msg, allowed = validateIPRangesAndSubnetInfo(newObj, externalIPPools); !allowed {
 			break
 		}
+		if msg, allowed = validateScheduling(newObj.Spec.Scheduling); !allowed {
+			break
+		}
 		oldIPRangeSet := getIPRangeSet(oldObj.Spec.IPRanges)
 		newIPRangeSet := getIPRangeSet(newObj.Spec.IPRanges)
 		deletedIPRanges := oldIPRangeSet.Difference(newIPRangeSet)
@@ -195,6 +203,30 @@ func validateIPRangesAndSubnetInfo(externalIPPool crdv1beta1.ExternalIPPool, exi
 	return "", true
 }
 
+func validateScheduling(scheduling *crdv1beta1.ExternalIPPoolScheduling) (string, bool) {
+	if scheduling == nil {
+		return "", true
+	}
+	for i := range scheduling.NodeAffinity {
+		term := &scheduling.NodeAffinity[i]
+		if term.Weight < 1 || term.Weight > 100 {
+			return fmt.Sprintf("invalid weight %d in nodeAffinity, it must be in the range 1-100", term.Weight), false
+		}
+		if _, err := metav1.LabelSelectorAsSelector(&term.NodeSelector); err != nil {
+			return fmt.Sprintf("invalid nodeSelector in nodeAffinity: %v", err), false
+		}
+	}
+	if spread := scheduling.TopologySpread; spread != nil {
+		if errs := validation.IsQualifiedName(spread.TopologyKey); len(errs) > 0 {
+			return fmt.Sprintf("invalid topologyKey %q: %s", spread.TopologyKey, strings.Join(errs, "; ")), false
+		}
+		if spread.MaxSkew < 0 {
+			return fmt.Sprintf("invalid maxSkew %d, it must be positive", spread.MaxSkew), false
+		}
+	}
+	return "", true
+}
+
 func parseIPRangeCIDR(cidrStr string) (netip.Prefix, string) {
 	var cidr netip.Prefix
 	var err error
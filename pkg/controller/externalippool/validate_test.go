@@ -109,6 +109,60 @@ func TestControllerValidateExternalIPPool(t *testing.T) {
 			},
 			expectedResponse: &admv1.AdmissionResponse{Allowed: true},
 		},
+		{
+			name: "CREATE operation with valid scheduling constraints should be allowed",
+			request: &admv1.AdmissionRequest{
+				Name:      "foo",
+				Operation: "CREATE",
+				Object: runtime.RawExtension{Raw: marshal(mutateExternalIPPool(newExternalIPPool("foo", "10.10.10.0/24", "", ""), func(pool *crdv1b1.ExternalIPPool) {
+					pool.Spec.Scheduling = &crdv1b1.ExternalIPPoolScheduling{
+						NodeAffinity: []crdv1b1.WeightedNodeSelector{
+							{Weight: 10, NodeSelector: metav1.LabelSelector{MatchLabels: map[string]string{"egress": "preferred"}}},
+						},
+						TopologySpread:   &crdv1b1.TopologySpread{TopologyKey: "topology.kubernetes.io/zone", MaxSkew: 2},
+						PreferLowTraffic: true,
+					}
+				}))},
+			},
+			expectedResponse: &admv1.AdmissionResponse{Allowed: true},
+		},
+		{
+			name: "UPDATE operation with invalid NodeAffinity weight should not be allowed",
+			request: &admv1.AdmissionRequest{
+				Name:      "foo",
+				Operation: "UPDATE",
+				OldObject: runtime.RawExtension{Raw: marshal(newExternalIPPool("foo", "10.10.10.0/24", "", ""))},
+				Object: runtime.RawExtension{Raw: marshal(mutateExternalIPPool(newExternalIPPool("foo", "10.10.10.0/24", "", ""), func(pool *crdv1b1.ExternalIPPool) {
+					pool.Spec.Scheduling = &crdv1b1.ExternalIPPoolScheduling{
+						NodeAffinity: []crdv1b1.WeightedNodeSelector{{Weight: 200}},
+					}
+				}))},
+			},
+			expectedResponse: &admv1.AdmissionResponse{
+				Allowed: false,
+				Result: &metav1.Status{
+					Message: "invalid weight 200 in nodeAffinity, it must be in the range 1-100",
+				},
+			},
+		},
+		{
+			name: "CREATE operation with invalid topologyKey should not be allowed",
+			request: &admv1.AdmissionRequest{
+				Name:      "foo",
+				Operation: "CREATE",
+				Object: runtime.RawExtension{Raw: marshal(mutateExternalIPPool(newExternalIPPool("foo", "10.10.10.0/24", "", ""), func(pool *crdv1b1.ExternalIPPool) {
+					pool.Spec.Scheduling = &crdv1b1.ExternalIPPoolScheduling{
+						TopologySpread: &crdv1b1.TopologySpread{TopologyKey: "-zone"},
+					}
+				}))},
+			},
+			expectedResponse: &admv1.AdmissionResponse{
+				Allowed: false,
+				Result: &metav1.Status{
+					Message: `invalid topologyKey "-zone": name part must consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyName',  or 'my.name',  or '123-abc', regex used for validation is '([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]')`,
+				},
+			},
+		},
 		{
 			name: "DELETE operation should be allowed",
 			request: &admv1.AdmissionRequest{
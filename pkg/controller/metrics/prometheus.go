@@ -117,6 +117,27 @@ var (
 		Help:           "The total number of actual status updates performed for Antrea ClusterNetworkPolicy Custom Resources",
 		StabilityLevel: metrics.ALPHA,
 	})
+	TraceflowRuns = metrics.NewCounterVec(&metrics.CounterOpts{
+		Namespace:      metricNamespaceAntrea,
+		Subsystem:      metricSubsystemController,
+		Name:           "traceflow_runs_total",
+		Help:           "The total number of finished runs of recurring Traceflows, partitioned by schedule (the name of the recurring Traceflow) and result (succeeded or failed)",
+		StabilityLevel: metrics.ALPHA,
+	}, []string{"schedule", "result"})
+	TraceflowLastRunSucceeded = metrics.NewGaugeVec(&metrics.GaugeOpts{
+		Namespace:      metricNamespaceAntrea,
+		Subsystem:      metricSubsystemController,
+		Name:           "traceflow_last_run_succeeded",
+		Help:           "Whether the last finished run of a recurring Traceflow succeeded (1) or failed (0)",
+		StabilityLevel: metrics.ALPHA,
+	}, []string{"schedule"})
+	TraceflowLastRunTimestamp = metrics.NewGaugeVec(&metrics.GaugeOpts{
+		Namespace:      metricNamespaceAntrea,
+		Subsystem:      metricSubsystemController,
+		Name:           "traceflow_last_run_timestamp_seconds",
+		Help:           "The start time of the last finished run of a recurring Traceflow, in seconds since the Unix epoch",
+		StabilityLevel: metrics.ALPHA,
+	}, []string{"schedule"})
 )
 
 // Initialize Prometheus metrics collection.
@@ -156,4 +177,13 @@ func InitializePrometheusMetrics() {
 	if err := legacyregistry.Register(AntreaClusterNetworkPolicyStatusUpdates); err != nil {
 		klog.Errorf("Failed to register antrea_controller_acnp_status_updates with Prometheus: %s", err.Error())
 	}
+	if err := legacyregistry.Register(TraceflowRuns); err != nil {
+		klog.Errorf("Failed to register antrea_controller_traceflow_runs_total with Prometheus: %s", err.Error())
+	}
+	if err := legacyregistry.Register(TraceflowLastRunSucceeded); err != nil {
+		klog.Errorf("Failed to register antrea_controller_traceflow_last_run_succeeded with Prometheus: %s", err.Error())
+	}
+	if err := legacyregistry.Register(TraceflowLastRunTimestamp); err != nil {
+		klog.Errorf("Failed to register antrea_controller_traceflow_last_run_timestamp_seconds with Prometheus: %s", err.Error())
+	}
 }
@@ -111,12 +111,14 @@ func (n *NetworkPolicyController) processAntreaNetworkPolicy(np *crdv1beta1.Netw
 			From:            *peer,
 			Services:        services,
 			Name:            ingressRule.Name,
-			Action:          ingressRule.Action,
+			Action:          toAntreaRuleActionForCRD(ingressRule.Action, np.Spec.AuditMode),
 			Priority:        int32(idx),
-			EnableLogging:   ingressRule.EnableLogging,
+			EnableLogging:   isRuleLoggingEnabled(&ingressRule, np.Spec.AuditMode),
 			AppliedToGroups: getAppliedToGroupNames(atgs),
 			L7Protocols:     toAntreaL7ProtocolsForCRD(ingressRule.L7Protocols),
 			LogLabel:        ingressRule.LogLabel,
+			Schedule:        toAntreaRuleScheduleForCRD(ingressRule.Schedule),
+			RateLimit:       toAntreaRuleRateLimitForCRD(ingressRule.RateLimit),
 		})
 	}
 	// Compute NetworkPolicyRule for Egress Rule.
@@ -143,12 +145,14 @@ func (n *NetworkPolicyController) processAntreaNetworkPolicy(np *crdv1beta1.Netw
 			To:              *peer,
 			Services:        services,
 			Name:            egressRule.Name,
-			Action:          egressRule.Action,
+			Action:          toAntreaRuleActionForCRD(egressRule.Action, np.Spec.AuditMode),
 			Priority:        int32(idx),
-			EnableLogging:   egressRule.EnableLogging,
+			EnableLogging:   isRuleLoggingEnabled(&egressRule, np.Spec.AuditMode),
 			AppliedToGroups: getAppliedToGroupNames(atgs),
 			L7Protocols:     toAntreaL7ProtocolsForCRD(egressRule.L7Protocols),
 			LogLabel:        egressRule.LogLabel,
+			Schedule:        toAntreaRuleScheduleForCRD(egressRule.Schedule),
+			RateLimit:       toAntreaRuleRateLimitForCRD(egressRule.RateLimit),
 		})
 	}
 	tierPriority := n.getTierPriority(np.Spec.Tier)
@@ -411,12 +411,14 @@ func (n *NetworkPolicyController) processClusterNetworkPolicy(cnp *crdv1beta1.Cl
 					Direction:       dir,
 					Services:        services,
 					Name:            cnpRule.Name,
-					Action:          cnpRule.Action,
+					Action:          toAntreaRuleActionForCRD(cnpRule.Action, cnp.Spec.AuditMode),
 					Priority:        priority,
-					EnableLogging:   cnpRule.EnableLogging,
+					EnableLogging:   isRuleLoggingEnabled(cnpRule, cnp.Spec.AuditMode),
 					AppliedToGroups: getAppliedToGroupNames(ruleAppliedTos),
 					L7Protocols:     toAntreaL7ProtocolsForCRD(cnpRule.L7Protocols),
 					LogLabel:        cnpRule.LogLabel,
+					Schedule:        toAntreaRuleScheduleForCRD(cnpRule.Schedule),
+					RateLimit:       toAntreaRuleRateLimitForCRD(cnpRule.RateLimit),
 				}
 				if dir == controlplane.DirectionIn {
 					rule.From = *peer
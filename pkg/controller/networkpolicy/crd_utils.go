@@ -32,6 +32,7 @@ import (
 	antreatypes "antrea.io/antrea/pkg/controller/types"
 	"antrea.io/antrea/pkg/util/ip"
 	"antrea.io/antrea/pkg/util/k8s"
+	"antrea.io/antrea/pkg/util/schedule"
 )
 
 var (
@@ -52,6 +53,10 @@ var semanticIgnoreLastTransitionTime = conversion.EqualitiesOrDie(
 		b.LastTransitionTime = metav1.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
 		return a == b
 	},
+	// The times read from the API have lost their location and sub-second precision.
+	func(a, b metav1.Time) bool {
+		return a.Unix() == b.Unix()
+	},
 )
 
 // NetworkPolicyStatusEqual compares two NetworkPolicyStatus objects. It disregards
@@ -119,13 +124,87 @@ func toAntreaL7ProtocolsForCRD(l7Protocols []crdv1beta1.L7Protocol) []controlpla
 	var antreaL7Protocols []controlplane.L7Protocol
 	for _, l7p := range l7Protocols {
 		antreaL7Protocols = append(antreaL7Protocols, controlplane.L7Protocol{
-			HTTP: (*controlplane.HTTPProtocol)(l7p.HTTP),
-			TLS:  (*controlplane.TLSProtocol)(l7p.TLS),
+			HTTP:  toAntreaHTTPProtocolForCRD(l7p.HTTP),
+			TLS:   (*controlplane.TLSProtocol)(l7p.TLS),
+			GRPC:  (*controlplane.GRPCProtocol)(l7p.GRPC),
+			Kafka: (*controlplane.KafkaProtocol)(l7p.Kafka),
+			DNS:   (*controlplane.DNSProtocol)(l7p.DNS),
 		})
 	}
 	return antreaL7Protocols
 }
 
+// toAntreaHTTPProtocolForCRD converts a crdv1beta1.HTTPProtocol to a controlplane.HTTPProtocol.
+func toAntreaHTTPProtocolForCRD(http *crdv1beta1.HTTPProtocol) *controlplane.HTTPProtocol {
+	if http == nil {
+		return nil
+	}
+	antreaHTTP := &controlplane.HTTPProtocol{
+		Host:      http.Host,
+		Method:    http.Method,
+		Path:      http.Path,
+		PathRegex: http.PathRegex,
+	}
+	for _, h := range http.Headers {
+		antreaHTTP.Headers = append(antreaHTTP.Headers, controlplane.HTTPHeaderMatch(h))
+	}
+	for _, q := range http.QueryParams {
+		antreaHTTP.QueryParams = append(antreaHTTP.QueryParams, controlplane.HTTPQueryParamMatch(q))
+	}
+	return antreaHTTP
+}
+
+// toAntreaRuleActionForCRD returns the action to be enforced for a crdv1beta1.Rule. Drop and Reject rules of a policy in
+// audit mode are enforced with the Audit action.
+func toAntreaRuleActionForCRD(action *crdv1beta1.RuleAction, auditMode bool) *crdv1beta1.RuleAction {
+	if auditMode && action != nil && (*action == crdv1beta1.RuleActionDrop || *action == crdv1beta1.RuleActionReject) {
+		auditAction := crdv1beta1.RuleActionAudit
+		return &auditAction
+	}
+	return action
+}
+
+// isRuleLoggingEnabled returns whether the traffic matching a crdv1beta1.Rule should be logged. Logging is always
+// enabled for rules enforced with the Audit action.
+func isRuleLoggingEnabled(rule *crdv1beta1.Rule, auditMode bool) bool {
+	action := toAntreaRuleActionForCRD(rule.Action, auditMode)
+	return rule.EnableLogging || (action != nil && *action == crdv1beta1.RuleActionAudit)
+}
+
+// toAntreaRuleScheduleForCRD converts a crdv1beta1.RuleSchedule to a controlplane.RuleSchedule.
+func toAntreaRuleScheduleForCRD(schedule *crdv1beta1.RuleSchedule) *controlplane.RuleSchedule {
+	if schedule == nil {
+		return nil
+	}
+	antreaSchedule := &controlplane.RuleSchedule{TimeZone: schedule.TimeZone}
+	for _, w := range schedule.Windows {
+		antreaSchedule.Windows = append(antreaSchedule.Windows, controlplane.ScheduleWindow{
+			Start:    w.Start,
+			Duration: w.Duration,
+		})
+	}
+	return antreaSchedule
+}
+
+// toAntreaRuleRateLimitForCRD converts a crdv1beta1.RuleRateLimit to a controlplane.RuleRateLimit.
+func toAntreaRuleRateLimitForCRD(rateLimit *crdv1beta1.RuleRateLimit) *controlplane.RuleRateLimit {
+	if rateLimit == nil {
+		return nil
+	}
+	return &controlplane.RuleRateLimit{
+		Rate:  rateLimit.Rate,
+		Burst: rateLimit.Burst,
+		Unit:  rateLimit.Unit,
+	}
+}
+
+// parseRuleSchedule parses a controlplane.RuleSchedule to a Schedule which can be evaluated.
+func parseRuleSchedule(ruleSchedule *controlplane.RuleSchedule) (*schedule.Schedule, error) {
+	return schedule.FromWindows(ruleSchedule.TimeZone, ruleSchedule.Windows, func(w controlplane.ScheduleWindow) (string, string) {
+		return w.Start, w.Duration
+	})
+}
+
 // toAntreaIPBlockForCRD converts a crdv1beta1.IPBlock to an Antrea IPBlock.
 func toAntreaIPBlockForCRD(ipBlock *crdv1beta1.IPBlock) (*controlplane.IPBlock, error) {
 	// Convert the allowed IPBlock to networkpolicy.IPNet.
@@ -218,6 +218,22 @@ func TestToAntreaL7ProtocolsForCRD(t *testing.T) {
 				{HTTP: &controlplane.HTTPProtocol{Host: "test.com", Method: "GET", Path: "/admin"}},
 			},
 		},
+		{
+			[]crdv1beta1.L7Protocol{
+				{HTTP: &crdv1beta1.HTTPProtocol{
+					PathRegex:   "/api/v[0-9]+/.*",
+					Headers:     []crdv1beta1.HTTPHeaderMatch{{Name: "X-Tenant-ID", Value: "tenant-a"}, {Name: "Authorization"}},
+					QueryParams: []crdv1beta1.HTTPQueryParamMatch{{Name: "version", Regex: "v[12]"}},
+				}},
+			},
+			[]controlplane.L7Protocol{
+				{HTTP: &controlplane.HTTPProtocol{
+					PathRegex:   "/api/v[0-9]+/.*",
+					Headers:     []controlplane.HTTPHeaderMatch{{Name: "X-Tenant-ID", Value: "tenant-a"}, {Name: "Authorization"}},
+					QueryParams: []controlplane.HTTPQueryParamMatch{{Name: "version", Regex: "v[12]"}},
+				}},
+			},
+		},
 		{
 			[]crdv1beta1.L7Protocol{
 				{TLS: &crdv1beta1.TLSProtocol{SNI: "test.com"}},
@@ -233,6 +249,62 @@ func TestToAntreaL7ProtocolsForCRD(t *testing.T) {
 	}
 }
 
+func TestToAntreaRuleActionForCRD(t *testing.T) {
+	rejectAction := crdv1beta1.RuleActionReject
+	tests := []struct {
+		name                  string
+		rule                  *crdv1beta1.Rule
+		auditMode             bool
+		expectedAction        *crdv1beta1.RuleAction
+		expectedEnableLogging bool
+	}{
+		{
+			name:           "drop",
+			rule:           &crdv1beta1.Rule{Action: &dropAction},
+			expectedAction: &dropAction,
+		},
+		{
+			name:                  "audit",
+			rule:                  &crdv1beta1.Rule{Action: &auditAction},
+			expectedAction:        &auditAction,
+			expectedEnableLogging: true,
+		},
+		{
+			name:                  "drop in audit mode",
+			rule:                  &crdv1beta1.Rule{Action: &dropAction},
+			auditMode:             true,
+			expectedAction:        &auditAction,
+			expectedEnableLogging: true,
+		},
+		{
+			name:                  "reject in audit mode",
+			rule:                  &crdv1beta1.Rule{Action: &rejectAction},
+			auditMode:             true,
+			expectedAction:        &auditAction,
+			expectedEnableLogging: true,
+		},
+		{
+			name:           "allow in audit mode",
+			rule:           &crdv1beta1.Rule{Action: &allowAction},
+			auditMode:      true,
+			expectedAction: &allowAction,
+		},
+		{
+			name:                  "pass with logging in audit mode",
+			rule:                  &crdv1beta1.Rule{Action: &passAction, EnableLogging: true},
+			auditMode:             true,
+			expectedAction:        &passAction,
+			expectedEnableLogging: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedAction, toAntreaRuleActionForCRD(tt.rule.Action, tt.auditMode))
+			assert.Equal(t, tt.expectedEnableLogging, isRuleLoggingEnabled(tt.rule, tt.auditMode))
+		})
+	}
+}
+
 func TestToAntreaIPBlockForCRD(t *testing.T) {
 	expIPNet := controlplane.IPNet{
 		IP:           ipStrToIPAddress("10.0.0.0"),
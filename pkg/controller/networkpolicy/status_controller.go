@@ -26,12 +26,14 @@ import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/retry"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
 
 	"antrea.io/antrea/pkg/apis/controlplane"
 	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
@@ -75,6 +77,8 @@ type StatusController struct {
 	acnpListerSynced cache.InformerSynced
 	// annpListerSynced is a function which returns true if the AntreaNetworkPolicies shared informer has been synced at least once.
 	annpListerSynced cache.InformerSynced
+
+	clock clock.Clock
 }
 
 func NewStatusController(antreaClient antreaclientset.Interface, internalNetworkPolicyStore storage.Interface, acnpInformer crdinformers.ClusterNetworkPolicyInformer, annpInformer crdinformers.NetworkPolicyInformer) *StatusController {
@@ -94,6 +98,7 @@ func NewStatusController(antreaClient antreaclientset.Interface, internalNetwork
 		statuses:                   map[string]map[string]*controlplane.NetworkPolicyNodeStatus{},
 		acnpListerSynced:           acnpInformer.Informer().HasSynced,
 		annpListerSynced:           annpInformer.Informer().HasSynced,
+		clock:                      clock.RealClock{},
 	}
 	// To save a "GET" query before each update, UpdateAntreaClusterNetworkPolicyStatus treats the cache of Lister as
 	// the state of kube-apiserver. In some cases the cache may not be in sync, then we might skip updating a policy's
@@ -277,6 +282,12 @@ func (c *StatusController) syncHandler(key string) error {
 	}
 	internalNP := internalNPObj.(*antreatypes.NetworkPolicy)
 
+	scheduledRules, nextTransitionTime := c.getScheduledRuleStatuses(internalNP)
+	if !nextTransitionTime.IsZero() {
+		// Sync the status again when any of the rules is activated or deactivated.
+		c.queue.AddAfter(key, nextTransitionTime.Sub(c.clock.Now()))
+	}
+
 	updateStatus := func(phase crdv1beta1.NetworkPolicyPhase, currentNodes, desiredNodes int, conditions []crdv1beta1.NetworkPolicyCondition) error {
 		status := &crdv1beta1.NetworkPolicyStatus{
 			Phase:                phase,
@@ -284,6 +295,7 @@ func (c *StatusController) syncHandler(key string) error {
 			CurrentNodesRealized: int32(currentNodes),
 			DesiredNodesRealized: int32(desiredNodes),
 			Conditions:           conditions,
+			ScheduledRules:       scheduledRules,
 		}
 		klog.V(2).Infof("Updating NetworkPolicy %s status: %v", internalNP.SourceRef.ToString(), status)
 		if internalNP.SourceRef.Type == controlplane.AntreaNetworkPolicy {
@@ -348,6 +360,38 @@ func (c *StatusController) syncHandler(key string) error {
 	return updateStatus(phase, currentNodes, desiredNodes, conditions)
 }
 
+// getScheduledRuleStatuses returns the activation states of the rules with a schedule in the given NetworkPolicy, and
+// the earliest time at which any of them is activated or deactivated. The returned time is zero if there is none.
+func (c *StatusController) getScheduledRuleStatuses(internalNP *antreatypes.NetworkPolicy) ([]crdv1beta1.ScheduledRuleStatus, time.Time) {
+	var statuses []crdv1beta1.ScheduledRuleStatus
+	var nextTransitionTime time.Time
+	now := c.clock.Now()
+	// A rule of an Antrea ClusterNetworkPolicy may be split into multiple internal rules with the same name.
+	processedRules := sets.New[string]()
+	for _, rule := range internalNP.Rules {
+		if rule.Schedule == nil || processedRules.Has(rule.Name) {
+			continue
+		}
+		processedRules.Insert(rule.Name)
+		s, err := parseRuleSchedule(rule.Schedule)
+		if err != nil {
+			// It should not happen as the schedule has been validated by the webhook.
+			klog.ErrorS(err, "Invalid schedule of rule", "policy", internalNP.SourceRef.ToString(), "rule", rule.Name)
+			continue
+		}
+		active, next := s.State(now)
+		status := crdv1beta1.ScheduledRuleStatus{Name: rule.Name, Active: active}
+		if !next.IsZero() {
+			status.NextTransitionTime = &v1.Time{Time: next.UTC()}
+			if nextTransitionTime.IsZero() || next.Before(nextTransitionTime) {
+				nextTransitionTime = next
+			}
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nextTransitionTime
+}
+
 // networkPolicyControlInterface is an interface that knows how to update Antrea NetworkPolicy status.
 // It's created as an interface to allow testing.
 type networkPolicyControlInterface interface {
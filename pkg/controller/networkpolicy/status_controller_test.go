@@ -25,6 +25,8 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
+	clocktesting "k8s.io/utils/clock/testing"
 
 	"antrea.io/antrea/pkg/apis/controlplane"
 	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
@@ -89,6 +91,7 @@ func newTestStatusController(initialObjects ...runtime.Object) (*StatusControlle
 		statuses:                   map[string]map[string]*controlplane.NetworkPolicyNodeStatus{},
 		acnpListerSynced:           acnpInformer.Informer().HasSynced,
 		annpListerSynced:           annpInformer.Informer().HasSynced,
+		clock:                      clock.RealClock{},
 	}
 	return statusController, antreaClientset, antreaInformerFactory, networkPolicyStore, networkPolicyControl
 }
@@ -416,3 +419,33 @@ func BenchmarkSyncHandler(b *testing.B) {
 		statusController.syncHandler("annp1")
 	}
 }
+
+func TestGetScheduledRuleStatuses(t *testing.T) {
+	acnp1 := newInternalNetworkPolicy("acnp1", 1, []string{"node1"}, newAntreaClusterNetworkPolicyReference("acnp1"))
+	nightlySchedule := &controlplane.RuleSchedule{
+		Windows: []controlplane.ScheduleWindow{{Start: "0 22 * * *", Duration: "2h"}},
+	}
+	weekdaySchedule := &controlplane.RuleSchedule{
+		Windows:  []controlplane.ScheduleWindow{{Start: "0 9 * * mon-fri", Duration: "8h"}},
+		TimeZone: "Europe/Paris",
+	}
+	acnp1.Rules = []controlplane.NetworkPolicyRule{
+		{Name: "rule1", Schedule: nightlySchedule},
+		// The same rule split by peer scope.
+		{Name: "rule1", Schedule: nightlySchedule},
+		{Name: "rule2", Schedule: weekdaySchedule},
+		{Name: "rule3"},
+	}
+	statusController, _, _, _, _ := newTestStatusController()
+	// Wednesday 10:00 in Paris.
+	statusController.clock = clocktesting.NewFakeClock(time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC))
+
+	statuses, next := statusController.getScheduledRuleStatuses(acnp1)
+	expectedNightlyTransition := time.Date(2024, 5, 1, 22, 0, 0, 0, time.UTC)
+	expectedWeekdayTransition := time.Date(2024, 5, 1, 15, 0, 0, 0, time.UTC)
+	assert.Equal(t, []crdv1beta1.ScheduledRuleStatus{
+		{Name: "rule1", Active: false, NextTransitionTime: &v1.Time{Time: expectedNightlyTransition}},
+		{Name: "rule2", Active: true, NextTransitionTime: &v1.Time{Time: expectedWeekdayTransition}},
+	}, statuses)
+	assert.True(t, expectedWeekdayTransition.Equal(next))
+}
@@ -26,6 +26,7 @@ import (
 	admv1 "k8s.io/api/admission/v1"
 	authenticationv1 "k8s.io/api/authentication/v1"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -526,6 +527,7 @@ func (v *antreaPolicyValidator) validatePolicy(curObj interface{}) ([]string, st
 	var tier string
 	var ingress, egress []crdv1beta1.Rule
 	var specAppliedTo []crdv1beta1.AppliedTo
+	var auditMode bool
 	var warnings []string
 	switch curObj.(type) {
 	case *crdv1beta1.ClusterNetworkPolicy:
@@ -534,12 +536,14 @@ func (v *antreaPolicyValidator) validatePolicy(curObj interface{}) ([]string, st
 		ingress = curACNP.Spec.Ingress
 		egress = curACNP.Spec.Egress
 		specAppliedTo = curACNP.Spec.AppliedTo
+		auditMode = curACNP.Spec.AuditMode
 	case *crdv1beta1.NetworkPolicy:
 		curANNP := curObj.(*crdv1beta1.NetworkPolicy)
 		tier = curANNP.Spec.Tier
 		ingress = curANNP.Spec.Ingress
 		egress = curANNP.Spec.Egress
 		specAppliedTo = curANNP.Spec.AppliedTo
+		auditMode = curANNP.Spec.AuditMode
 	}
 	reason, allowed := v.validateTierForPolicy(tier)
 	if !allowed {
@@ -580,9 +584,21 @@ func (v *antreaPolicyValidator) validatePolicy(curObj interface{}) ([]string, st
 	if !allowed {
 		return warnings, reason, allowed
 	}
+	reason, allowed = v.validateAuditAction(ingress, egress, auditMode)
+	if !allowed {
+		return warnings, reason, allowed
+	}
 	if err := v.validatePort(ingress, egress); err != nil {
 		return warnings, err.Error(), false
 	}
+	reason, allowed = v.validateSchedules(ingress, egress)
+	if !allowed {
+		return warnings, reason, allowed
+	}
+	reason, allowed = v.validateRateLimits(ingress, egress, specAppliedTo)
+	if !allowed {
+		return warnings, reason, allowed
+	}
 	warnings = append(warnings, v.checkLogLabel(specAppliedTo, ingress, egress)...)
 	return warnings, "", true
 }
@@ -949,20 +965,174 @@ func (v *antreaPolicyValidator) validateL7Protocols(ingressRules, egressRules []
 		if len(r.ToServices) != 0 {
 			return "layer 7 protocols can not be used with toServices", false
 		}
-		haveHTTP := false
+		// HTTP, gRPC and Kafka are carried over TCP only, while DNS can be carried over TCP or UDP.
+		var tcpOnlyProtocol string
+		haveDNS := false
 		for _, p := range r.L7Protocols {
+			if tcpOnlyProtocol == "" {
+				if p.HTTP != nil {
+					tcpOnlyProtocol = "HTTP"
+				} else if p.GRPC != nil {
+					tcpOnlyProtocol = "gRPC"
+				} else if p.Kafka != nil {
+					tcpOnlyProtocol = "Kafka"
+				}
+			}
+			if p.DNS != nil {
+				haveDNS = true
+			}
 			if p.HTTP != nil {
-				haveHTTP = true
+				if reason, allowed := validateHTTPProtocol(p.HTTP); !allowed {
+					return reason, false
+				}
+			}
+			if p.Kafka != nil && p.Kafka.Topic != "" && p.Kafka.APIKey != crdv1beta1.KafkaAPIKeyProduce &&
+				p.Kafka.APIKey != crdv1beta1.KafkaAPIKeyFetch && p.Kafka.APIKey != crdv1beta1.KafkaAPIKeyMetadata {
+				return "Kafka topic can only be used when apiKey is produce, fetch or metadata", false
 			}
 		}
 		for _, port := range r.Ports {
-			if haveHTTP && (port.Protocol != nil && *port.Protocol != v1.ProtocolTCP) {
-				return "HTTP protocol can only be used when layer 4 protocol is TCP or unset", false
+			if tcpOnlyProtocol != "" && (port.Protocol != nil && *port.Protocol != v1.ProtocolTCP) {
+				return fmt.Sprintf("%s protocol can only be used when layer 4 protocol is TCP or unset", tcpOnlyProtocol), false
+			}
+			if haveDNS && (port.Protocol != nil && *port.Protocol != v1.ProtocolTCP && *port.Protocol != v1.ProtocolUDP) {
+				return "DNS protocol can only be used when layer 4 protocol is TCP, UDP or unset", false
 			}
 		}
 		for _, protocol := range r.Protocols {
-			if haveHTTP && (protocol.IGMP != nil || protocol.ICMP != nil) {
-				return "HTTP protocol can not be used with protocol IGMP or ICMP", false
+			if tcpOnlyProtocol != "" && (protocol.IGMP != nil || protocol.ICMP != nil) {
+				return fmt.Sprintf("%s protocol can not be used with protocol IGMP or ICMP", tcpOnlyProtocol), false
+			}
+			if haveDNS && (protocol.IGMP != nil || protocol.ICMP != nil) {
+				return "DNS protocol can not be used with protocol IGMP or ICMP", false
+			}
+		}
+	}
+	return "", true
+}
+
+// validateHTTPProtocol validates the path, header and query parameter matches of an HTTP protocol.
+// Regular expressions are validated with the RE2 syntax, which is a subset of the PCRE syntax used by
+// the Suricata signatures they are compiled to.
+func validateHTTPProtocol(http *crdv1beta1.HTTPProtocol) (string, bool) {
+	if http.Path != "" && http.PathRegex != "" {
+		return "path and pathRegex of HTTP protocol can not be set together", false
+	}
+	if _, err := regexp.Compile(http.PathRegex); err != nil {
+		return fmt.Sprintf("invalid pathRegex %q of HTTP protocol: %v", http.PathRegex, err), false
+	}
+	for _, h := range http.Headers {
+		if h.Value != "" && h.Regex != "" {
+			return fmt.Sprintf("value and regex of HTTP header %q can not be set together", h.Name), false
+		}
+		if _, err := regexp.Compile(h.Regex); err != nil {
+			return fmt.Sprintf("invalid regex %q of HTTP header %q: %v", h.Regex, h.Name, err), false
+		}
+	}
+	for _, q := range http.QueryParams {
+		if q.Value != "" && q.Regex != "" {
+			return fmt.Sprintf("value and regex of HTTP query parameter %q can not be set together", q.Name), false
+		}
+		if _, err := regexp.Compile(q.Regex); err != nil {
+			return fmt.Sprintf("invalid regex %q of HTTP query parameter %q: %v", q.Regex, q.Name, err), false
+		}
+	}
+	return "", true
+}
+
+// validateSchedules validates the schedule field set in Antrea-native policy rules is valid.
+func (v *antreaPolicyValidator) validateSchedules(ingressRules, egressRules []crdv1beta1.Rule) (string, bool) {
+	for _, r := range append(ingressRules, egressRules...) {
+		if r.Schedule == nil {
+			continue
+		}
+		if len(r.Schedule.Windows) == 0 {
+			return fmt.Sprintf("schedule of rule %q must have at least one window", r.Name), false
+		}
+		if _, err := parseRuleSchedule(toAntreaRuleScheduleForCRD(r.Schedule)); err != nil {
+			return fmt.Sprintf("invalid schedule of rule %q: %v", r.Name, err), false
+		}
+	}
+	return "", true
+}
+
+// validateRateLimits validates the rateLimit field set in Antrea-native policy rules is valid. Rate limits are
+// enforced with OVS meters, hence they are only supported by Allow rules for unicast traffic applied to Pods.
+func (v *antreaPolicyValidator) validateRateLimits(ingressRules, egressRules []crdv1beta1.Rule, specAppliedTo []crdv1beta1.AppliedTo) (string, bool) {
+	appliedToNodes := func(appliedTo []crdv1beta1.AppliedTo) bool {
+		for _, at := range appliedTo {
+			if at.NodeSelector != nil {
+				return true
+			}
+		}
+		return false
+	}
+	for _, r := range append(ingressRules, egressRules...) {
+		if r.RateLimit == nil {
+			continue
+		}
+		if *r.Action != crdv1beta1.RuleActionAllow {
+			return fmt.Sprintf("rateLimit of rule %q is only supported with action Allow", r.Name), false
+		}
+		if appliedToNodes(specAppliedTo) || appliedToNodes(r.AppliedTo) {
+			return fmt.Sprintf("rateLimit of rule %q is not supported for rules applied to Nodes", r.Name), false
+		}
+		for _, protocol := range r.Protocols {
+			if protocol.IGMP != nil {
+				return fmt.Sprintf("rateLimit of rule %q is not supported for protocol IGMP", r.Name), false
+			}
+		}
+		for _, to := range r.To {
+			if to.IPBlock == nil {
+				continue
+			}
+			if toIPAddr, _, err := net.ParseCIDR(to.IPBlock.CIDR); err == nil && toIPAddr.IsMulticast() {
+				return fmt.Sprintf("rateLimit of rule %q is not supported for multicast traffic", r.Name), false
+			}
+		}
+		switch r.RateLimit.Unit {
+		case "", crdv1beta1.RateLimitUnitBitsPerSecond, crdv1beta1.RateLimitUnitPacketsPerSecond:
+		default:
+			return fmt.Sprintf("invalid unit %q in rateLimit of rule %q", r.RateLimit.Unit, r.Name), false
+		}
+		rate, err := resource.ParseQuantity(r.RateLimit.Rate)
+		if err != nil {
+			return fmt.Sprintf("invalid rate %q in rateLimit of rule %q: %v", r.RateLimit.Rate, r.Name, err), false
+		}
+		if rate.Sign() <= 0 {
+			return fmt.Sprintf("rate in rateLimit of rule %q must be positive", r.Name), false
+		}
+		if r.RateLimit.Burst != "" {
+			burst, err := resource.ParseQuantity(r.RateLimit.Burst)
+			if err != nil {
+				return fmt.Sprintf("invalid burst %q in rateLimit of rule %q: %v", r.RateLimit.Burst, r.Name, err), false
+			}
+			if burst.Sign() <= 0 {
+				return fmt.Sprintf("burst in rateLimit of rule %q must be positive", r.Name), false
+			}
+		}
+	}
+	return "", true
+}
+
+// validateAuditAction validates the rules enforced with the Audit action, either explicitly or because the policy is
+// in audit mode, don't match multicast or IGMP traffic, which cannot be logged.
+func (v *antreaPolicyValidator) validateAuditAction(ingressRules, egressRules []crdv1beta1.Rule, auditMode bool) (string, bool) {
+	for _, r := range append(ingressRules, egressRules...) {
+		if action := toAntreaRuleActionForCRD(r.Action, auditMode); action == nil || *action != crdv1beta1.RuleActionAudit {
+			continue
+		}
+		for _, protocol := range r.Protocols {
+			if protocol.IGMP != nil {
+				return "protocol IGMP does not support action Audit", false
+			}
+		}
+		for _, to := range r.To {
+			if to.IPBlock == nil {
+				continue
+			}
+			if toIPAddr, _, err := net.ParseCIDR(to.IPBlock.CIDR); err == nil && toIPAddr.IsMulticast() {
+				return "multicast does not support action Audit", false
 			}
 		}
 	}
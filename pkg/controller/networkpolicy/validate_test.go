@@ -36,6 +36,7 @@ var (
 	allowAction = crdv1beta1.RuleActionAllow
 	dropAction  = crdv1beta1.RuleActionDrop
 	passAction  = crdv1beta1.RuleActionPass
+	auditAction = crdv1beta1.RuleActionAudit
 	portNum80   = int32(80)
 )
 
@@ -1763,6 +1764,299 @@ func TestValidateAntreaClusterNetworkPolicy(t *testing.T) {
 			operation:      admv1.Create,
 			expectedReason: "HTTP protocol can not be used with protocol IGMP or ICMP",
 		},
+		{
+			name:         "acnp-l7protocols-Kafka-used-with-UDP",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							Service: &crdv1beta1.NamespacedName{
+								Namespace: "foo1",
+								Name:      "bar1",
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							Ports: []crdv1beta1.NetworkPolicyPort{
+								{
+									Protocol: &k8sProtocolUDP,
+								},
+							},
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									Kafka: &crdv1beta1.KafkaProtocol{
+										APIKey: crdv1beta1.KafkaAPIKeyProduce,
+										Topic:  "orders",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "Kafka protocol can only be used when layer 4 protocol is TCP or unset",
+		},
+		{
+			name:         "acnp-l7protocols-Kafka-topic-used-with-joinGroup",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							Service: &crdv1beta1.NamespacedName{
+								Namespace: "foo1",
+								Name:      "bar1",
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									Kafka: &crdv1beta1.KafkaProtocol{
+										APIKey: crdv1beta1.KafkaAPIKeyJoinGroup,
+										Topic:  "orders",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "Kafka topic can only be used when apiKey is produce, fetch or metadata",
+		},
+		{
+			name:         "acnp-l7protocols-DNS-used-with-UDP",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							Service: &crdv1beta1.NamespacedName{
+								Namespace: "foo1",
+								Name:      "bar1",
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							Ports: []crdv1beta1.NetworkPolicyPort{
+								{
+									Protocol: &k8sProtocolUDP,
+								},
+							},
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									DNS: &crdv1beta1.DNSProtocol{
+										QueryName: "*.foo.com",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "",
+		},
+		{
+			name:         "acnp-l7protocols-DNS-used-with-SCTP",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							Service: &crdv1beta1.NamespacedName{
+								Namespace: "foo1",
+								Name:      "bar1",
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							Ports: []crdv1beta1.NetworkPolicyPort{
+								{
+									Protocol: &k8sProtocolSCTP,
+								},
+							},
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									DNS: &crdv1beta1.DNSProtocol{
+										QueryName: "*.foo.com",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "DNS protocol can only be used when layer 4 protocol is TCP, UDP or unset",
+		},
+		{
+			name:         "acnp-l7protocols-HTTP-headers",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							Service: &crdv1beta1.NamespacedName{
+								Namespace: "foo1",
+								Name:      "bar1",
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									HTTP: &crdv1beta1.HTTPProtocol{
+										PathRegex: "/api/v[0-9]+/.*",
+										Headers: []crdv1beta1.HTTPHeaderMatch{
+											{Name: "X-Tenant-ID", Value: "tenant-a"},
+											{Name: "User-Agent", Regex: "curl/.*"},
+										},
+										QueryParams: []crdv1beta1.HTTPQueryParamMatch{
+											{Name: "debug"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "",
+		},
+		{
+			name:         "acnp-l7protocols-HTTP-path-and-pathRegex",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							Service: &crdv1beta1.NamespacedName{
+								Namespace: "foo1",
+								Name:      "bar1",
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									HTTP: &crdv1beta1.HTTPProtocol{
+										Path:      "/admin",
+										PathRegex: "/admin/.*",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "path and pathRegex of HTTP protocol can not be set together",
+		},
+		{
+			name:         "acnp-l7protocols-HTTP-invalid-header-regex",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							Service: &crdv1beta1.NamespacedName{
+								Namespace: "foo1",
+								Name:      "bar1",
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									HTTP: &crdv1beta1.HTTPProtocol{
+										Headers: []crdv1beta1.HTTPHeaderMatch{
+											{Name: "X-Tenant-ID", Regex: "tenant-(a|b"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "invalid regex \"tenant-(a|b\" of HTTP header \"X-Tenant-ID\": error parsing regexp: missing closing ): `tenant-(a|b`",
+		},
+		{
+			name:         "acnp-l7protocols-HTTP-query-param-value-and-regex",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							Service: &crdv1beta1.NamespacedName{
+								Namespace: "foo1",
+								Name:      "bar1",
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									HTTP: &crdv1beta1.HTTPProtocol{
+										QueryParams: []crdv1beta1.HTTPQueryParamMatch{
+											{Name: "version", Value: "v1", Regex: "v[12]"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "value and regex of HTTP query parameter \"version\" can not be set together",
+		},
 		{
 			name:         "acnp-l7protocols-used-with-toService",
 			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
@@ -1924,6 +2218,383 @@ func TestValidateAntreaClusterNetworkPolicy(t *testing.T) {
 			operation:      admv1.Create,
 			expectedReason: "protocol IGMP does not support Pass or Reject",
 		},
+		{
+			name: "acnp-rule-schedule",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-rule-schedule",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NamespaceSelector: &metav1.LabelSelector{},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Name:   "rule1",
+							Action: &allowAction,
+							From: []crdv1beta1.NetworkPolicyPeer{
+								{
+									NamespaceSelector: &metav1.LabelSelector{},
+								},
+							},
+							Schedule: &crdv1beta1.RuleSchedule{
+								Windows: []crdv1beta1.ScheduleWindow{
+									{Start: "0 22 * * mon-fri", Duration: "2h"},
+								},
+								TimeZone: "America/New_York",
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "",
+		},
+		{
+			name: "acnp-rule-schedule-without-window",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-rule-schedule-without-window",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NamespaceSelector: &metav1.LabelSelector{},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Name:   "rule1",
+							Action: &allowAction,
+							From: []crdv1beta1.NetworkPolicyPeer{
+								{
+									NamespaceSelector: &metav1.LabelSelector{},
+								},
+							},
+							Schedule: &crdv1beta1.RuleSchedule{},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "schedule of rule \"rule1\" must have at least one window",
+		},
+		{
+			name: "acnp-rule-schedule-invalid-start",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-rule-schedule-invalid-start",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NamespaceSelector: &metav1.LabelSelector{},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Name:   "rule1",
+							Action: &allowAction,
+							From: []crdv1beta1.NetworkPolicyPeer{
+								{
+									NamespaceSelector: &metav1.LabelSelector{},
+								},
+							},
+							Schedule: &crdv1beta1.RuleSchedule{
+								Windows: []crdv1beta1.ScheduleWindow{
+									{Start: "0 25 * * *", Duration: "2h"},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "invalid schedule of rule \"rule1\": invalid value \"25\" in hour field, must be in the range 0-23",
+		},
+		{
+			name: "acnp-rule-schedule-invalid-time-zone",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-rule-schedule-invalid-time-zone",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NamespaceSelector: &metav1.LabelSelector{},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Name:   "rule1",
+							Action: &allowAction,
+							From: []crdv1beta1.NetworkPolicyPeer{
+								{
+									NamespaceSelector: &metav1.LabelSelector{},
+								},
+							},
+							Schedule: &crdv1beta1.RuleSchedule{
+								Windows: []crdv1beta1.ScheduleWindow{
+									{Start: "0 22 * * *", Duration: "2h"},
+								},
+								TimeZone: "Mars/Olympus_Mons",
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "invalid schedule of rule \"rule1\": invalid time zone \"Mars/Olympus_Mons\": unknown time zone Mars/Olympus_Mons",
+		},
+		{
+			name: "acnp-rule-rate-limit",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-rule-rate-limit",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NamespaceSelector: &metav1.LabelSelector{},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Name:   "rule1",
+							Action: &allowAction,
+							From: []crdv1beta1.NetworkPolicyPeer{
+								{
+									NamespaceSelector: &metav1.LabelSelector{},
+								},
+							},
+							RateLimit: &crdv1beta1.RuleRateLimit{
+								Rate:  "100M",
+								Burst: "10M",
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "",
+		},
+		{
+			name: "acnp-rule-rate-limit-drop-action",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-rule-rate-limit-drop-action",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NamespaceSelector: &metav1.LabelSelector{},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Name:   "rule1",
+							Action: &dropAction,
+							From: []crdv1beta1.NetworkPolicyPeer{
+								{
+									NamespaceSelector: &metav1.LabelSelector{},
+								},
+							},
+							RateLimit: &crdv1beta1.RuleRateLimit{
+								Rate: "100M",
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "rateLimit of rule \"rule1\" is only supported with action Allow",
+		},
+		{
+			name: "acnp-rule-rate-limit-applied-to-node",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-rule-rate-limit-applied-to-node",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NodeSelector: &metav1.LabelSelector{},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Name:   "rule1",
+							Action: &allowAction,
+							From: []crdv1beta1.NetworkPolicyPeer{
+								{
+									NamespaceSelector: &metav1.LabelSelector{},
+								},
+							},
+							RateLimit: &crdv1beta1.RuleRateLimit{
+								Rate: "100M",
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "rateLimit of rule \"rule1\" is not supported for rules applied to Nodes",
+		},
+		{
+			name: "acnp-rule-rate-limit-invalid-rate",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-rule-rate-limit-invalid-rate",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NamespaceSelector: &metav1.LabelSelector{},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Name:   "rule1",
+							Action: &allowAction,
+							From: []crdv1beta1.NetworkPolicyPeer{
+								{
+									NamespaceSelector: &metav1.LabelSelector{},
+								},
+							},
+							RateLimit: &crdv1beta1.RuleRateLimit{
+								Rate: "100Mbps",
+								Unit: crdv1beta1.RateLimitUnitBitsPerSecond,
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "invalid rate \"100Mbps\" in rateLimit of rule \"rule1\": quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'",
+		},
+		{
+			name: "acnp-rule-rate-limit-zero-pps",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-rule-rate-limit-zero-pps",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NamespaceSelector: &metav1.LabelSelector{},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Name:   "rule1",
+							Action: &allowAction,
+							From: []crdv1beta1.NetworkPolicyPeer{
+								{
+									NamespaceSelector: &metav1.LabelSelector{},
+								},
+							},
+							RateLimit: &crdv1beta1.RuleRateLimit{
+								Rate: "0",
+								Unit: crdv1beta1.RateLimitUnitPacketsPerSecond,
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "rate in rateLimit of rule \"rule1\" must be positive",
+		},
+		{
+			name: "acnp-audit-mode-igmp",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-audit-mode-igmp",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NamespaceSelector: &metav1.LabelSelector{},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Protocols: []crdv1beta1.NetworkPolicyProtocol{
+								{
+									IGMP: &crdv1beta1.IGMPProtocol{
+										IGMPType:     &query,
+										GroupAddress: "224.0.0.1",
+									},
+								},
+							},
+							Action: &dropAction,
+						},
+					},
+					AuditMode: true,
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "protocol IGMP does not support action Audit",
+		},
+		{
+			name: "acnp-audit-action-multicast",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-audit-action-multicast",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NamespaceSelector: &metav1.LabelSelector{},
+						},
+					},
+					Egress: []crdv1beta1.Rule{
+						{
+							To: []crdv1beta1.NetworkPolicyPeer{
+								{
+									IPBlock: &crdv1beta1.IPBlock{
+										CIDR: "225.1.2.3/32",
+									},
+								},
+							},
+							Action: &auditAction,
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "multicast does not support action Audit",
+		},
+		{
+			name: "acnp-audit-action",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-audit-action",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NamespaceSelector: &metav1.LabelSelector{},
+						},
+					},
+					Egress: []crdv1beta1.Rule{
+						{
+							To: []crdv1beta1.NetworkPolicyPeer{
+								{
+									IPBlock: &crdv1beta1.IPBlock{
+										CIDR: "10.0.0.0/8",
+									},
+								},
+							},
+							Action: &auditAction,
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "",
+		},
 		// Update use same validate function as create. Only provide one update case here.
 		{
 			name: "acnp-non-existent-tier",
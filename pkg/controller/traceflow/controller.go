@@ -27,7 +27,9 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/wait"
 	coreinformers "k8s.io/client-go/informers/core/v1"
+	discoveryinformers "k8s.io/client-go/informers/discovery/v1"
 	corelisters "k8s.io/client-go/listers/core/v1"
+	discoverylisters "k8s.io/client-go/listers/discovery/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
@@ -75,6 +77,10 @@ type Controller struct {
 	client                 versioned.Interface
 	podInformer            coreinformers.PodInformer
 	podLister              corelisters.PodLister
+	serviceLister          corelisters.ServiceLister
+	serviceListerSynced    cache.InformerSynced
+	endpointSliceLister    discoverylisters.EndpointSliceLister
+	endpointSliceSynced    cache.InformerSynced
 	traceflowInformer      crdinformers.TraceflowInformer
 	traceflowLister        crdlisters.TraceflowLister
 	traceflowListerSynced  cache.InformerSynced
@@ -84,11 +90,19 @@ type Controller struct {
 }
 
 // NewTraceflowController creates a new traceflow controller and adds podIP indexer to podInformer.
-func NewTraceflowController(client versioned.Interface, podInformer coreinformers.PodInformer, traceflowInformer crdinformers.TraceflowInformer) *Controller {
+func NewTraceflowController(client versioned.Interface,
+	podInformer coreinformers.PodInformer,
+	serviceInformer coreinformers.ServiceInformer,
+	endpointSliceInformer discoveryinformers.EndpointSliceInformer,
+	traceflowInformer crdinformers.TraceflowInformer) *Controller {
 	c := &Controller{
 		client:                client,
 		podInformer:           podInformer,
 		podLister:             podInformer.Lister(),
+		serviceLister:         serviceInformer.Lister(),
+		serviceListerSynced:   serviceInformer.Informer().HasSynced,
+		endpointSliceLister:   endpointSliceInformer.Lister(),
+		endpointSliceSynced:   endpointSliceInformer.Informer().HasSynced,
 		traceflowInformer:     traceflowInformer,
 		traceflowLister:       traceflowInformer.Lister(),
 		traceflowListerSynced: traceflowInformer.Informer().HasSynced,
@@ -111,9 +125,13 @@ func NewTraceflowController(client versioned.Interface, podInformer coreinformer
 	return c
 }
 
-// enqueueTraceflow adds an object to the controller work queue.
+// enqueueTraceflow adds an object to the controller work queue. The multi-path Traceflow which
+// created the object is also enqueued to collect its results.
 func (c *Controller) enqueueTraceflow(tf *crdv1beta1.Traceflow) {
 	c.queue.Add(tf.Name)
+	if parent, ok := tf.Labels[crdv1beta1.TraceflowParentLabelKey]; ok {
+		c.queue.Add(parent)
+	}
 }
 
 func (c *Controller) Run(stopCh <-chan struct{}) {
@@ -122,7 +140,7 @@ func (c *Controller) Run(stopCh <-chan struct{}) {
 	klog.Infof("Starting %s", controllerName)
 	defer klog.Infof("Shutting down %s", controllerName)
 
-	if !cache.WaitForNamedCacheSync(controllerName, stopCh, c.traceflowListerSynced) {
+	if !cache.WaitForNamedCacheSync(controllerName, stopCh, c.traceflowListerSynced, c.serviceListerSynced, c.endpointSliceSynced) {
 		return
 	}
 
@@ -132,7 +150,7 @@ func (c *Controller) Run(stopCh <-chan struct{}) {
 		klog.Errorf("Failed to list all Antrea Traceflows")
 	}
 	for _, tf := range tfs {
-		if tf.Status.Phase == crdv1beta1.Running {
+		if tf.Status.Phase == crdv1beta1.Running && !isParentTraceflow(tf) {
 			if err := c.occupyTag(tf); err != nil {
 				klog.Errorf("Load Traceflow data plane tag failed %v+: %v", tf, err)
 			}
@@ -165,6 +183,15 @@ func (c *Controller) deleteTraceflow(old interface{}) {
 	tf := old.(*crdv1beta1.Traceflow)
 	klog.Infof("Processing Traceflow %s DELETE event", tf.Name)
 	c.deallocateTagForTF(tf)
+	if tf.Spec.Schedule != nil {
+		deleteRunMetrics(tf.Name)
+	}
+}
+
+// isParentTraceflow returns whether a Traceflow is a multi-path or recurring Traceflow, which is
+// traced by the Traceflows it creates and doesn't take a data plane tag itself.
+func isParentTraceflow(tf *crdv1beta1.Traceflow) bool {
+	return tf.Spec.MultiPath || tf.Spec.Schedule != nil
 }
 
 // worker is a long-running function that will continually call the processTraceflowItem function
@@ -182,6 +209,18 @@ func (c *Controller) checkTraceflowTimeout() {
 	}
 	c.runningTraceflowsMutex.Unlock()
 
+	// Running multi-path and recurring Traceflows don't take data plane tags, and are checked
+	// too.
+	allTfs, err := c.traceflowLister.List(labels.Everything())
+	if err != nil {
+		klog.ErrorS(err, "Failed to list Traceflows")
+	}
+	for _, tf := range allTfs {
+		if isParentTraceflow(tf) && tf.Status.Phase == crdv1beta1.Running {
+			tfs = append(tfs, tf.Name)
+		}
+	}
+
 	for _, tfName := range tfs {
 		// Re-post all running Traceflow requests to the work queue to
 		// be processed and checked for timeout.
@@ -229,6 +268,12 @@ func (c *Controller) syncTraceflow(traceflowName string) error {
 		}
 		return err
 	}
+	if tf.Spec.Schedule != nil {
+		return c.syncScheduledTraceflow(tf)
+	}
+	if tf.Spec.MultiPath {
+		return c.syncMultiPathTraceflow(tf)
+	}
 	switch tf.Status.Phase {
 	case "":
 		err = c.startTraceflow(tf)
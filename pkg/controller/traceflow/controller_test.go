@@ -16,6 +16,8 @@ package traceflow
 
 import (
 	"context"
+	"fmt"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -55,8 +57,12 @@ func newController(k8sObjects ...runtime.Object) *traceflowController {
 	crdInformerFactory := crdinformers.NewSharedInformerFactory(crdClient, informerDefaultResync)
 	controller := NewTraceflowController(crdClient,
 		informerFactory.Core().V1().Pods(),
+		informerFactory.Core().V1().Services(),
+		informerFactory.Discovery().V1().EndpointSlices(),
 		crdInformerFactory.Crd().V1beta1().Traceflows())
 	controller.traceflowListerSynced = alwaysReady
+	controller.serviceListerSynced = alwaysReady
+	controller.endpointSliceSynced = alwaysReady
 	return &traceflowController{
 		controller,
 		client,
@@ -184,9 +190,15 @@ func (tfc *traceflowController) waitForTraceflow(name string, phase crdv1beta1.T
 func newCRDClientset() *fakeversioned.Clientset {
 	client := fakeversioned.NewSimpleClientset()
 
+	var generatedNames atomic.Int32
 	client.PrependReactor("create", "traceflows", k8stesting.ReactionFunc(func(action k8stesting.Action) (bool, runtime.Object, error) {
 		tf := action.(k8stesting.CreateAction).GetObject().(*crdv1beta1.Traceflow)
 
+		// Fake client does not generate names.
+		if tf.Name == "" && tf.GenerateName != "" {
+			tf.Name = fmt.Sprintf("%s%d", tf.GenerateName, generatedNames.Add(1)-1)
+		}
+
 		// Fake client does not set CreationTimestamp.
 		if tf.ObjectMeta.CreationTimestamp == (metav1.Time{}) {
 			tf.ObjectMeta.CreationTimestamp.Time = time.Now()
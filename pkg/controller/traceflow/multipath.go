@@ -0,0 +1,296 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traceflow
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	discovery "k8s.io/api/discovery/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/klog/v2"
+
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	"antrea.io/antrea/pkg/util/k8s"
+)
+
+// maxMultiPathEndpoints is the maximum number of endpoints traced by a multi-path Traceflow. The
+// path to each endpoint is traced by a Traceflow which takes a data plane tag, and only 14 tags are
+// available.
+const maxMultiPathEndpoints = 8
+
+// serviceEndpoint is an endpoint of the destination Service of a multi-path Traceflow.
+type serviceEndpoint struct {
+	ip   string
+	port int32
+	pod  string
+	node string
+}
+
+// getServicePort returns the port of the destination Service which the Traceflow packet is sent
+// to, in the same way as the agent builds the packet.
+func getServicePort(tf *crdv1beta1.Traceflow, svc *corev1.Service) (*corev1.ServicePort, error) {
+	if len(svc.Spec.Ports) == 0 {
+		return nil, fmt.Errorf("destination Service %s has no port", k8s.NamespacedName(svc.Namespace, svc.Name))
+	}
+	var protocol corev1.Protocol
+	var port int32
+	if tcp := tf.Spec.Packet.TransportHeader.TCP; tcp != nil {
+		protocol, port = corev1.ProtocolTCP, tcp.DstPort
+	} else if udp := tf.Spec.Packet.TransportHeader.UDP; udp != nil {
+		protocol, port = corev1.ProtocolUDP, udp.DstPort
+	}
+	if port == 0 {
+		return &svc.Spec.Ports[0], nil
+	}
+	for i := range svc.Spec.Ports {
+		svcPort := &svc.Spec.Ports[i]
+		if svcPort.Port == port && svcPort.Protocol == protocol {
+			return svcPort, nil
+		}
+	}
+	return nil, fmt.Errorf("destination Service %s has no %s port %d", k8s.NamespacedName(svc.Namespace, svc.Name), protocol, port)
+}
+
+// getServiceEndpoints returns the ready endpoints of a Service port, sorted by IP.
+func (c *Controller) getServiceEndpoints(svc *corev1.Service, svcPort *corev1.ServicePort, isIPv6 bool) ([]serviceEndpoint, error) {
+	endpointSlices, err := c.endpointSliceLister.EndpointSlices(svc.Namespace).List(labels.Set{discovery.LabelServiceName: svc.Name}.AsSelector())
+	if err != nil {
+		return nil, err
+	}
+	addressType := discovery.AddressTypeIPv4
+	if isIPv6 {
+		addressType = discovery.AddressTypeIPv6
+	}
+	endpointsByIP := map[string]serviceEndpoint{}
+	for _, endpointSlice := range endpointSlices {
+		if endpointSlice.AddressType != addressType {
+			continue
+		}
+		var port *int32
+		for _, slicePort := range endpointSlice.Ports {
+			if slicePort.Name != nil && *slicePort.Name == svcPort.Name &&
+				slicePort.Protocol != nil && *slicePort.Protocol == svcPort.Protocol {
+				port = slicePort.Port
+				break
+			}
+		}
+		if port == nil {
+			continue
+		}
+		for _, endpoint := range endpointSlice.Endpoints {
+			// A nil Ready condition should be interpreted as "true".
+			if endpoint.Conditions.Ready != nil && !*endpoint.Conditions.Ready || len(endpoint.Addresses) == 0 {
+				continue
+			}
+			ep := serviceEndpoint{ip: endpoint.Addresses[0], port: *port}
+			if endpoint.TargetRef != nil && endpoint.TargetRef.Kind == "Pod" {
+				ep.pod = k8s.NamespacedName(endpoint.TargetRef.Namespace, endpoint.TargetRef.Name)
+			}
+			if endpoint.NodeName != nil {
+				ep.node = *endpoint.NodeName
+			}
+			endpointsByIP[ep.ip] = ep
+		}
+	}
+	endpoints := make([]serviceEndpoint, 0, len(endpointsByIP))
+	for _, ep := range endpointsByIP {
+		endpoints = append(endpoints, ep)
+	}
+	sort.Slice(endpoints, func(i, j int) bool {
+		return endpoints[i].ip < endpoints[j].ip
+	})
+	return endpoints, nil
+}
+
+func (ep serviceEndpoint) String() string {
+	return net.JoinHostPort(ep.ip, strconv.Itoa(int(ep.port)))
+}
+
+// newEndpointTraceflow creates the Traceflow tracing the path to an endpoint of the destination
+// Service of a multi-path Traceflow. The packet is still sent to the Service, and the agent on the
+// source Node load-balances it to the endpoint set in the annotation of the Traceflow, so that the
+// Service DNAT is traced as well.
+func newEndpointTraceflow(tf *crdv1beta1.Traceflow, endpoint serviceEndpoint) *crdv1beta1.Traceflow {
+	return &crdv1beta1.Traceflow{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName:    tf.Name + "-",
+			Labels:          map[string]string{crdv1beta1.TraceflowParentLabelKey: tf.Name},
+			Annotations:     map[string]string{crdv1beta1.TraceflowEndpointAnnotationKey: endpoint.String()},
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(tf, crdv1beta1.SchemeGroupVersion.WithKind("Traceflow"))},
+		},
+		Spec: crdv1beta1.TraceflowSpec{
+			Source: tf.Spec.Source,
+			Destination: crdv1beta1.Destination{
+				Namespace: tf.Spec.Destination.Namespace,
+				Service:   tf.Spec.Destination.Service,
+			},
+			Packet:  *tf.Spec.Packet.DeepCopy(),
+			Timeout: tf.Spec.Timeout,
+		},
+	}
+}
+
+// getEndpointTraceflows returns the Traceflows already created by a multi-path Traceflow, indexed
+// by the endpoint they trace the path to. A Traceflow may have been created without the status
+// of the multi-path Traceflow being updated, e.g. when the update failed.
+func (c *Controller) getEndpointTraceflows(tf *crdv1beta1.Traceflow) (map[string]string, error) {
+	endpointTfs, err := c.traceflowLister.List(labels.Set{crdv1beta1.TraceflowParentLabelKey: tf.Name}.AsSelector())
+	if err != nil {
+		return nil, err
+	}
+	traceflows := map[string]string{}
+	for _, endpointTf := range endpointTfs {
+		// The label alone doesn't tell whether the Traceflow was created by a previous
+		// Traceflow with the same name.
+		if owner := metav1.GetControllerOf(endpointTf); owner == nil || owner.UID != tf.UID {
+			continue
+		}
+		if endpoint, ok := endpointTf.Annotations[crdv1beta1.TraceflowEndpointAnnotationKey]; ok {
+			traceflows[endpoint] = endpointTf.Name
+		}
+	}
+	return traceflows, nil
+}
+
+func (c *Controller) syncMultiPathTraceflow(tf *crdv1beta1.Traceflow) error {
+	switch tf.Status.Phase {
+	case "":
+		return c.startMultiPathTraceflow(tf)
+	case crdv1beta1.Running:
+		return c.checkMultiPathTraceflowStatus(tf)
+	}
+	return nil
+}
+
+// startMultiPathTraceflow creates a Traceflow for each ready endpoint of the destination Service.
+// A multi-path Traceflow doesn't take a data plane tag itself.
+func (c *Controller) startMultiPathTraceflow(tf *crdv1beta1.Traceflow) error {
+	svc, err := c.serviceLister.Services(tf.Spec.Destination.Namespace).Get(tf.Spec.Destination.Service)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return c.updateTraceflowStatus(tf, crdv1beta1.Failed, fmt.Sprintf("destination Service %s not found", k8s.NamespacedName(tf.Spec.Destination.Namespace, tf.Spec.Destination.Service)), 0)
+		}
+		return err
+	}
+	svcPort, err := getServicePort(tf, svc)
+	if err != nil {
+		return c.updateTraceflowStatus(tf, crdv1beta1.Failed, err.Error(), 0)
+	}
+	endpoints, err := c.getServiceEndpoints(svc, svcPort, tf.Spec.Packet.IPv6Header != nil)
+	if err != nil {
+		return err
+	}
+	if len(endpoints) == 0 {
+		return c.updateTraceflowStatus(tf, crdv1beta1.Failed, fmt.Sprintf("destination Service %s has no ready endpoint", k8s.NamespacedName(svc.Namespace, svc.Name)), 0)
+	}
+	var reason string
+	if len(endpoints) > maxMultiPathEndpoints {
+		reason = fmt.Sprintf("Only the first %d of %d endpoints are traced", maxMultiPathEndpoints, len(endpoints))
+		endpoints = endpoints[:maxMultiPathEndpoints]
+	}
+
+	endpointTfs, err := c.getEndpointTraceflows(tf)
+	if err != nil {
+		return err
+	}
+	endpointResults := make([]crdv1beta1.EndpointResult, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		name, ok := endpointTfs[endpoint.String()]
+		if !ok {
+			endpointTf, err := c.client.CrdV1beta1().Traceflows().Create(context.TODO(), newEndpointTraceflow(tf, endpoint), metav1.CreateOptions{})
+			if err != nil {
+				return fmt.Errorf("failed to create Traceflow for endpoint %s: %w", endpoint.String(), err)
+			}
+			name = endpointTf.Name
+		}
+		endpointResults = append(endpointResults, crdv1beta1.EndpointResult{
+			IP:        endpoint.ip,
+			Port:      endpoint.port,
+			Pod:       endpoint.pod,
+			Node:      endpoint.node,
+			Traceflow: name,
+		})
+	}
+	klog.InfoS("Started multi-path Traceflow", "traceflow", klog.KObj(tf), "endpoints", len(endpointResults))
+
+	update := tf.DeepCopy()
+	update.Status.Phase = crdv1beta1.Running
+	t := metav1.Now()
+	update.Status.StartTime = &t
+	update.Status.Reason = reason
+	update.Status.EndpointResults = endpointResults
+	_, err = c.client.CrdV1beta1().Traceflows().UpdateStatus(context.TODO(), update, metav1.UpdateOptions{})
+	return err
+}
+
+// checkMultiPathTraceflowStatus collects the results of the Traceflows tracing the path to each
+// endpoint. The multi-path Traceflow succeeds if all of them succeed.
+func (c *Controller) checkMultiPathTraceflowStatus(tf *crdv1beta1.Traceflow) error {
+	update := tf.DeepCopy()
+	finished, failed := 0, 0
+	for i := range update.Status.EndpointResults {
+		endpointResult := &update.Status.EndpointResults[i]
+		endpointTf, err := c.traceflowLister.Get(endpointResult.Traceflow)
+		if err != nil {
+			// The Traceflow may not have been received by the informer yet.
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		endpointResult.Phase = endpointTf.Status.Phase
+		endpointResult.Reason = endpointTf.Status.Reason
+		endpointResult.Results = endpointTf.Status.Results
+		switch endpointTf.Status.Phase {
+		case crdv1beta1.Succeeded:
+			finished++
+		case crdv1beta1.Failed:
+			finished++
+			failed++
+		}
+	}
+
+	if finished == len(update.Status.EndpointResults) {
+		if failed == 0 {
+			update.Status.Phase = crdv1beta1.Succeeded
+		} else {
+			update.Status.Phase = crdv1beta1.Failed
+			update.Status.Reason = fmt.Sprintf("Traceflow to %d of %d endpoints failed", failed, len(update.Status.EndpointResults))
+		}
+	} else {
+		timeout := getTraceflowTimeout(tf)
+		// The Traceflows tracing the path to each endpoint time out by themselves. This is a
+		// fallback for a Traceflow which could never start, e.g. when no data plane tag is
+		// available.
+		if tf.Status.StartTime != nil && tf.Status.StartTime.Add(2*timeout).Before(time.Now()) {
+			update.Status.Phase = crdv1beta1.Failed
+			update.Status.Reason = traceflowTimeout
+		}
+	}
+	if apiequality.Semantic.DeepEqual(tf.Status, update.Status) {
+		return nil
+	}
+	_, err := c.client.CrdV1beta1().Traceflows().UpdateStatus(context.TODO(), update, metav1.UpdateOptions{})
+	return err
+}
@@ -0,0 +1,231 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traceflow
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	discovery "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+)
+
+func TestMultiPathTraceflow(t *testing.T) {
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "svc1", Namespace: "ns2"},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{Name: "http", Port: 80, Protocol: corev1.ProtocolTCP},
+				{Name: "dns", Port: 53, Protocol: corev1.ProtocolUDP},
+			},
+		},
+	}
+	endpointSlice := &discovery.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "svc1-abcde",
+			Namespace: "ns2",
+			Labels:    map[string]string{discovery.LabelServiceName: "svc1"},
+		},
+		AddressType: discovery.AddressTypeIPv4,
+		Endpoints: []discovery.Endpoint{
+			{
+				Addresses: []string{"10.10.1.2"},
+				NodeName:  ptr.To("node2"),
+				TargetRef: &corev1.ObjectReference{Kind: "Pod", Namespace: "ns2", Name: "pod2"},
+			},
+			{
+				Addresses:  []string{"10.10.0.3"},
+				Conditions: discovery.EndpointConditions{Ready: ptr.To(true)},
+				NodeName:   ptr.To("node1"),
+				TargetRef:  &corev1.ObjectReference{Kind: "Pod", Namespace: "ns2", Name: "pod3"},
+			},
+			{
+				Addresses:  []string{"10.10.0.4"},
+				Conditions: discovery.EndpointConditions{Ready: ptr.To(false)},
+			},
+		},
+		Ports: []discovery.EndpointPort{
+			{Name: ptr.To("http"), Port: ptr.To[int32](8080), Protocol: ptr.To(corev1.ProtocolTCP)},
+			{Name: ptr.To("dns"), Port: ptr.To[int32](5353), Protocol: ptr.To(corev1.ProtocolUDP)},
+		},
+	}
+
+	tfc := newController(svc, endpointSlice)
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	tfc.informerFactory.Start(stopCh)
+	tfc.crdInformerFactory.Start(stopCh)
+	tfc.informerFactory.WaitForCacheSync(stopCh)
+	tfc.crdInformerFactory.WaitForCacheSync(stopCh)
+	go tfc.Run(stopCh)
+
+	tf := &crdv1beta1.Traceflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "tf1", UID: "uid1"},
+		Spec: crdv1beta1.TraceflowSpec{
+			Source:      crdv1beta1.Source{Namespace: "ns1", Pod: "pod1"},
+			Destination: crdv1beta1.Destination{Namespace: "ns2", Service: "svc1"},
+			Packet: crdv1beta1.Packet{
+				TransportHeader: crdv1beta1.TransportHeader{UDP: &crdv1beta1.UDPHeader{SrcPort: 10000, DstPort: 53}},
+			},
+			MultiPath: true,
+			Timeout:   5,
+		},
+	}
+	_, err := tfc.client.CrdV1beta1().Traceflows().Create(context.TODO(), tf, metav1.CreateOptions{})
+	require.NoError(t, err)
+	res, _ := tfc.waitForTraceflow("tf1", crdv1beta1.Running, time.Second)
+	require.NotNil(t, res)
+	// A multi-path Traceflow doesn't take a data plane tag.
+	assert.Equal(t, int8(0), res.Status.DataplaneTag)
+	// The phases of the endpoint results may have been updated already.
+	endpointResults := res.Status.EndpointResults
+	for i := range endpointResults {
+		endpointResults[i].Phase = ""
+	}
+	assert.Equal(t, []crdv1beta1.EndpointResult{
+		{IP: "10.10.0.3", Port: 5353, Pod: "ns2/pod3", Node: "node1", Traceflow: "tf1-0"},
+		{IP: "10.10.1.2", Port: 5353, Pod: "ns2/pod2", Node: "node2", Traceflow: "tf1-1"},
+	}, endpointResults)
+
+	tf0, _ := tfc.waitForTraceflow("tf1-0", crdv1beta1.Running, time.Second)
+	require.NotNil(t, tf0)
+	assert.Equal(t, map[string]string{crdv1beta1.TraceflowParentLabelKey: "tf1"}, tf0.Labels)
+	// The packet is sent to the Service and load-balanced to the endpoint.
+	assert.Equal(t, map[string]string{crdv1beta1.TraceflowEndpointAnnotationKey: "10.10.0.3:5353"}, tf0.Annotations)
+	assert.Equal(t, crdv1beta1.Destination{Namespace: "ns2", Service: "svc1"}, tf0.Spec.Destination)
+	assert.Equal(t, &crdv1beta1.UDPHeader{SrcPort: 10000, DstPort: 53}, tf0.Spec.Packet.TransportHeader.UDP)
+	assert.False(t, tf0.Spec.MultiPath)
+	tf1, _ := tfc.waitForTraceflow("tf1-1", crdv1beta1.Running, time.Second)
+	require.NotNil(t, tf1)
+	assert.Equal(t, map[string]string{crdv1beta1.TraceflowEndpointAnnotationKey: "10.10.1.2:5353"}, tf1.Annotations)
+	assert.Equal(t, crdv1beta1.Destination{Namespace: "ns2", Service: "svc1"}, tf1.Spec.Destination)
+
+	results := []crdv1beta1.NodeResult{
+		{Observations: []crdv1beta1.Observation{{Component: crdv1beta1.ComponentSpoofGuard}}},
+		{Observations: []crdv1beta1.Observation{{Action: crdv1beta1.ActionDelivered}}},
+	}
+	tf0.Status.Results = results
+	_, err = tfc.client.CrdV1beta1().Traceflows().UpdateStatus(context.TODO(), tf0, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	tf1.Status.Phase = crdv1beta1.Failed
+	tf1.Status.Reason = "Traceflow timeout"
+	_, err = tfc.client.CrdV1beta1().Traceflows().UpdateStatus(context.TODO(), tf1, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	res, _ = tfc.waitForTraceflow("tf1", crdv1beta1.Failed, 2*time.Second)
+	require.NotNil(t, res)
+	assert.Equal(t, "Traceflow to 1 of 2 endpoints failed", res.Status.Reason)
+	require.Len(t, res.Status.EndpointResults, 2)
+	assert.Equal(t, crdv1beta1.Succeeded, res.Status.EndpointResults[0].Phase)
+	assert.Equal(t, results, res.Status.EndpointResults[0].Results)
+	assert.Equal(t, crdv1beta1.Failed, res.Status.EndpointResults[1].Phase)
+	assert.Equal(t, "Traceflow timeout", res.Status.EndpointResults[1].Reason)
+}
+
+func TestMultiPathTraceflowNoEndpoint(t *testing.T) {
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "svc1", Namespace: "ns2"},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{{Name: "http", Port: 80, Protocol: corev1.ProtocolTCP}},
+		},
+	}
+	tfc := newController(svc)
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	tfc.informerFactory.Start(stopCh)
+	tfc.crdInformerFactory.Start(stopCh)
+	tfc.informerFactory.WaitForCacheSync(stopCh)
+	tfc.crdInformerFactory.WaitForCacheSync(stopCh)
+	go tfc.Run(stopCh)
+
+	testCases := []struct {
+		name           string
+		service        string
+		tcpHeader      *crdv1beta1.TCPHeader
+		expectedReason string
+	}{
+		{
+			name:           "service not found",
+			service:        "svc2",
+			expectedReason: "destination Service ns2/svc2 not found",
+		},
+		{
+			name:           "port not found",
+			service:        "svc1",
+			tcpHeader:      &crdv1beta1.TCPHeader{DstPort: 443},
+			expectedReason: "destination Service ns2/svc1 has no TCP port 443",
+		},
+		{
+			name:           "no ready endpoint",
+			service:        "svc1",
+			expectedReason: "destination Service ns2/svc1 has no ready endpoint",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tf := &crdv1beta1.Traceflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "tf1", UID: "uid1"},
+				Spec: crdv1beta1.TraceflowSpec{
+					Source:      crdv1beta1.Source{Namespace: "ns1", Pod: "pod1"},
+					Destination: crdv1beta1.Destination{Namespace: "ns2", Service: tc.service},
+					Packet: crdv1beta1.Packet{
+						TransportHeader: crdv1beta1.TransportHeader{TCP: tc.tcpHeader},
+					},
+					MultiPath: true,
+				},
+			}
+			_, err := tfc.client.CrdV1beta1().Traceflows().Create(context.TODO(), tf, metav1.CreateOptions{})
+			require.NoError(t, err)
+			defer tfc.client.CrdV1beta1().Traceflows().Delete(context.TODO(), "tf1", metav1.DeleteOptions{})
+			res, _ := tfc.waitForTraceflow("tf1", crdv1beta1.Failed, time.Second)
+			require.NotNil(t, res)
+			assert.Equal(t, tc.expectedReason, res.Status.Reason)
+			assert.Empty(t, res.Status.EndpointResults)
+		})
+	}
+}
+
+func TestGetEndpointTraceflows(t *testing.T) {
+	tf := &crdv1beta1.Traceflow{ObjectMeta: metav1.ObjectMeta{Name: "tf1", UID: "uid1"}}
+	newChild := func(name, ownerUID, endpoint string) *crdv1beta1.Traceflow {
+		owner := tf.DeepCopy()
+		owner.UID = types.UID(ownerUID)
+		return &crdv1beta1.Traceflow{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            name,
+				Labels:          map[string]string{crdv1beta1.TraceflowParentLabelKey: "tf1"},
+				Annotations:     map[string]string{crdv1beta1.TraceflowEndpointAnnotationKey: endpoint},
+				OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(owner, crdv1beta1.SchemeGroupVersion.WithKind("Traceflow"))},
+			},
+		}
+	}
+	tfc := newController()
+	traceflowIndexer := tfc.crdInformerFactory.Crd().V1beta1().Traceflows().Informer().GetIndexer()
+	traceflowIndexer.Add(newChild("tf1-abcde", "uid1", "10.10.0.3:8080"))
+	// Created by a previous Traceflow with the same name.
+	traceflowIndexer.Add(newChild("tf1-fghij", "uid0", "10.10.1.2:8080"))
+
+	endpointTfs, err := tfc.getEndpointTraceflows(tf)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"10.10.0.3:8080": "tf1-abcde"}, endpointTfs)
+}
@@ -0,0 +1,192 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traceflow
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	"antrea.io/antrea/pkg/controller/metrics"
+)
+
+const (
+	runResultSucceeded = "succeeded"
+	runResultFailed    = "failed"
+)
+
+func isTraceflowFinished(phase crdv1beta1.TraceflowPhase) bool {
+	return phase == crdv1beta1.Succeeded || phase == crdv1beta1.Failed
+}
+
+func getTraceflowTimeout(tf *crdv1beta1.Traceflow) time.Duration {
+	if tf.Spec.Timeout != 0 {
+		return time.Duration(tf.Spec.Timeout) * time.Second
+	}
+	return defaultTimeoutDuration
+}
+
+func runTraceflowName(tfName string, startTime time.Time) string {
+	return fmt.Sprintf("%s-%d", tfName, startTime.Unix())
+}
+
+// newRunTraceflow creates the Traceflow of a run of a recurring Traceflow.
+func newRunTraceflow(tf *crdv1beta1.Traceflow, startTime time.Time) *crdv1beta1.Traceflow {
+	runTf := &crdv1beta1.Traceflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            runTraceflowName(tf.Name, startTime),
+			Labels:          map[string]string{crdv1beta1.TraceflowParentLabelKey: tf.Name},
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(tf, crdv1beta1.SchemeGroupVersion.WithKind("Traceflow"))},
+		},
+		Spec: *tf.Spec.DeepCopy(),
+	}
+	runTf.Spec.Schedule = nil
+	return runTf
+}
+
+// syncScheduledTraceflow creates a Traceflow for each run of a recurring Traceflow, and collects
+// the results of the runs in its history. The Traceflow of a run is deleted once the run is
+// finished. A recurring Traceflow stays in the Running phase until it is deleted, and doesn't take
+// a data plane tag itself.
+func (c *Controller) syncScheduledTraceflow(tf *crdv1beta1.Traceflow) error {
+	switch tf.Status.Phase {
+	case "":
+		return c.updateTraceflowStatus(tf, crdv1beta1.Running, "", 0)
+	case crdv1beta1.Running:
+	default:
+		return nil
+	}
+
+	// Delete the Traceflows of the runs whose results have been saved in the history.
+	for _, run := range tf.Status.History {
+		if !isTraceflowFinished(run.Phase) {
+			continue
+		}
+		if _, err := c.traceflowLister.Get(run.Traceflow); err != nil {
+			continue
+		}
+		if err := c.client.CrdV1beta1().Traceflows().Delete(context.TODO(), run.Traceflow, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete Traceflow %s of a finished run: %w", run.Traceflow, err)
+		}
+	}
+
+	now := time.Now()
+	timeout := getTraceflowTimeout(tf)
+	update := tf.DeepCopy()
+	var finishedRuns []*crdv1beta1.TraceflowRun
+	for i := range update.Status.History {
+		run := &update.Status.History[i]
+		if isTraceflowFinished(run.Phase) {
+			continue
+		}
+		runTf, err := c.traceflowLister.Get(run.Traceflow)
+		if err == nil {
+			run.Phase = runTf.Status.Phase
+			run.Reason = runTf.Status.Reason
+			run.Results = runTf.Status.Results
+			run.EndpointResults = runTf.Status.EndpointResults
+		} else if !apierrors.IsNotFound(err) {
+			return err
+		}
+		// The Traceflow of the run times out by itself. This is a fallback for a run which could
+		// never start, e.g. when no data plane tag is available, or whose Traceflow was deleted.
+		if !isTraceflowFinished(run.Phase) && run.StartTime.Add(2*timeout).Before(now) {
+			run.Phase = crdv1beta1.Failed
+			run.Reason = traceflowTimeout
+		}
+		if isTraceflowFinished(run.Phase) {
+			finishedRuns = append(finishedRuns, run)
+		}
+	}
+
+	interval := time.Duration(tf.Spec.Schedule.Interval) * time.Second
+	nextRunTime := now
+	if n := len(update.Status.History); n > 0 {
+		lastRun := update.Status.History[n-1]
+		nextRunTime = lastRun.StartTime.Add(interval)
+		// Runs don't overlap: if the last run is still in progress, the next run is started
+		// after it finishes.
+		if !isTraceflowFinished(lastRun.Phase) && !nextRunTime.After(now) {
+			nextRunTime = now.Add(timeoutCheckInterval)
+		}
+	}
+	// The runs removed from the history when it exceeds the limit.
+	var removedRuns []crdv1beta1.TraceflowRun
+	if !nextRunTime.After(now) {
+		runTf := newRunTraceflow(tf, now)
+		if _, err := c.client.CrdV1beta1().Traceflows().Create(context.TODO(), runTf, metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("failed to create Traceflow %s for a run: %w", runTf.Name, err)
+		}
+		klog.V(2).InfoS("Started a run of recurring Traceflow", "traceflow", klog.KObj(tf), "run", runTf.Name)
+		update.Status.History = append(update.Status.History, crdv1beta1.TraceflowRun{
+			Traceflow: runTf.Name,
+			StartTime: metav1.NewTime(now),
+		})
+		historyLimit := int(crdv1beta1.DefaultTraceflowHistoryLimit)
+		if tf.Spec.Schedule.HistoryLimit != 0 {
+			historyLimit = int(tf.Spec.Schedule.HistoryLimit)
+		}
+		if n := len(update.Status.History); n > historyLimit {
+			removedRuns = append(removedRuns, update.Status.History[:n-historyLimit]...)
+			update.Status.History = update.Status.History[n-historyLimit:]
+		}
+		nextRunTime = now.Add(interval)
+	}
+
+	if !apiequality.Semantic.DeepEqual(tf.Status, update.Status) {
+		if _, err := c.client.CrdV1beta1().Traceflows().UpdateStatus(context.TODO(), update, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+		for _, run := range finishedRuns {
+			recordRunMetrics(tf.Name, run)
+		}
+		// The Traceflows of the removed runs are not referenced by the history anymore, and
+		// would never be deleted otherwise, whether the runs are finished or not.
+		for _, run := range removedRuns {
+			if err := c.client.CrdV1beta1().Traceflows().Delete(context.TODO(), run.Traceflow, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+				return fmt.Errorf("failed to delete Traceflow %s of a run removed from the history: %w", run.Traceflow, err)
+			}
+		}
+	}
+	c.queue.AddAfter(tf.Name, nextRunTime.Sub(now))
+	return nil
+}
+
+// recordRunMetrics records the result of a finished run. The metrics are labeled with the name of
+// the recurring Traceflow, not the one of the Traceflow of each run, to keep a bounded number of
+// series per schedule.
+func recordRunMetrics(scheduleName string, run *crdv1beta1.TraceflowRun) {
+	result, succeeded := runResultFailed, 0.0
+	if run.Phase == crdv1beta1.Succeeded {
+		result, succeeded = runResultSucceeded, 1.0
+	}
+	metrics.TraceflowRuns.WithLabelValues(scheduleName, result).Inc()
+	metrics.TraceflowLastRunSucceeded.WithLabelValues(scheduleName).Set(succeeded)
+	metrics.TraceflowLastRunTimestamp.WithLabelValues(scheduleName).Set(float64(run.StartTime.Unix()))
+}
+
+// deleteRunMetrics deletes the metrics of a recurring Traceflow when it is deleted.
+func deleteRunMetrics(scheduleName string) {
+	metrics.TraceflowRuns.Delete(map[string]string{"schedule": scheduleName, "result": runResultSucceeded})
+	metrics.TraceflowRuns.Delete(map[string]string{"schedule": scheduleName, "result": runResultFailed})
+	metrics.TraceflowLastRunSucceeded.Delete(map[string]string{"schedule": scheduleName})
+	metrics.TraceflowLastRunTimestamp.Delete(map[string]string{"schedule": scheduleName})
+}
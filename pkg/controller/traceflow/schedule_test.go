@@ -0,0 +1,169 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traceflow
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/component-base/metrics/legacyregistry"
+
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	"antrea.io/antrea/pkg/controller/metrics"
+)
+
+func init() {
+	metrics.InitializePrometheusMetrics()
+}
+
+func TestScheduledTraceflow(t *testing.T) {
+	deleteRunMetrics("tf1")
+	tfc := newController()
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	tfc.informerFactory.Start(stopCh)
+	tfc.crdInformerFactory.Start(stopCh)
+	tfc.informerFactory.WaitForCacheSync(stopCh)
+	tfc.crdInformerFactory.WaitForCacheSync(stopCh)
+	go tfc.Run(stopCh)
+
+	tf := &crdv1beta1.Traceflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "tf1", UID: "uid1"},
+		Spec: crdv1beta1.TraceflowSpec{
+			Source:      crdv1beta1.Source{Namespace: "ns1", Pod: "pod1"},
+			Destination: crdv1beta1.Destination{Namespace: "ns2", Pod: "pod2"},
+			Timeout:     5,
+			Schedule:    &crdv1beta1.TraceflowSchedule{Interval: 1, HistoryLimit: 2},
+		},
+	}
+	_, err := tfc.client.CrdV1beta1().Traceflows().Create(context.TODO(), tf, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	getHistory := func() []crdv1beta1.TraceflowRun {
+		res, err := tfc.client.CrdV1beta1().Traceflows().Get(context.TODO(), "tf1", metav1.GetOptions{})
+		require.NoError(t, err)
+		return res.Status.History
+	}
+	// finishLastRun makes the last run succeed, and returns its Traceflow name.
+	finishLastRun := func(numRuns int) string {
+		var runName string
+		require.Eventually(t, func() bool {
+			history := getHistory()
+			if len(history) < numRuns {
+				return false
+			}
+			runName = history[len(history)-1].Traceflow
+			return true
+		}, 3*time.Second, 100*time.Millisecond)
+		runTf, _ := tfc.waitForTraceflow(runName, crdv1beta1.Running, time.Second)
+		require.NotNil(t, runTf)
+		assert.Equal(t, map[string]string{crdv1beta1.TraceflowParentLabelKey: "tf1"}, runTf.Labels)
+		assert.Nil(t, runTf.Spec.Schedule)
+		assert.Equal(t, tf.Spec.Destination, runTf.Spec.Destination)
+		runTf.Status.Results = []crdv1beta1.NodeResult{
+			{Observations: []crdv1beta1.Observation{{Component: crdv1beta1.ComponentSpoofGuard}}},
+			{Observations: []crdv1beta1.Observation{{Action: crdv1beta1.ActionDelivered}}},
+		}
+		_, err := tfc.client.CrdV1beta1().Traceflows().Update(context.TODO(), runTf, metav1.UpdateOptions{})
+		require.NoError(t, err)
+		// The Traceflow of the run should be deleted after its results are saved in the history.
+		require.Eventually(t, func() bool {
+			_, err := tfc.client.CrdV1beta1().Traceflows().Get(context.TODO(), runName, metav1.GetOptions{})
+			return apierrors.IsNotFound(err)
+		}, 3*time.Second, 100*time.Millisecond)
+		return runName
+	}
+
+	run1 := finishLastRun(1)
+	history := getHistory()
+	require.NotEmpty(t, history)
+	assert.Equal(t, run1, history[0].Traceflow)
+	assert.Equal(t, crdv1beta1.Succeeded, history[0].Phase)
+	assert.Len(t, history[0].Results, 2)
+
+	run2 := finishLastRun(2)
+	// The oldest run should be removed from the history when a new run is started.
+	require.Eventually(t, func() bool {
+		history := getHistory()
+		return len(history) == 2 && history[0].Traceflow == run2 && history[1].Traceflow != run2
+	}, 3*time.Second, 100*time.Millisecond)
+	assert.Equal(t, crdv1beta1.Succeeded, getHistory()[0].Phase)
+
+	expected := `
+	# HELP antrea_controller_traceflow_runs_total [ALPHA] The total number of finished runs of recurring Traceflows, partitioned by schedule (the name of the recurring Traceflow) and result (succeeded or failed)
+	# TYPE antrea_controller_traceflow_runs_total counter
+	antrea_controller_traceflow_runs_total{result="succeeded",schedule="tf1"} 2
+	# HELP antrea_controller_traceflow_last_run_succeeded [ALPHA] Whether the last finished run of a recurring Traceflow succeeded (1) or failed (0)
+	# TYPE antrea_controller_traceflow_last_run_succeeded gauge
+	antrea_controller_traceflow_last_run_succeeded{schedule="tf1"} 1
+	`
+	assert.NoError(t, testutil.GatherAndCompare(legacyregistry.DefaultGatherer, strings.NewReader(expected),
+		"antrea_controller_traceflow_runs_total", "antrea_controller_traceflow_last_run_succeeded"))
+
+	// A recurring Traceflow doesn't take a data plane tag, and stays in the Running phase.
+	res, err := tfc.client.CrdV1beta1().Traceflows().Get(context.TODO(), "tf1", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, crdv1beta1.Running, res.Status.Phase)
+	assert.Equal(t, int8(0), res.Status.DataplaneTag)
+}
+
+func TestScheduledTraceflowRemovedRun(t *testing.T) {
+	deleteRunMetrics("tf1")
+	tfc := newController()
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+
+	startTime := time.Now().Add(-2 * time.Second)
+	tf := &crdv1beta1.Traceflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "tf1", UID: "uid1"},
+		Spec: crdv1beta1.TraceflowSpec{
+			Source:      crdv1beta1.Source{Namespace: "ns1", Pod: "pod1"},
+			Destination: crdv1beta1.Destination{Namespace: "ns2", Pod: "pod2"},
+			Timeout:     5,
+			Schedule:    &crdv1beta1.TraceflowSchedule{Interval: 1, HistoryLimit: 1},
+		},
+		Status: crdv1beta1.TraceflowStatus{
+			Phase: crdv1beta1.Running,
+			History: []crdv1beta1.TraceflowRun{
+				{Traceflow: runTraceflowName("tf1", startTime), StartTime: metav1.NewTime(startTime)},
+			},
+		},
+	}
+	runTf := newRunTraceflow(tf, startTime)
+	runTf.Status.Phase = crdv1beta1.Succeeded
+	for _, obj := range []*crdv1beta1.Traceflow{tf, runTf} {
+		_, err := tfc.client.CrdV1beta1().Traceflows().Create(context.TODO(), obj, metav1.CreateOptions{})
+		require.NoError(t, err)
+	}
+	tfc.crdInformerFactory.Start(stopCh)
+	tfc.crdInformerFactory.WaitForCacheSync(stopCh)
+
+	// The run finishes and is removed from the history by the new run in the same sync, its
+	// Traceflow must still be deleted.
+	require.NoError(t, tfc.syncScheduledTraceflow(tf))
+	res, err := tfc.client.CrdV1beta1().Traceflows().Get(context.TODO(), "tf1", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Len(t, res.Status.History, 1)
+	assert.NotEqual(t, runTf.Name, res.Status.History[0].Traceflow)
+	_, err = tfc.client.CrdV1beta1().Traceflows().Get(context.TODO(), runTf.Name, metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err))
+}
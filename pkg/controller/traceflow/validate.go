@@ -17,6 +17,7 @@ package traceflow
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	admv1 "k8s.io/api/admission/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -86,5 +87,35 @@ func (c *Controller) validate(tf *crdv1beta1.Traceflow) (allowed bool, deniedRea
 	if tf.Spec.Source.Pod == "" && tf.Spec.Destination.Pod == "" {
 		return false, fmt.Sprintf("Traceflow %s has neither source nor destination Pod specified", tf.Name)
 	}
+	if tf.Spec.MultiPath {
+		if tf.Spec.LiveTraffic {
+			return false, "multi-path is not supported in live-traffic Traceflow"
+		}
+		if tf.Spec.Destination.Service == "" {
+			return false, "destination Service must be specified in multi-path Traceflow"
+		}
+	}
+	if schedule := tf.Spec.Schedule; schedule != nil {
+		if tf.Spec.LiveTraffic {
+			return false, "schedule is not supported in live-traffic Traceflow"
+		}
+		if time.Duration(schedule.Interval)*time.Second <= getTraceflowTimeout(tf) {
+			return false, "schedule interval must be larger than the timeout of the Traceflow"
+		}
+	}
+	if payload := tf.Spec.Packet.L7Payload; payload != nil {
+		if tf.Spec.LiveTraffic {
+			return false, "L7 payload is not supported in live-traffic Traceflow"
+		}
+		if (payload.HTTP == nil) == (payload.TLS == nil) {
+			return false, "exactly one of HTTP and TLS must be specified in L7 payload"
+		}
+		// The protocol of the packet is TCP if the TCP header is specified, or if the destination is a
+		// TCP Service and no other transport header is specified.
+		transportHeader := tf.Spec.Packet.TransportHeader
+		if transportHeader.TCP == nil && (tf.Spec.Destination.Service == "" || transportHeader.UDP != nil || transportHeader.ICMP != nil) {
+			return false, "L7 payload can only be specified for a TCP packet"
+		}
+	}
 	return true, ""
 }
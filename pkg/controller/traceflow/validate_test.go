@@ -82,6 +82,147 @@ func TestControllerValidate(t *testing.T) {
 			},
 			deniedReason: "using hostNetwork Pod as source in non-live-traffic Traceflow is not supported",
 		},
+		{
+			name: "L7 payload is not supported in live-traffic Traceflow",
+			newSpec: &crdv1beta1.TraceflowSpec{
+				LiveTraffic: true,
+				Destination: crdv1beta1.Destination{Namespace: "test-ns", Pod: "test-pod"},
+				Packet: crdv1beta1.Packet{
+					TransportHeader: crdv1beta1.TransportHeader{TCP: &crdv1beta1.TCPHeader{DstPort: 80}},
+					L7Payload:       &crdv1beta1.L7Payload{HTTP: &crdv1beta1.HTTPRequest{}},
+				},
+			},
+			deniedReason: "L7 payload is not supported in live-traffic Traceflow",
+		},
+		{
+			name: "L7 payload must have exactly one protocol",
+			pods: []*v1.Pod{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "test-pod"},
+				},
+			},
+			newSpec: &crdv1beta1.TraceflowSpec{
+				Source: crdv1beta1.Source{
+					Namespace: "test-ns",
+					Pod:       "test-pod",
+				},
+				Packet: crdv1beta1.Packet{
+					TransportHeader: crdv1beta1.TransportHeader{TCP: &crdv1beta1.TCPHeader{DstPort: 443}},
+					L7Payload: &crdv1beta1.L7Payload{
+						HTTP: &crdv1beta1.HTTPRequest{},
+						TLS:  &crdv1beta1.TLSClientHello{SNI: "www.foo.com"},
+					},
+				},
+			},
+			deniedReason: "exactly one of HTTP and TLS must be specified in L7 payload",
+		},
+		{
+			name: "L7 payload can only be specified for a TCP packet",
+			pods: []*v1.Pod{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "test-pod"},
+				},
+			},
+			newSpec: &crdv1beta1.TraceflowSpec{
+				Source: crdv1beta1.Source{
+					Namespace: "test-ns",
+					Pod:       "test-pod",
+				},
+				Destination: crdv1beta1.Destination{Namespace: "test-ns", Pod: "test-pod-2"},
+				Packet: crdv1beta1.Packet{
+					TransportHeader: crdv1beta1.TransportHeader{UDP: &crdv1beta1.UDPHeader{DstPort: 53}},
+					L7Payload:       &crdv1beta1.L7Payload{HTTP: &crdv1beta1.HTTPRequest{}},
+				},
+			},
+			deniedReason: "L7 payload can only be specified for a TCP packet",
+		},
+		{
+			name: "Valid request with L7 payload to a Service",
+			pods: []*v1.Pod{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "test-pod"},
+				},
+			},
+			newSpec: &crdv1beta1.TraceflowSpec{
+				Source: crdv1beta1.Source{
+					Namespace: "test-ns",
+					Pod:       "test-pod",
+				},
+				Destination: crdv1beta1.Destination{Namespace: "test-ns", Service: "test-svc"},
+				Packet: crdv1beta1.Packet{
+					L7Payload: &crdv1beta1.L7Payload{TLS: &crdv1beta1.TLSClientHello{SNI: "www.foo.com"}},
+				},
+			},
+			allowed: true,
+		},
+		{
+			name: "Multi-path is not supported in live-traffic Traceflow",
+			newSpec: &crdv1beta1.TraceflowSpec{
+				LiveTraffic: true,
+				Destination: crdv1beta1.Destination{Namespace: "test-ns", Pod: "test-pod"},
+				MultiPath:   true,
+			},
+			deniedReason: "multi-path is not supported in live-traffic Traceflow",
+		},
+		{
+			name: "Destination Service must be specified in multi-path Traceflow",
+			pods: []*v1.Pod{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "test-pod"},
+				},
+			},
+			newSpec: &crdv1beta1.TraceflowSpec{
+				Source: crdv1beta1.Source{
+					Namespace: "test-ns",
+					Pod:       "test-pod",
+				},
+				Destination: crdv1beta1.Destination{Namespace: "test-ns", Pod: "test-pod-2"},
+				MultiPath:   true,
+			},
+			deniedReason: "destination Service must be specified in multi-path Traceflow",
+		},
+		{
+			name: "Schedule is not supported in live-traffic Traceflow",
+			newSpec: &crdv1beta1.TraceflowSpec{
+				LiveTraffic: true,
+				Destination: crdv1beta1.Destination{Namespace: "test-ns", Pod: "test-pod"},
+				Schedule:    &crdv1beta1.TraceflowSchedule{Interval: 60},
+			},
+			deniedReason: "schedule is not supported in live-traffic Traceflow",
+		},
+		{
+			name: "Schedule interval must be larger than the timeout",
+			pods: []*v1.Pod{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "test-pod"},
+				},
+			},
+			newSpec: &crdv1beta1.TraceflowSpec{
+				Source: crdv1beta1.Source{
+					Namespace: "test-ns",
+					Pod:       "test-pod",
+				},
+				Schedule: &crdv1beta1.TraceflowSchedule{Interval: 20},
+			},
+			deniedReason: "schedule interval must be larger than the timeout of the Traceflow",
+		},
+		{
+			name: "Valid request with schedule",
+			pods: []*v1.Pod{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "test-pod"},
+				},
+			},
+			newSpec: &crdv1beta1.TraceflowSpec{
+				Source: crdv1beta1.Source{
+					Namespace: "test-ns",
+					Pod:       "test-pod",
+				},
+				Timeout:  10,
+				Schedule: &crdv1beta1.TraceflowSchedule{Interval: 20},
+			},
+			allowed: true,
+		},
 		{
 			name: "Valid request",
 			pods: []*v1.Pod{
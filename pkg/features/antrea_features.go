@@ -161,6 +161,10 @@ const (
 	// Allow users to allocate Egress IPs from a different subnet from the default Node subnet.
 	EgressSeparateSubnet featuregate.Feature = "EgressSeparateSubnet"
 
+	// alpha: v2.3
+	// Replicate the SNAT conntrack entries of Egress IPs to standby Nodes, to preserve connections on Egress failover.
+	EgressConntrackSync featuregate.Feature = "EgressConntrackSync"
+
 	// alpha: v1.15
 	// Allows users to apply ClusterNetworkPolicy to Kubernetes Nodes.
 	NodeNetworkPolicy featuregate.Feature = "NodeNetworkPolicy"
@@ -219,6 +223,7 @@ var (
 		AdminNetworkPolicy:          {Default: false, PreRelease: featuregate.Alpha},
 		EgressTrafficShaping:        {Default: false, PreRelease: featuregate.Alpha},
 		EgressSeparateSubnet:        {Default: false, PreRelease: featuregate.Alpha},
+		EgressConntrackSync:         {Default: false, PreRelease: featuregate.Alpha},
 		NodeNetworkPolicy:           {Default: false, PreRelease: featuregate.Alpha},
 		L7FlowExporter:              {Default: false, PreRelease: featuregate.Alpha},
 		NodeLatencyMonitor:          {Default: false, PreRelease: featuregate.Alpha},
@@ -253,6 +258,7 @@ var (
 		TrafficControl,
 		EgressTrafficShaping,
 		EgressSeparateSubnet,
+		EgressConntrackSync,
 		NodeNetworkPolicy,
 		L7FlowExporter,
 		NodeLatencyMonitor,
@@ -304,6 +310,7 @@ var (
 		CleanupStaleUDPSvcConntrack: {},
 		EgressTrafficShaping:        {},
 		EgressSeparateSubnet:        {},
+		EgressConntrackSync:         {},
 		NodeNetworkPolicy:           {},
 		L7FlowExporter:              {},
 		NodeLatencyMonitor:          {},
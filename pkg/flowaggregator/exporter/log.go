@@ -28,6 +28,7 @@ import (
 	"antrea.io/antrea/pkg/flowaggregator/flowlogger"
 	"antrea.io/antrea/pkg/flowaggregator/flowrecord"
 	"antrea.io/antrea/pkg/flowaggregator/options"
+	"antrea.io/antrea/pkg/ipfix"
 )
 
 type flowFilter struct {
@@ -64,6 +65,8 @@ func (e *LogExporter) buildFilters() {
 			return registry.NetworkPolicyRuleActionDrop
 		case flowaggregatorconfig.NetworkPolicyRuleActionReject:
 			return registry.NetworkPolicyRuleActionReject
+		case flowaggregatorconfig.NetworkPolicyRuleActionAudit:
+			return ipfix.NetworkPolicyRuleActionAudit
 		default: // invalid case
 			return math.MaxUint8
 		}
@@ -17,6 +17,7 @@ package flowlogger
 import (
 	"github.com/vmware/go-ipfix/pkg/registry"
 
+	"antrea.io/antrea/pkg/ipfix"
 	"antrea.io/antrea/pkg/util/ip"
 )
 
@@ -30,6 +31,8 @@ func PrettyPrintRuleAction(action uint8) string {
 		return "Drop"
 	case registry.NetworkPolicyRuleActionReject:
 		return "Reject"
+	case ipfix.NetworkPolicyRuleActionAudit:
+		return "Audit"
 	default:
 		return "Invalid"
 	}
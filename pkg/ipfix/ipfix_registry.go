@@ -19,6 +19,11 @@ import (
 	ipfixregistry "github.com/vmware/go-ipfix/pkg/registry"
 )
 
+// NetworkPolicyRuleActionAudit is the value of the ingressNetworkPolicyRuleAction and
+// egressNetworkPolicyRuleAction IEs for connections matching Antrea-native policy rules with the
+// Audit action. It extends the values defined by the go-ipfix registry.
+const NetworkPolicyRuleActionAudit = uint8(4)
+
 var _ IPFIXRegistry = new(ipfixRegistry)
 
 // IPFIXRegistry interface is added to facilitate unit testing without involving the code from go-ipfix library.
@@ -16,6 +16,7 @@ package querier
 
 import (
 	"context"
+	"io"
 
 	v1 "k8s.io/api/core/v1"
 	apitypes "k8s.io/apimachinery/pkg/types"
@@ -28,6 +29,7 @@ import (
 	"antrea.io/antrea/pkg/agent/multicast"
 	"antrea.io/antrea/pkg/agent/types"
 	cpv1beta "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	"antrea.io/antrea/pkg/apis/crd/v1alpha1"
 	"antrea.io/antrea/pkg/util/env"
 	"antrea.io/antrea/pkg/version"
 )
@@ -150,4 +152,14 @@ type AgentBGPPolicyInfoQuerier interface {
 	GetBGPPeerStatus(ctx context.Context) ([]bgp.PeerStatus, error)
 	// GetBGPRoutes returns the advertised BGP routes.
 	GetBGPRoutes(ctx context.Context) (map[bgp.Route]bgpcontroller.RouteMetadata, error)
+	// DryRunBGPPolicy evaluates a candidate BGPPolicy on the Node without applying it.
+	DryRunBGPPolicy(policy *v1alpha1.BGPPolicy) *bgpcontroller.BGPPolicyDryRunResult
+}
+
+type AgentPacketCaptureQuerier interface {
+	// GetPacketCaptureFile returns the file of the packets captured on the Node for a complete PacketCapture.
+	GetPacketCaptureFile(name string) (io.ReadCloser, error)
+	// StreamPacketCapture writes the packets captured on the Node for a running PacketCapture to w in the pcapng
+	// format, until the capture stops or ctx is done.
+	StreamPacketCapture(ctx context.Context, name string, w io.Writer) error
 }
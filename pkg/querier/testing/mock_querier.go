@@ -14,11 +14,11 @@
 //
 
 // Code generated by MockGen. DO NOT EDIT.
-// Source: antrea.io/antrea/pkg/querier (interfaces: AgentNetworkPolicyInfoQuerier,AgentMulticastInfoQuerier,EgressQuerier,AgentBGPPolicyInfoQuerier)
+// Source: antrea.io/antrea/pkg/querier (interfaces: AgentNetworkPolicyInfoQuerier,AgentMulticastInfoQuerier,EgressQuerier,AgentBGPPolicyInfoQuerier,AgentPacketCaptureQuerier)
 //
 // Generated by this command:
 //
-//	mockgen -copyright_file hack/boilerplate/license_header.raw.txt -destination pkg/querier/testing/mock_querier.go -package testing antrea.io/antrea/pkg/querier AgentNetworkPolicyInfoQuerier,AgentMulticastInfoQuerier,EgressQuerier,AgentBGPPolicyInfoQuerier
+//	mockgen -copyright_file hack/boilerplate/license_header.raw.txt -destination pkg/querier/testing/mock_querier.go -package testing antrea.io/antrea/pkg/querier AgentNetworkPolicyInfoQuerier,AgentMulticastInfoQuerier,EgressQuerier,AgentBGPPolicyInfoQuerier,AgentPacketCaptureQuerier
 //
 
 // Package testing is a generated GoMock package.
@@ -26,6 +26,7 @@ package testing
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
 
 	bgp "antrea.io/antrea/pkg/agent/bgp"
@@ -34,6 +35,7 @@ import (
 	multicast "antrea.io/antrea/pkg/agent/multicast"
 	types "antrea.io/antrea/pkg/agent/types"
 	v1beta2 "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	v1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
 	querier "antrea.io/antrea/pkg/querier"
 	gomock "go.uber.org/mock/gomock"
 	types0 "k8s.io/apimachinery/pkg/types"
@@ -364,6 +366,20 @@ func (m *MockAgentBGPPolicyInfoQuerier) EXPECT() *MockAgentBGPPolicyInfoQuerierM
 	return m.recorder
 }
 
+// DryRunBGPPolicy mocks base method.
+func (m *MockAgentBGPPolicyInfoQuerier) DryRunBGPPolicy(policy *v1alpha1.BGPPolicy) *bgp0.BGPPolicyDryRunResult {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DryRunBGPPolicy", policy)
+	ret0, _ := ret[0].(*bgp0.BGPPolicyDryRunResult)
+	return ret0
+}
+
+// DryRunBGPPolicy indicates an expected call of DryRunBGPPolicy.
+func (mr *MockAgentBGPPolicyInfoQuerierMockRecorder) DryRunBGPPolicy(policy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DryRunBGPPolicy", reflect.TypeOf((*MockAgentBGPPolicyInfoQuerier)(nil).DryRunBGPPolicy), policy)
+}
+
 // GetBGPPeerStatus mocks base method.
 func (m *MockAgentBGPPolicyInfoQuerier) GetBGPPeerStatus(ctx context.Context) ([]bgp.PeerStatus, error) {
 	m.ctrl.T.Helper()
@@ -410,3 +426,56 @@ func (mr *MockAgentBGPPolicyInfoQuerierMockRecorder) GetBGPRoutes(ctx any) *gomo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBGPRoutes", reflect.TypeOf((*MockAgentBGPPolicyInfoQuerier)(nil).GetBGPRoutes), ctx)
 }
+
+// MockAgentPacketCaptureQuerier is a mock of AgentPacketCaptureQuerier interface.
+type MockAgentPacketCaptureQuerier struct {
+	ctrl     *gomock.Controller
+	recorder *MockAgentPacketCaptureQuerierMockRecorder
+	isgomock struct{}
+}
+
+// MockAgentPacketCaptureQuerierMockRecorder is the mock recorder for MockAgentPacketCaptureQuerier.
+type MockAgentPacketCaptureQuerierMockRecorder struct {
+	mock *MockAgentPacketCaptureQuerier
+}
+
+// NewMockAgentPacketCaptureQuerier creates a new mock instance.
+func NewMockAgentPacketCaptureQuerier(ctrl *gomock.Controller) *MockAgentPacketCaptureQuerier {
+	mock := &MockAgentPacketCaptureQuerier{ctrl: ctrl}
+	mock.recorder = &MockAgentPacketCaptureQuerierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAgentPacketCaptureQuerier) EXPECT() *MockAgentPacketCaptureQuerierMockRecorder {
+	return m.recorder
+}
+
+// GetPacketCaptureFile mocks base method.
+func (m *MockAgentPacketCaptureQuerier) GetPacketCaptureFile(name string) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPacketCaptureFile", name)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPacketCaptureFile indicates an expected call of GetPacketCaptureFile.
+func (mr *MockAgentPacketCaptureQuerierMockRecorder) GetPacketCaptureFile(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPacketCaptureFile", reflect.TypeOf((*MockAgentPacketCaptureQuerier)(nil).GetPacketCaptureFile), name)
+}
+
+// StreamPacketCapture mocks base method.
+func (m *MockAgentPacketCaptureQuerier) StreamPacketCapture(ctx context.Context, name string, w io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamPacketCapture", ctx, name, w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamPacketCapture indicates an expected call of StreamPacketCapture.
+func (mr *MockAgentPacketCaptureQuerierMockRecorder) StreamPacketCapture(ctx, name, w any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamPacketCapture", reflect.TypeOf((*MockAgentPacketCaptureQuerier)(nil).StreamPacketCapture), ctx, name, w)
+}
@@ -0,0 +1,171 @@
+// Copyright 2024 Antrea Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pcapng
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/gopacket/gopacket"
+	"github.com/gopacket/gopacket/layers"
+	"github.com/gopacket/gopacket/pcapgo"
+)
+
+// File is a pcapng file captured on a Node.
+type File struct {
+	NodeName string
+	Reader   io.Reader
+}
+
+type nodeInterface struct {
+	nodeName string
+	index    int
+}
+
+// Writer writes the packets captured on multiple Nodes to a single pcapng file. The interfaces of
+// all the Nodes are kept in the file, with the Node they were captured on as their comment, and
+// each packet is still associated with the interface it was captured on.
+type Writer struct {
+	output     io.Writer
+	snapLength uint32
+	writer     *pcapgo.NgWriter
+	// interfaceIDs maps the interface indexes in the file of each Node to the interface indexes in
+	// the written file.
+	interfaceIDs map[nodeInterface]int
+}
+
+// NewWriter returns a Writer writing to output. snapLength is only used for the interface of the
+// file written when no packet is written at all.
+func NewWriter(output io.Writer, snapLength uint32) *Writer {
+	return &Writer{output: output, snapLength: snapLength, interfaceIDs: map[nodeInterface]int{}}
+}
+
+// addInterface adds an interface of a Node to the written file. The interfaces are added as they
+// are used, as a pcapng file can declare interfaces after some packets.
+func (w *Writer) addInterface(nodeName string, ngInterface pcapgo.NgInterface) (int, error) {
+	ngInterface.Comment = nodeName
+	// The writer always writes timestamps in nanoseconds, while statistics are specific to the original file.
+	ngInterface.TimestampResolution = pcapgo.DefaultNgInterface.TimestampResolution
+	ngInterface.TimestampOffset = 0
+	ngInterface.Statistics = pcapgo.NgInterfaceStatistics{}
+	if w.writer == nil {
+		var err error
+		w.writer, err = pcapgo.NewNgWriterInterface(w.output, ngInterface, pcapgo.DefaultNgWriterOptions)
+		return 0, err
+	}
+	return w.writer.AddInterface(ngInterface)
+}
+
+// WritePacket writes a packet captured on a Node. ngInterface is the interface the packet was
+// captured on, whose index in the file of the Node is ci.InterfaceIndex.
+func (w *Writer) WritePacket(nodeName string, ngInterface pcapgo.NgInterface, ci gopacket.CaptureInfo, data []byte) error {
+	key := nodeInterface{nodeName: nodeName, index: ci.InterfaceIndex}
+	id, ok := w.interfaceIDs[key]
+	if !ok {
+		var err error
+		if id, err = w.addInterface(nodeName, ngInterface); err != nil {
+			return fmt.Errorf("couldn't add interface to the pcap writer: %w", err)
+		}
+		w.interfaceIDs[key] = id
+	}
+	ci.InterfaceIndex = id
+	if err := w.writer.WritePacket(ci, data); err != nil {
+		return fmt.Errorf("couldn't write packets: %w", err)
+	}
+	return nil
+}
+
+// Flush writes the buffered packets to the output. If no packet has been written, a valid file
+// without packets is written.
+func (w *Writer) Flush() error {
+	if w.writer == nil {
+		ngInterface := pcapgo.DefaultNgInterface
+		ngInterface.SnapLength = w.snapLength
+		ngInterface.LinkType = layers.LinkTypeEthernet
+		var err error
+		if w.writer, err = pcapgo.NewNgWriterInterface(w.output, ngInterface, pcapgo.DefaultNgWriterOptions); err != nil {
+			return fmt.Errorf("couldn't initialize a pcap writer: %w", err)
+		}
+	}
+	return w.writer.Flush()
+}
+
+// fileReader reads the packets of a File one by one, keeping the next packet to merge.
+type fileReader struct {
+	nodeName string
+	reader   *pcapgo.NgReader
+	data     []byte
+	ci       gopacket.CaptureInfo
+}
+
+func (r *fileReader) next() (bool, error) {
+	data, ci, err := r.reader.ReadPacketData()
+	if errors.Is(err, io.EOF) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to read packets captured on Node %s: %w", r.nodeName, err)
+	}
+	r.data, r.ci = data, ci
+	return true, nil
+}
+
+// MergeFiles merges the pcapng files captured on multiple Nodes into a single pcapng file written
+// to output, in which packets are ordered by their timestamps.
+func MergeFiles(output io.Writer, files []File, snapLength uint32) error {
+	var readers []*fileReader
+	for _, file := range files {
+		reader, err := pcapgo.NewNgReader(file.Reader, pcapgo.DefaultNgReaderOptions)
+		if err != nil {
+			return fmt.Errorf("failed to read packets captured on Node %s: %w", file.NodeName, err)
+		}
+		r := &fileReader{nodeName: file.NodeName, reader: reader}
+		ok, err := r.next()
+		if err != nil {
+			return err
+		}
+		if ok {
+			readers = append(readers, r)
+		}
+	}
+
+	writer := NewWriter(output, snapLength)
+	for len(readers) > 0 {
+		// The number of files is the number of Nodes involved in the capture, so a linear scan is good enough.
+		earliest := 0
+		for i, r := range readers {
+			if r.ci.Timestamp.Before(readers[earliest].ci.Timestamp) {
+				earliest = i
+			}
+		}
+		r := readers[earliest]
+		ngInterface, err := r.reader.Interface(r.ci.InterfaceIndex)
+		if err != nil {
+			return fmt.Errorf("invalid interface for packets captured on Node %s: %w", r.nodeName, err)
+		}
+		if err := writer.WritePacket(r.nodeName, ngInterface, r.ci, r.data); err != nil {
+			return err
+		}
+		ok, err := r.next()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			readers = append(readers[:earliest], readers[earliest+1:]...)
+		}
+	}
+	return writer.Flush()
+}
@@ -0,0 +1,118 @@
+// Copyright 2024 Antrea Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pcapng
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/gopacket/gopacket"
+	"github.com/gopacket/gopacket/layers"
+	"github.com/gopacket/gopacket/pcapgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testPacket struct {
+	interfaceIndex int
+	timestamp      time.Time
+	data           []byte
+}
+
+// writeTestPacketFile generates a pcapng file with one interface per Pod.
+func writeTestPacketFile(t *testing.T, pods []string, packets []testPacket) []byte {
+	var buffer bytes.Buffer
+	newNgInterface := func(pod string) pcapgo.NgInterface {
+		ngInterface := pcapgo.DefaultNgInterface
+		ngInterface.LinkType = layers.LinkTypeEthernet
+		ngInterface.Description = pod
+		return ngInterface
+	}
+	writer, err := pcapgo.NewNgWriterInterface(&buffer, newNgInterface(pods[0]), pcapgo.DefaultNgWriterOptions)
+	require.NoError(t, err)
+	for _, pod := range pods[1:] {
+		_, err := writer.AddInterface(newNgInterface(pod))
+		require.NoError(t, err)
+	}
+	for _, packet := range packets {
+		ci := gopacket.CaptureInfo{
+			Timestamp:      packet.timestamp,
+			CaptureLength:  len(packet.data),
+			Length:         len(packet.data),
+			InterfaceIndex: packet.interfaceIndex,
+		}
+		require.NoError(t, writer.WritePacket(ci, packet.data))
+	}
+	require.NoError(t, writer.Flush())
+	return buffer.Bytes()
+}
+
+func TestMergeFiles(t *testing.T) {
+	start := time.Unix(1700000000, 0)
+	node1File := writeTestPacketFile(t, []string{"default/client"}, []testPacket{
+		{timestamp: start, data: []byte{1}},
+		{timestamp: start.Add(2 * time.Millisecond), data: []byte{3}},
+	})
+	node2File := writeTestPacketFile(t, []string{"default/server-1", "default/server-2"}, []testPacket{
+		{interfaceIndex: 1, timestamp: start.Add(time.Millisecond), data: []byte{2}},
+		{interfaceIndex: 0, timestamp: start.Add(3 * time.Millisecond), data: []byte{4}},
+	})
+	emptyFile := writeTestPacketFile(t, []string{"default/server-3"}, nil)
+
+	var output bytes.Buffer
+	err := MergeFiles(&output, []File{
+		{NodeName: "node-1", Reader: bytes.NewReader(node1File)},
+		{NodeName: "node-2", Reader: bytes.NewReader(node2File)},
+		{NodeName: "node-3", Reader: bytes.NewReader(emptyFile)},
+	}, 65536)
+	require.NoError(t, err)
+
+	reader, err := pcapgo.NewNgReader(&output, pcapgo.DefaultNgReaderOptions)
+	require.NoError(t, err)
+	var data []byte
+	var descriptions, comments []string
+	for {
+		packetData, ci, err := reader.ReadPacketData()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		data = append(data, packetData...)
+		ngInterface, err := reader.Interface(ci.InterfaceIndex)
+		require.NoError(t, err)
+		descriptions = append(descriptions, ngInterface.Description)
+		comments = append(comments, ngInterface.Comment)
+	}
+	// Packets are ordered by their timestamps across files, and keep the Pod and Node they were captured on.
+	assert.Equal(t, []byte{1, 2, 3, 4}, data)
+	assert.Equal(t, []string{"default/client", "default/server-2", "default/client", "default/server-1"}, descriptions)
+	assert.Equal(t, []string{"node-1", "node-2", "node-1", "node-2"}, comments)
+	// Interfaces without packets are not kept.
+	assert.Equal(t, 3, reader.NInterfaces())
+}
+
+func TestMergeFilesWithoutPackets(t *testing.T) {
+	var output bytes.Buffer
+	err := MergeFiles(&output, []File{
+		{NodeName: "node-1", Reader: bytes.NewReader(writeTestPacketFile(t, []string{"default/client"}, nil))},
+	}, 65536)
+	require.NoError(t, err)
+	reader, err := pcapgo.NewNgReader(&output, pcapgo.DefaultNgReaderOptions)
+	require.NoError(t, err)
+	_, _, err = reader.ReadPacketData()
+	assert.Equal(t, io.EOF, err)
+}
@@ -0,0 +1,197 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronField describes the range of a field of a cron expression.
+type cronField struct {
+	name     string
+	min, max int
+	// names maps the names accepted in the field to their values.
+	names map[string]int
+}
+
+var (
+	minuteField = cronField{name: "minute", min: 0, max: 59}
+	hourField   = cronField{name: "hour", min: 0, max: 23}
+	domField    = cronField{name: "day of month", min: 1, max: 31}
+	monthField  = cronField{name: "month", min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	// Both 0 and 7 are Sunday.
+	dowField = cronField{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+
+	cronDescriptors = map[string]string{
+		"@yearly":   "0 0 1 1 *",
+		"@annually": "0 0 1 1 *",
+		"@monthly":  "0 0 1 * *",
+		"@weekly":   "0 0 * * 0",
+		"@daily":    "0 0 * * *",
+		"@midnight": "0 0 * * *",
+		"@hourly":   "0 * * * *",
+	}
+)
+
+// The maximum number of years searched for the next time matching a cron expression. Some expressions never match,
+// e.g. "0 0 30 2 *".
+const maxSearchYears = 5
+
+// Cron is a parsed cron expression in the standard 5-field format: "minute hour day-of-month month day-of-week".
+type Cron struct {
+	// Each field is a bit set of the values it matches.
+	minute, hour, dom, month, dow uint64
+	// Whether day-of-month or day-of-week is "*". If both are restricted, a day matches if either of them matches,
+	// following the convention of cron.
+	domStar, dowStar bool
+}
+
+// ParseCron parses a cron expression. Each field accepts "*", values, ranges ("1-5"), steps ("*/15", "1-30/5") and
+// lists of them separated by commas. Months and days of week also accept their three-letter English names. The
+// descriptors "@yearly", "@annually", "@monthly", "@weekly", "@daily", "@midnight" and "@hourly" are also accepted.
+func ParseCron(expr string) (*Cron, error) {
+	expr = strings.TrimSpace(expr)
+	if descriptor, ok := cronDescriptors[strings.ToLower(expr)]; ok {
+		expr = descriptor
+	}
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("expected 5 fields in cron expression %q, found %d", expr, len(fields))
+	}
+	c := &Cron{}
+	var err error
+	if c.minute, _, err = parseCronField(fields[0], minuteField); err != nil {
+		return nil, err
+	}
+	if c.hour, _, err = parseCronField(fields[1], hourField); err != nil {
+		return nil, err
+	}
+	if c.dom, c.domStar, err = parseCronField(fields[2], domField); err != nil {
+		return nil, err
+	}
+	if c.month, _, err = parseCronField(fields[3], monthField); err != nil {
+		return nil, err
+	}
+	if c.dow, c.dowStar, err = parseCronField(fields[4], dowField); err != nil {
+		return nil, err
+	}
+	// 7 is an alias of Sunday.
+	if c.dow&(1<<7) != 0 {
+		c.dow |= 1
+	}
+	return c, nil
+}
+
+// parseCronField parses a field of a cron expression and returns the bit set of the values it matches, and whether
+// the field is "*".
+func parseCronField(field string, f cronField) (uint64, bool, error) {
+	var bits uint64
+	for _, item := range strings.Split(field, ",") {
+		rangeStr, stepStr, hasStep := strings.Cut(item, "/")
+		step := 1
+		if hasStep {
+			var err error
+			step, err = strconv.Atoi(stepStr)
+			if err != nil || step <= 0 {
+				return 0, false, fmt.Errorf("invalid step %q in %s field %q", stepStr, f.name, field)
+			}
+		}
+		var start, end int
+		if rangeStr == "*" {
+			start, end = f.min, f.max
+		} else {
+			startStr, endStr, isRange := strings.Cut(rangeStr, "-")
+			var err error
+			if start, err = parseCronValue(startStr, f); err != nil {
+				return 0, false, err
+			}
+			end = start
+			if isRange {
+				if end, err = parseCronValue(endStr, f); err != nil {
+					return 0, false, err
+				}
+			} else if hasStep {
+				// "n/step" means from n to the max value with the step.
+				end = f.max
+			}
+			if start > end {
+				return 0, false, fmt.Errorf("invalid range %q in %s field %q", rangeStr, f.name, field)
+			}
+		}
+		for i := start; i <= end; i += step {
+			bits |= 1 << i
+		}
+	}
+	return bits, field == "*", nil
+}
+
+func parseCronValue(s string, f cronField) (int, error) {
+	if v, ok := f.names[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil || v < f.min || v > f.max {
+		return 0, fmt.Errorf("invalid value %q in %s field, must be in the range %d-%d", s, f.name, f.min, f.max)
+	}
+	return v, nil
+}
+
+// Next returns the earliest time matching the cron expression that is after the given time. The expression is
+// evaluated in the location of the given time. It returns the zero time if no time matches within 5 years.
+func (c *Cron) Next(t time.Time) time.Time {
+	loc := t.Location()
+	// Start from the next whole minute.
+	t = t.Add(time.Minute - time.Duration(t.Second())*time.Second - time.Duration(t.Nanosecond()))
+	yearLimit := t.Year() + maxSearchYears
+	for t.Year() <= yearLimit {
+		if c.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !c.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if c.hour&(1<<uint(t.Hour())) == 0 {
+			// Add the duration instead of constructing the time from the wall clock, which could go backwards when
+			// the clock is set back at the end of daylight saving time.
+			t = t.Add(time.Duration(60-t.Minute()) * time.Minute)
+			continue
+		}
+		if c.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+func (c *Cron) dayMatches(t time.Time) bool {
+	domMatches := c.dom&(1<<uint(t.Day())) != 0
+	dowMatches := c.dow&(1<<uint(t.Weekday())) != 0
+	if c.domStar || c.dowStar {
+		return domMatches && dowMatches
+	}
+	return domMatches || dowMatches
+}
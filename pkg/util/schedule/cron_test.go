@@ -0,0 +1,131 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCron(t *testing.T) {
+	tests := []struct {
+		expr        string
+		expectedErr string
+	}{
+		{expr: "* * * * *"},
+		{expr: "0 22 * * 1-5"},
+		{expr: "*/15 0-6,22-23 1,15 jan-mar MON-FRI"},
+		{expr: "30 2 * * 7"},
+		{expr: "@daily"},
+		{expr: "0 0 * *", expectedErr: "expected 5 fields"},
+		{expr: "60 * * * *", expectedErr: "invalid value \"60\" in minute field"},
+		{expr: "* 5-1 * * *", expectedErr: "invalid range \"5-1\" in hour field"},
+		{expr: "*/0 * * * *", expectedErr: "invalid step \"0\" in minute field"},
+		{expr: "* * 0 * *", expectedErr: "invalid value \"0\" in day of month field"},
+		{expr: "* * * foo *", expectedErr: "invalid value \"foo\" in month field"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			_, err := ParseCron(tt.expr)
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
+
+func TestCronNext(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	tests := []struct {
+		name     string
+		expr     string
+		from     time.Time
+		expected time.Time
+	}{
+		{
+			name:     "every minute",
+			expr:     "* * * * *",
+			from:     time.Date(2024, 5, 1, 10, 0, 30, 0, time.UTC),
+			expected: time.Date(2024, 5, 1, 10, 1, 0, 0, time.UTC),
+		},
+		{
+			name:     "exact match is excluded",
+			expr:     "0 22 * * *",
+			from:     time.Date(2024, 5, 1, 22, 0, 0, 0, time.UTC),
+			expected: time.Date(2024, 5, 2, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "weekdays",
+			expr:     "0 22 * * mon-fri",
+			from:     time.Date(2024, 5, 3, 23, 0, 0, 0, time.UTC), // Friday
+			expected: time.Date(2024, 5, 6, 22, 0, 0, 0, time.UTC), // Monday
+		},
+		{
+			name:     "Sunday as 7",
+			expr:     "0 1 * * 7",
+			from:     time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+			expected: time.Date(2024, 5, 5, 1, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "day of month or day of week",
+			expr:     "0 0 15 * sat",
+			from:     time.Date(2024, 5, 5, 0, 0, 0, 0, time.UTC),
+			expected: time.Date(2024, 5, 11, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "steps",
+			expr:     "10/20 * * * *",
+			from:     time.Date(2024, 5, 1, 10, 31, 0, 0, time.UTC),
+			expected: time.Date(2024, 5, 1, 10, 50, 0, 0, time.UTC),
+		},
+		{
+			name:     "leap day",
+			expr:     "0 0 29 2 *",
+			from:     time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			expected: time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "never",
+			expr: "0 0 30 2 *",
+			from: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "skipped hour in daylight saving time",
+			expr:     "30 2 * * *",
+			from:     time.Date(2024, 3, 10, 0, 0, 0, 0, newYork),
+			expected: time.Date(2024, 3, 11, 2, 30, 0, 0, newYork),
+		},
+		{
+			name:     "repeated hour at the end of daylight saving time",
+			expr:     "30 * * * *",
+			from:     time.Date(2024, 11, 3, 5, 40, 0, 0, time.UTC).In(newYork), // 01:40 EDT
+			expected: time.Date(2024, 11, 3, 6, 30, 0, 0, time.UTC),             // 01:30 EST
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := ParseCron(tt.expr)
+			require.NoError(t, err)
+			next := c.Next(tt.from)
+			assert.True(t, tt.expected.Equal(next), "expected %v, got %v", tt.expected, next)
+		})
+	}
+}
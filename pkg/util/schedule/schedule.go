@@ -0,0 +1,132 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule
+
+import (
+	"fmt"
+	"time"
+	// Embed the time zone database, as it may not be available in the container images.
+	_ "time/tzdata"
+)
+
+// The maximum number of window boundaries checked when looking for the next transition of a Schedule. Overlapping
+// windows may produce boundaries at which the Schedule stays active.
+const maxBoundaries = 1000
+
+// Window is a recurring time window, which starts at the times matching a cron expression and lasts for a duration.
+type Window struct {
+	start    *Cron
+	duration time.Duration
+}
+
+// ParseWindow parses a Window from a cron expression and a duration string, e.g. "0 22 * * 1-5" and "2h".
+func ParseWindow(start, duration string) (*Window, error) {
+	c, err := ParseCron(start)
+	if err != nil {
+		return nil, err
+	}
+	d, err := time.ParseDuration(duration)
+	if err != nil {
+		return nil, fmt.Errorf("invalid duration %q: %w", duration, err)
+	}
+	if d < time.Minute {
+		return nil, fmt.Errorf("invalid duration %q: must be at least 1m", duration)
+	}
+	return &Window{start: c, duration: d}, nil
+}
+
+// active returns whether the window is open at the given time, and the time at which it opened most recently.
+func (w *Window) active(t time.Time) (bool, time.Time) {
+	// The earliest start after t - duration is the only start which could cover t and end the earliest.
+	start := w.start.Next(t.Add(-w.duration))
+	if start.IsZero() || start.After(t) {
+		return false, time.Time{}
+	}
+	return true, start
+}
+
+// nextBoundary returns the earliest time after the given time at which the window may open or close.
+func (w *Window) nextBoundary(t time.Time) time.Time {
+	next := w.start.Next(t)
+	if active, start := w.active(t); active {
+		if end := start.Add(w.duration); next.IsZero() || end.Before(next) {
+			next = end
+		}
+	}
+	return next
+}
+
+// Schedule is a set of Windows evaluated in a time zone. It is active when any of its Windows is open.
+type Schedule struct {
+	windows  []*Window
+	location *time.Location
+}
+
+// New creates a Schedule from Windows and an IANA time zone name. An empty time zone means UTC.
+func New(timeZone string, windows ...*Window) (*Schedule, error) {
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time zone %q: %w", timeZone, err)
+	}
+	return &Schedule{windows: windows, location: location}, nil
+}
+
+// FromWindows creates a Schedule from the specs of Windows and an IANA time zone name. spec returns the cron expression
+// and the duration string of a Window, which allows parsing the schedules of the different API versions.
+func FromWindows[W any](timeZone string, specs []W, spec func(W) (start, duration string)) (*Schedule, error) {
+	windows := make([]*Window, 0, len(specs))
+	for _, s := range specs {
+		window, err := ParseWindow(spec(s))
+		if err != nil {
+			return nil, err
+		}
+		windows = append(windows, window)
+	}
+	return New(timeZone, windows...)
+}
+
+// Active returns whether the Schedule is active at the given time.
+func (s *Schedule) Active(t time.Time) bool {
+	t = t.In(s.location)
+	for _, w := range s.windows {
+		if active, _ := w.active(t); active {
+			return true
+		}
+	}
+	return false
+}
+
+// State returns whether the Schedule is active at the given time, and the earliest time after it at which the
+// Schedule is activated or deactivated. The returned time is zero if no transition is found.
+func (s *Schedule) State(t time.Time) (bool, time.Time) {
+	active := s.Active(t)
+	boundary := t.In(s.location)
+	for i := 0; i < maxBoundaries; i++ {
+		var next time.Time
+		for _, w := range s.windows {
+			if b := w.nextBoundary(boundary); !b.IsZero() && (next.IsZero() || b.Before(next)) {
+				next = b
+			}
+		}
+		if next.IsZero() {
+			break
+		}
+		if s.Active(next) != active {
+			return active, next
+		}
+		boundary = next
+	}
+	return active, time.Time{}
+}
@@ -0,0 +1,145 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseWindow(t *testing.T) {
+	_, err := ParseWindow("0 22 * * *", "2h")
+	assert.NoError(t, err)
+	_, err = ParseWindow("0 22 * *", "2h")
+	assert.ErrorContains(t, err, "expected 5 fields")
+	_, err = ParseWindow("0 22 * * *", "2")
+	assert.ErrorContains(t, err, "invalid duration \"2\"")
+	_, err = ParseWindow("0 22 * * *", "30s")
+	assert.ErrorContains(t, err, "must be at least 1m")
+}
+
+func TestNewSchedule(t *testing.T) {
+	_, err := New("")
+	assert.NoError(t, err)
+	_, err = New("Europe/Paris")
+	assert.NoError(t, err)
+	_, err = New("Mars/Olympus_Mons")
+	assert.ErrorContains(t, err, "invalid time zone \"Mars/Olympus_Mons\"")
+}
+
+func TestFromWindows(t *testing.T) {
+	spec := func(w [2]string) (string, string) { return w[0], w[1] }
+	s, err := FromWindows("Europe/Paris", [][2]string{{"0 22 * * *", "2h"}, {"0 8 * * 1-5", "1h"}}, spec)
+	require.NoError(t, err)
+	assert.Len(t, s.windows, 2)
+	assert.Equal(t, "Europe/Paris", s.location.String())
+	_, err = FromWindows("", [][2]string{{"0 22 * * *", "2h"}, {"0 22 * *", "2h"}}, spec)
+	assert.ErrorContains(t, err, "expected 5 fields")
+	_, err = FromWindows("Mars/Olympus_Mons", [][2]string{{"0 22 * * *", "2h"}}, spec)
+	assert.ErrorContains(t, err, "invalid time zone \"Mars/Olympus_Mons\"")
+}
+
+func mustParseWindow(t *testing.T, start, duration string) *Window {
+	w, err := ParseWindow(start, duration)
+	require.NoError(t, err)
+	return w
+}
+
+func TestScheduleState(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	require.NoError(t, err)
+	tests := []struct {
+		name             string
+		timeZone         string
+		windows          [][2]string
+		now              time.Time
+		expectedActive   bool
+		expectedNextTime time.Time
+	}{
+		{
+			name:             "before window",
+			windows:          [][2]string{{"0 22 * * *", "2h"}},
+			now:              time.Date(2024, 5, 1, 21, 0, 0, 0, time.UTC),
+			expectedActive:   false,
+			expectedNextTime: time.Date(2024, 5, 1, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			name:             "window start",
+			windows:          [][2]string{{"0 22 * * *", "2h"}},
+			now:              time.Date(2024, 5, 1, 22, 0, 0, 0, time.UTC),
+			expectedActive:   true,
+			expectedNextTime: time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:             "window end",
+			windows:          [][2]string{{"0 22 * * *", "2h"}},
+			now:              time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC),
+			expectedActive:   false,
+			expectedNextTime: time.Date(2024, 5, 2, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			name:             "time zone",
+			timeZone:         "Europe/Paris",
+			windows:          [][2]string{{"0 22 * * *", "2h"}},
+			now:              time.Date(2024, 5, 1, 20, 30, 0, 0, time.UTC),
+			expectedActive:   true,
+			expectedNextTime: time.Date(2024, 5, 2, 0, 0, 0, 0, paris),
+		},
+		{
+			name:             "overlapping starts",
+			windows:          [][2]string{{"0 * * * *", "90m"}},
+			now:              time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC),
+			expectedActive:   true,
+			expectedNextTime: time.Time{},
+		},
+		{
+			name:             "adjacent windows",
+			windows:          [][2]string{{"0 8 * * *", "4h"}, {"0 12 * * *", "2h"}, {"0 20 * * *", "1h"}},
+			now:              time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC),
+			expectedActive:   true,
+			expectedNextTime: time.Date(2024, 5, 1, 14, 0, 0, 0, time.UTC),
+		},
+		{
+			name:             "weekend",
+			windows:          [][2]string{{"0 0 * * sat", "48h"}},
+			now:              time.Date(2024, 5, 5, 12, 0, 0, 0, time.UTC), // Sunday
+			expectedActive:   true,
+			expectedNextTime: time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:             "never",
+			windows:          [][2]string{{"0 0 30 2 *", "1h"}},
+			now:              time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+			expectedActive:   false,
+			expectedNextTime: time.Time{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var windows []*Window
+			for _, w := range tt.windows {
+				windows = append(windows, mustParseWindow(t, w[0], w[1]))
+			}
+			s, err := New(tt.timeZone, windows...)
+			require.NoError(t, err)
+			active, next := s.State(tt.now)
+			assert.Equal(t, tt.expectedActive, active)
+			assert.True(t, tt.expectedNextTime.Equal(next), "expected %v, got %v", tt.expectedNextTime, next)
+		})
+	}
+}
@@ -109,3 +109,50 @@ func upload(address string, path string, config *ssh.ClientConfig, file io.Reade
 	klog.InfoS("Successfully uploaded file to path", "filePath", path)
 	return nil
 }
+
+type Downloader interface {
+	// Download downloads a file from the target sftp address using ssh config, and writes its content to outputFile.
+	Download(url string, fileName string, config *ssh.ClientConfig, outputFile io.Writer) error
+}
+
+type sftpDownloader struct {
+}
+
+func NewDownloader() Downloader {
+	return &sftpDownloader{}
+}
+
+func (downloader *sftpDownloader) Download(url string, fileName string, config *ssh.ClientConfig, outputFile io.Writer) error {
+	// url should be like: 10.92.23.154:22/path or sftp://10.92.23.154:22/path
+	parsedURL, err := ParseSFTPUploadUrl(url)
+	if err != nil {
+		return err
+	}
+	return download(parsedURL.Host, path.Join(parsedURL.Path, fileName), config, outputFile)
+}
+
+func download(address string, path string, config *ssh.ClientConfig, file io.Writer) error {
+	conn, err := ssh.Dial("tcp", address, config)
+	if err != nil {
+		return fmt.Errorf("error when connecting to the file server: %w", err)
+	}
+	sftpClient, err := sftp.NewClient(conn)
+	if err != nil {
+		return fmt.Errorf("error when setting up sftp client: %w", err)
+	}
+	defer func() {
+		if err := sftpClient.Close(); err != nil {
+			klog.ErrorS(err, "Error when closing sftp client")
+		}
+	}()
+	sourceFile, err := sftpClient.Open(path)
+	if err != nil {
+		return fmt.Errorf("error when opening source file on the remote server: %w", err)
+	}
+	defer sourceFile.Close()
+	if read, err := io.Copy(file, sourceFile); err != nil {
+		return fmt.Errorf("error encountered after copying %d bytes from source file: %w", read, err)
+	}
+	klog.InfoS("Successfully downloaded file from path", "filePath", path)
+	return nil
+}
@@ -65,6 +65,9 @@ func TestL7NetworkPolicy(t *testing.T) {
 	t.Run("TLS", func(t *testing.T) {
 		testL7NetworkPolicyTLS(t, data)
 	})
+	t.Run("Kafka", func(t *testing.T) {
+		testL7NetworkPolicyKafka(t, data)
+	})
 	t.Run("Logging", func(t *testing.T) {
 		testL7NetworkPolicyLogging(t, data)
 	})
@@ -201,6 +204,33 @@ func probeL7NetworkPolicyTLS(t *testing.T, data *TestData, clientPodName string,
 	}
 }
 
+// probeL7NetworkPolicyKafka sends an ApiVersions request followed by the given Kafka request over the same connection,
+// and then keeps sending data over the connection, which fails once the connection is reset by the application-aware
+// engine.
+func probeL7NetworkPolicyKafka(t *testing.T, data *TestData, clientPodName string, serverIPs []*net.IP, request string, canAccess bool) {
+	// ApiVersions v0 request with correlation ID 1 and client ID "c".
+	apiVersionsRequest := `\x00\x00\x00\x0b\x00\x12\x00\x00\x00\x00\x00\x01\x00\x01c`
+	for _, serverIP := range serverIPs {
+		// For IPv6, there is an issue that reject packet cannot be generated by Suricata and sent back to client.
+		if serverIP.To4() == nil {
+			continue
+		}
+		script := fmt.Sprintf("exec 3<>/dev/tcp/%s/9092 && printf '%s' >&3 && sleep 1 && printf '%s' >&3 && sleep 1 && printf '%s' >&3 && sleep 1 && printf '%s' >&3",
+			serverIP.String(), apiVersionsRequest, request, request, request)
+		assert.Eventually(t, func() bool {
+			stdout, stderr, err := data.RunCommandFromPod(data.testNamespace, clientPodName, agnhostContainerName, []string{"bash", "-c", script})
+			if canAccess && err != nil {
+				t.Logf("Failed to send Kafka requests to %s: %v\nStdout: %s\nStderr: %s\n", serverIP, err, stdout, stderr)
+				return false
+			} else if !canAccess && err == nil {
+				t.Logf("Expected the Kafka requests to %s to be rejected, but they were sent", serverIP)
+				return false
+			}
+			return true
+		}, 10*time.Second, time.Second)
+	}
+}
+
 func testL7NetworkPolicyHTTP(t *testing.T, data *TestData) {
 	clientPodName := "test-l7-http-client-selected"
 	clientPodLabels := map[string]string{"test-l7-http-e2e": "client"}
@@ -289,6 +319,48 @@ func testL7NetworkPolicyHTTP(t *testing.T, data *TestData) {
 	})
 }
 
+func testL7NetworkPolicyKafka(t *testing.T, data *TestData) {
+	clientPodName := "test-l7-kafka-client-selected"
+	clientPodLabels := map[string]string{"test-l7-kafka-e2e": "client"}
+
+	// Create a client Pod which will be selected by test L7 NetworkPolices.
+	require.NoError(t, NewPodBuilder(clientPodName, data.testNamespace, agnhostImage).OnNode(nodeName(0)).WithLabels(clientPodLabels).Create(data))
+	_, err := data.podWaitForIPs(defaultTimeout, clientPodName, data.testNamespace)
+	require.NoError(t, err, "Expected IP for Pod '%s'", clientPodName)
+
+	// The requests are matched by the application-aware engine only, so the server just needs to accept connections.
+	serverPodName := "test-l7-kafka-server"
+	serverPodLabels := map[string]string{"test-l7-kafka-e2e": "server"}
+	cmd := []string{"nc", "-lk", "9092"}
+	require.NoError(t, NewPodBuilder(serverPodName, data.testNamespace, ToolboxImage).OnNode(nodeName(0)).WithCommand(cmd).WithLabels(serverPodLabels).Create(data))
+	podIPs, err := data.podWaitForIPs(defaultTimeout, serverPodName, data.testNamespace)
+	require.NoError(t, err, "Expected IP for Pod '%s'", serverPodName)
+	serverIPs := podIPs.AsSlice()
+
+	// Metadata v1 request for topic "orders", and Produce v3 request without transactional ID for topic "orders".
+	metadataRequest := `\x00\x00\x00\x17\x00\x03\x00\x01\x00\x00\x00\x02\x00\x01c\x00\x00\x00\x01\x00\x06orders`
+	produceRequest := `\x00\x00\x00\x1f\x00\x00\x00\x03\x00\x00\x00\x03\x00\x01c\xff\xff\x00\x01\x00\x00\x03\xe8\x00\x00\x00\x01\x00\x06orders`
+	l7ProtocolAllowsMetadata := []crdv1beta1.L7Protocol{
+		{
+			Kafka: &crdv1beta1.KafkaProtocol{
+				APIKey: crdv1beta1.KafkaAPIKeyMetadata,
+				Topic:  "orders",
+			},
+		},
+	}
+
+	policyAllowMetadata := "test-l7-kafka-allow-metadata"
+	createL7NetworkPolicy(t, data, false, policyAllowMetadata, 1, nil, clientPodLabels, ProtocolTCP, 9092, l7ProtocolAllowsMetadata)
+	time.Sleep(networkPolicyDelay)
+
+	// The ApiVersions request is forwarded without deciding the verdict of the connection, so the connection is
+	// allowed if its next request is a Metadata request, and rejected if its next request is a Produce request.
+	probeL7NetworkPolicyKafka(t, data, clientPodName, serverIPs, metadataRequest, true)
+	probeL7NetworkPolicyKafka(t, data, clientPodName, serverIPs, produceRequest, false)
+
+	data.crdClient.CrdV1beta1().NetworkPolicies(data.testNamespace).Delete(context.TODO(), policyAllowMetadata, metav1.DeleteOptions{})
+}
+
 func testL7NetworkPolicyTLS(t *testing.T, data *TestData) {
 	clientPodName := "test-l7-tls-client-selected"
 	clientPodLabels := map[string]string{"test-l7-tls-e2e": "client"}